@@ -7,7 +7,6 @@ import (
 
 	"github.com/breez/breez/data"
 	"github.com/breez/lightninglib/lnrpc"
-	"github.com/breez/lightninglib/lnwallet"
 	"github.com/golang/protobuf/proto"
 	"golang.org/x/sync/singleflight"
 
@@ -136,10 +135,7 @@ func getRecievePayLimit() (maxReceive int64, maxPay int64, err error) {
 	var maxAllowedToReceive int64
 	var maxAllowedToPay int64
 	for _, b := range channels.Channels {
-		accountMinAmount := b.Capacity / 100
-		if accountMinAmount < int64(lnwallet.DefaultDustLimit()) {
-			accountMinAmount = int64(lnwallet.DefaultDustLimit())
-		}
+		accountMinAmount := channelReserve(b)
 		thisChannelCanReceive := b.RemoteBalance - accountMinAmount
 		if thisChannelCanReceive < 0 {
 			thisChannelCanReceive = 0
@@ -285,6 +281,6 @@ func calculateAccountAndNotify() (*data.Account, error) {
 		return nil, err
 	}
 	saveAccount(accBuf)
-	notificationsChan <- data.NotificationEvent{Type: data.NotificationEvent_ACCOUNT_CHANGED}
+	notify(data.NotificationEvent{Type: data.NotificationEvent_ACCOUNT_CHANGED})
 	return acc, nil
 }
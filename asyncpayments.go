@@ -0,0 +1,128 @@
+package breez
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/breez/breez/data"
+)
+
+const (
+	//paymentStatusBuffer fits every status of a payment so publishing never blocks
+	paymentStatusBuffer = 3
+)
+
+var (
+	paymentStatusMu          sync.Mutex
+	paymentStatuses          = make(map[string]*data.PaymentStatus)
+	paymentStatusSubscribers = make(map[string][]chan *data.PaymentStatus)
+)
+
+// publishPaymentStatus records the payment status and delivers it to the
+// subscribers of the payment, who are unsubscribed once the status is final.
+func publishPaymentStatus(status *data.PaymentStatus) {
+	paymentStatusMu.Lock()
+	defer paymentStatusMu.Unlock()
+	paymentStatuses[status.PaymentHash] = status
+	final := status.Status != data.PaymentStatus_IN_FLIGHT
+	for _, c := range paymentStatusSubscribers[status.PaymentHash] {
+		c <- status
+		if final {
+			close(c)
+		}
+	}
+	if final {
+		delete(paymentStatusSubscribers, status.PaymentHash)
+	}
+	notify(data.NotificationEvent{
+		Type: data.NotificationEvent_PAYMENT_STATUS_CHANGED,
+		Data: []string{status.PaymentHash, status.Status.String(), status.Error},
+	})
+}
+
+/*
+SendPaymentAsync starts paying the payment request and returns its payment hash without waiting for the
+payment to complete. The progress is delivered to the SubscribePaymentStatus subscribers and as
+PAYMENT_STATUS_CHANGED notifications.
+*/
+func SendPaymentAsync(paymentRequest string, amountSatoshi int64) (string, error) {
+	return startAsyncPayment(paymentRequest, func() error {
+		return sendPaymentForRequest(context.Background(), paymentRequest, amountSatoshi, 0)
+	})
+}
+
+// startAsyncPayment publishes the payment as in flight and runs send in the
+// background, publishing its outcome. A payment already in flight or succeeded
+// can't be started again.
+func startAsyncPayment(paymentRequest string, send func() error) (string, error) {
+	decodedReq, err := decodePayReqLocally(paymentRequest)
+	if err != nil {
+		return "", err
+	}
+	paymentHash := decodedReq.PaymentHash
+	inFlight := &data.PaymentStatus{PaymentHash: paymentHash, Status: data.PaymentStatus_IN_FLIGHT}
+	paymentStatusMu.Lock()
+	current, ok := paymentStatuses[paymentHash]
+	if ok && current.Status != data.PaymentStatus_FAILED {
+		paymentStatusMu.Unlock()
+		return "", fmt.Errorf("payment %v is %v", paymentHash, current.Status)
+	}
+	//reserved before publishing so a concurrent call for the same payment fails
+	paymentStatuses[paymentHash] = inFlight
+	paymentStatusMu.Unlock()
+
+	publishPaymentStatus(inFlight)
+	go func() {
+		if err := send(); err != nil {
+			publishPaymentStatus(&data.PaymentStatus{PaymentHash: paymentHash, Status: data.PaymentStatus_FAILED, Error: err.Error()})
+			return
+		}
+		publishPaymentStatus(&data.PaymentStatus{PaymentHash: paymentHash, Status: data.PaymentStatus_SUCCEEDED})
+	}()
+	return paymentHash, nil
+}
+
+/*
+SubscribePaymentStatus returns a channel receiving the status updates of a payment sent by SendPaymentAsync,
+starting with its current status. The channel is closed after the payment succeeded or failed. The returned
+function cancels the subscription.
+*/
+func SubscribePaymentStatus(paymentHash string) (<-chan *data.PaymentStatus, func()) {
+	c := make(chan *data.PaymentStatus, paymentStatusBuffer)
+	paymentStatusMu.Lock()
+	defer paymentStatusMu.Unlock()
+	if current, ok := paymentStatuses[paymentHash]; ok {
+		c <- current
+		if current.Status != data.PaymentStatus_IN_FLIGHT {
+			close(c)
+			return c, func() {}
+		}
+	}
+	paymentStatusSubscribers[paymentHash] = append(paymentStatusSubscribers[paymentHash], c)
+	return c, func() {
+		paymentStatusMu.Lock()
+		defer paymentStatusMu.Unlock()
+		subscribers := paymentStatusSubscribers[paymentHash]
+		for i, s := range subscribers {
+			if s == c {
+				paymentStatusSubscribers[paymentHash] = append(subscribers[:i], subscribers[i+1:]...)
+				close(c)
+				return
+			}
+		}
+	}
+}
+
+/*
+GetPaymentStatus returns the last status of a payment sent by SendPaymentAsync.
+*/
+func GetPaymentStatus(paymentHash string) (*data.PaymentStatus, error) {
+	paymentStatusMu.Lock()
+	defer paymentStatusMu.Unlock()
+	status, ok := paymentStatuses[paymentHash]
+	if !ok {
+		return nil, fmt.Errorf("payment %v was not sent asynchronously", paymentHash)
+	}
+	return status, nil
+}
@@ -0,0 +1,110 @@
+package breez
+
+import (
+	"context"
+
+	"github.com/breez/breez/data"
+)
+
+type spendInitiatorKey struct{}
+type spendAuditKey struct{}
+
+type spendInitiator struct {
+	initiator data.SpendAuditEntry_Initiator
+	id        string
+}
+
+// withSpendInitiator marks the spending done with the returned context as initiated
+// by initiator instead of the user. id identifies it, e.g. the API token or the rule.
+func withSpendInitiator(ctx context.Context, initiator data.SpendAuditEntry_Initiator, id string) context.Context {
+	return context.WithValue(ctx, spendInitiatorKey{}, spendInitiator{initiator: initiator, id: id})
+}
+
+// newSpendAudit starts the audit entry of a spending API call made with ctx.
+func newSpendAudit(ctx context.Context, kind data.SpendAuditEntry_Kind) *data.SpendAuditEntry {
+	entry := &data.SpendAuditEntry{Kind: kind, Initiator: data.SpendAuditEntry_UI}
+	if i, ok := ctx.Value(spendInitiatorKey{}).(spendInitiator); ok {
+		entry.Initiator = i.initiator
+		entry.InitiatorId = i.id
+	}
+	return entry
+}
+
+func withSpendAudit(ctx context.Context, entry *data.SpendAuditEntry) context.Context {
+	return context.WithValue(ctx, spendAuditKey{}, entry)
+}
+
+// recordSpendCheck adds the outcome of a policy check to the audit entry of ctx.
+// A check repeated by a later attempt replaces the previous outcome.
+func recordSpendCheck(ctx context.Context, name string, err error) {
+	entry, ok := ctx.Value(spendAuditKey{}).(*data.SpendAuditEntry)
+	if !ok {
+		return
+	}
+	check := &data.SpendPolicyCheck{Name: name, Passed: err == nil}
+	if err != nil {
+		check.Reason = err.Error()
+	}
+	for i, c := range entry.Checks {
+		if c.Name == name {
+			entry.Checks[i] = check
+			return
+		}
+	}
+	entry.Checks = append(entry.Checks, check)
+}
+
+// finishSpendAudit appends the entry with the outcome of the call to the audit log.
+func finishSpendAudit(entry *data.SpendAuditEntry, err error) {
+	entry.Timestamp = trustedNow().Unix()
+	entry.Succeeded = err == nil
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	if err := addSpendAuditEntry(entry); err != nil {
+		log.Errorf("finishSpendAudit - failed to add audit entry: %v", err)
+	}
+}
+
+func spendAuditMatches(entry *data.SpendAuditEntry, filter *data.SpendAuditFilter) bool {
+	if filter == nil {
+		return true
+	}
+	if filter.FromTimestamp > 0 && entry.Timestamp < filter.FromTimestamp {
+		return false
+	}
+	if filter.ToTimestamp > 0 && entry.Timestamp > filter.ToTimestamp {
+		return false
+	}
+	if filter.FailedOnly && entry.Succeeded {
+		return false
+	}
+	if len(filter.Initiators) == 0 {
+		return true
+	}
+	for _, i := range filter.Initiators {
+		if i == entry.Initiator {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+GetAuditLog returns the entries of the spending audit log matching the filter, newest first. Every call
+of a spending API is recorded with who initiated it (the user, the payment queue, an automated rule or an
+API token), the policy checks applied and the outcome. The log is append only.
+*/
+func GetAuditLog(filter *data.SpendAuditFilter) (*data.SpendAuditLog, error) {
+	entries, err := fetchSpendAuditEntries()
+	if err != nil {
+		return nil, err
+	}
+	result := &data.SpendAuditLog{}
+	for _, e := range entries {
+		if spendAuditMatches(e, filter) {
+			result.Entries = append(result.Entries, e)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,70 @@
+package breez
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/breez/breez/data"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestSpendAuditInitiatorAndChecks(t *testing.T) {
+	ctx := withSpendInitiator(context.Background(), data.SpendAuditEntry_SCHEDULER, "queue")
+	entry := newSpendAudit(ctx, data.SpendAuditEntry_LIGHTNING)
+	if entry.Initiator != data.SpendAuditEntry_SCHEDULER || entry.InitiatorId != "queue" {
+		t.Fatalf("unexpected initiator %v %q", entry.Initiator, entry.InitiatorId)
+	}
+	ctx = withSpendAudit(ctx, entry)
+	recordSpendCheck(ctx, "spendable_balance", errors.New("locked"))
+	recordSpendCheck(ctx, "spendable_balance", nil)
+	recordSpendCheck(ctx, "invoice_expiry", nil)
+	if len(entry.Checks) != 2 || !entry.Checks[0].Passed || entry.Checks[0].Reason != "" {
+		t.Fatalf("expected the repeated check to be replaced, got %v", entry.Checks)
+	}
+
+	if e := newSpendAudit(context.Background(), data.SpendAuditEntry_ONCHAIN); e.Initiator != data.SpendAuditEntry_UI {
+		t.Fatalf("expected calls without an initiator to be attributed to the UI, got %v", e.Initiator)
+	}
+}
+
+func TestSpendAuditMatches(t *testing.T) {
+	entry := &data.SpendAuditEntry{Timestamp: 100, Initiator: data.SpendAuditEntry_PLUGIN, Succeeded: true}
+	tests := []struct {
+		filter  *data.SpendAuditFilter
+		matches bool
+	}{
+		{nil, true},
+		{&data.SpendAuditFilter{FromTimestamp: 50, ToTimestamp: 150}, true},
+		{&data.SpendAuditFilter{FromTimestamp: 101}, false},
+		{&data.SpendAuditFilter{ToTimestamp: 99}, false},
+		{&data.SpendAuditFilter{FailedOnly: true}, false},
+		{&data.SpendAuditFilter{Initiators: []data.SpendAuditEntry_Initiator{data.SpendAuditEntry_UI}}, false},
+		{&data.SpendAuditFilter{Initiators: []data.SpendAuditEntry_Initiator{data.SpendAuditEntry_UI, data.SpendAuditEntry_PLUGIN}}, true},
+	}
+	for i, test := range tests {
+		if spendAuditMatches(entry, test.filter) != test.matches {
+			t.Errorf("filter %v: expected match %v", i, test.matches)
+		}
+	}
+}
+
+func TestGRPCSpendInitiator(t *testing.T) {
+	previousCfg := cfg
+	cfg = &Config{GRPCToken: "token"}
+	defer func() { cfg = previousCfg }()
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token"))
+	var entry *data.SpendAuditEntry
+	_, err := authorizeUnaryCall(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ interface{}) (interface{}, error) {
+		entry = newSpendAudit(ctx, data.SpendAuditEntry_LIGHTNING)
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entry.Initiator != data.SpendAuditEntry_API_TOKEN || entry.InitiatorId != grpcTokenID {
+		t.Errorf("expected the call to be attributed to the gRPC token, got %v %q", entry.Initiator, entry.InitiatorId)
+	}
+}
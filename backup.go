@@ -2,6 +2,8 @@ package breez
 
 import (
 	"io/ioutil"
+	"path/filepath"
+	"time"
 
 	"github.com/breez/breez/data"
 	"github.com/breez/lightninglib/lnrpc"
@@ -15,6 +17,21 @@ func Backup() error {
 	return extractBackupPaths()
 }
 
+/*
+SetBackupPrivacy controls whether payment descriptions, payee/payer identities, payment requests
+and destinations are excluded from the backup files. When set, only amounts and hashes are backed up.
+*/
+func SetBackupPrivacy(excludeDetails bool) error {
+	return setBackupPrivacy(excludeDetails)
+}
+
+/*
+GetBackupPrivacy returns true if payment details are excluded from the backup files.
+*/
+func GetBackupPrivacy() (bool, error) {
+	return fetchBackupPrivacy()
+}
+
 func breezdbCopy() (string, error) {
 	dir, err := ioutil.TempDir("", "backup")
 	if err != nil {
@@ -26,16 +43,26 @@ func breezdbCopy() (string, error) {
 func extractBackupPaths() error {
 	response, err := lightningClient.GetBackup(context.Background(), &lnrpc.GetBackupRequest{})
 	if err != nil {
-		log.Errorf("Couldn't get backup: %v", err)
+		backupLog.Errorf("Couldn't get backup: %v", err)
 		return err
 	}
 	f, err := breezdbCopy()
 	if err != nil {
-		log.Errorf("Couldn't get breez backup file: %v", err)
+		backupLog.Errorf("Couldn't get breez backup file: %v", err)
 		return err
 	}
 	files := append(response.Files, f)
-	log.Infof("Database backed up: %v", response.Files)
-	notificationsChan <- data.NotificationEvent{Type: data.NotificationEvent_BACKUP_FILES_AVAILABLE, Data: files}
+	credentials, err := backupCredentials(filepath.Dir(f))
+	if err != nil {
+		backupLog.Errorf("Couldn't backup the credentials: %v", err)
+	} else if credentials != "" {
+		files = append(files, credentials)
+	}
+	backupLog.Infof("Database backed up: %v", response.Files)
+	if err := saveLastBackupTime(time.Now().Unix()); err != nil {
+		backupLog.Errorf("Couldn't save the backup time: %v", err)
+	}
+	notify(data.NotificationEvent{Type: data.NotificationEvent_BACKUP_FILES_AVAILABLE, Data: files})
+	go prepareDeviceBackup(files)
 	return nil
 }
@@ -2,8 +2,11 @@ package bindings
 
 import (
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"os"
+	"sync"
+	"time"
 
 	"github.com/breez/breez"
 	"github.com/breez/breez/bootstrap"
@@ -13,191 +16,1147 @@ import (
 )
 
 /*
-BreezNotifier is the interface that is used to send notifications to the user of this library
+BreezNotifier is the interface that is used to send notifications to the user of this library.
+Every notification is delivered again after a restart or AttachNotifier until it is acknowledged
+with AckNotification, so the app acknowledges it once it was handled.
 */
 type BreezNotifier interface {
 	Notify(notificationEvent []byte)
 }
 
+var (
+	notifierMu      sync.Mutex
+	currentNotifier BreezNotifier
+)
+
+/*
+PaymentAuthorizer is the interface that is used to ask the user of this library to authorize a payment.
+AuthorizePayment receives a serialized data.PaymentAuthorizationRequest
+*/
+type PaymentAuthorizer interface {
+	AuthorizePayment(request []byte) bool
+}
+
+type paymentAuthorizer struct {
+	authorizer PaymentAuthorizer
+}
+
+func (a *paymentAuthorizer) AuthorizePayment(request *data.PaymentAuthorizationRequest) bool {
+	res, err := proto.Marshal(request)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error in marshaing authorization request", err)
+		return false
+	}
+	return a.authorizer.AuthorizePayment(res)
+}
+
+/*
+Start the lightning client
+*/
+func Start(workingDir string, tempDir string, notifier BreezNotifier) (err error) {
+	os.Setenv("TMPDIR", tempDir)
+	notificationsChan, err := breez.Start(workingDir, false)
+	if err != nil {
+		return breez.PrefixErrorCode(err)
+	}
+	setNotifier(notifier)
+	go deliverNotifications(notificationsChan)
+	return nil
+}
+
+/*
+RecoverCorruptedDatabase is part of the binding inteface which is delegated to breez.RecoverCorruptedDatabase
+*/
+func RecoverCorruptedDatabase(workingDir string) (string, error) {
+	return breez.RecoverCorruptedDatabase(workingDir)
+}
+
+/*
+AttachNotifier sets the notifier after the UI was detached and delivers again the notifications
+it didn't handle.
+*/
+func AttachNotifier(notifier BreezNotifier) {
+	setNotifier(notifier)
+	breez.ReplayNotifications()
+}
+
+/*
+AckNotification is part of the binding inteface which is delegated to breez.AckNotification
+*/
+func AckNotification(id int64) error {
+	return breez.AckNotification(uint64(id))
+}
+
+/*
+DetachNotifier stops delivering notifications to the UI, they are kept until AttachNotifier is called.
+*/
+func DetachNotifier() {
+	setNotifier(nil)
+}
+
+func setNotifier(notifier BreezNotifier) {
+	notifierMu.Lock()
+	defer notifierMu.Unlock()
+	currentNotifier = notifier
+}
+
+func getNotifier() BreezNotifier {
+	notifierMu.Lock()
+	defer notifierMu.Unlock()
+	return currentNotifier
+}
+
+/*
+StartSyncJob starts breez only to reach synchronized state.
+The daemon closes itself automatically when reaching this state.
+The notifications which matter to the app, e.g. INVOICE_PAID, are kept until it is attached.
+*/
+func StartSyncJob(workingDir string) error {
+	notificationsChan, err := breez.Start(workingDir, true)
+	if err != nil {
+		return err
+	}
+	go func() {
+		for notification := range notificationsChan {
+			if err := breez.AckTransientNotification(notification); err != nil {
+				fmt.Fprintln(os.Stderr, "Error in acknowledging notification", err)
+			}
+		}
+	}()
+	return nil
+}
+
+/*
+WakeupAndSettle starts breez to settle the payments the routing node holds for us and stops it.
+It returns a serialized data.WakeupResult
+*/
+func WakeupAndSettle(workingDir string, tempDir string, timeoutSeconds int64) ([]byte, error) {
+	os.Setenv("TMPDIR", tempDir)
+	return marshalResponse(breez.WakeupAndSettle(workingDir, time.Duration(timeoutSeconds)*time.Second))
+}
+
+/*
+RegisterPaymentNotification is part of the binding inteface which is delegated to breez.RegisterPaymentNotification
+*/
+func RegisterPaymentNotification(token string) error {
+	return breez.RegisterPaymentNotification(token)
+}
+
+/*
+RestoreCredentials is part of the binding inteface which is delegated to breez.RestoreCredentials
+*/
+func RestoreCredentials(workingDir string, key []byte) error {
+	return breez.RestoreCredentials(workingDir, key)
+}
+
+/*
+SetCredentialsBackupKey is part of the binding inteface which is delegated to breez.SetCredentialsBackupKey
+*/
+func SetCredentialsBackupKey(key []byte) error {
+	return breez.SetCredentialsBackupKey(key)
+}
+
+/*
+RegenerateMacaroons is part of the binding inteface which is delegated to breez.RegenerateMacaroons
+*/
+func RegenerateMacaroons() error {
+	return breez.RegenerateMacaroons()
+}
+
+/*
+Stop the lightning client
+*/
+func Stop() {
+	breez.Stop()
+}
+
+/*
+Backup triggers breez backup
+*/
+func Backup() error {
+	return breez.Backup()
+}
+
+/*
+WaitDaemonShutdown blocks untill the daemon shutdown
+*/
+func WaitDaemonShutdown() {
+	breez.WaitDaemonShutdown()
+}
+
+/*
+DaemonReady returns the status of the daemon
+*/
+func DaemonReady() bool {
+	return breez.DaemonReady()
+}
+
+/*
+OnResume just calls the breez.OnResume
+*/
+func OnResume() {
+	breez.OnResume()
+}
+
+/*
+Log is a function that uses the breez logger
+*/
+func Log(msg string, lvl string) {
+	breez.Log(msg, lvl)
+}
+
+/*
+GetAccountInfo is part of the binding inteface which is delegated to breez.GetAccountInfo
+*/
+func GetAccountInfo() ([]byte, error) {
+	return marshalResponse(breez.GetAccountInfo())
+}
+
+/*
+ConnectAccount is part of the binding inteface which is delegated to breez.ConnectAccount
+*/
+func ConnectAccount() error {
+	return breez.ConnectAccount()
+}
+
+/*
+IsConnectedToRoutingNode is part of the binding inteface which is delegated to breez.IsConnectedToRoutingNode
+*/
+func IsConnectedToRoutingNode() bool {
+	return breez.IsConnectedToRoutingNode()
+}
+
+/*
+HealthCheck is part of the binding inteface which is delegated to breez.HealthCheck
+*/
+func HealthCheck() ([]byte, error) {
+	return proto.Marshal(breez.HealthCheck())
+}
+
+/*
+AddFundsInit is part of the binding inteface which is delegated to breez.AddFundsInit
+*/
+func AddFundsInit(breezID string) ([]byte, error) {
+	return marshalResponse(breez.AddFundsInit(breezID))
+}
+
+/*
+GetRefundableSwapAddresses returns all addresses that are refundable, e.g expired and not paid
+*/
+func GetRefundableSwapAddresses() ([]byte, error) {
+	fmt.Println("GetRefundableSwapAddresses in api")
+	refundableAddresses, err := breez.GetRefundableAddresses()
+	if err != nil {
+		fmt.Println("GetRefundableSwapAddresses in api returned error from breez")
+		return nil, err
+	}
+
+	addressList := swapAddressList(refundableAddresses)
+	fmt.Printf("GetRefundableSwapAddresses returned %v addresses", len(addressList.Addresses))
+	return marshalResponse(addressList, nil)
+}
+
+/*
+AddFundsInitForSource is part of the binding inteface which is delegated to breez.AddFundsInitForSource
+*/
+func AddFundsInitForSource(breezID, source string) ([]byte, error) {
+	return marshalResponse(breez.AddFundsInitForSource(breezID, source))
+}
+
+/*
+CheckSwapAddressReuse is part of the binding inteface which is delegated to breez.CheckSwapAddressReuse
+*/
+func CheckSwapAddressReuse(address, source string) ([]byte, error) {
+	return marshalResponse(breez.CheckSwapAddressReuse(address, source))
+}
+
+/*
+GetSwapAddressesBySource is part of the binding inteface which is delegated to breez.GetSwapAddressesBySource
+*/
+func GetSwapAddressesBySource(source string) ([]byte, error) {
+	addresses, err := breez.GetSwapAddressesBySource(source)
+	if err != nil {
+		return nil, err
+	}
+	return marshalResponse(swapAddressList(addresses), nil)
+}
+
+func swapAddressList(addresses []*breez.SwapAddressInfo) *data.SwapAddressList {
+	var rpcAddresses []*data.SwapAddressInfo
+	for _, a := range addresses {
+		rpcAddresses = append(rpcAddresses, &data.SwapAddressInfo{
+			Address:                 a.Address,
+			PaymentHash:             hex.EncodeToString(a.PaymentHash),
+			ConfirmedAmount:         a.ConfirmedAmount,
+			ConfirmedTransactionIds: a.ConfirmedTransactionIds,
+			PaidAmount:              a.PaidAmount,
+			LockHeight:              a.LockHeight,
+			ErrorMessage:            a.ErrorMessage,
+			LastRefundTxID:          a.LastRefundTxID,
+			Source:                  a.Source,
+		})
+	}
+	return &data.SwapAddressList{Addresses: rpcAddresses}
+}
+
+/*
+GetSwapLimits is part of the binding inteface which is delegated to breez.GetSwapLimits
+*/
+func GetSwapLimits() ([]byte, error) {
+	return marshalResponse(breez.GetSwapLimits())
+}
+
+//Refund transfers the funds in address to the user destination address
+func Refund(refundRequest []byte) (string, error) {
+	request := &data.RefundRequest{}
+	if err := proto.Unmarshal(refundRequest, request); err != nil {
+		return "", err
+	}
+	return breez.Refund(request.Address, request.RefundAddress)
+}
+
+/*
+GetFundStatus is part of the binding inteface which is delegated to breez.GetFundStatus
+*/
+func GetFundStatus(notificationToken string) ([]byte, error) {
+	return marshalResponse(breez.GetFundStatus(notificationToken))
+}
+
+/*
+RemoveFund is part of the binding inteface which is delegated to breez.RemoveFund
+*/
+func RemoveFund(removeFundRequest []byte) ([]byte, error) {
+	request := &data.RemoveFundRequest{}
+	proto.Unmarshal(removeFundRequest, request)
+	return marshalResponse(breez.RemoveFund(request.Amount, request.Address))
+}
+
+/*
+GetLogPath is part of the binding inteface which is delegated to breez.GetLogPath
+*/
+func GetLogPath() string {
+	return breez.GetLogPath()
+}
+
+/*
+CreateSupportBundle is part of the binding inteface which is delegated to breez.CreateSupportBundle
+*/
+func CreateSupportBundle(redactionLevel int32) ([]byte, error) {
+	return marshalResponse(breez.CreateSupportBundle(data.RedactionLevel(redactionLevel)))
+}
+
+/*
+GetSavings is part of the binding inteface which is delegated to breez.GetSavings
+*/
+func GetSavings() ([]byte, error) {
+	return marshalResponse(breez.GetSavings())
+}
+
+/*
+SetSavings is part of the binding inteface which is delegated to breez.SetSavings
+*/
+func SetSavings(amount int64) error {
+	return breez.SetSavings(amount)
+}
+
+/*
+RequestSavingsUnlock is part of the binding inteface which is delegated to breez.RequestSavingsUnlock
+*/
+func RequestSavingsUnlock(amount int64) (int64, error) {
+	return breez.RequestSavingsUnlock(amount)
+}
+
+/*
+GetSpendableBalance is part of the binding inteface which is delegated to breez.GetSpendableBalance
+*/
+func GetSpendableBalance() (int64, error) {
+	return breez.GetSpendableBalance()
+}
+
+/*
+UpdatePinSet is part of the binding inteface which is delegated to breez.UpdatePinSet
+*/
+func UpdatePinSet(pinSet, signature []byte) error {
+	return breez.UpdatePinSet(pinSet, signature)
+}
+
+/*
+MoveFunds is part of the binding inteface which is delegated to breez.MoveFunds
+*/
+func MoveFunds(direction int32, amount int64) ([]byte, error) {
+	return marshalResponse(breez.MoveFunds(data.MoveFundsOperation_Direction(direction), amount))
+}
+
+/*
+GetMoveFundsOperations is part of the binding inteface which is delegated to breez.GetMoveFundsOperations
+*/
+func GetMoveFundsOperations() ([]byte, error) {
+	return marshalResponse(breez.GetMoveFundsOperations())
+}
+
+/*
+SetBackupPrivacy is part of the binding inteface which is delegated to breez.SetBackupPrivacy
+*/
+func SetBackupPrivacy(excludeDetails bool) error {
+	return breez.SetBackupPrivacy(excludeDetails)
+}
+
+/*
+GetBackupPrivacy is part of the binding inteface which is delegated to breez.GetBackupPrivacy
+*/
+func GetBackupPrivacy() (bool, error) {
+	return breez.GetBackupPrivacy()
+}
+
+/*
+GetCloseChannelFeeOptions is part of the binding inteface which is delegated to breez.GetCloseChannelFeeOptions
+*/
+func GetCloseChannelFeeOptions() ([]byte, error) {
+	return marshalResponse(breez.GetCloseChannelFeeOptions())
+}
+
+/*
+CloseChannel is part of the binding inteface which is delegated to breez.CloseChannel
+*/
+func CloseChannel(closeChannelRequest []byte) (string, error) {
+	request := &data.CloseChannelRequest{}
+	if err := proto.Unmarshal(closeChannelRequest, request); err != nil {
+		return "", err
+	}
+	return stringResponse(breez.CloseChannel(request.ChannelPoint, request.Strategy, request.SatPerByte))
+}
+
+/*
+GetChannelConsolidationPreview is part of the binding inteface which is delegated to breez.GetChannelConsolidationPreview
+*/
+func GetChannelConsolidationPreview(consolidateRequest []byte) ([]byte, error) {
+	request := &data.ConsolidateChannelsRequest{}
+	if err := proto.Unmarshal(consolidateRequest, request); err != nil {
+		return nil, err
+	}
+	return marshalResponse(breez.GetChannelConsolidationPreview(request.Strategy, request.SatPerByte))
+}
+
+/*
+ConsolidateChannels is part of the binding inteface which is delegated to breez.ConsolidateChannels
+*/
+func ConsolidateChannels(consolidateRequest []byte) ([]byte, error) {
+	request := &data.ConsolidateChannelsRequest{}
+	if err := proto.Unmarshal(consolidateRequest, request); err != nil {
+		return nil, err
+	}
+	return marshalResponse(breez.ConsolidateChannels(request.Strategy, request.SatPerByte))
+}
+
+/*
+GetChannelConsolidations is part of the binding inteface which is delegated to breez.GetChannelConsolidations
+*/
+func GetChannelConsolidations() ([]byte, error) {
+	return marshalResponse(breez.GetChannelConsolidations())
+}
+
+/*
+GetObserverCredential is part of the binding inteface which is delegated to breez.GetObserverCredential
+*/
+func GetObserverCredential() ([]byte, error) {
+	return marshalResponse(breez.GetObserverCredential())
+}
+
+/*
+ResetObserverCredential is part of the binding inteface which is delegated to breez.ResetObserverCredential
+*/
+func ResetObserverCredential() error {
+	return breez.ResetObserverCredential()
+}
+
+/*
+ExportObserverSnapshot is part of the binding inteface which is delegated to breez.ExportObserverSnapshot
+*/
+func ExportObserverSnapshot() ([]byte, error) {
+	return breez.ExportObserverSnapshot()
+}
+
+/*
+ReadObserverSnapshot is part of the binding inteface which is delegated to breez.ReadObserverSnapshot
+*/
+func ReadObserverSnapshot(key, snapshot []byte) ([]byte, error) {
+	return marshalResponse(breez.ReadObserverSnapshot(key, snapshot))
+}
+
+/*
+StartPairing is part of the binding inteface which is delegated to breez.StartPairing
+*/
+func StartPairing(permissions []byte) ([]byte, error) {
+	request := &data.PairingPermissions{}
+	if err := proto.Unmarshal(permissions, request); err != nil {
+		return nil, err
+	}
+	return marshalResponse(breez.StartPairing(request))
+}
+
+/*
+GetPairingSessions is part of the binding inteface which is delegated to breez.GetPairingSessions
+*/
+func GetPairingSessions() ([]byte, error) {
+	return marshalResponse(breez.GetPairingSessions())
+}
+
+/*
+RevokePairing is part of the binding inteface which is delegated to breez.RevokePairing
+*/
+func RevokePairing(sessionID string) error {
+	return breez.RevokePairing(sessionID)
+}
+
+/*
+HandlePairingMessage is part of the binding inteface which is delegated to breez.HandlePairingMessage
+*/
+func HandlePairingMessage(sessionID, encryptedMessage string) (string, error) {
+	return breez.HandlePairingMessage(sessionID, encryptedMessage)
+}
+
+/*
+SetBackupDevice is part of the binding inteface which is delegated to breez.SetBackupDevice
+*/
+func SetBackupDevice(sessionID string) error {
+	return breez.SetBackupDevice(sessionID)
+}
+
+/*
+GetDeviceBackup is part of the binding inteface which is delegated to breez.GetDeviceBackup
+*/
+func GetDeviceBackup() ([]byte, error) {
+	return marshalResponse(breez.GetDeviceBackup())
+}
+
+/*
+GetDeviceBackupStatus is part of the binding inteface which is delegated to breez.GetDeviceBackupStatus
+*/
+func GetDeviceBackupStatus() ([]byte, error) {
+	return marshalResponse(breez.GetDeviceBackupStatus())
+}
+
+/*
+GetHubPolicy is part of the binding inteface which is delegated to breez.GetHubPolicy
+*/
+func GetHubPolicy() ([]byte, error) {
+	return marshalResponse(breez.GetHubPolicy())
+}
+
+/*
+SetDeviceConditions is part of the binding inteface which is delegated to breez.SetDeviceConditions
+*/
+func SetDeviceConditions(charging, unmetered bool) {
+	breez.SetDeviceConditions(charging, unmetered)
+}
+
+/*
+ForceMaintenanceNow is part of the binding inteface which is delegated to breez.ForceMaintenanceNow
+*/
+func ForceMaintenanceNow() ([]byte, error) {
+	return marshalResponse(breez.ForceMaintenanceNow())
+}
+
+/*
+GetMaintenanceReport is part of the binding inteface which is delegated to breez.GetMaintenanceReport
+*/
+func GetMaintenanceReport() ([]byte, error) {
+	return marshalResponse(breez.GetMaintenanceReport())
+}
+
+/*
+GetHTTPAPIToken is part of the binding inteface which is delegated to breez.GetHTTPAPIToken
+*/
+func GetHTTPAPIToken() (string, error) {
+	return breez.GetHTTPAPIToken()
+}
+
+/*
+RotateHTTPAPIToken is part of the binding inteface which is delegated to breez.RotateHTTPAPIToken
+*/
+func RotateHTTPAPIToken() (string, error) {
+	return breez.RotateHTTPAPIToken()
+}
+
+/*
+GetGRPCToken is part of the binding inteface which is delegated to breez.GetGRPCToken
+*/
+func GetGRPCToken() (string, error) {
+	return breez.GetGRPCToken()
+}
+
+/*
+RotateGRPCToken is part of the binding inteface which is delegated to breez.RotateGRPCToken
+*/
+func RotateGRPCToken() (string, error) {
+	return breez.RotateGRPCToken()
+}
+
+/*
+GetMetrics is part of the binding inteface which is delegated to breez.GetMetrics
+*/
+func GetMetrics() string {
+	return breez.GetMetrics()
+}
+
+/*
+SetLogLevel is part of the binding inteface which is delegated to breez.SetLogLevel
+*/
+func SetLogLevel(subsystem, level string) error {
+	return breez.SetLogLevel(subsystem, level)
+}
+
+/*
+ExportLogs is part of the binding inteface which is delegated to breez.ExportLogs
+*/
+func ExportLogs() (string, error) {
+	return breez.ExportLogs()
+}
+
+/*
+SetDatabaseSecret is part of the binding inteface which is delegated to breez.SetDatabaseSecret
+*/
+func SetDatabaseSecret(secret []byte) {
+	breez.SetDatabaseSecret(secret)
+}
+
+/*
+RevokeAllTokens is part of the binding inteface which is delegated to breez.RevokeAllTokens
+*/
+func RevokeAllTokens() (int, error) {
+	return breez.RevokeAllTokens()
+}
+
+/*
+RevokeWebhooks is part of the binding inteface which is delegated to breez.RevokeWebhooks
+*/
+func RevokeWebhooks() (int, error) {
+	return breez.RevokeWebhooks()
+}
+
+/*
+AddSplitInvoice is part of the binding inteface which is delegated to breez.AddSplitInvoice
+*/
+func AddSplitInvoice(splitInvoiceRequest []byte) (string, error) {
+	request := &data.AddSplitInvoiceRequest{}
+	if err := proto.Unmarshal(splitInvoiceRequest, request); err != nil {
+		return "", err
+	}
+	return stringResponse(breez.AddSplitInvoice(request.Invoice, request.Recipients))
+}
+
+/*
+GetPaymentSplit is part of the binding inteface which is delegated to breez.GetPaymentSplit
+*/
+func GetPaymentSplit(paymentHash string) ([]byte, error) {
+	return marshalResponse(breez.GetPaymentSplit(paymentHash))
+}
+
+/*
+AddSettlementRule is part of the binding inteface which is delegated to breez.AddSettlementRule
+*/
+func AddSettlementRule(rule []byte) (int64, error) {
+	request := &data.SettlementRule{}
+	if err := proto.Unmarshal(rule, request); err != nil {
+		return 0, err
+	}
+	id, err := breez.AddSettlementRule(request)
+	return int64(id), err
+}
+
+/*
+RemoveSettlementRule is part of the binding inteface which is delegated to breez.RemoveSettlementRule
+*/
+func RemoveSettlementRule(id int64) error {
+	return breez.RemoveSettlementRule(uint64(id))
+}
+
+/*
+GetSettlementRules is part of the binding inteface which is delegated to breez.GetSettlementRules
+*/
+func GetSettlementRules() ([]byte, error) {
+	return marshalResponse(breez.GetSettlementRules())
+}
+
+/*
+GetSettlementAudit is part of the binding inteface which is delegated to breez.GetSettlementAudit
+*/
+func GetSettlementAudit() ([]byte, error) {
+	return marshalResponse(breez.GetSettlementAudit())
+}
+
+/*
+AddWebhook is part of the binding inteface which is delegated to breez.AddWebhook
+*/
+func AddWebhook(webhookURL, paymentHash string) ([]byte, error) {
+	return marshalResponse(breez.AddWebhook(webhookURL, paymentHash))
+}
+
+/*
+RemoveWebhook is part of the binding inteface which is delegated to breez.RemoveWebhook
+*/
+func RemoveWebhook(id int64) error {
+	return breez.RemoveWebhook(uint64(id))
+}
+
+/*
+GetWebhooks is part of the binding inteface which is delegated to breez.GetWebhooks
+*/
+func GetWebhooks() ([]byte, error) {
+	return marshalResponse(breez.GetWebhooks())
+}
+
+/*
+GetWebhookDeliveries is part of the binding inteface which is delegated to breez.GetWebhookDeliveries
+*/
+func GetWebhookDeliveries(paymentHash string) ([]byte, error) {
+	return marshalResponse(breez.GetWebhookDeliveries(paymentHash))
+}
+
+/*
+GetPayments is part of the binding inteface which is delegated to breez.GetPayments
+
+Deprecated: use apiv2.GetPayments which returns the payments a page at a time.
+*/
+func GetPayments() ([]byte, error) {
+	return marshalResponse(breez.GetPayments())
+}
+
+/*
+SearchPayments is part of the binding inteface which is delegated to breez.SearchPayments
+*/
+func SearchPayments(query string) ([]byte, error) {
+	return marshalResponse(breez.SearchPayments(query))
+}
+
+/*
+GetSpendingByPayee is part of the binding inteface which is delegated to breez.GetSpendingByPayee
+*/
+func GetSpendingByPayee(window int64) ([]byte, error) {
+	return marshalResponse(breez.GetSpendingByPayee(window))
+}
+
 /*
-Start the lightning client
+GetSuggestedAmounts is part of the binding inteface which is delegated to breez.GetSuggestedAmounts
 */
-func Start(workingDir string, tempDir string, notifier BreezNotifier) (err error) {
-	os.Setenv("TMPDIR", tempDir)
-	notificationsChan, err := breez.Start(workingDir, false)
-	if err != nil {
+func GetSuggestedAmounts(request []byte) ([]byte, error) {
+	suggestionsRequest := &data.SuggestedAmountsRequest{}
+	if err := proto.Unmarshal(request, suggestionsRequest); err != nil {
+		return nil, err
+	}
+	return marshalResponse(breez.GetSuggestedAmounts(suggestionsRequest))
+}
+
+/*
+ExportPaymentsCSV is part of the binding inteface which is delegated to breez.ExportPaymentsCSV
+*/
+func ExportPaymentsCSV(request []byte) error {
+	exportRequest := &data.ExportPaymentsRequest{}
+	if err := proto.Unmarshal(request, exportRequest); err != nil {
 		return err
 	}
-	go deliverNotifications(notificationsChan, notifier)
-	return nil
+	return breez.ExportPaymentsCSV(exportRequest)
 }
 
 /*
-StartSyncJob starts breez only to reach synchronized state.
-The daemon closes itself automatically when reaching this state.
+GenerateStatement is part of the binding inteface which is delegated to breez.GenerateStatement
 */
-func StartSyncJob(workingDir string) error {
-	_, err := breez.Start(workingDir, true)
-	return err
+func GenerateStatement(month string) ([]byte, error) {
+	return marshalResponse(breez.GenerateStatement(month))
 }
 
 /*
-Stop the lightning client
+GetQuarantinedPayments is part of the binding inteface which is delegated to breez.GetQuarantinedPayments
 */
-func Stop() {
-	breez.Stop()
+func GetQuarantinedPayments() ([]byte, error) {
+	return marshalResponse(breez.GetQuarantinedPayments())
 }
 
 /*
-Backup triggers breez backup
+ResolveQuarantinedPayment is part of the binding inteface which is delegated to breez.ResolveQuarantinedPayment
 */
-func Backup() error {
-	return breez.Backup()
+func ResolveQuarantinedPayment(resolveRequest []byte) error {
+	request := &data.ResolveQuarantinedPaymentRequest{}
+	if err := proto.Unmarshal(resolveRequest, request); err != nil {
+		return err
+	}
+	return breez.ResolveQuarantinedPayment(request.Id, request.Resolution)
 }
 
 /*
-WaitDaemonShutdown blocks untill the daemon shutdown
+StreamPayments is part of the binding inteface which is delegated to breez.StreamPayments.
+The payments chunks are written to the given file descriptor which is closed when done.
 */
-func WaitDaemonShutdown() {
-	breez.WaitDaemonShutdown()
+func StreamPayments(fd int64, chunkSize int64) error {
+	f := os.NewFile(uintptr(fd), "payments")
+	defer f.Close()
+	return breez.StreamPayments(f, int(chunkSize))
 }
 
 /*
-DaemonReady returns the status of the daemon
+SendPaymentWithIdempotencyKey is part of the binding inteface which is delegated to breez.SendPaymentWithIdempotencyKey
 */
-func DaemonReady() bool {
-	return breez.DaemonReady()
+func SendPaymentWithIdempotencyKey(paymentRequest string, amountSatoshi int64, idempotencyKey string) error {
+	return breez.PrefixErrorCode(breez.SendPaymentWithIdempotencyKey(paymentRequest, amountSatoshi, idempotencyKey))
 }
 
 /*
-OnResume just calls the breez.OnResume
+GetPaymentIntents is part of the binding inteface which is delegated to breez.GetPaymentIntents
 */
-func OnResume() {
-	breez.OnResume()
+func GetPaymentIntents() ([]byte, error) {
+	return marshalResponse(breez.GetPaymentIntents())
 }
 
 /*
-Log is a function that uses the breez logger
+SendPaymentAsync is part of the binding inteface which is delegated to breez.SendPaymentAsync.
+The payment progress is delivered as PAYMENT_STATUS_CHANGED notifications.
 */
-func Log(msg string, lvl string) {
-	breez.Log(msg, lvl)
+func SendPaymentAsync(payInvoiceRequest []byte) (string, error) {
+	request := &data.PayInvoiceRequest{}
+	if err := proto.Unmarshal(payInvoiceRequest, request); err != nil {
+		return "", err
+	}
+	return stringResponse(breez.SendPaymentAsync(request.PaymentRequest, request.Amount))
 }
 
 /*
-GetAccountInfo is part of the binding inteface which is delegated to breez.GetAccountInfo
+SendPaymentWithRetry is part of the binding inteface which is delegated to breez.SendPaymentWithRetry.
+The attempts are delivered as PAYMENT_STATUS_CHANGED notifications.
 */
-func GetAccountInfo() ([]byte, error) {
-	return marshalResponse(breez.GetAccountInfo())
+func SendPaymentWithRetry(retryPaymentRequest []byte) (string, error) {
+	request := &data.RetryPaymentRequest{}
+	if err := proto.Unmarshal(retryPaymentRequest, request); err != nil {
+		return "", err
+	}
+	if request.Payment == nil {
+		return "", errors.New("payment is required")
+	}
+	return stringResponse(breez.SendPaymentWithRetry(request.Payment.PaymentRequest, request.Payment.Amount, request.Policy))
 }
 
 /*
-ConnectAccount is part of the binding inteface which is delegated to breez.ConnectAccount
+GetPaymentStatus is part of the binding inteface which is delegated to breez.GetPaymentStatus
 */
-func ConnectAccount() error {
-	return breez.ConnectAccount()
+func GetPaymentStatus(paymentHash string) ([]byte, error) {
+	return marshalResponse(breez.GetPaymentStatus(paymentHash))
 }
 
 /*
-IsConnectedToRoutingNode is part of the binding inteface which is delegated to breez.IsConnectedToRoutingNode
+ComputeSendMax is part of the binding inteface which is delegated to breez.ComputeSendMax
 */
-func IsConnectedToRoutingNode() bool {
-	return breez.IsConnectedToRoutingNode()
+func ComputeSendMax(paymentRequest string) ([]byte, error) {
+	return marshalResponse(breez.ComputeSendMax(paymentRequest))
 }
 
 /*
-AddFundsInit is part of the binding inteface which is delegated to breez.AddFundsInit
+EstimatePaymentFee is part of the binding inteface which is delegated to breez.EstimatePaymentFee
 */
-func AddFundsInit(breezID string) ([]byte, error) {
-	return marshalResponse(breez.AddFundsInit(breezID))
+func EstimatePaymentFee(payInvoiceRequest []byte) ([]byte, error) {
+	request := &data.PayInvoiceRequest{}
+	if err := proto.Unmarshal(payInvoiceRequest, request); err != nil {
+		return nil, err
+	}
+	return marshalResponse(breez.EstimatePaymentFee(request.PaymentRequest, request.Amount))
 }
 
 /*
-GetRefundableSwapAddresses returns all addresses that are refundable, e.g expired and not paid
+GetPaymentRoute is part of the binding inteface which is delegated to breez.GetPaymentRoute
 */
-func GetRefundableSwapAddresses() ([]byte, error) {
-	fmt.Println("GetRefundableSwapAddresses in api")
-	refundableAddresses, err := breez.GetRefundableAddresses()
-	if err != nil {
-		fmt.Println("GetRefundableSwapAddresses in api returned error from breez")
+func GetPaymentRoute(paymentHash string) ([]byte, error) {
+	return marshalResponse(breez.GetPaymentRoute(paymentHash))
+}
+
+/*
+GetFailedPayments is part of the binding inteface which is delegated to breez.GetFailedPayments
+*/
+func GetFailedPayments() ([]byte, error) {
+	return marshalResponse(breez.GetFailedPayments())
+}
+
+/*
+GetRecentHTLCEvents is part of the binding inteface which is delegated to breez.GetRecentHTLCEvents
+*/
+func GetRecentHTLCEvents() ([]byte, error) {
+	return marshalResponse(breez.GetRecentHTLCEvents())
+}
+
+/*
+ClearFailedPayments is part of the binding inteface which is delegated to breez.ClearFailedPayments
+*/
+func ClearFailedPayments() error {
+	return breez.ClearFailedPayments()
+}
+
+/*
+CreateDonationCampaign is part of the binding inteface which is delegated to breez.CreateDonationCampaign
+*/
+func CreateDonationCampaign(campaign []byte) ([]byte, error) {
+	request := &data.DonationCampaign{}
+	if err := proto.Unmarshal(campaign, request); err != nil {
 		return nil, err
 	}
+	return marshalResponse(breez.CreateDonationCampaign(request))
+}
 
-	var rpcAddresses []*data.SwapAddressInfo
-	for _, a := range refundableAddresses {
-		rpcAddresses = append(rpcAddresses, &data.SwapAddressInfo{
-			Address:                 a.Address,
-			PaymentHash:             hex.EncodeToString(a.PaymentHash),
-			ConfirmedAmount:         a.ConfirmedAmount,
-			ConfirmedTransactionIds: a.ConfirmedTransactionIds,
-			PaidAmount:              a.PaidAmount,
-			LockHeight:              a.LockHeight,
-			ErrorMessage:            a.ErrorMessage,
-			LastRefundTxID:          a.LastRefundTxID,
-		})
+/*
+AddDonationInvoice is part of the binding inteface which is delegated to breez.AddDonationInvoice
+*/
+func AddDonationInvoice(donationInvoiceRequest []byte) (string, error) {
+	request := &data.DonationInvoiceRequest{}
+	if err := proto.Unmarshal(donationInvoiceRequest, request); err != nil {
+		return "", err
 	}
+	return stringResponse(breez.AddDonationInvoice(request))
+}
+
+/*
+GetDonationCampaigns is part of the binding inteface which is delegated to breez.GetDonationCampaigns
+*/
+func GetDonationCampaigns() ([]byte, error) {
+	return marshalResponse(breez.GetDonationCampaigns())
+}
+
+/*
+GetDonationContributions is part of the binding inteface which is delegated to breez.GetDonationContributions
+*/
+func GetDonationContributions(campaignID string) ([]byte, error) {
+	return marshalResponse(breez.GetDonationContributions(campaignID))
+}
+
+/*
+DeleteDonationCampaign is part of the binding inteface which is delegated to breez.DeleteDonationCampaign
+*/
+func DeleteDonationCampaign(campaignID string) error {
+	return breez.DeleteDonationCampaign(campaignID)
+}
 
-	addressList := &data.SwapAddressList{
-		Addresses: rpcAddresses,
+/*
+QueuePayment is part of the binding inteface which is delegated to breez.QueuePayment
+*/
+func QueuePayment(request []byte) ([]byte, error) {
+	queueRequest := &data.QueuePaymentRequest{}
+	if err := proto.Unmarshal(request, queueRequest); err != nil {
+		return nil, err
 	}
-	fmt.Printf("GetRefundableSwapAddresses returned %v addresses", len(rpcAddresses))
-	return marshalResponse(addressList, nil)
+	return marshalResponse(breez.QueuePayment(queueRequest.PaymentRequest, queueRequest.Amount, queueRequest.FeeLimit))
 }
 
-//Refund transfers the funds in address to the user destination address
-func Refund(refundRequest []byte) (string, error) {
-	request := &data.RefundRequest{}
-	if err := proto.Unmarshal(refundRequest, request); err != nil {
-		return "", err
+/*
+GetQueuedPayments is part of the binding inteface which is delegated to breez.GetQueuedPayments
+*/
+func GetQueuedPayments() ([]byte, error) {
+	return marshalResponse(breez.GetQueuedPayments())
+}
+
+/*
+CancelQueuedPayment is part of the binding inteface which is delegated to breez.CancelQueuedPayment
+*/
+func CancelQueuedPayment(paymentHash string) error {
+	return breez.CancelQueuedPayment(paymentHash)
+}
+
+/*
+SetFaultInjection is part of the binding inteface which is delegated to breez.SetFaultInjection
+*/
+func SetFaultInjection(rules []byte) error {
+	faultRules := &data.FaultInjectionRules{}
+	if err := proto.Unmarshal(rules, faultRules); err != nil {
+		return err
 	}
-	return breez.Refund(request.Address, request.RefundAddress)
+	return breez.SetFaultInjection(faultRules)
 }
 
 /*
-GetFundStatus is part of the binding inteface which is delegated to breez.GetFundStatus
+SetChaosMode is part of the binding inteface which is delegated to breez.SetChaosMode
 */
-func GetFundStatus(notificationToken string) ([]byte, error) {
-	return marshalResponse(breez.GetFundStatus(notificationToken))
+func SetChaosMode(probability float64) error {
+	return breez.SetChaosMode(probability)
 }
 
 /*
-RemoveFund is part of the binding inteface which is delegated to breez.RemoveFund
+DecodeLNURLPay is part of the binding inteface which is delegated to breez.DecodeLNURLPay
 */
-func RemoveFund(removeFundRequest []byte) ([]byte, error) {
-	request := &data.RemoveFundRequest{}
-	proto.Unmarshal(removeFundRequest, request)
-	return marshalResponse(breez.RemoveFund(request.Amount, request.Address))
+func DecodeLNURLPay(lnurl string) ([]byte, error) {
+	return marshalResponse(breez.DecodeLNURLPay(lnurl))
 }
 
 /*
-GetLogPath is part of the binding inteface which is delegated to breez.GetLogPath
+PayLNURL is part of the binding inteface which is delegated to breez.PayLNURL
 */
-func GetLogPath() string {
-	return breez.GetLogPath()
+func PayLNURL(payRequest []byte) error {
+	request := &data.PayLNURLRequest{}
+	if err := proto.Unmarshal(payRequest, request); err != nil {
+		return err
+	}
+	return breez.PrefixErrorCode(breez.PayLNURL(request.Params, request.Amount, request.Comment))
 }
 
 /*
-GetPayments is part of the binding inteface which is delegated to breez.GetPayments
+ResolveLightningAddress is part of the binding inteface which is delegated to breez.ResolveLightningAddress
 */
-func GetPayments() ([]byte, error) {
-	return marshalResponse(breez.GetPayments())
+func ResolveLightningAddress(address string, amount int64) ([]byte, error) {
+	return marshalResponse(breez.ResolveLightningAddress(address, amount))
+}
+
+/*
+GetAuditLog is part of the binding inteface which is delegated to breez.GetAuditLog
+*/
+func GetAuditLog(auditFilter []byte) ([]byte, error) {
+	filter := &data.SpendAuditFilter{}
+	if err := proto.Unmarshal(auditFilter, filter); err != nil {
+		return nil, err
+	}
+	return marshalResponse(breez.GetAuditLog(filter))
+}
+
+/*
+GetRecentPaymentsSnapshot is part of the binding inteface which is delegated to breez.GetRecentPaymentsSnapshot
+*/
+func GetRecentPaymentsSnapshot() ([]byte, error) {
+	return marshalResponse(breez.GetRecentPaymentsSnapshot())
+}
+
+/*
+GetIssuedInvoices is part of the binding inteface which is delegated to breez.GetIssuedInvoices
+*/
+func GetIssuedInvoices() ([]byte, error) {
+	return marshalResponse(breez.GetIssuedInvoices())
+}
+
+/*
+AddInvoiceWithPreimage is part of the binding inteface which is delegated to breez.AddInvoiceWithPreimage
+*/
+func AddInvoiceWithPreimage(request []byte) ([]byte, error) {
+	addInvoiceRequest := &data.AddInvoiceRequest{}
+	if err := proto.Unmarshal(request, addInvoiceRequest); err != nil {
+		return nil, err
+	}
+	return marshalResponse(breez.AddInvoiceWithPreimage(addInvoiceRequest))
+}
+
+/*
+SetTaxTemplate is part of the binding inteface which is delegated to breez.SetTaxTemplate
+*/
+func SetTaxTemplate(template []byte) error {
+	taxTemplate := &data.TaxInfo{}
+	if err := proto.Unmarshal(template, taxTemplate); err != nil {
+		return err
+	}
+	return breez.SetTaxTemplate(taxTemplate)
+}
+
+/*
+GetTaxTemplate is part of the binding inteface which is delegated to breez.GetTaxTemplate
+*/
+func GetTaxTemplate() ([]byte, error) {
+	return marshalResponse(breez.GetTaxTemplate())
+}
+
+/*
+SetSpendingPolicy is part of the binding inteface which is delegated to breez.SetSpendingPolicy
+*/
+func SetSpendingPolicy(policy []byte) error {
+	spendingPolicy := &data.SpendingPolicy{}
+	if err := proto.Unmarshal(policy, spendingPolicy); err != nil {
+		return err
+	}
+	return breez.SetSpendingPolicy(spendingPolicy)
+}
+
+/*
+GetSpendingPolicy is part of the binding inteface which is delegated to breez.GetSpendingPolicy
+*/
+func GetSpendingPolicy() ([]byte, error) {
+	return marshalResponse(breez.GetSpendingPolicy())
+}
+
+/*
+SetPaymentAuthorizer is part of the binding inteface which is delegated to breez.SetPaymentAuthorizer
+*/
+func SetPaymentAuthorizer(authorizer PaymentAuthorizer) {
+	if authorizer == nil {
+		breez.SetPaymentAuthorizer(nil)
+		return
+	}
+	breez.SetPaymentAuthorizer(&paymentAuthorizer{authorizer: authorizer})
+}
+
+/*
+CreatePaymentCode is part of the binding inteface which is delegated to breez.CreatePaymentCode
+*/
+func CreatePaymentCode(request []byte) ([]byte, error) {
+	codeRequest := &data.CreatePaymentCodeRequest{}
+	if err := proto.Unmarshal(request, codeRequest); err != nil {
+		return nil, err
+	}
+	return marshalResponse(breez.CreatePaymentCode(codeRequest))
+}
+
+/*
+GetPaymentCodes is part of the binding inteface which is delegated to breez.GetPaymentCodes
+*/
+func GetPaymentCodes() ([]byte, error) {
+	return marshalResponse(breez.GetPaymentCodes())
+}
+
+/*
+DeletePaymentCode is part of the binding inteface which is delegated to breez.DeletePaymentCode
+*/
+func DeletePaymentCode(id string) error {
+	return breez.DeletePaymentCode(id)
+}
+
+/*
+NextPaymentCodeInvoice is part of the binding inteface which is delegated to breez.NextPaymentCodeInvoice
+*/
+func NextPaymentCodeInvoice(id string) (string, error) {
+	return breez.NextPaymentCodeInvoice(id)
+}
+
+/*
+GetDeviceIdentityKey is part of the binding inteface which is delegated to breez.GetDeviceIdentityKey
+*/
+func GetDeviceIdentityKey() (string, error) {
+	return breez.GetDeviceIdentityKey()
+}
+
+/*
+SetDeviceIdentityKey is part of the binding inteface which is delegated to breez.SetDeviceIdentityKey
+*/
+func SetDeviceIdentityKey(identityKey string) error {
+	return breez.SetDeviceIdentityKey(identityKey)
+}
+
+/*
+SetTransferAutoAccept is part of the binding inteface which is delegated to breez.SetTransferAutoAccept
+*/
+func SetTransferAutoAccept(enabled bool) error {
+	return breez.SetTransferAutoAccept(enabled)
+}
+
+/*
+AutoAcceptTransfer is part of the binding inteface which is delegated to breez.AutoAcceptTransfer
+*/
+func AutoAcceptTransfer(paymentRequest string) (bool, error) {
+	return breez.AutoAcceptTransfer(paymentRequest)
 }
 
 /*
-PayBlankInvoice is part of the binding inteface which is delegated to breez.PayBlankInvoice
+AddInvoiceReminder is part of the binding inteface which is delegated to breez.AddInvoiceReminder
 */
-func SendPaymentForRequest(payInvoiceRequest []byte) error {
-	decodedRequest := &data.PayInvoiceRequest{}
-	proto.Unmarshal(payInvoiceRequest, decodedRequest)
-	return breez.SendPaymentForRequest(decodedRequest.PaymentRequest, decodedRequest.Amount)
+func AddInvoiceReminder(reminderRequest []byte) error {
+	request := &data.InvoiceReminderRequest{}
+	if err := proto.Unmarshal(reminderRequest, request); err != nil {
+		return err
+	}
+	return breez.AddInvoiceReminder(request.PaymentRequest, request.RemindBefore, request.Locale)
 }
 
 /*
-AddInvoice is part of the binding inteface which is delegated to breez.AddInvoice
+CancelInvoiceReminder is part of the binding inteface which is delegated to breez.CancelInvoiceReminder
 */
-func AddInvoice(invoice []byte) (paymentRequest string, err error) {
-	decodedInvoiceMemo := &data.InvoiceMemo{}
-	proto.Unmarshal(invoice, decodedInvoiceMemo)
-	return breez.AddInvoice(decodedInvoiceMemo)
+func CancelInvoiceReminder(paymentHash string) error {
+	return breez.CancelInvoiceReminder(paymentHash)
 }
 
 /*
@@ -206,7 +1165,7 @@ AddStandardInvoice is part of the binding inteface which is delegated to breez.A
 func AddStandardInvoice(invoice []byte) (paymentRequest string, err error) {
 	decodedStandardInvoiceMemo := &data.InvoiceMemo{}
 	proto.Unmarshal(invoice, decodedStandardInvoiceMemo)
-	return breez.AddStandardInvoice(decodedStandardInvoiceMemo)
+	return stringResponse(breez.AddStandardInvoice(decodedStandardInvoiceMemo))
 }
 
 /*
@@ -216,6 +1175,13 @@ func DecodePaymentRequest(paymentRequest string) ([]byte, error) {
 	return marshalResponse(breez.DecodePaymentRequest(paymentRequest))
 }
 
+/*
+ParsePaymentURI is part of the binding inteface which is delegated to breez.ParsePaymentURI
+*/
+func ParsePaymentURI(uri string) ([]byte, error) {
+	return marshalResponse(breez.ParsePaymentURI(uri))
+}
+
 /*
 GetRelatedInvoice is part of the binding inteface which is delegated to breez.GetRelatedInvoice
 */
@@ -229,7 +1195,7 @@ SendWalletCoins is part of the binding inteface which is delegated to breez.Send
 func SendWalletCoins(sendCoinsRequest []byte) (string, error) {
 	unmarshaledRequest := data.SendWalletCoinsRequest{}
 	proto.Unmarshal(sendCoinsRequest, &unmarshaledRequest)
-	return breez.SendWalletCoins(unmarshaledRequest.Address, unmarshaledRequest.Amount, unmarshaledRequest.SatPerByteFee)
+	return stringResponse(breez.SendWalletCoins(unmarshaledRequest.Address, unmarshaledRequest.Amount, unmarshaledRequest.SatPerByteFee))
 }
 
 /*
@@ -246,6 +1212,48 @@ func ValidateAddress(address string) error {
 	return breez.ValidateAddress(address)
 }
 
+/*
+GetRate is part of the binding inteface which is delegated to breez.GetRate
+*/
+func GetRate(currency string) ([]byte, error) {
+	return marshalResponse(breez.GetRate(currency))
+}
+
+/*
+GetRates is part of the binding inteface which is delegated to breez.GetRates
+*/
+func GetRates() ([]byte, error) {
+	return marshalResponse(breez.GetRates())
+}
+
+/*
+GetRatesProviders is part of the binding inteface which is delegated to breez.GetRatesProviders
+*/
+func GetRatesProviders() ([]byte, error) {
+	return marshalResponse(breez.GetRatesProviders())
+}
+
+/*
+SetRatesSource is part of the binding inteface which is delegated to breez.SetRatesSource
+*/
+func SetRatesSource(name string) error {
+	return breez.SetRatesSource(name)
+}
+
+/*
+SetFiatCurrency is part of the binding inteface which is delegated to breez.SetFiatCurrency
+*/
+func SetFiatCurrency(currency string) error {
+	return breez.SetFiatCurrency(currency)
+}
+
+/*
+GetFiatCurrency is part of the binding inteface which is delegated to breez.GetFiatCurrency
+*/
+func GetFiatCurrency() (string, error) {
+	return breez.GetFiatCurrency()
+}
+
 /*
 SendCommand is part of the binding inteface which is delegated to breez.SendPaymentForRequest
 */
@@ -358,9 +1366,14 @@ func BootstrapFiles(request []byte) error {
 	return bootstrap.PutFiles(req.GetWorkingDir(), req.GetFullPaths())
 }
 
-func deliverNotifications(notificationsChan chan data.NotificationEvent, notifier BreezNotifier) {
+func deliverNotifications(notificationsChan chan data.NotificationEvent) {
 	for {
 		notification := <-notificationsChan
+		notifier := getNotifier()
+		if notifier == nil {
+			//left in the outbox, replayed when a notifier is attached
+			continue
+		}
 		res, err := proto.Marshal(&notification)
 		if err != nil {
 			fmt.Fprintln(os.Stderr, "Error in marshaing notification", err)
@@ -371,7 +1384,7 @@ func deliverNotifications(notificationsChan chan data.NotificationEvent, notifie
 
 func marshalResponse(message proto.Message, responseError error) (buffer []byte, err error) {
 	if responseError != nil {
-		return nil, responseError
+		return nil, breez.PrefixErrorCode(responseError)
 	}
 	res, err := proto.Marshal(message)
 	if err != nil {
@@ -379,3 +1392,9 @@ func marshalResponse(message proto.Message, responseError error) (buffer []byte,
 	}
 	return res, nil
 }
+
+// stringResponse prefixes the error of a call returning a string with its
+// code, as marshalResponse does.
+func stringResponse(response string, responseError error) (string, error) {
+	return response, breez.PrefixErrorCode(responseError)
+}
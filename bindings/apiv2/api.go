@@ -0,0 +1,73 @@
+/*
+Package apiv2 is the second version of the mobile bindings. It only holds the functions
+whose signature changed from the first version (bindings), so the apps can move to them
+one call at a time while keeping using the first version for the rest.
+
+The calls take a call id which CancelCall uses to cancel them, an empty id makes a call
+that can't be canceled. Their errors are of type *Error which carries the error code.
+*/
+package apiv2
+
+import (
+	"github.com/breez/breez"
+	"github.com/breez/breez/data"
+	"github.com/golang/protobuf/proto"
+)
+
+func marshalResponse(message proto.Message, responseError error) (buffer []byte, err error) {
+	if responseError != nil {
+		return nil, newError(responseError)
+	}
+	return proto.Marshal(message)
+}
+
+/*
+GetPayments returns a page of the payments history.
+The request is a serialized data.PaymentsPageRequest and the response a serialized data.PaymentsPage.
+It replaces bindings.GetPayments which returns the whole history at once.
+*/
+func GetPayments(callID string, request []byte) ([]byte, error) {
+	pageRequest := &data.PaymentsPageRequest{}
+	if err := proto.Unmarshal(request, pageRequest); err != nil {
+		return nil, err
+	}
+	ctx, release := callContext(callID)
+	defer release()
+	return marshalResponse(breez.GetPaymentsPageContext(ctx, pageRequest))
+}
+
+/*
+SendPaymentForRequest is delegated to breez.SendPaymentForRequestContext, or to
+breez.SendPaymentWithFeeLimitContext when the request has a fee limit.
+The request is a serialized data.PayInvoiceRequest.
+*/
+func SendPaymentForRequest(callID string, payInvoiceRequest []byte) error {
+	request := &data.PayInvoiceRequest{}
+	if err := proto.Unmarshal(payInvoiceRequest, request); err != nil {
+		return err
+	}
+	ctx, release := callContext(callID)
+	defer release()
+	if request.FeeLimit != nil {
+		return newError(breez.SendPaymentWithFeeLimitContext(ctx, request.PaymentRequest, request.Amount, request.FeeLimit))
+	}
+	return newError(breez.SendPaymentForRequestContext(ctx, request.PaymentRequest, request.Amount))
+}
+
+/*
+AddInvoice is delegated to breez.AddInvoiceContext.
+The invoice is a serialized data.InvoiceMemo, the response is the payment request.
+*/
+func AddInvoice(callID string, invoice []byte) (string, error) {
+	invoiceMemo := &data.InvoiceMemo{}
+	if err := proto.Unmarshal(invoice, invoiceMemo); err != nil {
+		return "", err
+	}
+	ctx, release := callContext(callID)
+	defer release()
+	paymentRequest, err := breez.AddInvoiceContext(ctx, invoiceMemo)
+	if err != nil {
+		return "", newError(err)
+	}
+	return paymentRequest, nil
+}
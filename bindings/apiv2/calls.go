@@ -0,0 +1,43 @@
+package apiv2
+
+import (
+	"context"
+	"sync"
+)
+
+var (
+	callsMu sync.Mutex
+	calls   = make(map[string]context.CancelFunc)
+)
+
+// callContext returns the context of the call identified by callID, which
+// CancelCall cancels, and the function releasing it once the call returned.
+// Calls without an id can't be canceled.
+func callContext(callID string) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	if callID == "" {
+		return ctx, cancel
+	}
+	callsMu.Lock()
+	calls[callID] = cancel
+	callsMu.Unlock()
+	return ctx, func() {
+		callsMu.Lock()
+		delete(calls, callID)
+		callsMu.Unlock()
+		cancel()
+	}
+}
+
+/*
+CancelCall cancels the running call started with the given call id, which makes it return an error
+if it didn't complete yet. The apps pick the ids, which must be unique among the running calls, e.g. a
+random UUID per call.
+*/
+func CancelCall(callID string) {
+	callsMu.Lock()
+	defer callsMu.Unlock()
+	if cancel, ok := calls[callID]; ok {
+		cancel()
+	}
+}
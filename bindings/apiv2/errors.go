@@ -0,0 +1,26 @@
+package apiv2
+
+import (
+	"github.com/breez/breez"
+)
+
+/*
+Error is the error returned by the calls of this version. Code is a data.ErrorCode value so the apps
+can handle the failures without parsing the message, which is the one of the underlying error.
+*/
+type Error struct {
+	Code    int32
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// newError converts an error of the breez API to an Error.
+func newError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{Code: int32(breez.ErrorCodeOf(err)), Message: err.Error()}
+}
@@ -0,0 +1,51 @@
+package bindings
+
+import (
+	"fmt"
+
+	"github.com/breez/breez/bindings/apiv2"
+	"github.com/breez/breez/data"
+)
+
+// The functions of this file keep the signatures of the first version but are
+// delegated to their apiv2 counterparts, so both versions behave the same
+// while the apps move to apiv2.
+
+// v1Error converts an apiv2 error to the error of the first version, whose
+// message is prefixed by its code.
+func v1Error(err error) error {
+	e, ok := err.(*apiv2.Error)
+	if !ok || data.ErrorCode(e.Code) == data.ErrorCode_UNKNOWN_ERROR {
+		return err
+	}
+	return fmt.Errorf("%v: %v", data.ErrorCode(e.Code), e.Message)
+}
+
+/*
+GetPaymentsPage is part of the binding inteface which is delegated to apiv2.GetPayments
+
+Deprecated: use apiv2.GetPayments.
+*/
+func GetPaymentsPage(request []byte) ([]byte, error) {
+	response, err := apiv2.GetPayments("", request)
+	return response, v1Error(err)
+}
+
+/*
+SendPaymentForRequest is part of the binding inteface which is delegated to apiv2.SendPaymentForRequest
+
+Deprecated: use apiv2.SendPaymentForRequest which can be canceled.
+*/
+func SendPaymentForRequest(payInvoiceRequest []byte) error {
+	return v1Error(apiv2.SendPaymentForRequest("", payInvoiceRequest))
+}
+
+/*
+AddInvoice is part of the binding inteface which is delegated to apiv2.AddInvoice
+
+Deprecated: use apiv2.AddInvoice which can be canceled.
+*/
+func AddInvoice(invoice []byte) (paymentRequest string, err error) {
+	paymentRequest, err = apiv2.AddInvoice("", invoice)
+	return paymentRequest, v1Error(err)
+}
@@ -0,0 +1,24 @@
+package bindings
+
+const (
+	//apiVersion is the latest bindings version, every version is a package: bindings (1), apiv2 (2)
+	apiVersion = 2
+
+	//minAPIVersion is the oldest bindings version still supported
+	minAPIVersion = 1
+)
+
+/*
+GetAPIVersion returns the latest version of the bindings API this library implements.
+*/
+func GetAPIVersion() int64 {
+	return apiVersion
+}
+
+/*
+GetMinAPIVersion returns the oldest version of the bindings API this library still supports.
+Functions of older versions are removed.
+*/
+func GetMinAPIVersion() int64 {
+	return minAPIVersion
+}
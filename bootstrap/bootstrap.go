@@ -17,6 +17,7 @@ var (
 		"wallet.db":              "data/chain/bitcoin/{{network}}",
 		"channel.db":             "data/graph/{{network}}",
 		"breez.db":               "",
+		"credentials.enc":        "",
 	}
 	defaultPath = "data/chain/bitcoin"
 )
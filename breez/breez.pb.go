@@ -26,8 +26,14 @@ It has these top-level messages:
 	MempoolRegisterReply
 	RegisterTransactionConfirmationRequest
 	RegisterTransactionConfirmationResponse
+	AddWrappedInvoiceRequest
+	AddWrappedInvoiceReply
+	RegisterPaymentNotificationRequest
+	RegisterPaymentNotificationReply
 	PingRequest
 	PingReply
+	GetSwapLimitsRequest
+	GetSwapLimitsReply
 */
 package breez
 
@@ -534,13 +540,129 @@ func (*RegisterTransactionConfirmationResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor0, []int{17}
 }
 
+type AddWrappedInvoiceRequest struct {
+	NodeID      string `protobuf:"bytes,1,opt,name=nodeID" json:"nodeID,omitempty"`
+	PaymentHash []byte `protobuf:"bytes,2,opt,name=paymentHash,proto3" json:"paymentHash,omitempty"`
+	Amount      int64  `protobuf:"varint,3,opt,name=amount" json:"amount,omitempty"`
+	Memo        string `protobuf:"bytes,4,opt,name=memo" json:"memo,omitempty"`
+	Expiry      int64  `protobuf:"varint,5,opt,name=expiry" json:"expiry,omitempty"`
+}
+
+func (m *AddWrappedInvoiceRequest) Reset()                    { *m = AddWrappedInvoiceRequest{} }
+func (m *AddWrappedInvoiceRequest) String() string            { return proto.CompactTextString(m) }
+func (*AddWrappedInvoiceRequest) ProtoMessage()               {}
+func (*AddWrappedInvoiceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+func (m *AddWrappedInvoiceRequest) GetNodeID() string {
+	if m != nil {
+		return m.NodeID
+	}
+	return ""
+}
+
+func (m *AddWrappedInvoiceRequest) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+func (m *AddWrappedInvoiceRequest) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *AddWrappedInvoiceRequest) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *AddWrappedInvoiceRequest) GetExpiry() int64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+type AddWrappedInvoiceReply struct {
+	PaymentRequest string `protobuf:"bytes,1,opt,name=paymentRequest" json:"paymentRequest,omitempty"`
+	ErrorMessage   string `protobuf:"bytes,2,opt,name=errorMessage" json:"errorMessage,omitempty"`
+	ServiceFee     int64  `protobuf:"varint,3,opt,name=serviceFee" json:"serviceFee,omitempty"`
+}
+
+func (m *AddWrappedInvoiceReply) Reset()                    { *m = AddWrappedInvoiceReply{} }
+func (m *AddWrappedInvoiceReply) String() string            { return proto.CompactTextString(m) }
+func (*AddWrappedInvoiceReply) ProtoMessage()               {}
+func (*AddWrappedInvoiceReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+func (m *AddWrappedInvoiceReply) GetPaymentRequest() string {
+	if m != nil {
+		return m.PaymentRequest
+	}
+	return ""
+}
+
+func (m *AddWrappedInvoiceReply) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func (m *AddWrappedInvoiceReply) GetServiceFee() int64 {
+	if m != nil {
+		return m.ServiceFee
+	}
+	return 0
+}
+
+type RegisterPaymentNotificationRequest struct {
+	NodeID            string `protobuf:"bytes,1,opt,name=nodeID" json:"nodeID,omitempty"`
+	NotificationToken string `protobuf:"bytes,2,opt,name=notificationToken" json:"notificationToken,omitempty"`
+}
+
+func (m *RegisterPaymentNotificationRequest) Reset()         { *m = RegisterPaymentNotificationRequest{} }
+func (m *RegisterPaymentNotificationRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterPaymentNotificationRequest) ProtoMessage()    {}
+func (*RegisterPaymentNotificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{20}
+}
+
+func (m *RegisterPaymentNotificationRequest) GetNodeID() string {
+	if m != nil {
+		return m.NodeID
+	}
+	return ""
+}
+
+func (m *RegisterPaymentNotificationRequest) GetNotificationToken() string {
+	if m != nil {
+		return m.NotificationToken
+	}
+	return ""
+}
+
+type RegisterPaymentNotificationReply struct {
+}
+
+func (m *RegisterPaymentNotificationReply) Reset()         { *m = RegisterPaymentNotificationReply{} }
+func (m *RegisterPaymentNotificationReply) String() string { return proto.CompactTextString(m) }
+func (*RegisterPaymentNotificationReply) ProtoMessage()    {}
+func (*RegisterPaymentNotificationReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{21}
+}
+
 type PingRequest struct {
 }
 
 func (m *PingRequest) Reset()                    { *m = PingRequest{} }
 func (m *PingRequest) String() string            { return proto.CompactTextString(m) }
 func (*PingRequest) ProtoMessage()               {}
-func (*PingRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (*PingRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
 type PingReply struct {
 	Version string `protobuf:"bytes,1,opt,name=version" json:"version,omitempty"`
@@ -549,7 +671,7 @@ type PingReply struct {
 func (m *PingReply) Reset()                    { *m = PingReply{} }
 func (m *PingReply) String() string            { return proto.CompactTextString(m) }
 func (*PingReply) ProtoMessage()               {}
-func (*PingReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (*PingReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
 func (m *PingReply) GetVersion() string {
 	if m != nil {
@@ -558,6 +680,62 @@ func (m *PingReply) GetVersion() string {
 	return ""
 }
 
+type GetSwapLimitsRequest struct {
+	NodeID string `protobuf:"bytes,1,opt,name=nodeID" json:"nodeID,omitempty"`
+}
+
+func (m *GetSwapLimitsRequest) Reset()                    { *m = GetSwapLimitsRequest{} }
+func (m *GetSwapLimitsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetSwapLimitsRequest) ProtoMessage()               {}
+func (*GetSwapLimitsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+
+func (m *GetSwapLimitsRequest) GetNodeID() string {
+	if m != nil {
+		return m.NodeID
+	}
+	return ""
+}
+
+type GetSwapLimitsReply struct {
+	MinSwapIn  int64 `protobuf:"varint,1,opt,name=minSwapIn" json:"minSwapIn,omitempty"`
+	MaxSwapIn  int64 `protobuf:"varint,2,opt,name=maxSwapIn" json:"maxSwapIn,omitempty"`
+	MinSwapOut int64 `protobuf:"varint,3,opt,name=minSwapOut" json:"minSwapOut,omitempty"`
+	MaxSwapOut int64 `protobuf:"varint,4,opt,name=maxSwapOut" json:"maxSwapOut,omitempty"`
+}
+
+func (m *GetSwapLimitsReply) Reset()                    { *m = GetSwapLimitsReply{} }
+func (m *GetSwapLimitsReply) String() string            { return proto.CompactTextString(m) }
+func (*GetSwapLimitsReply) ProtoMessage()               {}
+func (*GetSwapLimitsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+
+func (m *GetSwapLimitsReply) GetMinSwapIn() int64 {
+	if m != nil {
+		return m.MinSwapIn
+	}
+	return 0
+}
+
+func (m *GetSwapLimitsReply) GetMaxSwapIn() int64 {
+	if m != nil {
+		return m.MaxSwapIn
+	}
+	return 0
+}
+
+func (m *GetSwapLimitsReply) GetMinSwapOut() int64 {
+	if m != nil {
+		return m.MinSwapOut
+	}
+	return 0
+}
+
+func (m *GetSwapLimitsReply) GetMaxSwapOut() int64 {
+	if m != nil {
+		return m.MaxSwapOut
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*OpenChannelRequest)(nil), "breez.OpenChannelRequest")
 	proto.RegisterType((*OpenChannelReply)(nil), "breez.OpenChannelReply")
@@ -579,8 +757,14 @@ func init() {
 	proto.RegisterType((*MempoolRegisterReply_Transaction)(nil), "breez.MempoolRegisterReply.Transaction")
 	proto.RegisterType((*RegisterTransactionConfirmationRequest)(nil), "breez.RegisterTransactionConfirmationRequest")
 	proto.RegisterType((*RegisterTransactionConfirmationResponse)(nil), "breez.RegisterTransactionConfirmationResponse")
+	proto.RegisterType((*AddWrappedInvoiceRequest)(nil), "breez.AddWrappedInvoiceRequest")
+	proto.RegisterType((*AddWrappedInvoiceReply)(nil), "breez.AddWrappedInvoiceReply")
+	proto.RegisterType((*RegisterPaymentNotificationRequest)(nil), "breez.RegisterPaymentNotificationRequest")
+	proto.RegisterType((*RegisterPaymentNotificationReply)(nil), "breez.RegisterPaymentNotificationReply")
 	proto.RegisterType((*PingRequest)(nil), "breez.PingRequest")
 	proto.RegisterType((*PingReply)(nil), "breez.PingReply")
+	proto.RegisterType((*GetSwapLimitsRequest)(nil), "breez.GetSwapLimitsRequest")
+	proto.RegisterType((*GetSwapLimitsReply)(nil), "breez.GetSwapLimitsReply")
 	proto.RegisterEnum("breez.RegisterTransactionConfirmationRequest_NotificationType", RegisterTransactionConfirmationRequest_NotificationType_name, RegisterTransactionConfirmationRequest_NotificationType_value)
 }
 
@@ -731,6 +915,9 @@ type FundManagerClient interface {
 	RedeemRemovedFunds(ctx context.Context, in *RedeemRemovedFundsRequest, opts ...grpc.CallOption) (*RedeemRemovedFundsReply, error)
 	GetSwapPayment(ctx context.Context, in *GetSwapPaymentRequest, opts ...grpc.CallOption) (*GetSwapPaymentReply, error)
 	RegisterTransactionConfirmation(ctx context.Context, in *RegisterTransactionConfirmationRequest, opts ...grpc.CallOption) (*RegisterTransactionConfirmationResponse, error)
+	AddWrappedInvoice(ctx context.Context, in *AddWrappedInvoiceRequest, opts ...grpc.CallOption) (*AddWrappedInvoiceReply, error)
+	RegisterPaymentNotification(ctx context.Context, in *RegisterPaymentNotificationRequest, opts ...grpc.CallOption) (*RegisterPaymentNotificationReply, error)
+	GetSwapLimits(ctx context.Context, in *GetSwapLimitsRequest, opts ...grpc.CallOption) (*GetSwapLimitsReply, error)
 }
 
 type fundManagerClient struct {
@@ -813,6 +1000,33 @@ func (c *fundManagerClient) RegisterTransactionConfirmation(ctx context.Context,
 	return out, nil
 }
 
+func (c *fundManagerClient) AddWrappedInvoice(ctx context.Context, in *AddWrappedInvoiceRequest, opts ...grpc.CallOption) (*AddWrappedInvoiceReply, error) {
+	out := new(AddWrappedInvoiceReply)
+	err := grpc.Invoke(ctx, "/breez.FundManager/AddWrappedInvoice", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fundManagerClient) RegisterPaymentNotification(ctx context.Context, in *RegisterPaymentNotificationRequest, opts ...grpc.CallOption) (*RegisterPaymentNotificationReply, error) {
+	out := new(RegisterPaymentNotificationReply)
+	err := grpc.Invoke(ctx, "/breez.FundManager/RegisterPaymentNotification", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fundManagerClient) GetSwapLimits(ctx context.Context, in *GetSwapLimitsRequest, opts ...grpc.CallOption) (*GetSwapLimitsReply, error) {
+	out := new(GetSwapLimitsReply)
+	err := grpc.Invoke(ctx, "/breez.FundManager/GetSwapLimits", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for FundManager service
 
 type FundManagerServer interface {
@@ -824,6 +1038,9 @@ type FundManagerServer interface {
 	RedeemRemovedFunds(context.Context, *RedeemRemovedFundsRequest) (*RedeemRemovedFundsReply, error)
 	GetSwapPayment(context.Context, *GetSwapPaymentRequest) (*GetSwapPaymentReply, error)
 	RegisterTransactionConfirmation(context.Context, *RegisterTransactionConfirmationRequest) (*RegisterTransactionConfirmationResponse, error)
+	AddWrappedInvoice(context.Context, *AddWrappedInvoiceRequest) (*AddWrappedInvoiceReply, error)
+	RegisterPaymentNotification(context.Context, *RegisterPaymentNotificationRequest) (*RegisterPaymentNotificationReply, error)
+	GetSwapLimits(context.Context, *GetSwapLimitsRequest) (*GetSwapLimitsReply, error)
 }
 
 func RegisterFundManagerServer(s *grpc.Server, srv FundManagerServer) {
@@ -974,6 +1191,60 @@ func _FundManager_RegisterTransactionConfirmation_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FundManager_AddWrappedInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWrappedInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FundManagerServer).AddWrappedInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/breez.FundManager/AddWrappedInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FundManagerServer).AddWrappedInvoice(ctx, req.(*AddWrappedInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FundManager_RegisterPaymentNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterPaymentNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FundManagerServer).RegisterPaymentNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/breez.FundManager/RegisterPaymentNotification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FundManagerServer).RegisterPaymentNotification(ctx, req.(*RegisterPaymentNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FundManager_GetSwapLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSwapLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FundManagerServer).GetSwapLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/breez.FundManager/GetSwapLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FundManagerServer).GetSwapLimits(ctx, req.(*GetSwapLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _FundManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "breez.FundManager",
 	HandlerType: (*FundManagerServer)(nil),
@@ -1010,6 +1281,18 @@ var _FundManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterTransactionConfirmation",
 			Handler:    _FundManager_RegisterTransactionConfirmation_Handler,
 		},
+		{
+			MethodName: "AddWrappedInvoice",
+			Handler:    _FundManager_AddWrappedInvoice_Handler,
+		},
+		{
+			MethodName: "RegisterPaymentNotification",
+			Handler:    _FundManager_RegisterPaymentNotification_Handler,
+		},
+		{
+			MethodName: "GetSwapLimits",
+			Handler:    _FundManager_GetSwapLimits_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "breez.proto",
@@ -1018,70 +1301,83 @@ var _FundManager_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("breez.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1029 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x51, 0x73, 0xdb, 0x44,
-	0x10, 0xb6, 0xec, 0xb8, 0xc4, 0xeb, 0xc4, 0x75, 0xaf, 0x49, 0xea, 0xa8, 0xa5, 0x0d, 0x37, 0xd3,
-	0x36, 0xcc, 0x50, 0x3f, 0x18, 0x1e, 0x28, 0x33, 0x01, 0x1c, 0x47, 0x50, 0x0f, 0xb5, 0xe3, 0xb9,
-	0x98, 0x4e, 0x0a, 0xc3, 0x64, 0x64, 0xeb, 0xe2, 0x88, 0xc8, 0x77, 0x42, 0x92, 0xd3, 0x98, 0x47,
-	0x1e, 0xf8, 0x0f, 0xbc, 0xf1, 0x0b, 0x78, 0x62, 0xf8, 0x7d, 0xcc, 0x9d, 0x4e, 0xb2, 0x64, 0xcb,
-	0x4e, 0xf2, 0xa6, 0xdd, 0xdb, 0xfd, 0xf6, 0xdb, 0xd5, 0xee, 0xde, 0x41, 0x79, 0xe0, 0x51, 0xfa,
-	0x7b, 0xdd, 0xf5, 0x78, 0xc0, 0x51, 0x51, 0x0a, 0xf8, 0x27, 0x40, 0xc7, 0x2e, 0x65, 0xad, 0x0b,
-	0x93, 0x31, 0xea, 0x10, 0xfa, 0xdb, 0x84, 0xfa, 0x01, 0xda, 0x81, 0x7b, 0xee, 0x64, 0xf0, 0x03,
-	0x9d, 0xd6, 0xb4, 0x3d, 0x6d, 0xbf, 0x44, 0x94, 0x84, 0x3e, 0x83, 0x07, 0x8c, 0x07, 0xf6, 0xb9,
-	0x3d, 0x34, 0x03, 0x9b, 0xb3, 0x3e, 0xbf, 0xa4, 0xac, 0x96, 0x97, 0x26, 0x8b, 0x07, 0x18, 0x41,
-	0x35, 0x85, 0xed, 0x3a, 0x53, 0xfc, 0x05, 0xe8, 0x3f, 0xba, 0x96, 0x19, 0x50, 0xa5, 0xed, 0x71,
-	0xc7, 0x1e, 0x4e, 0x6f, 0x88, 0x8b, 0x75, 0xa8, 0x65, 0x7a, 0x09, 0xc4, 0x3f, 0x35, 0x40, 0x4d,
-	0xcb, 0xfa, 0x6e, 0xc2, 0xac, 0x36, 0xb3, 0x83, 0x04, 0x14, 0xe3, 0x16, 0x6d, 0x1f, 0x45, 0x50,
-	0xa1, 0x74, 0xb7, 0x14, 0x14, 0xa1, 0x4b, 0x3a, 0xad, 0x15, 0xf6, 0xb4, 0xfd, 0x0d, 0xa2, 0x24,
-	0x84, 0x60, 0xed, 0xc2, 0xf4, 0x2f, 0x6a, 0x6b, 0x52, 0x2b, 0xbf, 0xf1, 0x7f, 0x1a, 0x54, 0x53,
-	0x44, 0x5c, 0x67, 0x8a, 0x6a, 0xf0, 0x91, 0x69, 0x59, 0x1e, 0xf5, 0x7d, 0xc5, 0x23, 0x12, 0x13,
-	0xd0, 0xf9, 0x14, 0xf4, 0x53, 0x00, 0x87, 0x0f, 0x2f, 0xdf, 0x50, 0x7b, 0x74, 0x11, 0xc8, 0xb0,
-	0x05, 0x92, 0xd0, 0x88, 0x04, 0xc6, 0xe6, 0x75, 0xd3, 0x71, 0xf8, 0x07, 0x6a, 0x1d, 0x51, 0x97,
-	0xfb, 0x76, 0x20, 0x79, 0x14, 0xc8, 0xe2, 0x01, 0xc2, 0xb0, 0x41, 0x3d, 0x8f, 0x7b, 0x1d, 0xea,
-	0xfb, 0xe6, 0x88, 0xd6, 0x8a, 0x92, 0x44, 0x4a, 0x87, 0x07, 0xb0, 0xa5, 0x78, 0x9f, 0x04, 0x66,
-	0x30, 0xf1, 0xa3, 0x12, 0x3e, 0x81, 0x92, 0x22, 0x4b, 0x05, 0xfb, 0xc2, 0x7e, 0x89, 0xcc, 0x14,
-	0x77, 0xec, 0x85, 0x7f, 0xf3, 0xf1, 0x5f, 0x8a, 0x82, 0x88, 0xf2, 0xb4, 0x60, 0xdd, 0x97, 0xa2,
-	0x8a, 0x50, 0x6e, 0xbc, 0xac, 0x87, 0x5d, 0xba, 0x68, 0x5c, 0x3f, 0x51, 0x96, 0x06, 0x0b, 0xbc,
-	0x29, 0x89, 0x1d, 0x75, 0x1f, 0x36, 0x9b, 0x21, 0xad, 0xd0, 0x02, 0x55, 0x20, 0x1f, 0x5c, 0xab,
-	0x7a, 0xe7, 0x83, 0x6b, 0x51, 0x6a, 0x73, 0xcc, 0x27, 0x2c, 0x90, 0xfc, 0x0a, 0x44, 0x49, 0x22,
-	0xc1, 0x21, 0x67, 0xe7, 0xb6, 0x37, 0xa6, 0x96, 0xac, 0xf4, 0x3a, 0x99, 0x29, 0xc4, 0xe9, 0x40,
-	0xd6, 0x3d, 0xfa, 0xd1, 0x25, 0x32, 0x53, 0xe8, 0x16, 0x6c, 0xa6, 0xf8, 0xa0, 0x2a, 0x14, 0x2e,
-	0xe3, 0xc6, 0x15, 0x9f, 0xe8, 0x00, 0x8a, 0x57, 0xa6, 0x33, 0xa1, 0x32, 0xea, 0xca, 0xcc, 0x52,
-	0xf4, 0x49, 0xe8, 0xf5, 0x55, 0xfe, 0x4b, 0x0d, 0x1b, 0xf0, 0x80, 0xd0, 0x31, 0xbf, 0xa2, 0xc2,
-	0x23, 0xfa, 0x2f, 0x2b, 0x7b, 0x2a, 0x2b, 0x51, 0xfc, 0x0b, 0xdc, 0x4f, 0xc2, 0x88, 0xca, 0xbf,
-	0x80, 0x8a, 0x6b, 0x4e, 0xc7, 0x94, 0x45, 0x13, 0xa3, 0xb0, 0xe6, 0xb4, 0x0b, 0x0d, 0x94, 0xcf,
-	0x68, 0xa0, 0x03, 0xd8, 0x25, 0xd4, 0xa2, 0x74, 0x1c, 0x06, 0x91, 0xe9, 0xc5, 0x5d, 0xb4, 0x07,
-	0x65, 0x05, 0x29, 0x27, 0x26, 0x8c, 0x92, 0x54, 0xe1, 0x57, 0xf0, 0x28, 0xcb, 0x5d, 0xb0, 0x44,
-	0xb0, 0x16, 0x5c, 0xdb, 0x96, 0xf2, 0x92, 0xdf, 0xf8, 0x1b, 0xd8, 0xfe, 0x9e, 0x06, 0x27, 0x1f,
-	0x4c, 0xb7, 0x97, 0xa6, 0x7a, 0xcb, 0x94, 0xf0, 0x6b, 0x78, 0x38, 0x0f, 0x20, 0x62, 0x61, 0xd8,
-	0x50, 0x86, 0x86, 0x48, 0x4e, 0x39, 0xa7, 0x74, 0x98, 0xc0, 0x4e, 0x87, 0x8e, 0x5d, 0xce, 0x1d,
-	0x42, 0x47, 0xb6, 0x1f, 0x50, 0x2f, 0x0a, 0xae, 0xc3, 0xfa, 0xd0, 0xb1, 0x29, 0x0b, 0xe2, 0x8d,
-	0x13, 0xcb, 0xe9, 0x41, 0xca, 0xcf, 0x0d, 0x12, 0xfe, 0x5b, 0x83, 0xad, 0x05, 0x50, 0x41, 0xe8,
-	0x35, 0x14, 0xfa, 0xa7, 0x27, 0x73, 0x73, 0x91, 0x65, 0x59, 0xef, 0x7b, 0x26, 0xf3, 0xcd, 0xa1,
-	0x18, 0x37, 0x22, 0x7c, 0xf4, 0x0e, 0x94, 0x13, 0x3a, 0x31, 0x10, 0xfd, 0xd3, 0x68, 0x20, 0xfa,
-	0xa7, 0xa2, 0x83, 0x54, 0xcb, 0xa9, 0xff, 0x19, 0x89, 0x68, 0x0b, 0x8a, 0xef, 0x64, 0xcf, 0x8a,
-	0x71, 0xd0, 0x48, 0x28, 0xe0, 0xbf, 0xf2, 0xf0, 0x22, 0x8a, 0x98, 0xc0, 0x6d, 0x85, 0xb3, 0x22,
-	0xc7, 0x3c, 0xaa, 0x83, 0xfc, 0x63, 0x71, 0x0d, 0xe4, 0xf7, 0x1d, 0x77, 0xee, 0xaf, 0x50, 0x4d,
-	0x29, 0xa7, 0x6e, 0xc8, 0xa6, 0xd2, 0xf8, 0x5a, 0xd5, 0xe0, 0x76, 0x54, 0xea, 0xdd, 0x39, 0x14,
-	0xb2, 0x80, 0x8b, 0x9b, 0x50, 0x9d, 0xb7, 0x42, 0xbb, 0xb0, 0x4d, 0x8c, 0xe6, 0xd1, 0xfb, 0x33,
-	0x62, 0xb4, 0x8c, 0xf6, 0x3b, 0xe3, 0xac, 0xd7, 0x7c, 0xdf, 0x31, 0xba, 0xfd, 0x6a, 0x0e, 0x21,
-	0xa8, 0xb4, 0xde, 0x34, 0xbb, 0x5d, 0xe3, 0xed, 0xd9, 0x71, 0xcf, 0xe8, 0x1a, 0x47, 0x55, 0x0d,
-	0x7f, 0x0a, 0x2f, 0x6f, 0xe4, 0xe3, 0xbb, 0x9c, 0xf9, 0x14, 0x6f, 0x42, 0xb9, 0x67, 0xb3, 0x51,
-	0xd4, 0x87, 0xcf, 0xa1, 0x14, 0x8a, 0xea, 0xa2, 0xb8, 0xa2, 0x9e, 0x6f, 0x73, 0x16, 0x0d, 0xb5,
-	0x12, 0x1b, 0x07, 0x50, 0x6e, 0xb3, 0x73, 0xae, 0xc0, 0x50, 0x1d, 0xd6, 0x84, 0x17, 0x42, 0xaa,
-	0x18, 0x09, 0x44, 0xbd, 0x9a, 0xd2, 0x89, 0xdb, 0x31, 0xd7, 0x18, 0xc0, 0x7d, 0xd5, 0x33, 0x61,
-	0xa6, 0xd4, 0x43, 0xc7, 0xb1, 0x2a, 0x62, 0x8e, 0x3e, 0x5e, 0xd6, 0x5e, 0x21, 0xf0, 0xe3, 0x15,
-	0xdd, 0x87, 0x73, 0x8d, 0x7f, 0x8a, 0x50, 0x16, 0x53, 0xdb, 0x31, 0x99, 0x39, 0xa2, 0x1e, 0x6a,
-	0x41, 0x39, 0x71, 0xf3, 0xa3, 0x5d, 0xe5, 0xbd, 0xf8, 0xd2, 0xd0, 0x1f, 0x65, 0x1d, 0x49, 0x50,
-	0xf4, 0x33, 0x3c, 0xcc, 0xb8, 0xf4, 0xd1, 0x27, 0xca, 0x63, 0xf9, 0x33, 0x42, 0x7f, 0xb6, 0xca,
-	0x24, 0x04, 0x6f, 0x41, 0x39, 0x71, 0x57, 0xc7, 0x0c, 0x17, 0x1f, 0x12, 0x31, 0xc3, 0xf9, 0xab,
-	0x1d, 0xe7, 0x50, 0x5b, 0x5e, 0x3c, 0xb3, 0x65, 0x8e, 0x1e, 0x67, 0xaf, 0xf8, 0x10, 0x68, 0x77,
-	0xe9, 0xfe, 0xc7, 0x39, 0xf4, 0x2d, 0xc0, 0x6c, 0x43, 0xa3, 0x5a, 0xdc, 0xe8, 0x73, 0xbb, 0x5f,
-	0xdf, 0xc9, 0x38, 0x09, 0x11, 0x4e, 0x01, 0x2d, 0x6e, 0x51, 0xb4, 0x17, 0xdb, 0x2f, 0xd9, 0xcf,
-	0xfa, 0xd3, 0x15, 0x16, 0x21, 0xf2, 0x5b, 0xa8, 0xa4, 0xf7, 0x25, 0x7a, 0xa2, 0x7c, 0x32, 0xf7,
-	0xb0, 0xae, 0x2f, 0x39, 0x0d, 0xd1, 0xfe, 0xd0, 0xe0, 0xd9, 0x0d, 0x03, 0x83, 0x5e, 0xdd, 0x69,
-	0xd0, 0xf5, 0xfa, 0x6d, 0xcd, 0xd5, 0x1c, 0xe6, 0x0e, 0x9f, 0xc3, 0xb6, 0xcd, 0xeb, 0x23, 0xcf,
-	0x1d, 0x2a, 0x57, 0x9f, 0x7a, 0x57, 0xf6, 0x90, 0x1e, 0xc2, 0xa1, 0x10, 0x7b, 0xe2, 0x89, 0xdc,
-	0xd3, 0x06, 0xf7, 0xe4, 0x5b, 0xf9, 0xf3, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x1f, 0x9f,
-	0x6a, 0x3a, 0x0b, 0x00, 0x00,
+	// 1236 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xad, 0x57, 0x5f, 0x53, 0xdb, 0x46,
+	0x10, 0x47, 0x36, 0x24, 0xb0, 0x0e, 0x8e, 0xb9, 0x00, 0x31, 0x22, 0x09, 0xe4, 0x66, 0x12, 0x9a,
+	0x99, 0xc6, 0x0f, 0xb4, 0x0f, 0x4d, 0x67, 0x68, 0x6b, 0x8c, 0xd2, 0x78, 0x8a, 0xc1, 0x23, 0x9c,
+	0x94, 0x34, 0x93, 0x61, 0x64, 0xeb, 0x00, 0x05, 0x5b, 0x52, 0x25, 0x99, 0xe0, 0x3e, 0x76, 0x3a,
+	0x7d, 0xee, 0x63, 0xfb, 0xd6, 0x0f, 0xd1, 0xe9, 0x37, 0xe8, 0xf7, 0xea, 0xfd, 0x93, 0x2c, 0x59,
+	0xb2, 0x0d, 0x33, 0x79, 0xd3, 0xee, 0xed, 0xed, 0xfe, 0x76, 0x6f, 0xff, 0x09, 0x0a, 0x6d, 0x8f,
+	0x90, 0x5f, 0x2a, 0xae, 0xe7, 0x04, 0x0e, 0x9a, 0xe3, 0x04, 0xfe, 0x09, 0xd0, 0xa1, 0x4b, 0xec,
+	0xda, 0xb9, 0x61, 0xdb, 0xa4, 0xab, 0x93, 0x9f, 0xfb, 0xc4, 0x0f, 0xd0, 0x2a, 0xdc, 0x72, 0xfb,
+	0xed, 0x1f, 0xc8, 0xa0, 0xac, 0x6c, 0x2a, 0x9f, 0x2d, 0xe8, 0x92, 0x42, 0x9f, 0xc3, 0x92, 0xed,
+	0x04, 0xd6, 0xa9, 0xd5, 0x31, 0x02, 0xcb, 0xb1, 0x5b, 0xce, 0x05, 0xb1, 0xcb, 0x39, 0x2e, 0x92,
+	0x3e, 0xc0, 0x08, 0x4a, 0x09, 0xdd, 0x6e, 0x77, 0x80, 0xbf, 0x04, 0xf5, 0xb5, 0x6b, 0x1a, 0x01,
+	0x91, 0xdc, 0xa6, 0xd3, 0xb5, 0x3a, 0x83, 0x29, 0x76, 0xb1, 0x0a, 0xe5, 0xcc, 0x5b, 0x4c, 0xe3,
+	0xef, 0x0a, 0xa0, 0xaa, 0x69, 0xbe, 0xec, 0xdb, 0x66, 0xdd, 0xb6, 0x82, 0x98, 0x2a, 0xdb, 0x31,
+	0x49, 0x7d, 0x2f, 0x54, 0x25, 0xa8, 0x9b, 0xb9, 0x20, 0x01, 0x5d, 0x50, 0x40, 0x79, 0x2a, 0x72,
+	0x47, 0x97, 0x14, 0x42, 0x30, 0x7b, 0x6e, 0xf8, 0xe7, 0xe5, 0x59, 0xce, 0xe5, 0xdf, 0xf8, 0x5f,
+	0x05, 0x4a, 0x09, 0x20, 0x14, 0x1d, 0x2a, 0xc3, 0x6d, 0xc3, 0x34, 0x3d, 0xe2, 0xfb, 0x12, 0x47,
+	0x48, 0xc6, 0x54, 0xe7, 0x12, 0xaa, 0x1f, 0x01, 0x74, 0x9d, 0xce, 0xc5, 0x2b, 0x62, 0x9d, 0x9d,
+	0x07, 0xdc, 0x6c, 0x5e, 0x8f, 0x71, 0x98, 0x03, 0x3d, 0xe3, 0xaa, 0xda, 0xed, 0x3a, 0x1f, 0x89,
+	0xb9, 0x47, 0x5c, 0xc7, 0xb7, 0x02, 0x8e, 0x23, 0xaf, 0xa7, 0x0f, 0x10, 0x86, 0x3b, 0xc4, 0xf3,
+	0x1c, 0xaf, 0x41, 0x4d, 0x1a, 0x67, 0xa4, 0x3c, 0xc7, 0x41, 0x24, 0x78, 0xb8, 0x0d, 0xcb, 0x12,
+	0xf7, 0x51, 0x60, 0x04, 0x7d, 0x3f, 0x0c, 0xe1, 0x03, 0x58, 0x90, 0x60, 0x09, 0x43, 0x9f, 0xa7,
+	0x17, 0x87, 0x8c, 0x1b, 0xe6, 0xc2, 0x3f, 0xb9, 0xe8, 0x95, 0x42, 0x23, 0x2c, 0x3c, 0x35, 0x98,
+	0xf7, 0x39, 0x29, 0x2d, 0x14, 0xb6, 0xb7, 0x2a, 0x22, 0x4b, 0xd3, 0xc2, 0x95, 0x23, 0x29, 0xa9,
+	0xd9, 0x81, 0x37, 0xd0, 0xa3, 0x8b, 0xaa, 0x0f, 0x8b, 0x55, 0x01, 0x4b, 0x48, 0xa0, 0x22, 0xe4,
+	0x82, 0x2b, 0x19, 0x6f, 0xfa, 0xc5, 0x42, 0x6d, 0xf4, 0x9c, 0xbe, 0x1d, 0x70, 0x7c, 0x79, 0x5d,
+	0x52, 0xcc, 0xc1, 0x8e, 0x63, 0x9f, 0x5a, 0x5e, 0x8f, 0x98, 0x3c, 0xd2, 0xf3, 0xfa, 0x90, 0xc1,
+	0x4e, 0xdb, 0x3c, 0xee, 0xe1, 0x43, 0x53, 0xf7, 0x23, 0x86, 0x6a, 0xc2, 0x62, 0x02, 0x0f, 0x2a,
+	0x41, 0xfe, 0x22, 0x4a, 0x5c, 0xf6, 0x89, 0x76, 0x60, 0xee, 0xd2, 0xe8, 0xf6, 0x09, 0xb7, 0x3a,
+	0xd1, 0xb3, 0x04, 0x7c, 0x5d, 0xdc, 0xfa, 0x3a, 0xf7, 0x95, 0x82, 0x35, 0x58, 0xd2, 0x49, 0xcf,
+	0xb9, 0x24, 0xec, 0x46, 0xf8, 0x2e, 0x13, 0x73, 0x2a, 0xcb, 0x51, 0xfc, 0x1e, 0xee, 0xc6, 0xd5,
+	0xb0, 0xc8, 0x3f, 0x85, 0xa2, 0x6b, 0x0c, 0x7a, 0xc4, 0x0e, 0x2b, 0x46, 0xea, 0x1a, 0xe1, 0xa6,
+	0x12, 0x28, 0x97, 0x91, 0x40, 0x3b, 0xb0, 0xa6, 0x13, 0x93, 0x90, 0x9e, 0x30, 0xc2, 0xdd, 0x8b,
+	0xb2, 0x68, 0x13, 0x0a, 0x52, 0x25, 0xaf, 0x18, 0x61, 0x25, 0xce, 0xc2, 0xcf, 0xe1, 0x7e, 0xd6,
+	0x75, 0x86, 0x92, 0xd6, 0x59, 0x70, 0x65, 0x99, 0xf2, 0x16, 0xff, 0xc6, 0xdf, 0xc2, 0xca, 0xf7,
+	0x24, 0x38, 0xfa, 0x68, 0xb8, 0xcd, 0x24, 0xd4, 0x6b, 0xba, 0x84, 0x5f, 0xc0, 0xbd, 0x51, 0x05,
+	0xcc, 0x16, 0xf5, 0x54, 0x0a, 0x6a, 0xcc, 0x39, 0x79, 0x39, 0xc1, 0xc3, 0x3a, 0xac, 0x36, 0x48,
+	0xcf, 0x75, 0x1c, 0xda, 0xce, 0xce, 0x2c, 0x3f, 0x20, 0x5e, 0x68, 0x5c, 0x85, 0xf9, 0x4e, 0xd7,
+	0xa2, 0x82, 0x51, 0xc7, 0x89, 0xe8, 0x64, 0x21, 0xe5, 0x46, 0x0a, 0x09, 0xff, 0xad, 0xc0, 0x72,
+	0x4a, 0x29, 0x03, 0xf4, 0x02, 0xf2, 0xad, 0xe3, 0xa3, 0x91, 0xba, 0xc8, 0x92, 0xac, 0xb4, 0x3c,
+	0xc3, 0xf6, 0x8d, 0x0e, 0x2b, 0x37, 0x9d, 0xdd, 0x51, 0x1b, 0x50, 0x88, 0xf1, 0x58, 0x41, 0xb4,
+	0x8e, 0xc3, 0x82, 0x68, 0x1d, 0xb3, 0x0c, 0x92, 0x29, 0x27, 0xdf, 0x33, 0x24, 0xd1, 0x32, 0xcc,
+	0xbd, 0xe1, 0x39, 0xcb, 0xca, 0x41, 0xd1, 0x05, 0x81, 0xff, 0xca, 0xc1, 0xd3, 0xd0, 0x62, 0x4c,
+	0x6f, 0x4d, 0xd4, 0x0a, 0x2f, 0xf3, 0x30, 0x0e, 0xfc, 0xc5, 0xa2, 0x18, 0xf0, 0xef, 0x1b, 0xf6,
+	0xdc, 0x0f, 0x50, 0x4a, 0x30, 0x07, 0xae, 0x40, 0x53, 0xdc, 0xfe, 0x46, 0xc6, 0xe0, 0x7a, 0x50,
+	0x2a, 0x07, 0x23, 0x5a, 0xf4, 0x94, 0x5e, 0x5c, 0x85, 0xd2, 0xa8, 0x14, 0x5a, 0x83, 0x15, 0x5d,
+	0xab, 0xee, 0xbd, 0x3d, 0xd1, 0xb5, 0x9a, 0x56, 0x7f, 0xa3, 0x9d, 0x34, 0xab, 0x6f, 0x1b, 0xda,
+	0x41, 0xab, 0x34, 0x43, 0x9d, 0x2b, 0xd6, 0x5e, 0x55, 0x0f, 0x0e, 0xb4, 0xfd, 0x93, 0xc3, 0xa6,
+	0x76, 0xa0, 0xed, 0x95, 0x14, 0xfc, 0x0c, 0xb6, 0xa6, 0xe2, 0xf1, 0x5d, 0xc7, 0xf6, 0x09, 0xfe,
+	0x53, 0x81, 0x32, 0x0d, 0xf4, 0x8f, 0x9e, 0xe1, 0xba, 0x84, 0x0e, 0x89, 0x4b, 0xc7, 0xea, 0x90,
+	0x69, 0x03, 0x6b, 0x58, 0x3f, 0xbc, 0x11, 0x89, 0x61, 0x11, 0x67, 0xc5, 0xaa, 0x3e, 0x9f, 0x68,
+	0x6f, 0xf4, 0x29, 0x7a, 0xb4, 0xa2, 0x64, 0xef, 0xe2, 0xdf, 0x4c, 0x96, 0x5c, 0xb9, 0x96, 0x37,
+	0xe0, 0x93, 0x80, 0xca, 0x0a, 0x0a, 0xff, 0xa6, 0xc0, 0x6a, 0x06, 0xb4, 0x4f, 0xdc, 0x29, 0xd8,
+	0x70, 0xf3, 0x89, 0x77, 0x49, 0x75, 0xbf, 0x24, 0x24, 0x1c, 0x6e, 0x43, 0x0e, 0xfe, 0x00, 0x38,
+	0x0c, 0xa6, 0xac, 0xcd, 0xf8, 0xf3, 0x7c, 0xd2, 0xd9, 0x8e, 0x31, 0x6c, 0x4e, 0xb4, 0xc5, 0x96,
+	0x8b, 0x45, 0x28, 0x34, 0x2d, 0xfb, 0x2c, 0xec, 0x1c, 0x4f, 0x60, 0x41, 0x90, 0x72, 0xb4, 0x5f,
+	0x12, 0xcf, 0xa7, 0xb2, 0x61, 0x1b, 0x96, 0x24, 0xae, 0xc0, 0xb2, 0x6c, 0x30, 0xfb, 0x56, 0xcf,
+	0x0a, 0xfc, 0x29, 0xb8, 0xf1, 0x1f, 0x74, 0x85, 0x19, 0xb9, 0xc0, 0x0c, 0xd0, 0xb6, 0xd1, 0xb3,
+	0x6c, 0xc6, 0xad, 0x0b, 0x13, 0x79, 0x7d, 0xc8, 0xe0, 0xa7, 0xc6, 0x95, 0x3c, 0xcd, 0xc9, 0xd3,
+	0x90, 0xc1, 0x02, 0x2d, 0x45, 0x0f, 0xfb, 0xd1, 0x16, 0x31, 0xe4, 0xf0, 0x73, 0x21, 0xcc, 0xce,
+	0x67, 0xe5, 0x79, 0xc4, 0xd9, 0xde, 0x81, 0x42, 0xdd, 0x3e, 0x75, 0x64, 0x06, 0xa3, 0x0a, 0xcc,
+	0x32, 0xc7, 0x11, 0x92, 0x15, 0x18, 0x0b, 0x8a, 0x5a, 0x4a, 0xf0, 0x58, 0xd4, 0x66, 0xb6, 0xdb,
+	0x70, 0x57, 0x36, 0x2a, 0x11, 0x53, 0xe2, 0xa1, 0xc3, 0x88, 0x15, 0x46, 0x1d, 0x3d, 0x1c, 0xd7,
+	0xd3, 0x84, 0xe2, 0xf5, 0x09, 0x2d, 0x8f, 0xda, 0xf8, 0xef, 0x36, 0x14, 0xd8, 0xa8, 0x68, 0x18,
+	0x36, 0x4d, 0x2d, 0x8f, 0xee, 0x12, 0x85, 0xd8, 0xba, 0x89, 0xd6, 0xe4, 0xed, 0xf4, 0x7a, 0xab,
+	0xde, 0xcf, 0x3a, 0xe2, 0x4a, 0xd1, 0x3b, 0xb8, 0x97, 0xb1, 0x69, 0xa2, 0xc7, 0xf2, 0xc6, 0xf8,
+	0xdd, 0x55, 0xdd, 0x98, 0x24, 0x22, 0x94, 0x53, 0x84, 0xb1, 0x05, 0x31, 0x42, 0x98, 0xde, 0x5e,
+	0x23, 0x84, 0xa3, 0xfb, 0x24, 0x55, 0x52, 0xe7, 0xdb, 0xce, 0x70, 0x83, 0x40, 0xeb, 0xd9, 0x7b,
+	0x85, 0x50, 0xb4, 0x36, 0x76, 0xe9, 0xa0, 0xaa, 0xbe, 0x03, 0x18, 0xae, 0x05, 0xa8, 0x1c, 0x75,
+	0xd7, 0x91, 0x85, 0x43, 0x5d, 0xcd, 0x38, 0x11, 0x1a, 0x8e, 0x01, 0xa5, 0x47, 0x37, 0xda, 0x8c,
+	0xe4, 0xc7, 0x2c, 0x05, 0xea, 0xa3, 0x09, 0x12, 0x42, 0xf3, 0x3e, 0x14, 0x93, 0x43, 0x1a, 0x3d,
+	0x90, 0x77, 0x32, 0x87, 0xbf, 0xaa, 0x8e, 0x39, 0x15, 0xda, 0x7e, 0x55, 0x60, 0x63, 0x4a, 0x97,
+	0x46, 0xcf, 0x6f, 0x34, 0x5d, 0xd4, 0xca, 0x75, 0xc5, 0x65, 0xf3, 0x9f, 0x41, 0xaf, 0x61, 0x29,
+	0xd5, 0x62, 0xd1, 0xc6, 0xf0, 0x81, 0x32, 0xe7, 0x82, 0xfa, 0x70, 0xbc, 0x80, 0xf0, 0xcd, 0x87,
+	0xf5, 0x09, 0x7d, 0x0c, 0x3d, 0x1b, 0xc1, 0x39, 0xbe, 0xaf, 0xaa, 0x5b, 0xd7, 0x11, 0x8d, 0xb2,
+	0x30, 0xd1, 0xb1, 0xa2, 0x2c, 0xcc, 0x6a, 0x7c, 0x51, 0x16, 0xa6, 0x9b, 0x1c, 0x9e, 0xd9, 0x7d,
+	0x02, 0x2b, 0x96, 0x53, 0x39, 0xf3, 0xdc, 0x8e, 0x94, 0x92, 0xf3, 0x60, 0x17, 0x76, 0x19, 0xd9,
+	0x64, 0xbf, 0xab, 0x4d, 0xa5, 0x7d, 0x8b, 0xff, 0xb7, 0x7e, 0xf1, 0x3f, 0xea, 0x06, 0xdf, 0xc7,
+	0xc6, 0x0e, 0x00, 0x00,
 }
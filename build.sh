@@ -2,4 +2,4 @@
 # gomobile & gobind needs to be installed in $GOPATH/bin
 
 mkdir -p build/android
-PATH=$PATH:$GOPATH/bin gomobile bind -target=android -tags="android experimental" -o build/android/breez.aar github.com/breez/breez/bindings
+PATH=$PATH:$GOPATH/bin gomobile bind -target=android -tags="android experimental" -o build/android/breez.aar github.com/breez/breez/bindings github.com/breez/breez/bindings/apiv2
@@ -2,12 +2,10 @@ package breez
 
 import (
 	"context"
-	"errors"
 	"io"
 	"time"
 
-	"github.com/btcsuite/btcd/chaincfg"
-
+	"github.com/breez/breez/data"
 	"github.com/breez/lightninglib/lnrpc"
 	"github.com/btcsuite/btcutil"
 )
@@ -28,21 +26,14 @@ var (
 ValidateAddress validates a bitcoin address based on the network type
 */
 func ValidateAddress(address string) error {
-	var network *chaincfg.Params
-
-	if cfg.Network == "testnet" {
-		network = &chaincfg.TestNet3Params
-	} else if cfg.Network == "simnet" {
-		network = &chaincfg.SimNetParams
-	} else if cfg.Network == "mainnet" {
-		network = &chaincfg.MainNetParams
-	} else {
-		return errors.New("unknown network type " + cfg.Network)
+	network, err := networkParams()
+	if err != nil {
+		return err
 	}
 
-	_, err := btcutil.DecodeAddress(address, network)
+	_, err = btcutil.DecodeAddress(address, network)
 	if err != nil {
-		log.Errorf("Error parsing %s as address\t", address)
+		chainLog.Errorf("Error parsing %s as address\t", address)
 		return err
 	}
 
@@ -53,7 +44,19 @@ func ValidateAddress(address string) error {
 SendWalletCoins executes a request to send wallet coins to a particular address.
 */
 func SendWalletCoins(address string, satAmount, satPerByteFee int64) (string, error) {
-	res, err := lightningClient.SendCoins(context.Background(), &lnrpc.SendCoinsRequest{Addr: address, Amount: satAmount, SatPerByte: satPerByteFee})
+	return SendWalletCoinsContext(context.Background(), address, satAmount, satPerByteFee)
+}
+
+/*
+SendWalletCoinsContext is SendWalletCoins with a context, which attributes the send in the audit log
+and cancels it.
+*/
+func SendWalletCoinsContext(ctx context.Context, address string, satAmount, satPerByteFee int64) (string, error) {
+	audit := newSpendAudit(ctx, data.SpendAuditEntry_ONCHAIN)
+	audit.Destination = address
+	audit.Amount = satAmount
+	res, err := lightningClient.SendCoins(ctx, &lnrpc.SendCoinsRequest{Addr: address, Amount: satAmount, SatPerByte: satPerByteFee})
+	finishSpendAudit(audit, err)
 	if err != nil {
 		return "", err
 	}
@@ -68,16 +71,19 @@ func GetDefaultSatPerByteFee() int64 {
 }
 
 func syncToChain(pollInterval time.Duration) error {
+	start := time.Now()
 	for {
 		chainInfo, chainErr := lightningClient.GetInfo(context.Background(), &lnrpc.GetInfoRequest{})
 		if chainErr != nil {
-			log.Warnf("Failed get chain info", chainErr)
+			chainLog.Warnf("Failed get chain info", chainErr)
 			return chainErr
 		}
 
-		log.Infof("Sync to chain interval Synced=%v BlockHeight=%v", chainInfo.SyncedToChain, chainInfo.BlockHeight)
+		chainLog.Infof("Sync to chain interval Synced=%v BlockHeight=%v", chainInfo.SyncedToChain, chainInfo.BlockHeight)
 		if chainInfo.SyncedToChain {
-			log.Infof("Synchronized to chain finshed BlockHeight=%v", chainInfo.BlockHeight)
+			chainLog.Infof("Synchronized to chain finshed BlockHeight=%v", chainInfo.BlockHeight)
+			chainSyncSeconds.since("app", start)
+			updateClockSkew(chainInfo)
 			break
 		}
 		time.Sleep(pollInterval)
@@ -88,22 +94,43 @@ func syncToChain(pollInterval time.Duration) error {
 //This function is responsible for refreshing the account on each transaction.
 // mainly it is for synchronizing with channel open/close events.
 func watchOnChainState() {
-	stream, err := lightningClient.SubscribeTransactions(context.Background(), &lnrpc.GetTransactionsRequest{})
+	checkFallbackPayments()
+	for receiveTransactions() {
+		chainLog.Infof("watchOnChainState - resubscribing to wallet transactions")
+	}
+}
+
+// receiveTransactions syncs the channels on every wallet transaction. It returns
+// true when chaos mode interrupted the subscription.
+func receiveTransactions() bool {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := lightningClient.SubscribeTransactions(ctx, &lnrpc.GetTransactionsRequest{})
 	if err != nil {
-		log.Criticalf("Failed to call SubscribeTransactions %v, %v", stream, err)
+		chainLog.Criticalf("Failed to call SubscribeTransactions %v, %v", stream, err)
+		return false
 	}
-	log.Infof("Wallet transactions subscription created")
+	chainLog.Infof("Wallet transactions subscription created")
 	for {
+		if chaosFires("wallet transactions subscription") {
+			return true
+		}
 		_, err := stream.Recv()
-		log.Infof("watchOnChainState Wallet transactions subscription received new transaction")
+		chainLog.Infof("watchOnChainState Wallet transactions subscription received new transaction")
 		if err == io.EOF {
-			log.Errorf("Failed to call SubscribeTransactions %v, %v", stream, err)
-			return
+			chainLog.Errorf("Failed to call SubscribeTransactions %v, %v", stream, err)
+			return false
 		}
 		if err != nil {
-			log.Errorf("Failed to receive a transaction : %v", err)
+			chainLog.Errorf("Failed to receive a transaction : %v", err)
+		}
+		if err := syncClosedChannels(); err != nil {
+			chainLog.Errorf("watchOnChainState - failed to sync closed channels: %v", err)
 		}
-		log.Infof("watchOnChainState sending account change notification")
+		resumeChannelConsolidations()
+		checkFallbackPayments()
+		updateMoveFundsOperations()
+		chainLog.Infof("watchOnChainState sending account change notification")
 		onAccountChanged()
 		ensureRoutingChannelOpened()
 	}
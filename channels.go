@@ -0,0 +1,201 @@
+package breez
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+const (
+	//closeTxVirtualSize is the approximate size of a cooperative close transaction
+	closeTxVirtualSize = 170
+
+	economicalCloseSatPerByte = defaultSatPerByteFee / 5
+	normalCloseSatPerByte     = defaultSatPerByteFee / 2
+	urgentCloseSatPerByte     = defaultSatPerByteFee
+)
+
+func closeSatPerByte(strategy data.CloseFeeStrategy, satPerByte int64) (int64, error) {
+	switch strategy {
+	case data.CloseFeeStrategy_ECONOMICAL:
+		return economicalCloseSatPerByte, nil
+	case data.CloseFeeStrategy_NORMAL:
+		return normalCloseSatPerByte, nil
+	case data.CloseFeeStrategy_URGENT:
+		return urgentCloseSatPerByte, nil
+	case data.CloseFeeStrategy_CUSTOM:
+		if satPerByte <= 0 {
+			return 0, errors.New("sat per byte must be positive")
+		}
+		return satPerByte, nil
+	}
+	return 0, fmt.Errorf("unknown close fee strategy %v", strategy)
+}
+
+/*
+GetCloseChannelFeeOptions returns the available fee strategies for closing a channel together
+with the estimated cost of each one so the user can choose before closing.
+*/
+func GetCloseChannelFeeOptions() (*data.CloseFeeOptions, error) {
+	options := &data.CloseFeeOptions{}
+	for _, strategy := range []data.CloseFeeStrategy{data.CloseFeeStrategy_ECONOMICAL, data.CloseFeeStrategy_NORMAL, data.CloseFeeStrategy_URGENT} {
+		satPerByte, err := closeSatPerByte(strategy, 0)
+		if err != nil {
+			return nil, err
+		}
+		options.Options = append(options.Options, &data.CloseFeeOption{
+			Strategy:     strategy,
+			SatPerByte:   satPerByte,
+			EstimatedFee: satPerByte * closeTxVirtualSize,
+		})
+	}
+	return options, nil
+}
+
+/*
+CloseChannel cooperatively closes the channel identified by channelPoint ("txid:index")
+using the fee of the given strategy. satPerByte is only used by the CUSTOM strategy.
+It returns the closing transaction id once it is broadcasted.
+*/
+func CloseChannel(channelPoint string, strategy data.CloseFeeStrategy, satPerByte int64) (string, error) {
+	feeRate, err := closeSatPerByte(strategy, satPerByte)
+	if err != nil {
+		return "", err
+	}
+	parts := strings.Split(channelPoint, ":")
+	if len(parts) != 2 {
+		return "", fmt.Errorf("invalid channel point %v", channelPoint)
+	}
+	index, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return "", fmt.Errorf("unable to decode output index: %v", err)
+	}
+
+	log.Infof("CloseChannel - closing %v with %v sat/byte", channelPoint, feeRate)
+	stream, err := lightningClient.CloseChannel(context.Background(), &lnrpc.CloseChannelRequest{
+		ChannelPoint: &lnrpc.ChannelPoint{
+			FundingTxid: &lnrpc.ChannelPoint_FundingTxidStr{FundingTxidStr: parts[0]},
+			OutputIndex: uint32(index),
+		},
+		SatPerByte: feeRate,
+	})
+	if err != nil {
+		return "", err
+	}
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return "", errors.New("channel close stream ended before the closing transaction was broadcasted")
+		} else if err != nil {
+			return "", err
+		}
+		if update, ok := resp.Update.(*lnrpc.CloseStatusUpdate_ClosePending); ok {
+			txid, err := chainhash.NewHash(update.ClosePending.Txid)
+			if err != nil {
+				return "", err
+			}
+			onAccountChanged()
+			return txid.String(), nil
+		}
+	}
+}
+
+// closeReason is the reason a channel was closed as recorded in the payments history.
+type closeReason byte
+
+const (
+	cooperativeClose = closeReason(0)
+	localForceClose  = closeReason(1)
+	remoteForceClose = closeReason(2)
+	breachClose      = closeReason(3)
+	fundingCanceled  = closeReason(4)
+	abandonedClose   = closeReason(5)
+)
+
+var closeReasons = map[lnrpc.ChannelCloseSummary_ClosureType]closeReason{
+	lnrpc.ChannelCloseSummary_COOPERATIVE_CLOSE:  cooperativeClose,
+	lnrpc.ChannelCloseSummary_LOCAL_FORCE_CLOSE:  localForceClose,
+	lnrpc.ChannelCloseSummary_REMOTE_FORCE_CLOSE: remoteForceClose,
+	lnrpc.ChannelCloseSummary_BREACH_CLOSE:       breachClose,
+	lnrpc.ChannelCloseSummary_FUNDING_CANCELED:   fundingCanceled,
+	lnrpc.ChannelCloseSummary_ABANDONED:          abandonedClose,
+}
+
+func (r closeReason) toProto() data.Payment_CloseReason {
+	switch r {
+	case localForceClose:
+		return data.Payment_LOCAL_FORCE_CLOSE
+	case remoteForceClose:
+		return data.Payment_REMOTE_FORCE_CLOSE
+	case breachClose:
+		return data.Payment_BREACH_CLOSE
+	case fundingCanceled:
+		return data.Payment_FUNDING_CANCELED
+	case abandonedClose:
+		return data.Payment_ABANDONED
+	}
+	return data.Payment_COOPERATIVE_CLOSE
+}
+
+func channelCloseHash(channelPoint string) string {
+	return "close:" + channelPoint
+}
+
+// syncClosedChannels records every closed channel that is not in the payments
+// history yet as a channel close entry.
+func syncClosedChannels() error {
+	closed, err := lightningClient.ClosedChannels(context.Background(), &lnrpc.ClosedChannelsRequest{})
+	if err != nil {
+		return err
+	}
+	var transactions []*lnrpc.Transaction
+	added := false
+	for _, c := range closed.Channels {
+		recorded, err := hasPayment(channelCloseHash(c.ChannelPoint))
+		if err != nil {
+			return err
+		}
+		if recorded {
+			continue
+		}
+		if transactions == nil {
+			txs, err := lightningClient.GetTransactions(context.Background(), &lnrpc.GetTransactionsRequest{})
+			if err != nil {
+				return err
+			}
+			transactions = txs.Transactions
+		}
+		closeInfo := &paymentInfo{
+			Type:              channelClosePayment,
+			Amount:            c.SettledBalance,
+			CreationTimestamp: trustedNow().Unix(),
+			PaymentHash:       channelCloseHash(c.ChannelPoint),
+			Destination:       c.RemotePubkey,
+			CloseReason:       closeReasons[c.CloseType],
+			ClosingTxID:       c.ClosingTxHash,
+		}
+		for _, tx := range transactions {
+			if tx.TxHash == c.ClosingTxHash {
+				closeInfo.CreationTimestamp = tx.TimeStamp
+				closeInfo.CloseFee = tx.TotalFees
+			}
+		}
+		log.Infof("syncClosedChannels - channel %v closed, reason = %v", c.ChannelPoint, c.CloseType)
+		if err := addAccountPayment(closeInfo, 0, 0); err != nil {
+			return err
+		}
+		added = true
+	}
+	if added {
+		notify(data.NotificationEvent{Type: data.NotificationEvent_CHANNEL_CLOSED})
+		go regenerateInvoices()
+	}
+	return nil
+}
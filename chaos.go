@@ -0,0 +1,57 @@
+package breez
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+var (
+	chaosMu          sync.Mutex
+	chaosProbability float64
+	chaosRand        = rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	// errChaos is returned by the operations chaos mode interrupts.
+	errChaos = errors.New("interrupted by chaos mode")
+)
+
+/*
+SetChaosMode makes the invoices and wallet transactions streams drop and the payments db transactions
+abort at random with the given probability, 0 disables it. It is meant for testing that the streams are
+resumed and the payments resynced without losing or duplicating any, and is only available in developer mode.
+*/
+func SetChaosMode(probability float64) error {
+	if cfg == nil || !cfg.DeveloperMode {
+		return errors.New("chaos mode is only available in developer mode")
+	}
+	if probability < 0 || probability > 1 {
+		return fmt.Errorf("chaos probability %v is not between 0 and 1", probability)
+	}
+	chaosMu.Lock()
+	defer chaosMu.Unlock()
+	chaosProbability = probability
+	log.Infof("SetChaosMode - chaos probability set to %v", probability)
+	return nil
+}
+
+// chaosFires returns true if chaos mode interrupts the operation at the given point.
+func chaosFires(point string) bool {
+	chaosMu.Lock()
+	defer chaosMu.Unlock()
+	if chaosProbability == 0 || chaosRand.Float64() >= chaosProbability {
+		return false
+	}
+	log.Infof("chaosFires - interrupting %v", point)
+	return true
+}
+
+// chaosAbort returns errChaos when chaos mode interrupts the operation, inside a
+// db transaction it rolls the transaction back.
+func chaosAbort(point string) error {
+	if chaosFires(point) {
+		return errChaos
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+package breez
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/rand"
+	"sync/atomic"
+	"testing"
+
+	"github.com/breez/lightninglib/lnrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeInvoiceStream replays the settled invoices after the subscription index
+// like the daemon does.
+type fakeInvoiceStream struct {
+	invoices []*lnrpc.Invoice
+}
+
+func (s *fakeInvoiceStream) Recv() (*lnrpc.Invoice, error) {
+	if len(s.invoices) == 0 {
+		return nil, errors.New("stream closed")
+	}
+	invoice := s.invoices[0]
+	s.invoices = s.invoices[1:]
+	return invoice, nil
+}
+
+// setChaos sets a deterministic chaos mode and returns a function disabling it.
+func setChaos(probability float64) func() {
+	chaosMu.Lock()
+	defer chaosMu.Unlock()
+	chaosProbability = probability
+	chaosRand = rand.New(rand.NewSource(1))
+	return func() {
+		chaosMu.Lock()
+		defer chaosMu.Unlock()
+		chaosProbability = 0
+	}
+}
+
+func checkRecordedOnce(t *testing.T, count int) {
+	payments, err := fetchAllAccountPayments()
+	if err != nil || len(payments) != count {
+		t.Fatalf("expected %v payments, got %v err = %v", count, len(payments), err)
+	}
+	quarantined, err := fetchQuarantinedPayments()
+	if err != nil || len(quarantined) != 0 {
+		t.Fatalf("expected no duplicate payments, got %v err = %v", len(quarantined), err)
+	}
+}
+
+func TestChaosReceivedPayments(t *testing.T) {
+	defer openTestDB(t)()
+	defer setChaos(0.3)()
+
+	var invoices []*lnrpc.Invoice
+	for i := 1; i <= 50; i++ {
+		invoices = append(invoices, &lnrpc.Invoice{RHash: []byte{byte(i)}, Settled: true, SettleIndex: uint64(i), AmtPaidSat: int64(i)})
+	}
+	subscribe := func(ctx context.Context, settleIndex uint64) (invoiceStream, error) {
+		return &fakeInvoiceStream{invoices: invoices[settleIndex:]}, nil
+	}
+	onSettled := func(invoice *lnrpc.Invoice) error {
+		payment := &paymentInfo{Type: receivedPayment, PaymentHash: hex.EncodeToString(invoice.RHash), Amount: invoice.AmtPaidSat}
+		return addAccountPayment(payment, invoice.SettleIndex, 0)
+	}
+	for i := 0; ; i++ {
+		if i > 1000 {
+			t.Fatal("subscription never completed")
+		}
+		if _, err := receiveInvoices(subscribe, onSettled); err != errChaos {
+			break
+		}
+	}
+	checkRecordedOnce(t, len(invoices))
+	if _, settledIndex := fetchPaymentsSyncInfo(); settledIndex != uint64(len(invoices)) {
+		t.Errorf("settled index should be %v and it is %v", len(invoices), settledIndex)
+	}
+}
+
+func TestChaosSentPayments(t *testing.T) {
+	defer openTestDB(t)()
+	defer setChaos(0.3)()
+
+	var daemonPayments []*lnrpc.Payment
+	for i := 1; i <= 50; i++ {
+		//payments made in the same second must not be skipped after an interrupted sync
+		daemonPayments = append(daemonPayments, &lnrpc.Payment{PaymentHash: hex.EncodeToString([]byte{byte(i)}), CreationDate: int64(i / 3), Value: int64(i)})
+	}
+	for i := 0; ; i++ {
+		if i > 1000 {
+			t.Fatal("sync never completed")
+		}
+		newPayments := newSentPayments(daemonPayments)
+		if len(newPayments) == 0 {
+			break
+		}
+		for _, p := range newPayments {
+			payment := &paymentInfo{Type: sentPayment, PaymentHash: p.PaymentHash, Amount: p.Value, CreationTimestamp: p.CreationDate}
+			if err := addAccountPayment(payment, 0, uint64(p.CreationDate)); err != nil {
+				break
+			}
+		}
+	}
+	checkRecordedOnce(t, len(daemonPayments))
+}
+
+var errDaemonKilled = status.Error(codes.Unavailable, "transport is closing")
+
+// killableDaemon is a memory daemon killed right after it sent a payment,
+// before answering. Its payment calls fail until it is restarted.
+type killableDaemon struct {
+	*memoryDaemon
+	killed int32
+}
+
+func (d *killableDaemon) SendPaymentSync(ctx context.Context, in *lnrpc.SendRequest, opts ...grpc.CallOption) (*lnrpc.SendResponse, error) {
+	if atomic.LoadInt32(&d.killed) == 1 {
+		return nil, errDaemonKilled
+	}
+	if _, err := d.memoryDaemon.SendPaymentSync(ctx, in, opts...); err != nil {
+		return nil, err
+	}
+	atomic.StoreInt32(&d.killed, 1)
+	return nil, errDaemonKilled
+}
+
+func (d *killableDaemon) ListPayments(ctx context.Context, in *lnrpc.ListPaymentsRequest, opts ...grpc.CallOption) (*lnrpc.ListPaymentsResponse, error) {
+	if atomic.LoadInt32(&d.killed) == 1 {
+		return nil, errDaemonKilled
+	}
+	return d.memoryDaemon.ListPayments(ctx, in, opts...)
+}
+
+func TestChaosDaemonKilledMidPayment(t *testing.T) {
+	defer openTestDB(t)()
+	memory, restore := installMemoryDaemon(t, 10000)
+	defer restore()
+	daemon := &killableDaemon{memoryDaemon: memory}
+	paymentsClient = daemon
+	lightningClient = daemon
+
+	paymentRequest := memory.remoteInvoice(t, 500, "pizza")
+	if err := SendPaymentForRequest(paymentRequest, 0); err == nil {
+		t.Fatal("expected the payment to fail when the daemon is killed")
+	}
+	if err := syncSentPayments(); err == nil {
+		t.Fatal("expected the sync to fail while the daemon is down")
+	}
+	checkRecordedOnce(t, 0)
+
+	//the payment left before the daemon was killed, the restarted daemon has it
+	atomic.StoreInt32(&daemon.killed, 0)
+	for i := 0; i < 2; i++ {
+		if err := syncSentPayments(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	checkRecordedOnce(t, 1)
+	if err := SendPaymentForRequest(paymentRequest, 0); err != ErrAlreadyPaid {
+		t.Errorf("expected the payment not to be sent again, got %v", err)
+	}
+	if memory.balance != 10000-500-memoryDaemonFee {
+		t.Errorf("expected the payment to be paid once, balance %v", memory.balance)
+	}
+}
+
+func TestChaosAppKilledMidPayment(t *testing.T) {
+	defer openTestDB(t)()
+	daemon, restore := installMemoryDaemon(t, 10000)
+	defer restore()
+
+	//the app was killed after the daemon sent the payment, before recording it
+	paymentRequest := daemon.remoteInvoice(t, 500, "pizza")
+	decodedReq, err := decodePayReqLocally(paymentRequest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	intent := &paymentIntent{PaymentHash: decodedReq.PaymentHash, PaymentRequest: paymentRequest, Amount: 500}
+	if err := savePaymentIntent(intent); err != nil {
+		t.Fatal(err)
+	}
+	if err := savePaymentRequest(decodedReq.PaymentHash, []byte(paymentRequest)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := daemon.SendPaymentSync(context.Background(), &lnrpc.SendRequest{PaymentRequest: paymentRequest}); err != nil {
+		t.Fatal(err)
+	}
+
+	//the restart resyncs the payments and reconciles the interrupted intents
+	if err := syncSentPayments(); err != nil {
+		t.Fatal(err)
+	}
+	if unresolved := reconcilePaymentIntents(); unresolved != 0 {
+		t.Errorf("expected every intent to be resolved, %v are not", unresolved)
+	}
+	checkRecordedOnce(t, 1)
+	if intents, err := fetchPaymentIntents(); err != nil || len(intents) != 0 {
+		t.Errorf("expected the intent to be resolved: %v, %v", intents, err)
+	}
+	if err := SendPaymentForRequest(paymentRequest, 0); err != ErrAlreadyPaid {
+		t.Errorf("expected the payment not to be sent again, got %v", err)
+	}
+}
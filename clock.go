@@ -0,0 +1,71 @@
+package breez
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
+)
+
+const (
+	//maxClockBehind is how far the device clock can be behind the chain tip, blocks
+	//can't be more than two hours ahead of the network time
+	maxClockBehind = 2 * time.Hour
+
+	//maxClockAhead is how far the device clock can be ahead of the chain tip once synced
+	maxClockAhead = 6 * time.Hour
+)
+
+var (
+	clockMu     sync.Mutex
+	clockOffset time.Duration
+	clockSkewed bool
+)
+
+// trustedNow returns the current time corrected by the offset measured against
+// the chain when the device clock is badly off. It is used for the timestamps
+// that are persisted so payments keep their order when the clock is wrong, and
+// for the invoice expiry checks.
+func trustedNow() time.Time {
+	clockMu.Lock()
+	defer clockMu.Unlock()
+	return time.Now().Add(clockOffset).UTC()
+}
+
+// clockSkew returns how far the device clock is ahead of the chain tip time.
+func clockSkew(info *lnrpc.GetInfoResponse) time.Duration {
+	return time.Since(time.Unix(info.BestHeaderTimestamp, 0))
+}
+
+func checkClockSkew(info *lnrpc.GetInfoResponse) error {
+	skew := clockSkew(info)
+	if skew < -maxClockBehind {
+		return fmt.Errorf("device clock is %v behind the chain", (-skew).Truncate(time.Second))
+	}
+	if info.SyncedToChain && skew > maxClockAhead {
+		return fmt.Errorf("device clock is %v ahead of the chain", skew.Truncate(time.Second))
+	}
+	return nil
+}
+
+// updateClockSkew measures the device clock against the chain tip and notifies
+// once when the clock becomes badly off. The notification data is the skew in
+// seconds, positive when the clock is ahead.
+func updateClockSkew(info *lnrpc.GetInfoResponse) {
+	err := checkClockSkew(info)
+	skew := clockSkew(info)
+	clockMu.Lock()
+	wasSkewed := clockSkewed
+	clockSkewed = err != nil
+	clockOffset = 0
+	if clockSkewed {
+		clockOffset = -skew
+	}
+	clockMu.Unlock()
+	if err != nil && !wasSkewed {
+		log.Warnf("updateClockSkew - %v", err)
+		notify(data.NotificationEvent{Type: data.NotificationEvent_CLOCK_SKEW, Data: []string{fmt.Sprintf("%v", int64(skew.Seconds()))}})
+	}
+}
@@ -0,0 +1,28 @@
+package breez
+
+import (
+	"testing"
+	"time"
+
+	"github.com/breez/lightninglib/lnrpc"
+)
+
+func TestCheckClockSkew(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		header time.Time
+		synced bool
+		skewed bool
+	}{
+		{now.Add(-10 * time.Minute), true, false},
+		{now.Add(3 * time.Hour), true, true},
+		{now.Add(-7 * time.Hour), true, true},
+		{now.Add(-7 * time.Hour), false, false},
+	}
+	for i, test := range tests {
+		info := &lnrpc.GetInfoResponse{BestHeaderTimestamp: test.header.Unix(), SyncedToChain: test.synced}
+		if err := checkClockSkew(info); (err != nil) != test.skewed {
+			t.Errorf("test %v: expected skewed=%v got %v", i, test.skewed, err)
+		}
+	}
+}
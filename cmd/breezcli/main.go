@@ -0,0 +1,316 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/breez/breez"
+	"github.com/breez/breez/data"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/urfave/cli"
+)
+
+const defaultStartTimeout = 2 * time.Minute
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "breez-cli"
+	app.Usage = "run the breez daemon from a config directory and operate the wallet"
+	app.Flags = []cli.Flag{
+		cli.StringFlag{
+			Name:  "datadir",
+			Usage: "the working directory holding breez.conf and the daemon data",
+			Value: ".",
+		},
+		cli.DurationFlag{
+			Name:  "timeout",
+			Usage: "how long to wait for the daemon to be ready",
+			Value: defaultStartTimeout,
+		},
+	}
+	app.Commands = []cli.Command{
+		{
+			Name:   "info",
+			Usage:  "print the account info",
+			Action: withDaemon(accountInfo),
+		},
+		{
+			Name:   "health",
+			Usage:  "run the health check",
+			Action: withDaemon(healthCheck),
+		},
+		{
+			Name:   "payments",
+			Usage:  "list the payments",
+			Action: withDaemon(listPayments),
+		},
+		{
+			Name:      "pay",
+			Usage:     "pay a payment request",
+			ArgsUsage: "paymentRequest",
+			Flags: []cli.Flag{
+				cli.Int64Flag{Name: "amt", Usage: "the amount in satoshi, for payment requests without an amount"},
+			},
+			Action: withDaemon(pay),
+		},
+		{
+			Name:  "invoice",
+			Usage: "create an invoice and print its payment request",
+			Flags: []cli.Flag{
+				cli.Int64Flag{Name: "amt", Usage: "the amount in satoshi"},
+				cli.StringFlag{Name: "desc", Usage: "the description of the invoice"},
+				cli.Int64Flag{Name: "expiry", Usage: "the expiry in seconds"},
+			},
+			Action: withDaemon(addInvoice),
+		},
+		{
+			Name:      "decode",
+			Usage:     "decode a payment request",
+			ArgsUsage: "paymentRequest",
+			Action:    withDaemon(decodePaymentRequest),
+		},
+		{
+			Name:   "backup",
+			Usage:  "create the backup files and print their paths",
+			Action: withDaemon(backup),
+		},
+		{
+			Name:  "channels",
+			Usage: "channel operations",
+			Subcommands: []cli.Command{
+				{
+					Name:   "closefees",
+					Usage:  "print the fee options for closing a channel",
+					Action: withDaemon(closeFeeOptions),
+				},
+				{
+					Name:      "close",
+					Usage:     "close a channel cooperatively",
+					ArgsUsage: "channelPoint",
+					Flags:     closeFeeFlags(),
+					Action:    withDaemon(closeChannel),
+				},
+				{
+					Name:   "consolidate",
+					Usage:  "consolidate the small routing node channels",
+					Flags:  closeFeeFlags(),
+					Action: withDaemon(consolidateChannels),
+				},
+				{
+					Name:   "consolidations",
+					Usage:  "list the channel consolidations",
+					Action: withDaemon(listConsolidations),
+				},
+			},
+		},
+		{
+			Name:      "lncli",
+			Usage:     "run a command of the embedded lightning daemon, e.g. listchannels",
+			ArgsUsage: "command [arguments...]",
+			Action:    withDaemon(lncli),
+		},
+	}
+	if err := app.Run(os.Args); err != nil {
+		fmt.Fprintln(os.Stderr, "[breez-cli]", err)
+		os.Exit(1)
+	}
+}
+
+// withDaemon starts the daemon from the data directory, runs the command once
+// it is ready and stops the daemon.
+func withDaemon(command func(*cli.Context) error) func(*cli.Context) error {
+	return func(ctx *cli.Context) error {
+		notifications, err := breez.Start(ctx.GlobalString("datadir"), false)
+		if err != nil {
+			return err
+		}
+		defer breez.WaitDaemonShutdown()
+		defer breez.Stop()
+		ready := make(chan error, 1)
+		go func() {
+			for event := range notifications {
+				switch event.Type {
+				case data.NotificationEvent_READY:
+					ready <- nil
+				case data.NotificationEvent_INITIALIZATION_FAILED:
+					ready <- errors.New("daemon initialization failed")
+				}
+				//the cli is the only consumer, nothing is left for an app to replay
+				if err := breez.AckNotification(event.Id); err != nil {
+					fmt.Fprintln(os.Stderr, "[breez-cli] failed to acknowledge notification:", err)
+				}
+			}
+		}()
+		select {
+		case err := <-ready:
+			if err != nil {
+				return err
+			}
+		case <-time.After(ctx.GlobalDuration("timeout")):
+			return errors.New("timed out waiting for the daemon")
+		}
+		return command(ctx)
+	}
+}
+
+func printJSON(message proto.Message) error {
+	m := jsonpb.Marshaler{EmitDefaults: true, Indent: "  "}
+	s, err := m.MarshalToString(message)
+	if err != nil {
+		return err
+	}
+	fmt.Println(s)
+	return nil
+}
+
+func paymentRequestArg(ctx *cli.Context) (string, error) {
+	if ctx.NArg() != 1 {
+		return "", errors.New("a payment request is required")
+	}
+	return ctx.Args().First(), nil
+}
+
+func accountInfo(ctx *cli.Context) error {
+	account, err := breez.GetAccountInfo()
+	if err != nil {
+		return err
+	}
+	return printJSON(account)
+}
+
+func healthCheck(ctx *cli.Context) error {
+	return printJSON(breez.HealthCheck())
+}
+
+func listPayments(ctx *cli.Context) error {
+	payments, err := breez.GetPayments()
+	if err != nil {
+		return err
+	}
+	return printJSON(payments)
+}
+
+func pay(ctx *cli.Context) error {
+	paymentRequest, err := paymentRequestArg(ctx)
+	if err != nil {
+		return err
+	}
+	return breez.SendPaymentForRequest(paymentRequest, ctx.Int64("amt"))
+}
+
+func addInvoice(ctx *cli.Context) error {
+	paymentRequest, err := breez.AddInvoice(&data.InvoiceMemo{
+		Amount:      ctx.Int64("amt"),
+		Description: ctx.String("desc"),
+		Expiry:      ctx.Int64("expiry"),
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Println(paymentRequest)
+	return nil
+}
+
+func decodePaymentRequest(ctx *cli.Context) error {
+	paymentRequest, err := paymentRequestArg(ctx)
+	if err != nil {
+		return err
+	}
+	memo, err := breez.DecodePaymentRequest(paymentRequest)
+	if err != nil {
+		return err
+	}
+	return printJSON(memo)
+}
+
+func backup(ctx *cli.Context) error {
+	subscription := breez.SubscribeNotifications(data.NotificationEvent_BACKUP_FILES_AVAILABLE)
+	defer subscription.Unsubscribe()
+	if err := breez.Backup(); err != nil {
+		return err
+	}
+	select {
+	case event := <-subscription.C:
+		for _, file := range event.Data {
+			fmt.Println(file)
+		}
+		return nil
+	case <-time.After(ctx.GlobalDuration("timeout")):
+		return errors.New("timed out waiting for the backup files")
+	}
+}
+
+func closeFeeFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{Name: "strategy", Usage: "NORMAL, ECONOMICAL, URGENT or CUSTOM", Value: "NORMAL"},
+		cli.Int64Flag{Name: "satperbyte", Usage: "the fee rate of the CUSTOM strategy"},
+	}
+}
+
+func closeFeeStrategy(ctx *cli.Context) (data.CloseFeeStrategy, error) {
+	strategy, ok := data.CloseFeeStrategy_value[strings.ToUpper(ctx.String("strategy"))]
+	if !ok {
+		return 0, fmt.Errorf("unknown strategy %v", ctx.String("strategy"))
+	}
+	return data.CloseFeeStrategy(strategy), nil
+}
+
+func closeFeeOptions(ctx *cli.Context) error {
+	options, err := breez.GetCloseChannelFeeOptions()
+	if err != nil {
+		return err
+	}
+	return printJSON(options)
+}
+
+func closeChannel(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return errors.New("a channel point is required")
+	}
+	strategy, err := closeFeeStrategy(ctx)
+	if err != nil {
+		return err
+	}
+	txID, err := breez.CloseChannel(ctx.Args().First(), strategy, ctx.Int64("satperbyte"))
+	if err != nil {
+		return err
+	}
+	fmt.Println(txID)
+	return nil
+}
+
+func consolidateChannels(ctx *cli.Context) error {
+	strategy, err := closeFeeStrategy(ctx)
+	if err != nil {
+		return err
+	}
+	consolidation, err := breez.ConsolidateChannels(strategy, ctx.Int64("satperbyte"))
+	if err != nil {
+		return err
+	}
+	return printJSON(consolidation)
+}
+
+func listConsolidations(ctx *cli.Context) error {
+	consolidations, err := breez.GetChannelConsolidations()
+	if err != nil {
+		return err
+	}
+	return printJSON(consolidations)
+}
+
+func lncli(ctx *cli.Context) error {
+	if ctx.NArg() == 0 {
+		return errors.New("a command is required")
+	}
+	output, err := breez.SendCommand(strings.Join(ctx.Args(), " "))
+	if err != nil {
+		return err
+	}
+	fmt.Println(output)
+	return nil
+}
@@ -62,10 +62,11 @@ func onRoutingNodeConnectionChanged(connected bool) {
 		connectedFlag = 1
 	}
 	atomic.StoreInt32(&connectedToRoutingNode, connectedFlag)
-	notificationsChan <- data.NotificationEvent{Type: data.NotificationEvent_ROUTING_NODE_CONNECTION_CHANGED}
+	notify(data.NotificationEvent{Type: data.NotificationEvent_ROUTING_NODE_CONNECTION_CHANGED})
 
 	// BREEZ-377: When there is no channel request one from Breez
 	if connected {
+		signalPaymentQueue()
 		accData, _ := calculateAccount()
 		go updateNodeChannelPolicy(accData.Id)
 		ensureRoutingChannelOpened()
@@ -0,0 +1,271 @@
+package breez
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+const (
+	//defaultMinChannelSize is the routing node channel capacity below which channels are consolidated
+	defaultMinChannelSize = 100000
+
+	//fundingTxVirtualSize is the approximate size of a channel funding transaction
+	fundingTxVirtualSize = 155
+)
+
+var consolidationMu sync.Mutex
+
+func serializeChannelConsolidation(c *data.ChannelConsolidation) ([]byte, error) {
+	return json.Marshal(c)
+}
+
+func deserializeChannelConsolidation(consolidationBytes []byte) (*data.ChannelConsolidation, error) {
+	var c data.ChannelConsolidation
+	err := json.Unmarshal(consolidationBytes, &c)
+	return &c, err
+}
+
+func minChannelSize() int64 {
+	if cfg != nil && cfg.MinChannelSize > 0 {
+		return cfg.MinChannelSize
+	}
+	return defaultMinChannelSize
+}
+
+// routingNodeChannels returns the open channels with the routing node.
+func routingNodeChannels() ([]*lnrpc.Channel, error) {
+	channels, err := paymentsClient.ListChannels(context.Background(), &lnrpc.ListChannelsRequest{PrivateOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	var result []*lnrpc.Channel
+	for _, c := range channels.Channels {
+		if c.RemotePubkey == cfg.RoutingNodePubKey {
+			result = append(result, c)
+		}
+	}
+	return result, nil
+}
+
+// consolidationPreview computes which routing node channels are below the minimum
+// size and the cost of replacing them by a single channel.
+func consolidationPreview(satPerByte int64) (*data.ChannelConsolidationPreview, error) {
+	channels, err := routingNodeChannels()
+	if err != nil {
+		return nil, err
+	}
+	preview := &data.ChannelConsolidationPreview{MinChannelSize: minChannelSize()}
+	for _, c := range channels {
+		if c.Capacity < preview.MinChannelSize {
+			preview.ChannelPoints = append(preview.ChannelPoints, c.ChannelPoint)
+			preview.Amount += c.LocalBalance
+		}
+	}
+	//a single small channel is only fragmented capacity if there are other channels
+	preview.Fragmented = len(preview.ChannelPoints) > 0 && len(channels) > 1
+	preview.EstimatedCloseFees = int64(len(preview.ChannelPoints)) * satPerByte * closeTxVirtualSize
+	preview.EstimatedOpenFee = satPerByte * fundingTxVirtualSize
+	preview.NewChannelSize = preview.Amount - preview.EstimatedCloseFees - preview.EstimatedOpenFee
+	if preview.NewChannelSize < 0 {
+		preview.NewChannelSize = 0
+	}
+	return preview, nil
+}
+
+/*
+GetChannelConsolidationPreview returns the routing node channels below the minimum channel size and
+the estimated cost of closing them and reopening a single channel with their balance, using the fee
+of the given strategy. satPerByte is only used by the CUSTOM strategy.
+*/
+func GetChannelConsolidationPreview(strategy data.CloseFeeStrategy, satPerByte int64) (*data.ChannelConsolidationPreview, error) {
+	feeRate, err := closeSatPerByte(strategy, satPerByte)
+	if err != nil {
+		return nil, err
+	}
+	return consolidationPreview(feeRate)
+}
+
+/*
+ConsolidateChannels closes the routing node channels below the minimum channel size and, once the
+closing transactions confirm, opens one larger channel to the routing node with the recovered funds.
+The consolidation is tracked as an operation returned by GetChannelConsolidations.
+*/
+func ConsolidateChannels(strategy data.CloseFeeStrategy, satPerByte int64) (*data.ChannelConsolidation, error) {
+	feeRate, err := closeSatPerByte(strategy, satPerByte)
+	if err != nil {
+		return nil, err
+	}
+	consolidationMu.Lock()
+	defer consolidationMu.Unlock()
+	consolidations, err := fetchChannelConsolidations()
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range consolidations {
+		if c.Status == data.ChannelConsolidation_CLOSING || c.Status == data.ChannelConsolidation_OPENING {
+			return nil, fmt.Errorf("consolidation %v is in progress", c.Id)
+		}
+	}
+	preview, err := consolidationPreview(feeRate)
+	if err != nil {
+		return nil, err
+	}
+	if !preview.Fragmented {
+		return nil, errors.New("channels are not fragmented")
+	}
+	if preview.NewChannelSize < preview.MinChannelSize {
+		return nil, fmt.Errorf("the consolidated channel of %v satoshi would be below the minimum channel size", preview.NewChannelSize)
+	}
+
+	consolidation := &data.ChannelConsolidation{
+		Status:        data.ChannelConsolidation_CLOSING,
+		ChannelPoints: preview.ChannelPoints,
+		SatPerByte:    feeRate,
+		Timestamp:     trustedNow().Unix(),
+	}
+	if err := addChannelConsolidation(consolidation); err != nil {
+		return nil, err
+	}
+	log.Infof("ConsolidateChannels - consolidation %v closing %v channels", consolidation.Id, len(consolidation.ChannelPoints))
+	for _, channelPoint := range consolidation.ChannelPoints {
+		txid, err := CloseChannel(channelPoint, data.CloseFeeStrategy_CUSTOM, feeRate)
+		if err != nil {
+			log.Errorf("ConsolidateChannels - failed to close %v: %v", channelPoint, err)
+			consolidation.Status = data.ChannelConsolidation_FAILED
+			consolidation.ErrorMessage = err.Error()
+			break
+		}
+		consolidation.ClosingTxids = append(consolidation.ClosingTxids, txid)
+	}
+	if err := updateChannelConsolidation(consolidation); err != nil {
+		return nil, err
+	}
+	return consolidation, nil
+}
+
+/*
+GetChannelConsolidations returns all the operations started by ConsolidateChannels.
+*/
+func GetChannelConsolidations() (*data.ChannelConsolidationsList, error) {
+	consolidations, err := fetchChannelConsolidations()
+	if err != nil {
+		return nil, err
+	}
+	return &data.ChannelConsolidationsList{Consolidations: consolidations}, nil
+}
+
+// resumeChannelConsolidations moves the consolidations in progress forward: it opens the
+// new channel once all the small channels are closed and completes the consolidation
+// once the new channel is active.
+func resumeChannelConsolidations() {
+	consolidationMu.Lock()
+	defer consolidationMu.Unlock()
+	consolidations, err := fetchChannelConsolidations()
+	if err != nil {
+		log.Errorf("resumeChannelConsolidations - failed to fetch consolidations: %v", err)
+		return
+	}
+	for _, c := range consolidations {
+		var err error
+		switch c.Status {
+		case data.ChannelConsolidation_CLOSING:
+			err = openConsolidatedChannel(c)
+		case data.ChannelConsolidation_OPENING:
+			err = checkConsolidatedChannel(c)
+		default:
+			continue
+		}
+		if err != nil {
+			log.Errorf("resumeChannelConsolidations - consolidation %v failed: %v", c.Id, err)
+			c.Status = data.ChannelConsolidation_FAILED
+			c.ErrorMessage = err.Error()
+			if err := updateChannelConsolidation(c); err != nil {
+				log.Errorf("resumeChannelConsolidations - failed to save consolidation %v: %v", c.Id, err)
+			}
+		}
+	}
+}
+
+// openConsolidatedChannel opens the new channel with the settled balance of the closed
+// channels. It does nothing until all of them are closed and their funds confirmed.
+func openConsolidatedChannel(c *data.ChannelConsolidation) error {
+	closed, err := lightningClient.ClosedChannels(context.Background(), &lnrpc.ClosedChannelsRequest{})
+	if err != nil {
+		return err
+	}
+	settled := make(map[string]int64)
+	for _, s := range closed.Channels {
+		settled[s.ChannelPoint] = s.SettledBalance
+	}
+	var amount int64
+	for _, channelPoint := range c.ChannelPoints {
+		balance, ok := settled[channelPoint]
+		if !ok {
+			return nil
+		}
+		amount += balance
+	}
+	amount -= c.SatPerByte * fundingTxVirtualSize
+	walletBalance, err := lightningClient.WalletBalance(context.Background(), &lnrpc.WalletBalanceRequest{})
+	if err != nil {
+		return err
+	}
+	if walletBalance.ConfirmedBalance < amount {
+		return nil
+	}
+	if amount < minChannelSize() {
+		return fmt.Errorf("the recovered %v satoshi are below the minimum channel size", amount)
+	}
+
+	log.Infof("openConsolidatedChannel - opening a channel of %v satoshi for consolidation %v", amount, c.Id)
+	channelPoint, err := lightningClient.OpenChannelSync(context.Background(), &lnrpc.OpenChannelRequest{
+		NodePubkeyString:   cfg.RoutingNodePubKey,
+		LocalFundingAmount: amount,
+		SatPerByte:         c.SatPerByte,
+		Private:            true,
+	})
+	if err != nil {
+		return err
+	}
+	txid, err := chainhash.NewHash(channelPoint.GetFundingTxidBytes())
+	if err != nil {
+		return err
+	}
+	c.Amount = amount
+	c.FundingTxid = txid.String()
+	c.Status = data.ChannelConsolidation_OPENING
+	go onAccountChanged()
+	return updateChannelConsolidation(c)
+}
+
+// checkConsolidatedChannel completes the consolidation once the new channel is open.
+func checkConsolidatedChannel(c *data.ChannelConsolidation) error {
+	channels, err := routingNodeChannels()
+	if err != nil {
+		return err
+	}
+	for _, channel := range channels {
+		if strings.HasPrefix(channel.ChannelPoint, c.FundingTxid+":") {
+			c.Status = data.ChannelConsolidation_COMPLETED
+			return updateChannelConsolidation(c)
+		}
+	}
+	return nil
+}
+
+// updateChannelConsolidation saves the consolidation and notifies about its new status.
+func updateChannelConsolidation(c *data.ChannelConsolidation) error {
+	if err := saveChannelConsolidation(c); err != nil {
+		return err
+	}
+	notify(data.NotificationEvent{Type: data.NotificationEvent_CHANNEL_CONSOLIDATION_CHANGED, Data: []string{fmt.Sprintf("%v", c.Id), c.Status.String()}})
+	return nil
+}
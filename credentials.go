@@ -0,0 +1,259 @@
+package breez
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/breez/breez/data"
+	"github.com/golang/protobuf/proto"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	credentialsBackupFile   = "credentials.enc"
+	credentialsRestoredFile = "credentials.restored"
+	credentialsKeySize      = 32
+	securityAlertMacaroons  = "macaroons"
+)
+
+var (
+	credentialsKeyMu sync.Mutex
+	credentialsKey   []byte
+
+	//ErrCredentialsMismatch is returned when the backed up credentials belong to another node than the restored one
+	ErrCredentialsMismatch = errors.New("credentials belong to another node")
+)
+
+// tlsFiles are in the working directory and macaroonFiles in the network
+// directory, macaroons.db holds the root key the macaroons are derived from.
+var (
+	tlsFiles      = []string{"tls.cert", "tls.key"}
+	macaroonFiles = []string{"admin.macaroon", "readonly.macaroon", "invoice.macaroon", "macaroons.db"}
+)
+
+// credentialsArchive is the content of the encrypted credentials backup, the
+// files by their path relative to the working directory.
+type credentialsArchive struct {
+	NodeID string
+	Files  map[string][]byte
+}
+
+func networkDir(workingDir, network string) string {
+	return strings.Join([]string{workingDir, "data", "chain", "bitcoin", network}, "/")
+}
+
+func credentialPaths(network string) []string {
+	var paths []string
+	paths = append(paths, tlsFiles...)
+	for _, f := range macaroonFiles {
+		paths = append(paths, path.Join("data", "chain", "bitcoin", network, f))
+	}
+	return paths
+}
+
+/*
+SetCredentialsBackupKey sets the 32 bytes key used to encrypt the daemon TLS and macaroon files added to
+the backup. The key is kept in memory only since the backup holds the database, the app is expected to
+keep it in the platform keystore and set it on every start. Without a key the credentials are not backed up.
+*/
+func SetCredentialsBackupKey(key []byte) error {
+	if key != nil && len(key) != credentialsKeySize {
+		return fmt.Errorf("credentials key must be %v bytes", credentialsKeySize)
+	}
+	credentialsKeyMu.Lock()
+	defer credentialsKeyMu.Unlock()
+	credentialsKey = key
+	return nil
+}
+
+func currentCredentialsKey() []byte {
+	credentialsKeyMu.Lock()
+	defer credentialsKeyMu.Unlock()
+	return credentialsKey
+}
+
+// backupCredentials writes the encrypted credentials archive to dir and
+// returns its path, empty when no key is set.
+func backupCredentials(dir string) (string, error) {
+	key := currentCredentialsKey()
+	if key == nil {
+		return "", nil
+	}
+	acc, err := GetAccountInfo()
+	if err != nil {
+		return "", err
+	}
+	archive := &credentialsArchive{NodeID: acc.Id, Files: make(map[string][]byte)}
+	for _, p := range credentialPaths(cfg.Network) {
+		content, err := ioutil.ReadFile(filepath.Join(appWorkingDir, p))
+		if err != nil {
+			return "", err
+		}
+		archive.Files[p] = content
+	}
+	archiveBuf, err := json.Marshal(archive)
+	if err != nil {
+		return "", err
+	}
+	encrypted, err := encryptWithKey(key, archiveBuf)
+	if err != nil {
+		return "", err
+	}
+	f := filepath.Join(dir, credentialsBackupFile)
+	return f, ioutil.WriteFile(f, encrypted, 0600)
+}
+
+/*
+RestoreCredentials restores the daemon TLS and macaroon files from the credentials backup put in the working
+directory with the other backup files. It is called before Start. ErrCredentialsMismatch is returned when they
+belong to another node than the restored database, the daemon then generates new ones. The node identity is
+checked again once the daemon is started.
+*/
+func RestoreCredentials(workingDir string, key []byte) error {
+	c, err := GetConfig(workingDir)
+	if err != nil {
+		return err
+	}
+	backupPath := filepath.Join(workingDir, credentialsBackupFile)
+	encrypted, err := ioutil.ReadFile(backupPath)
+	if err != nil {
+		return err
+	}
+	archiveBuf, err := decryptWithKey(key, encrypted)
+	if err != nil {
+		return err
+	}
+	var archive credentialsArchive
+	if err := json.Unmarshal(archiveBuf, &archive); err != nil {
+		return err
+	}
+	nodeID, err := restoredNodeID(filepath.Join(workingDir, "breez.db"))
+	if err != nil {
+		return err
+	}
+	if nodeID != archive.NodeID {
+		return ErrCredentialsMismatch
+	}
+	for _, p := range credentialPaths(c.Network) {
+		content, ok := archive.Files[p]
+		if !ok {
+			return fmt.Errorf("credentials backup is missing %v", p)
+		}
+		dest := filepath.Join(workingDir, p)
+		if err := os.MkdirAll(filepath.Dir(dest), 0700); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(dest, content, 0600); err != nil {
+			return err
+		}
+	}
+	if err := ioutil.WriteFile(filepath.Join(workingDir, credentialsRestoredFile), []byte(archive.NodeID), 0600); err != nil {
+		return err
+	}
+	return os.Remove(backupPath)
+}
+
+// restoredNodeID reads the node id of the account in a database which is not open.
+func restoredNodeID(dbPath string) (string, error) {
+	restoredDB, err := bolt.Open(dbPath, 0600, &bolt.Options{ReadOnly: true})
+	if err != nil {
+		return "", err
+	}
+	defer restoredDB.Close()
+	account := &data.Account{}
+	err = restoredDB.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(accountBucket))
+		if b == nil {
+			return nil
+		}
+		accBuf := b.Get([]byte("account"))
+		if accBuf == nil {
+			return nil
+		}
+		return proto.Unmarshal(accBuf, account)
+	})
+	return account.Id, err
+}
+
+// verifyRestoredCredentials checks the restored credentials belong to the
+// daemon node and regenerates the macaroons on the next start otherwise.
+func verifyRestoredCredentials() {
+	restoredPath := filepath.Join(appWorkingDir, credentialsRestoredFile)
+	nodeID, err := ioutil.ReadFile(restoredPath)
+	if err != nil {
+		return
+	}
+	acc, err := GetAccountInfo()
+	if err != nil || acc.Id == "" {
+		return
+	}
+	if acc.Id != string(nodeID) {
+		securityAlert(securityAlertMacaroons, acc.Id, "restored credentials belong to another node")
+		if err := saveMacaroonRotation(true); err != nil {
+			log.Errorf("verifyRestoredCredentials - failed to schedule the macaroons rotation: %v", err)
+			return
+		}
+	}
+	os.Remove(restoredPath)
+}
+
+/*
+RegenerateMacaroons rotates the macaroons root key so the current macaroons, and every copy of them, stop
+being valid, together with the HTTP API and gRPC tokens. The daemon is restarted to regenerate the
+macaroons, notifications keep coming on the channel returned by Start. Once they are regenerated a new
+backup is made and a CREDENTIALS_ROTATED notification is sent so the app can hand the new macaroons and
+tokens to whatever used the old ones. Paired companions use their own encrypted sessions and are not affected.
+*/
+func RegenerateMacaroons() error {
+	if atomic.LoadInt32(&started) == 0 || !DaemonReady() {
+		return errors.New("the daemon is not ready")
+	}
+	if err := saveMacaroonRotation(true); err != nil {
+		return err
+	}
+	if _, err := RotateHTTPAPIToken(); err != nil {
+		return err
+	}
+	if _, err := RotateGRPCToken(); err != nil {
+		return err
+	}
+	log.Infof("RegenerateMacaroons - restarting the daemon to rotate the macaroons")
+	stopped := stoppedChan
+	Stop()
+	<-stopped
+	_, err := Start(appWorkingDir, false)
+	return err
+}
+
+// rotateMacaroons deletes the macaroons and their root key before the daemon
+// starts when a rotation is pending, the daemon then creates new ones.
+func rotateMacaroons() (bool, error) {
+	pending, err := fetchMacaroonRotation()
+	if err != nil || !pending {
+		return false, err
+	}
+	dir := networkDir(appWorkingDir, cfg.Network)
+	for _, f := range macaroonFiles {
+		if err := os.Remove(filepath.Join(dir, f)); err != nil && !os.IsNotExist(err) {
+			return false, err
+		}
+	}
+	log.Infof("rotateMacaroons - macaroons removed, the daemon generates new ones")
+	return true, saveMacaroonRotation(false)
+}
+
+// onMacaroonsRotated backs up the new credentials and notifies the app.
+func onMacaroonsRotated() {
+	if err := extractBackupPaths(); err != nil {
+		log.Errorf("onMacaroonsRotated - failed to backup the new credentials: %v", err)
+	}
+	notify(data.NotificationEvent{Type: data.NotificationEvent_CREDENTIALS_ROTATED})
+}
@@ -11,10 +11,20 @@ It has these top-level messages:
 	ChainStatus
 	Account
 	Payment
+	FundingSource
 	PaymentsList
+	PaymentsPageRequest
+	ExportPaymentsRequest
+	PayeeSpending
+	SpendingByPayee
+	PaymentsPage
 	SendWalletCoinsRequest
 	PayInvoiceRequest
+	FeeLimit
 	InvoiceMemo
+	TaxInfo
+	AddInvoiceRequest
+	AddInvoiceReply
 	Invoice
 	NotificationEvent
 	AddFundInitReply
@@ -25,6 +35,7 @@ It has these top-level messages:
 	RemoveFundReply
 	SwapAddressInfo
 	SwapAddressList
+	SwapLimits
 	CreateRatchetSessionRequest
 	CreateRatchetSessionReply
 	RatchetSessionInfoReply
@@ -32,6 +43,103 @@ It has these top-level messages:
 	RatchetEncryptRequest
 	RatchetDecryptRequest
 	BootstrapFilesRequest
+	SavingsInfo
+	MoveFundsOperation
+	MoveFundsOperationsList
+	CloseFeeOption
+	CloseFeeOptions
+	CloseChannelRequest
+	ObserverCredential
+	ObserverSnapshot
+	QuarantinedPayment
+	QuarantinedPaymentsList
+	ResolveQuarantinedPaymentRequest
+	PairingPermissions
+	PairingSession
+	PairingSessionsList
+	StartPairingReply
+	PairingRequest
+	PairingReply
+	SplitRecipient
+	SplitForward
+	PaymentSplit
+	AddSplitInvoiceRequest
+	SettlementRule
+	SettlementRulesList
+	SettlementAuditEntry
+	SettlementAuditList
+	SupportBundle
+	LNURLPayParams
+	PayLNURLRequest
+	LightningAddressInvoice
+	InvoiceReminderRequest
+	HealthCheckResult
+	HealthStatus
+	Rate
+	Rates
+	FaultInjectionRule
+	FaultInjectionRules
+	QueuePaymentRequest
+	QueuedPayment
+	QueuedPayments
+	SendMax
+	RouteHop
+	PaymentRoute
+	FailedPayment
+	FailedPayments
+	DonationCampaign
+	DonationCampaigns
+	DonationInvoiceRequest
+	DonationContribution
+	DonationContributions
+	PaymentStatus
+	RetryPolicy
+	RetryPaymentRequest
+	RatesProvider
+	RatesProviders
+	SwapAddressReuse
+	StatementItem
+	Statement
+	PaymentFeeEstimate
+	HTLCEvent
+	HTLCEvents
+	ConsolidateChannelsRequest
+	ChannelConsolidationPreview
+	ChannelConsolidation
+	ChannelConsolidationsList
+	IssuedInvoice
+	IssuedInvoices
+	SpendPolicyCheck
+	SpendAuditEntry
+	SpendAuditFilter
+	SpendAuditLog
+	PaymentSummary
+	PaymentsSnapshot
+	CreatePaymentCodeRequest
+	PaymentCode
+	PaymentCodes
+	PaymentURI
+	SuggestedAmountsRequest
+	SuggestedAmounts
+	PaymentIntent
+	PaymentIntents
+	SpendingPolicy
+	PaymentAuthorizationRequest
+	Webhook
+	Webhooks
+	WebhookDelivery
+	WebhookDeliveries
+	WakeupResult
+	DeviceBackupManifest
+	DeviceBackupChunk
+	DeviceBackup
+	DeviceBackupStatus
+	HubPolicy
+	MaintenanceTaskResult
+	MaintenanceReport
+	Empty
+	PaymentRequest
+	SubscribeNotificationsRequest
 */
 package data
 
@@ -39,6 +147,11 @@ import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
@@ -50,6 +163,162 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type CloseFeeStrategy int32
+
+const (
+	CloseFeeStrategy_NORMAL     CloseFeeStrategy = 0
+	CloseFeeStrategy_ECONOMICAL CloseFeeStrategy = 1
+	CloseFeeStrategy_URGENT     CloseFeeStrategy = 2
+	CloseFeeStrategy_CUSTOM     CloseFeeStrategy = 3
+)
+
+var CloseFeeStrategy_name = map[int32]string{
+	0: "NORMAL",
+	1: "ECONOMICAL",
+	2: "URGENT",
+	3: "CUSTOM",
+}
+var CloseFeeStrategy_value = map[string]int32{
+	"NORMAL":     0,
+	"ECONOMICAL": 1,
+	"URGENT":     2,
+	"CUSTOM":     3,
+}
+
+func (x CloseFeeStrategy) String() string {
+	return proto.EnumName(CloseFeeStrategy_name, int32(x))
+}
+func (CloseFeeStrategy) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+type QuarantineResolution int32
+
+const (
+	QuarantineResolution_DISCARD          QuarantineResolution = 0
+	QuarantineResolution_REPLACE_EXISTING QuarantineResolution = 1
+	QuarantineResolution_KEEP_BOTH        QuarantineResolution = 2
+)
+
+var QuarantineResolution_name = map[int32]string{
+	0: "DISCARD",
+	1: "REPLACE_EXISTING",
+	2: "KEEP_BOTH",
+}
+var QuarantineResolution_value = map[string]int32{
+	"DISCARD":          0,
+	"REPLACE_EXISTING": 1,
+	"KEEP_BOTH":        2,
+}
+
+func (x QuarantineResolution) String() string {
+	return proto.EnumName(QuarantineResolution_name, int32(x))
+}
+func (QuarantineResolution) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+type RedactionLevel int32
+
+const (
+	RedactionLevel_MINIMAL  RedactionLevel = 0
+	RedactionLevel_STANDARD RedactionLevel = 1
+	RedactionLevel_STRICT   RedactionLevel = 2
+)
+
+var RedactionLevel_name = map[int32]string{
+	0: "MINIMAL",
+	1: "STANDARD",
+	2: "STRICT",
+}
+var RedactionLevel_value = map[string]int32{
+	"MINIMAL":  0,
+	"STANDARD": 1,
+	"STRICT":   2,
+}
+
+func (x RedactionLevel) String() string {
+	return proto.EnumName(RedactionLevel_name, int32(x))
+}
+func (RedactionLevel) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+type ErrorCode int32
+
+const (
+	ErrorCode_UNKNOWN_ERROR             ErrorCode = 0
+	ErrorCode_DAEMON_NOT_READY          ErrorCode = 1
+	ErrorCode_INVOICE_EXPIRED           ErrorCode = 2
+	ErrorCode_NO_ROUTE                  ErrorCode = 3
+	ErrorCode_INSUFFICIENT_BALANCE      ErrorCode = 4
+	ErrorCode_FEE_LIMIT_EXCEEDED        ErrorCode = 5
+	ErrorCode_TIMEOUT                   ErrorCode = 6
+	ErrorCode_INCORRECT_PAYMENT_DETAILS ErrorCode = 7
+	ErrorCode_RECIPIENT_OFFLINE         ErrorCode = 8
+	ErrorCode_ROUTE_UNAVAILABLE         ErrorCode = 9
+	ErrorCode_ROUTE_POLICY_CHANGED      ErrorCode = 10
+	ErrorCode_NODE_FAILURE              ErrorCode = 11
+	ErrorCode_ALREADY_PAID              ErrorCode = 12
+	ErrorCode_PAYMENT_IN_FLIGHT         ErrorCode = 13
+	ErrorCode_SPENDING_LIMIT_EXCEEDED   ErrorCode = 14
+	ErrorCode_DAILY_BUDGET_EXCEEDED     ErrorCode = 15
+	ErrorCode_PAYMENT_NOT_AUTHORIZED    ErrorCode = 16
+	ErrorCode_NOT_SUPPORTED             ErrorCode = 17
+	ErrorCode_INVALID_PAYMENT_REQUEST   ErrorCode = 18
+	ErrorCode_CERTIFICATE_PIN_MISMATCH  ErrorCode = 19
+	ErrorCode_CREDENTIALS_MISMATCH      ErrorCode = 20
+	ErrorCode_DATABASE_CORRUPTED        ErrorCode = 21
+)
+
+var ErrorCode_name = map[int32]string{
+	0:  "UNKNOWN_ERROR",
+	1:  "DAEMON_NOT_READY",
+	2:  "INVOICE_EXPIRED",
+	3:  "NO_ROUTE",
+	4:  "INSUFFICIENT_BALANCE",
+	5:  "FEE_LIMIT_EXCEEDED",
+	6:  "TIMEOUT",
+	7:  "INCORRECT_PAYMENT_DETAILS",
+	8:  "RECIPIENT_OFFLINE",
+	9:  "ROUTE_UNAVAILABLE",
+	10: "ROUTE_POLICY_CHANGED",
+	11: "NODE_FAILURE",
+	12: "ALREADY_PAID",
+	13: "PAYMENT_IN_FLIGHT",
+	14: "SPENDING_LIMIT_EXCEEDED",
+	15: "DAILY_BUDGET_EXCEEDED",
+	16: "PAYMENT_NOT_AUTHORIZED",
+	17: "NOT_SUPPORTED",
+	18: "INVALID_PAYMENT_REQUEST",
+	19: "CERTIFICATE_PIN_MISMATCH",
+	20: "CREDENTIALS_MISMATCH",
+	21: "DATABASE_CORRUPTED",
+}
+var ErrorCode_value = map[string]int32{
+	"UNKNOWN_ERROR":             0,
+	"DAEMON_NOT_READY":          1,
+	"INVOICE_EXPIRED":           2,
+	"NO_ROUTE":                  3,
+	"INSUFFICIENT_BALANCE":      4,
+	"FEE_LIMIT_EXCEEDED":        5,
+	"TIMEOUT":                   6,
+	"INCORRECT_PAYMENT_DETAILS": 7,
+	"RECIPIENT_OFFLINE":         8,
+	"ROUTE_UNAVAILABLE":         9,
+	"ROUTE_POLICY_CHANGED":      10,
+	"NODE_FAILURE":              11,
+	"ALREADY_PAID":              12,
+	"PAYMENT_IN_FLIGHT":         13,
+	"SPENDING_LIMIT_EXCEEDED":   14,
+	"DAILY_BUDGET_EXCEEDED":     15,
+	"PAYMENT_NOT_AUTHORIZED":    16,
+	"NOT_SUPPORTED":             17,
+	"INVALID_PAYMENT_REQUEST":   18,
+	"CERTIFICATE_PIN_MISMATCH":  19,
+	"CREDENTIALS_MISMATCH":      20,
+	"DATABASE_CORRUPTED":        21,
+}
+
+func (x ErrorCode) String() string {
+	return proto.EnumName(ErrorCode_name, int32(x))
+}
+func (ErrorCode) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
 type Account_AccountStatus int32
 
 const (
@@ -83,10 +352,13 @@ func (Account_AccountStatus) EnumDescriptor() ([]byte, []int) { return fileDescr
 type Payment_PaymentType int32
 
 const (
-	Payment_DEPOSIT    Payment_PaymentType = 0
-	Payment_WITHDRAWAL Payment_PaymentType = 1
-	Payment_SENT       Payment_PaymentType = 2
-	Payment_RECEIVED   Payment_PaymentType = 3
+	Payment_DEPOSIT        Payment_PaymentType = 0
+	Payment_WITHDRAWAL     Payment_PaymentType = 1
+	Payment_SENT           Payment_PaymentType = 2
+	Payment_RECEIVED       Payment_PaymentType = 3
+	Payment_REFUND         Payment_PaymentType = 4
+	Payment_SERVICE_FEE    Payment_PaymentType = 5
+	Payment_CHANNEL_CLOSED Payment_PaymentType = 6
 )
 
 var Payment_PaymentType_name = map[int32]string{
@@ -94,12 +366,18 @@ var Payment_PaymentType_name = map[int32]string{
 	1: "WITHDRAWAL",
 	2: "SENT",
 	3: "RECEIVED",
+	4: "REFUND",
+	5: "SERVICE_FEE",
+	6: "CHANNEL_CLOSED",
 }
 var Payment_PaymentType_value = map[string]int32{
-	"DEPOSIT":    0,
-	"WITHDRAWAL": 1,
-	"SENT":       2,
-	"RECEIVED":   3,
+	"DEPOSIT":        0,
+	"WITHDRAWAL":     1,
+	"SENT":           2,
+	"RECEIVED":       3,
+	"REFUND":         4,
+	"SERVICE_FEE":    5,
+	"CHANNEL_CLOSED": 6,
 }
 
 func (x Payment_PaymentType) String() string {
@@ -107,6 +385,39 @@ func (x Payment_PaymentType) String() string {
 }
 func (Payment_PaymentType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{2, 0} }
 
+type Payment_CloseReason int32
+
+const (
+	Payment_COOPERATIVE_CLOSE  Payment_CloseReason = 0
+	Payment_LOCAL_FORCE_CLOSE  Payment_CloseReason = 1
+	Payment_REMOTE_FORCE_CLOSE Payment_CloseReason = 2
+	Payment_BREACH_CLOSE       Payment_CloseReason = 3
+	Payment_FUNDING_CANCELED   Payment_CloseReason = 4
+	Payment_ABANDONED          Payment_CloseReason = 5
+)
+
+var Payment_CloseReason_name = map[int32]string{
+	0: "COOPERATIVE_CLOSE",
+	1: "LOCAL_FORCE_CLOSE",
+	2: "REMOTE_FORCE_CLOSE",
+	3: "BREACH_CLOSE",
+	4: "FUNDING_CANCELED",
+	5: "ABANDONED",
+}
+var Payment_CloseReason_value = map[string]int32{
+	"COOPERATIVE_CLOSE":  0,
+	"LOCAL_FORCE_CLOSE":  1,
+	"REMOTE_FORCE_CLOSE": 2,
+	"BREACH_CLOSE":       3,
+	"FUNDING_CANCELED":   4,
+	"ABANDONED":          5,
+}
+
+func (x Payment_CloseReason) String() string {
+	return proto.EnumName(Payment_CloseReason_name, int32(x))
+}
+func (Payment_CloseReason) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{2, 1} }
+
 type NotificationEvent_NotificationType int32
 
 const (
@@ -118,17 +429,55 @@ const (
 	NotificationEvent_LIGHTNING_SERVICE_DOWN          NotificationEvent_NotificationType = 5
 	NotificationEvent_FUND_ADDRESS_UNSPENT_CHANGED    NotificationEvent_NotificationType = 6
 	NotificationEvent_BACKUP_FILES_AVAILABLE          NotificationEvent_NotificationType = 7
+	NotificationEvent_FUND_ADDRESS_REFUNDED           NotificationEvent_NotificationType = 8
+	NotificationEvent_SECURITY_PIN_FAILED             NotificationEvent_NotificationType = 9
+	NotificationEvent_CHANNEL_CLOSED                  NotificationEvent_NotificationType = 10
+	NotificationEvent_INVOICE_REMINDER                NotificationEvent_NotificationType = 11
+	NotificationEvent_RATES_CHANGED                   NotificationEvent_NotificationType = 12
+	NotificationEvent_QUEUED_PAYMENT_CHANGED          NotificationEvent_NotificationType = 13
+	NotificationEvent_PAYMENT_FAILED                  NotificationEvent_NotificationType = 14
+	NotificationEvent_DONATION_RECEIVED               NotificationEvent_NotificationType = 15
+	NotificationEvent_PAYMENT_STATUS_CHANGED          NotificationEvent_NotificationType = 16
+	NotificationEvent_CLOCK_SKEW                      NotificationEvent_NotificationType = 17
+	NotificationEvent_CHANNEL_CONSOLIDATION_CHANGED   NotificationEvent_NotificationType = 18
+	NotificationEvent_PENDING_EXPIRY_CHANGED          NotificationEvent_NotificationType = 20
+	NotificationEvent_INVOICE_EXPIRED                 NotificationEvent_NotificationType = 21
+	NotificationEvent_SECURITY_ALERT                  NotificationEvent_NotificationType = 22
+	NotificationEvent_INVOICE_REGENERATED             NotificationEvent_NotificationType = 23
+	NotificationEvent_PAYMENT_INTENT_RESOLVED         NotificationEvent_NotificationType = 24
+	NotificationEvent_CREDENTIALS_ROTATED             NotificationEvent_NotificationType = 25
+	NotificationEvent_DEVICE_BACKUP_READY             NotificationEvent_NotificationType = 26
+	NotificationEvent_HUB_POLICY_CHANGED              NotificationEvent_NotificationType = 27
 )
 
 var NotificationEvent_NotificationType_name = map[int32]string{
-	0: "READY",
-	1: "INITIALIZATION_FAILED",
-	2: "ACCOUNT_CHANGED",
-	3: "INVOICE_PAID",
-	4: "ROUTING_NODE_CONNECTION_CHANGED",
-	5: "LIGHTNING_SERVICE_DOWN",
-	6: "FUND_ADDRESS_UNSPENT_CHANGED",
-	7: "BACKUP_FILES_AVAILABLE",
+	0:  "READY",
+	1:  "INITIALIZATION_FAILED",
+	2:  "ACCOUNT_CHANGED",
+	3:  "INVOICE_PAID",
+	4:  "ROUTING_NODE_CONNECTION_CHANGED",
+	5:  "LIGHTNING_SERVICE_DOWN",
+	6:  "FUND_ADDRESS_UNSPENT_CHANGED",
+	7:  "BACKUP_FILES_AVAILABLE",
+	8:  "FUND_ADDRESS_REFUNDED",
+	9:  "SECURITY_PIN_FAILED",
+	10: "CHANNEL_CLOSED",
+	11: "INVOICE_REMINDER",
+	12: "RATES_CHANGED",
+	13: "QUEUED_PAYMENT_CHANGED",
+	14: "PAYMENT_FAILED",
+	15: "DONATION_RECEIVED",
+	16: "PAYMENT_STATUS_CHANGED",
+	17: "CLOCK_SKEW",
+	18: "CHANNEL_CONSOLIDATION_CHANGED",
+	20: "PENDING_EXPIRY_CHANGED",
+	21: "INVOICE_EXPIRED",
+	22: "SECURITY_ALERT",
+	23: "INVOICE_REGENERATED",
+	24: "PAYMENT_INTENT_RESOLVED",
+	25: "CREDENTIALS_ROTATED",
+	26: "DEVICE_BACKUP_READY",
+	27: "HUB_POLICY_CHANGED",
 }
 var NotificationEvent_NotificationType_value = map[string]int32{
 	"READY":                           0,
@@ -139,13 +488,32 @@ var NotificationEvent_NotificationType_value = map[string]int32{
 	"LIGHTNING_SERVICE_DOWN":          5,
 	"FUND_ADDRESS_UNSPENT_CHANGED":    6,
 	"BACKUP_FILES_AVAILABLE":          7,
+	"FUND_ADDRESS_REFUNDED":           8,
+	"SECURITY_PIN_FAILED":             9,
+	"CHANNEL_CLOSED":                  10,
+	"INVOICE_REMINDER":                11,
+	"RATES_CHANGED":                   12,
+	"QUEUED_PAYMENT_CHANGED":          13,
+	"PAYMENT_FAILED":                  14,
+	"DONATION_RECEIVED":               15,
+	"PAYMENT_STATUS_CHANGED":          16,
+	"CLOCK_SKEW":                      17,
+	"CHANNEL_CONSOLIDATION_CHANGED":   18,
+	"PENDING_EXPIRY_CHANGED":          20,
+	"INVOICE_EXPIRED":                 21,
+	"SECURITY_ALERT":                  22,
+	"INVOICE_REGENERATED":             23,
+	"PAYMENT_INTENT_RESOLVED":         24,
+	"CREDENTIALS_ROTATED":             25,
+	"DEVICE_BACKUP_READY":             26,
+	"HUB_POLICY_CHANGED":              27,
 }
 
 func (x NotificationEvent_NotificationType) String() string {
 	return proto.EnumName(NotificationEvent_NotificationType_name, int32(x))
 }
 func (NotificationEvent_NotificationType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{8, 0}
+	return fileDescriptor0, []int{18, 0}
 }
 
 type FundStatusReply_FundStatus int32
@@ -171,8 +539,529 @@ func (x FundStatusReply_FundStatus) String() string {
 	return proto.EnumName(FundStatusReply_FundStatus_name, int32(x))
 }
 func (FundStatusReply_FundStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{12, 0}
+	return fileDescriptor0, []int{22, 0}
+}
+
+type MoveFundsOperation_Direction int32
+
+const (
+	MoveFundsOperation_TO_LIGHTNING MoveFundsOperation_Direction = 0
+	MoveFundsOperation_TO_ONCHAIN   MoveFundsOperation_Direction = 1
+)
+
+var MoveFundsOperation_Direction_name = map[int32]string{
+	0: "TO_LIGHTNING",
+	1: "TO_ONCHAIN",
+}
+var MoveFundsOperation_Direction_value = map[string]int32{
+	"TO_LIGHTNING": 0,
+	"TO_ONCHAIN":   1,
+}
+
+func (x MoveFundsOperation_Direction) String() string {
+	return proto.EnumName(MoveFundsOperation_Direction_name, int32(x))
+}
+func (MoveFundsOperation_Direction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{36, 0}
+}
+
+type MoveFundsOperation_Status int32
+
+const (
+	MoveFundsOperation_PENDING   MoveFundsOperation_Status = 0
+	MoveFundsOperation_COMPLETED MoveFundsOperation_Status = 1
+	MoveFundsOperation_FAILED    MoveFundsOperation_Status = 2
+)
+
+var MoveFundsOperation_Status_name = map[int32]string{
+	0: "PENDING",
+	1: "COMPLETED",
+	2: "FAILED",
+}
+var MoveFundsOperation_Status_value = map[string]int32{
+	"PENDING":   0,
+	"COMPLETED": 1,
+	"FAILED":    2,
+}
+
+func (x MoveFundsOperation_Status) String() string {
+	return proto.EnumName(MoveFundsOperation_Status_name, int32(x))
+}
+func (MoveFundsOperation_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{36, 1}
+}
+
+type PairingRequest_Type int32
+
+const (
+	PairingRequest_GET_ACCOUNT    PairingRequest_Type = 0
+	PairingRequest_GET_PAYMENTS   PairingRequest_Type = 1
+	PairingRequest_CREATE_INVOICE PairingRequest_Type = 2
+	PairingRequest_BACKUP_ACK     PairingRequest_Type = 3
+)
+
+var PairingRequest_Type_name = map[int32]string{
+	0: "GET_ACCOUNT",
+	1: "GET_PAYMENTS",
+	2: "CREATE_INVOICE",
+	3: "BACKUP_ACK",
+}
+var PairingRequest_Type_value = map[string]int32{
+	"GET_ACCOUNT":    0,
+	"GET_PAYMENTS":   1,
+	"CREATE_INVOICE": 2,
+	"BACKUP_ACK":     3,
+}
+
+func (x PairingRequest_Type) String() string {
+	return proto.EnumName(PairingRequest_Type_name, int32(x))
+}
+func (PairingRequest_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{50, 0} }
+
+type SettlementRule_Action int32
+
+const (
+	SettlementRule_NOTIFY_SERVICE  SettlementRule_Action = 0
+	SettlementRule_MOVE_TO_SAVINGS SettlementRule_Action = 1
+)
+
+var SettlementRule_Action_name = map[int32]string{
+	0: "NOTIFY_SERVICE",
+	1: "MOVE_TO_SAVINGS",
+}
+var SettlementRule_Action_value = map[string]int32{
+	"NOTIFY_SERVICE":  0,
+	"MOVE_TO_SAVINGS": 1,
+}
+
+func (x SettlementRule_Action) String() string {
+	return proto.EnumName(SettlementRule_Action_name, int32(x))
+}
+func (SettlementRule_Action) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{56, 0} }
+
+type FaultInjectionRule_Fault int32
+
+const (
+	FaultInjectionRule_NO_ROUTE        FaultInjectionRule_Fault = 0
+	FaultInjectionRule_TIMEOUT         FaultInjectionRule_Fault = 1
+	FaultInjectionRule_FEE_TOO_HIGH    FaultInjectionRule_Fault = 2
+	FaultInjectionRule_INVOICE_EXPIRED FaultInjectionRule_Fault = 3
+)
+
+var FaultInjectionRule_Fault_name = map[int32]string{
+	0: "NO_ROUTE",
+	1: "TIMEOUT",
+	2: "FEE_TOO_HIGH",
+	3: "INVOICE_EXPIRED",
+}
+var FaultInjectionRule_Fault_value = map[string]int32{
+	"NO_ROUTE":        0,
+	"TIMEOUT":         1,
+	"FEE_TOO_HIGH":    2,
+	"INVOICE_EXPIRED": 3,
+}
+
+func (x FaultInjectionRule_Fault) String() string {
+	return proto.EnumName(FaultInjectionRule_Fault_name, int32(x))
+}
+func (FaultInjectionRule_Fault) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{69, 0}
+}
+
+type QueuedPayment_Status int32
+
+const (
+	QueuedPayment_QUEUED  QueuedPayment_Status = 0
+	QueuedPayment_SENDING QueuedPayment_Status = 1
+	QueuedPayment_SENT    QueuedPayment_Status = 2
+	QueuedPayment_FAILED  QueuedPayment_Status = 3
+	QueuedPayment_EXPIRED QueuedPayment_Status = 4
+)
+
+var QueuedPayment_Status_name = map[int32]string{
+	0: "QUEUED",
+	1: "SENDING",
+	2: "SENT",
+	3: "FAILED",
+	4: "EXPIRED",
+}
+var QueuedPayment_Status_value = map[string]int32{
+	"QUEUED":  0,
+	"SENDING": 1,
+	"SENT":    2,
+	"FAILED":  3,
+	"EXPIRED": 4,
+}
+
+func (x QueuedPayment_Status) String() string {
+	return proto.EnumName(QueuedPayment_Status_name, int32(x))
+}
+func (QueuedPayment_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{72, 0} }
+
+type FailedPayment_Reason int32
+
+const (
+	FailedPayment_UNKNOWN                   FailedPayment_Reason = 0
+	FailedPayment_NO_ROUTE                  FailedPayment_Reason = 1
+	FailedPayment_INSUFFICIENT_BALANCE      FailedPayment_Reason = 2
+	FailedPayment_INVOICE_EXPIRED           FailedPayment_Reason = 3
+	FailedPayment_TIMEOUT                   FailedPayment_Reason = 4
+	FailedPayment_FEE_LIMIT_EXCEEDED        FailedPayment_Reason = 5
+	FailedPayment_INCORRECT_PAYMENT_DETAILS FailedPayment_Reason = 6
+	FailedPayment_RECIPIENT_OFFLINE         FailedPayment_Reason = 7
+	FailedPayment_ROUTE_UNAVAILABLE         FailedPayment_Reason = 8
+	FailedPayment_ROUTE_POLICY_CHANGED      FailedPayment_Reason = 9
+	FailedPayment_NODE_FAILURE              FailedPayment_Reason = 10
+)
+
+var FailedPayment_Reason_name = map[int32]string{
+	0:  "UNKNOWN",
+	1:  "NO_ROUTE",
+	2:  "INSUFFICIENT_BALANCE",
+	3:  "INVOICE_EXPIRED",
+	4:  "TIMEOUT",
+	5:  "FEE_LIMIT_EXCEEDED",
+	6:  "INCORRECT_PAYMENT_DETAILS",
+	7:  "RECIPIENT_OFFLINE",
+	8:  "ROUTE_UNAVAILABLE",
+	9:  "ROUTE_POLICY_CHANGED",
+	10: "NODE_FAILURE",
+}
+var FailedPayment_Reason_value = map[string]int32{
+	"UNKNOWN":                   0,
+	"NO_ROUTE":                  1,
+	"INSUFFICIENT_BALANCE":      2,
+	"INVOICE_EXPIRED":           3,
+	"TIMEOUT":                   4,
+	"FEE_LIMIT_EXCEEDED":        5,
+	"INCORRECT_PAYMENT_DETAILS": 6,
+	"RECIPIENT_OFFLINE":         7,
+	"ROUTE_UNAVAILABLE":         8,
+	"ROUTE_POLICY_CHANGED":      9,
+	"NODE_FAILURE":              10,
+}
+
+func (x FailedPayment_Reason) String() string {
+	return proto.EnumName(FailedPayment_Reason_name, int32(x))
+}
+func (FailedPayment_Reason) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{77, 0} }
+
+type FailedPayment_FailureCode int32
+
+const (
+	FailedPayment_NO_FAILURE_CODE                  FailedPayment_FailureCode = 0
+	FailedPayment_INVALID_REALM                    FailedPayment_FailureCode = 1
+	FailedPayment_TEMPORARY_NODE_FAILURE           FailedPayment_FailureCode = 2
+	FailedPayment_PERMANENT_NODE_FAILURE           FailedPayment_FailureCode = 3
+	FailedPayment_REQUIRED_NODE_FEATURE_MISSING    FailedPayment_FailureCode = 4
+	FailedPayment_INVALID_ONION_VERSION            FailedPayment_FailureCode = 5
+	FailedPayment_INVALID_ONION_HMAC               FailedPayment_FailureCode = 6
+	FailedPayment_INVALID_ONION_KEY                FailedPayment_FailureCode = 7
+	FailedPayment_TEMPORARY_CHANNEL_FAILURE        FailedPayment_FailureCode = 8
+	FailedPayment_PERMANENT_CHANNEL_FAILURE        FailedPayment_FailureCode = 9
+	FailedPayment_REQUIRED_CHANNEL_FEATURE_MISSING FailedPayment_FailureCode = 10
+	FailedPayment_UNKNOWN_NEXT_PEER                FailedPayment_FailureCode = 11
+	FailedPayment_AMOUNT_BELOW_MINIMUM             FailedPayment_FailureCode = 12
+	FailedPayment_FEE_INSUFFICIENT                 FailedPayment_FailureCode = 13
+	FailedPayment_INCORRECT_CLTV_EXPIRY            FailedPayment_FailureCode = 14
+	FailedPayment_EXPIRY_TOO_SOON                  FailedPayment_FailureCode = 15
+	FailedPayment_CHANNEL_DISABLED                 FailedPayment_FailureCode = 16
+	FailedPayment_UNKNOWN_PAYMENT_HASH             FailedPayment_FailureCode = 17
+	FailedPayment_INCORRECT_PAYMENT_AMOUNT         FailedPayment_FailureCode = 18
+	FailedPayment_FINAL_EXPIRY_TOO_SOON            FailedPayment_FailureCode = 19
+	FailedPayment_FINAL_INCORRECT_CLTV_EXPIRY      FailedPayment_FailureCode = 20
+	FailedPayment_FINAL_INCORRECT_HTLC_AMOUNT      FailedPayment_FailureCode = 21
+	FailedPayment_EXPIRY_TOO_FAR                   FailedPayment_FailureCode = 22
+)
+
+var FailedPayment_FailureCode_name = map[int32]string{
+	0:  "NO_FAILURE_CODE",
+	1:  "INVALID_REALM",
+	2:  "TEMPORARY_NODE_FAILURE",
+	3:  "PERMANENT_NODE_FAILURE",
+	4:  "REQUIRED_NODE_FEATURE_MISSING",
+	5:  "INVALID_ONION_VERSION",
+	6:  "INVALID_ONION_HMAC",
+	7:  "INVALID_ONION_KEY",
+	8:  "TEMPORARY_CHANNEL_FAILURE",
+	9:  "PERMANENT_CHANNEL_FAILURE",
+	10: "REQUIRED_CHANNEL_FEATURE_MISSING",
+	11: "UNKNOWN_NEXT_PEER",
+	12: "AMOUNT_BELOW_MINIMUM",
+	13: "FEE_INSUFFICIENT",
+	14: "INCORRECT_CLTV_EXPIRY",
+	15: "EXPIRY_TOO_SOON",
+	16: "CHANNEL_DISABLED",
+	17: "UNKNOWN_PAYMENT_HASH",
+	18: "INCORRECT_PAYMENT_AMOUNT",
+	19: "FINAL_EXPIRY_TOO_SOON",
+	20: "FINAL_INCORRECT_CLTV_EXPIRY",
+	21: "FINAL_INCORRECT_HTLC_AMOUNT",
+	22: "EXPIRY_TOO_FAR",
+}
+var FailedPayment_FailureCode_value = map[string]int32{
+	"NO_FAILURE_CODE":                  0,
+	"INVALID_REALM":                    1,
+	"TEMPORARY_NODE_FAILURE":           2,
+	"PERMANENT_NODE_FAILURE":           3,
+	"REQUIRED_NODE_FEATURE_MISSING":    4,
+	"INVALID_ONION_VERSION":            5,
+	"INVALID_ONION_HMAC":               6,
+	"INVALID_ONION_KEY":                7,
+	"TEMPORARY_CHANNEL_FAILURE":        8,
+	"PERMANENT_CHANNEL_FAILURE":        9,
+	"REQUIRED_CHANNEL_FEATURE_MISSING": 10,
+	"UNKNOWN_NEXT_PEER":                11,
+	"AMOUNT_BELOW_MINIMUM":             12,
+	"FEE_INSUFFICIENT":                 13,
+	"INCORRECT_CLTV_EXPIRY":            14,
+	"EXPIRY_TOO_SOON":                  15,
+	"CHANNEL_DISABLED":                 16,
+	"UNKNOWN_PAYMENT_HASH":             17,
+	"INCORRECT_PAYMENT_AMOUNT":         18,
+	"FINAL_EXPIRY_TOO_SOON":            19,
+	"FINAL_INCORRECT_CLTV_EXPIRY":      20,
+	"FINAL_INCORRECT_HTLC_AMOUNT":      21,
+	"EXPIRY_TOO_FAR":                   22,
+}
+
+func (x FailedPayment_FailureCode) String() string {
+	return proto.EnumName(FailedPayment_FailureCode_name, int32(x))
+}
+func (FailedPayment_FailureCode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{77, 1}
+}
+
+type FailedPayment_Hop int32
+
+const (
+	FailedPayment_UNKNOWN_HOP FailedPayment_Hop = 0
+	FailedPayment_SENDER      FailedPayment_Hop = 1
+	FailedPayment_ROUTE       FailedPayment_Hop = 2
+	FailedPayment_RECIPIENT   FailedPayment_Hop = 3
+)
+
+var FailedPayment_Hop_name = map[int32]string{
+	0: "UNKNOWN_HOP",
+	1: "SENDER",
+	2: "ROUTE",
+	3: "RECIPIENT",
+}
+var FailedPayment_Hop_value = map[string]int32{
+	"UNKNOWN_HOP": 0,
+	"SENDER":      1,
+	"ROUTE":       2,
+	"RECIPIENT":   3,
+}
+
+func (x FailedPayment_Hop) String() string {
+	return proto.EnumName(FailedPayment_Hop_name, int32(x))
+}
+func (FailedPayment_Hop) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{77, 2} }
+
+type PaymentStatus_Status int32
+
+const (
+	PaymentStatus_IN_FLIGHT PaymentStatus_Status = 0
+	PaymentStatus_SUCCEEDED PaymentStatus_Status = 1
+	PaymentStatus_FAILED    PaymentStatus_Status = 2
+)
+
+var PaymentStatus_Status_name = map[int32]string{
+	0: "IN_FLIGHT",
+	1: "SUCCEEDED",
+	2: "FAILED",
+}
+var PaymentStatus_Status_value = map[string]int32{
+	"IN_FLIGHT": 0,
+	"SUCCEEDED": 1,
+	"FAILED":    2,
+}
+
+func (x PaymentStatus_Status) String() string {
+	return proto.EnumName(PaymentStatus_Status_name, int32(x))
+}
+func (PaymentStatus_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{84, 0} }
+
+type HTLCEvent_EventType int32
+
+const (
+	HTLCEvent_ADDED    HTLCEvent_EventType = 0
+	HTLCEvent_SETTLED  HTLCEvent_EventType = 1
+	HTLCEvent_FAILED   HTLCEvent_EventType = 2
+	HTLCEvent_RESOLVED HTLCEvent_EventType = 3
+)
+
+var HTLCEvent_EventType_name = map[int32]string{
+	0: "ADDED",
+	1: "SETTLED",
+	2: "FAILED",
+	3: "RESOLVED",
+}
+var HTLCEvent_EventType_value = map[string]int32{
+	"ADDED":    0,
+	"SETTLED":  1,
+	"FAILED":   2,
+	"RESOLVED": 3,
+}
+
+func (x HTLCEvent_EventType) String() string {
+	return proto.EnumName(HTLCEvent_EventType_name, int32(x))
+}
+func (HTLCEvent_EventType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{93, 0} }
+
+type ChannelConsolidation_Status int32
+
+const (
+	ChannelConsolidation_CLOSING   ChannelConsolidation_Status = 0
+	ChannelConsolidation_OPENING   ChannelConsolidation_Status = 1
+	ChannelConsolidation_COMPLETED ChannelConsolidation_Status = 2
+	ChannelConsolidation_FAILED    ChannelConsolidation_Status = 3
+)
+
+var ChannelConsolidation_Status_name = map[int32]string{
+	0: "CLOSING",
+	1: "OPENING",
+	2: "COMPLETED",
+	3: "FAILED",
+}
+var ChannelConsolidation_Status_value = map[string]int32{
+	"CLOSING":   0,
+	"OPENING":   1,
+	"COMPLETED": 2,
+	"FAILED":    3,
+}
+
+func (x ChannelConsolidation_Status) String() string {
+	return proto.EnumName(ChannelConsolidation_Status_name, int32(x))
+}
+func (ChannelConsolidation_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{97, 0}
+}
+
+type IssuedInvoice_State int32
+
+const (
+	IssuedInvoice_OPEN    IssuedInvoice_State = 0
+	IssuedInvoice_SETTLED IssuedInvoice_State = 1
+	IssuedInvoice_EXPIRED IssuedInvoice_State = 2
+	IssuedInvoice_HIDDEN  IssuedInvoice_State = 3
+)
+
+var IssuedInvoice_State_name = map[int32]string{
+	0: "OPEN",
+	1: "SETTLED",
+	2: "EXPIRED",
+	3: "HIDDEN",
+}
+var IssuedInvoice_State_value = map[string]int32{
+	"OPEN":    0,
+	"SETTLED": 1,
+	"EXPIRED": 2,
+	"HIDDEN":  3,
+}
+
+func (x IssuedInvoice_State) String() string {
+	return proto.EnumName(IssuedInvoice_State_name, int32(x))
+}
+func (IssuedInvoice_State) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{99, 0} }
+
+type SpendAuditEntry_Initiator int32
+
+const (
+	SpendAuditEntry_UI        SpendAuditEntry_Initiator = 0
+	SpendAuditEntry_SCHEDULER SpendAuditEntry_Initiator = 1
+	SpendAuditEntry_PLUGIN    SpendAuditEntry_Initiator = 2
+	SpendAuditEntry_API_TOKEN SpendAuditEntry_Initiator = 3
+)
+
+var SpendAuditEntry_Initiator_name = map[int32]string{
+	0: "UI",
+	1: "SCHEDULER",
+	2: "PLUGIN",
+	3: "API_TOKEN",
+}
+var SpendAuditEntry_Initiator_value = map[string]int32{
+	"UI":        0,
+	"SCHEDULER": 1,
+	"PLUGIN":    2,
+	"API_TOKEN": 3,
+}
+
+func (x SpendAuditEntry_Initiator) String() string {
+	return proto.EnumName(SpendAuditEntry_Initiator_name, int32(x))
+}
+func (SpendAuditEntry_Initiator) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{102, 0}
+}
+
+type SpendAuditEntry_Kind int32
+
+const (
+	SpendAuditEntry_LIGHTNING SpendAuditEntry_Kind = 0
+	SpendAuditEntry_ONCHAIN   SpendAuditEntry_Kind = 1
+)
+
+var SpendAuditEntry_Kind_name = map[int32]string{
+	0: "LIGHTNING",
+	1: "ONCHAIN",
+}
+var SpendAuditEntry_Kind_value = map[string]int32{
+	"LIGHTNING": 0,
+	"ONCHAIN":   1,
+}
+
+func (x SpendAuditEntry_Kind) String() string {
+	return proto.EnumName(SpendAuditEntry_Kind_name, int32(x))
+}
+func (SpendAuditEntry_Kind) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{102, 1} }
+
+type SuggestedAmountsRequest_Screen int32
+
+const (
+	SuggestedAmountsRequest_SEND    SuggestedAmountsRequest_Screen = 0
+	SuggestedAmountsRequest_REQUEST SuggestedAmountsRequest_Screen = 1
+)
+
+var SuggestedAmountsRequest_Screen_name = map[int32]string{
+	0: "SEND",
+	1: "REQUEST",
+}
+var SuggestedAmountsRequest_Screen_value = map[string]int32{
+	"SEND":    0,
+	"REQUEST": 1,
+}
+
+func (x SuggestedAmountsRequest_Screen) String() string {
+	return proto.EnumName(SuggestedAmountsRequest_Screen_name, int32(x))
+}
+func (SuggestedAmountsRequest_Screen) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{111, 0}
+}
+
+type WebhookDelivery_Status int32
+
+const (
+	WebhookDelivery_PENDING   WebhookDelivery_Status = 0
+	WebhookDelivery_DELIVERED WebhookDelivery_Status = 1
+	WebhookDelivery_FAILED    WebhookDelivery_Status = 2
+)
+
+var WebhookDelivery_Status_name = map[int32]string{
+	0: "PENDING",
+	1: "DELIVERED",
+	2: "FAILED",
+}
+var WebhookDelivery_Status_value = map[string]int32{
+	"PENDING":   0,
+	"DELIVERED": 1,
+	"FAILED":    2,
+}
+
+func (x WebhookDelivery_Status) String() string {
+	return proto.EnumName(WebhookDelivery_Status_name, int32(x))
 }
+func (WebhookDelivery_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{119, 0} }
 
 type ChainStatus struct {
 	BlockHeight   uint32 `protobuf:"varint,1,opt,name=blockHeight" json:"blockHeight,omitempty"`
@@ -284,6 +1173,16 @@ type Payment struct {
 	Destination                string              `protobuf:"bytes,9,opt,name=destination" json:"destination,omitempty"`
 	PendingExpirationHeight    uint32              `protobuf:"varint,10,opt,name=PendingExpirationHeight" json:"PendingExpirationHeight,omitempty"`
 	PendingExpirationTimestamp int64               `protobuf:"varint,11,opt,name=PendingExpirationTimestamp" json:"PendingExpirationTimestamp,omitempty"`
+	ParentPaymentHash          string              `protobuf:"bytes,12,opt,name=parentPaymentHash" json:"parentPaymentHash,omitempty"`
+	FeeRecipient               string              `protobuf:"bytes,13,opt,name=feeRecipient" json:"feeRecipient,omitempty"`
+	CloseReason                Payment_CloseReason `protobuf:"varint,14,opt,name=closeReason,enum=data.Payment_CloseReason" json:"closeReason,omitempty"`
+	ClosingTxID                string              `protobuf:"bytes,15,opt,name=closingTxID" json:"closingTxID,omitempty"`
+	CloseFee                   int64               `protobuf:"varint,16,opt,name=closeFee" json:"closeFee,omitempty"`
+	FiatAmount                 float64             `protobuf:"fixed64,17,opt,name=fiatAmount" json:"fiatAmount,omitempty"`
+	FiatCurrency               string              `protobuf:"bytes,18,opt,name=fiatCurrency" json:"fiatCurrency,omitempty"`
+	Fee                        int64               `protobuf:"varint,19,opt,name=fee" json:"fee,omitempty"`
+	FeeMsat                    int64               `protobuf:"varint,20,opt,name=feeMsat" json:"feeMsat,omitempty"`
+	FundingSource              *FundingSource      `protobuf:"bytes,21,opt,name=fundingSource" json:"fundingSource,omitempty"`
 }
 
 func (m *Payment) Reset()                    { *m = Payment{} }
@@ -354,646 +1253,4930 @@ func (m *Payment) GetPendingExpirationTimestamp() int64 {
 	return 0
 }
 
-type PaymentsList struct {
-	PaymentsList []*Payment `protobuf:"bytes,1,rep,name=paymentsList" json:"paymentsList,omitempty"`
+func (m *Payment) GetParentPaymentHash() string {
+	if m != nil {
+		return m.ParentPaymentHash
+	}
+	return ""
 }
 
-func (m *PaymentsList) Reset()                    { *m = PaymentsList{} }
-func (m *PaymentsList) String() string            { return proto.CompactTextString(m) }
-func (*PaymentsList) ProtoMessage()               {}
-func (*PaymentsList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
-
-func (m *PaymentsList) GetPaymentsList() []*Payment {
+func (m *Payment) GetFeeRecipient() string {
 	if m != nil {
-		return m.PaymentsList
+		return m.FeeRecipient
 	}
-	return nil
+	return ""
 }
 
-type SendWalletCoinsRequest struct {
-	Address       string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
-	Amount        int64  `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
-	SatPerByteFee int64  `protobuf:"varint,3,opt,name=satPerByteFee" json:"satPerByteFee,omitempty"`
+func (m *Payment) GetCloseReason() Payment_CloseReason {
+	if m != nil {
+		return m.CloseReason
+	}
+	return Payment_COOPERATIVE_CLOSE
 }
 
-func (m *SendWalletCoinsRequest) Reset()                    { *m = SendWalletCoinsRequest{} }
-func (m *SendWalletCoinsRequest) String() string            { return proto.CompactTextString(m) }
-func (*SendWalletCoinsRequest) ProtoMessage()               {}
-func (*SendWalletCoinsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
-
-func (m *SendWalletCoinsRequest) GetAddress() string {
+func (m *Payment) GetClosingTxID() string {
 	if m != nil {
-		return m.Address
+		return m.ClosingTxID
 	}
 	return ""
 }
 
-func (m *SendWalletCoinsRequest) GetAmount() int64 {
+func (m *Payment) GetCloseFee() int64 {
 	if m != nil {
-		return m.Amount
+		return m.CloseFee
 	}
 	return 0
 }
 
-func (m *SendWalletCoinsRequest) GetSatPerByteFee() int64 {
+func (m *Payment) GetFiatAmount() float64 {
 	if m != nil {
-		return m.SatPerByteFee
+		return m.FiatAmount
 	}
 	return 0
 }
 
-type PayInvoiceRequest struct {
-	Amount         int64  `protobuf:"varint,1,opt,name=amount" json:"amount,omitempty"`
-	PaymentRequest string `protobuf:"bytes,2,opt,name=paymentRequest" json:"paymentRequest,omitempty"`
+func (m *Payment) GetFiatCurrency() string {
+	if m != nil {
+		return m.FiatCurrency
+	}
+	return ""
 }
 
-func (m *PayInvoiceRequest) Reset()                    { *m = PayInvoiceRequest{} }
-func (m *PayInvoiceRequest) String() string            { return proto.CompactTextString(m) }
-func (*PayInvoiceRequest) ProtoMessage()               {}
-func (*PayInvoiceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (m *Payment) GetFee() int64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
 
-func (m *PayInvoiceRequest) GetAmount() int64 {
+func (m *Payment) GetFeeMsat() int64 {
 	if m != nil {
-		return m.Amount
+		return m.FeeMsat
 	}
 	return 0
 }
 
-func (m *PayInvoiceRequest) GetPaymentRequest() string {
+func (m *Payment) GetFundingSource() *FundingSource {
 	if m != nil {
-		return m.PaymentRequest
+		return m.FundingSource
 	}
-	return ""
+	return nil
 }
 
-type InvoiceMemo struct {
-	Description     string `protobuf:"bytes,1,opt,name=description" json:"description,omitempty"`
-	Amount          int64  `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
-	PayeeName       string `protobuf:"bytes,3,opt,name=payeeName" json:"payeeName,omitempty"`
-	PayeeImageURL   string `protobuf:"bytes,4,opt,name=payeeImageURL" json:"payeeImageURL,omitempty"`
-	PayerName       string `protobuf:"bytes,5,opt,name=payerName" json:"payerName,omitempty"`
-	PayerImageURL   string `protobuf:"bytes,6,opt,name=payerImageURL" json:"payerImageURL,omitempty"`
-	TransferRequest bool   `protobuf:"varint,7,opt,name=transferRequest" json:"transferRequest,omitempty"`
-	Expiry          int64  `protobuf:"varint,8,opt,name=expiry" json:"expiry,omitempty"`
+type FundingSource struct {
+	Provider     string   `protobuf:"bytes,1,opt,name=provider" json:"provider,omitempty"`
+	OrderID      string   `protobuf:"bytes,2,opt,name=orderID" json:"orderID,omitempty"`
+	Counterparty string   `protobuf:"bytes,3,opt,name=counterparty" json:"counterparty,omitempty"`
+	TxIDs        []string `protobuf:"bytes,4,rep,name=txIDs" json:"txIDs,omitempty"`
 }
 
-func (m *InvoiceMemo) Reset()                    { *m = InvoiceMemo{} }
-func (m *InvoiceMemo) String() string            { return proto.CompactTextString(m) }
-func (*InvoiceMemo) ProtoMessage()               {}
-func (*InvoiceMemo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (m *FundingSource) Reset()                    { *m = FundingSource{} }
+func (m *FundingSource) String() string            { return proto.CompactTextString(m) }
+func (*FundingSource) ProtoMessage()               {}
+func (*FundingSource) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
 
-func (m *InvoiceMemo) GetDescription() string {
+func (m *FundingSource) GetProvider() string {
 	if m != nil {
-		return m.Description
+		return m.Provider
 	}
 	return ""
 }
 
-func (m *InvoiceMemo) GetAmount() int64 {
+func (m *FundingSource) GetOrderID() string {
 	if m != nil {
-		return m.Amount
+		return m.OrderID
 	}
-	return 0
+	return ""
 }
 
-func (m *InvoiceMemo) GetPayeeName() string {
+func (m *FundingSource) GetCounterparty() string {
 	if m != nil {
-		return m.PayeeName
+		return m.Counterparty
 	}
 	return ""
 }
 
-func (m *InvoiceMemo) GetPayeeImageURL() string {
+func (m *FundingSource) GetTxIDs() []string {
 	if m != nil {
-		return m.PayeeImageURL
+		return m.TxIDs
 	}
-	return ""
+	return nil
 }
 
-func (m *InvoiceMemo) GetPayerName() string {
-	if m != nil {
-		return m.PayerName
-	}
-	return ""
+type PaymentsList struct {
+	PaymentsList []*Payment `protobuf:"bytes,1,rep,name=paymentsList" json:"paymentsList,omitempty"`
 }
 
-func (m *InvoiceMemo) GetPayerImageURL() string {
+func (m *PaymentsList) Reset()                    { *m = PaymentsList{} }
+func (m *PaymentsList) String() string            { return proto.CompactTextString(m) }
+func (*PaymentsList) ProtoMessage()               {}
+func (*PaymentsList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *PaymentsList) GetPaymentsList() []*Payment {
 	if m != nil {
-		return m.PayerImageURL
+		return m.PaymentsList
 	}
-	return ""
+	return nil
 }
 
-func (m *InvoiceMemo) GetTransferRequest() bool {
+type PaymentsPageRequest struct {
+	Cursor        string                `protobuf:"bytes,1,opt,name=cursor" json:"cursor,omitempty"`
+	Limit         int32                 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+	Types         []Payment_PaymentType `protobuf:"varint,3,rep,packed,name=types,enum=data.Payment_PaymentType" json:"types,omitempty"`
+	FromTimestamp int64                 `protobuf:"varint,4,opt,name=fromTimestamp" json:"fromTimestamp,omitempty"`
+	ToTimestamp   int64                 `protobuf:"varint,5,opt,name=toTimestamp" json:"toTimestamp,omitempty"`
+}
+
+func (m *PaymentsPageRequest) Reset()                    { *m = PaymentsPageRequest{} }
+func (m *PaymentsPageRequest) String() string            { return proto.CompactTextString(m) }
+func (*PaymentsPageRequest) ProtoMessage()               {}
+func (*PaymentsPageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *PaymentsPageRequest) GetCursor() string {
 	if m != nil {
-		return m.TransferRequest
+		return m.Cursor
 	}
-	return false
+	return ""
 }
 
-func (m *InvoiceMemo) GetExpiry() int64 {
+func (m *PaymentsPageRequest) GetLimit() int32 {
 	if m != nil {
-		return m.Expiry
+		return m.Limit
 	}
 	return 0
 }
 
-type Invoice struct {
-	Memo    *InvoiceMemo `protobuf:"bytes,1,opt,name=memo" json:"memo,omitempty"`
-	Settled bool         `protobuf:"varint,2,opt,name=settled" json:"settled,omitempty"`
-	AmtPaid int64        `protobuf:"varint,3,opt,name=amtPaid" json:"amtPaid,omitempty"`
-}
-
-func (m *Invoice) Reset()                    { *m = Invoice{} }
-func (m *Invoice) String() string            { return proto.CompactTextString(m) }
-func (*Invoice) ProtoMessage()               {}
-func (*Invoice) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
-
-func (m *Invoice) GetMemo() *InvoiceMemo {
+func (m *PaymentsPageRequest) GetTypes() []Payment_PaymentType {
 	if m != nil {
-		return m.Memo
+		return m.Types
 	}
 	return nil
 }
 
-func (m *Invoice) GetSettled() bool {
+func (m *PaymentsPageRequest) GetFromTimestamp() int64 {
 	if m != nil {
-		return m.Settled
+		return m.FromTimestamp
 	}
-	return false
+	return 0
 }
 
-func (m *Invoice) GetAmtPaid() int64 {
+func (m *PaymentsPageRequest) GetToTimestamp() int64 {
 	if m != nil {
-		return m.AmtPaid
+		return m.ToTimestamp
 	}
 	return 0
 }
 
-type NotificationEvent struct {
-	Type NotificationEvent_NotificationType `protobuf:"varint,1,opt,name=type,enum=data.NotificationEvent_NotificationType" json:"type,omitempty"`
-	Data []string                           `protobuf:"bytes,2,rep,name=data" json:"data,omitempty"`
+type ExportPaymentsRequest struct {
+	Path          string                `protobuf:"bytes,1,opt,name=path" json:"path,omitempty"`
+	Types         []Payment_PaymentType `protobuf:"varint,2,rep,packed,name=types,enum=data.Payment_PaymentType" json:"types,omitempty"`
+	FromTimestamp int64                 `protobuf:"varint,3,opt,name=fromTimestamp" json:"fromTimestamp,omitempty"`
+	ToTimestamp   int64                 `protobuf:"varint,4,opt,name=toTimestamp" json:"toTimestamp,omitempty"`
+	FiatCurrency  string                `protobuf:"bytes,5,opt,name=fiatCurrency" json:"fiatCurrency,omitempty"`
 }
 
-func (m *NotificationEvent) Reset()                    { *m = NotificationEvent{} }
-func (m *NotificationEvent) String() string            { return proto.CompactTextString(m) }
-func (*NotificationEvent) ProtoMessage()               {}
-func (*NotificationEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (m *ExportPaymentsRequest) Reset()                    { *m = ExportPaymentsRequest{} }
+func (m *ExportPaymentsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExportPaymentsRequest) ProtoMessage()               {}
+func (*ExportPaymentsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
 
-func (m *NotificationEvent) GetType() NotificationEvent_NotificationType {
+func (m *ExportPaymentsRequest) GetPath() string {
 	if m != nil {
-		return m.Type
+		return m.Path
 	}
-	return NotificationEvent_READY
+	return ""
 }
 
-func (m *NotificationEvent) GetData() []string {
+func (m *ExportPaymentsRequest) GetTypes() []Payment_PaymentType {
 	if m != nil {
-		return m.Data
+		return m.Types
 	}
 	return nil
 }
 
-type AddFundInitReply struct {
-	Address           string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
-	MaxAllowedDeposit int64  `protobuf:"varint,2,opt,name=maxAllowedDeposit" json:"maxAllowedDeposit,omitempty"`
-	ErrorMessage      string `protobuf:"bytes,3,opt,name=errorMessage" json:"errorMessage,omitempty"`
-	BackupJson        string `protobuf:"bytes,4,opt,name=backupJson" json:"backupJson,omitempty"`
-}
-
-func (m *AddFundInitReply) Reset()                    { *m = AddFundInitReply{} }
-func (m *AddFundInitReply) String() string            { return proto.CompactTextString(m) }
-func (*AddFundInitReply) ProtoMessage()               {}
-func (*AddFundInitReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
-
-func (m *AddFundInitReply) GetAddress() string {
-	if m != nil {
-		return m.Address
-	}
-	return ""
-}
-
-func (m *AddFundInitReply) GetMaxAllowedDeposit() int64 {
+func (m *ExportPaymentsRequest) GetFromTimestamp() int64 {
 	if m != nil {
-		return m.MaxAllowedDeposit
+		return m.FromTimestamp
 	}
 	return 0
 }
 
-func (m *AddFundInitReply) GetErrorMessage() string {
+func (m *ExportPaymentsRequest) GetToTimestamp() int64 {
 	if m != nil {
-		return m.ErrorMessage
+		return m.ToTimestamp
 	}
-	return ""
+	return 0
 }
 
-func (m *AddFundInitReply) GetBackupJson() string {
+func (m *ExportPaymentsRequest) GetFiatCurrency() string {
 	if m != nil {
-		return m.BackupJson
+		return m.FiatCurrency
 	}
 	return ""
 }
 
-type AddFundReply struct {
-	ErrorMessage string `protobuf:"bytes,1,opt,name=errorMessage" json:"errorMessage,omitempty"`
+type PayeeSpending struct {
+	PayeeName            string `protobuf:"bytes,1,opt,name=payeeName" json:"payeeName,omitempty"`
+	Destination          string `protobuf:"bytes,2,opt,name=destination" json:"destination,omitempty"`
+	Amount               int64  `protobuf:"varint,3,opt,name=amount" json:"amount,omitempty"`
+	Count                int64  `protobuf:"varint,4,opt,name=count" json:"count,omitempty"`
+	LastPaymentTimestamp int64  `protobuf:"varint,5,opt,name=lastPaymentTimestamp" json:"lastPaymentTimestamp,omitempty"`
 }
 
-func (m *AddFundReply) Reset()                    { *m = AddFundReply{} }
-func (m *AddFundReply) String() string            { return proto.CompactTextString(m) }
-func (*AddFundReply) ProtoMessage()               {}
-func (*AddFundReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (m *PayeeSpending) Reset()                    { *m = PayeeSpending{} }
+func (m *PayeeSpending) String() string            { return proto.CompactTextString(m) }
+func (*PayeeSpending) ProtoMessage()               {}
+func (*PayeeSpending) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
-func (m *AddFundReply) GetErrorMessage() string {
+func (m *PayeeSpending) GetPayeeName() string {
 	if m != nil {
-		return m.ErrorMessage
+		return m.PayeeName
 	}
 	return ""
 }
 
-type RefundRequest struct {
-	Address       string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
-	RefundAddress string `protobuf:"bytes,2,opt,name=refundAddress" json:"refundAddress,omitempty"`
-}
-
-func (m *RefundRequest) Reset()                    { *m = RefundRequest{} }
-func (m *RefundRequest) String() string            { return proto.CompactTextString(m) }
-func (*RefundRequest) ProtoMessage()               {}
-func (*RefundRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
-
-func (m *RefundRequest) GetAddress() string {
+func (m *PayeeSpending) GetDestination() string {
 	if m != nil {
-		return m.Address
+		return m.Destination
 	}
 	return ""
 }
 
-func (m *RefundRequest) GetRefundAddress() string {
+func (m *PayeeSpending) GetAmount() int64 {
 	if m != nil {
-		return m.RefundAddress
+		return m.Amount
 	}
-	return ""
+	return 0
 }
 
-type FundStatusReply struct {
-	Status FundStatusReply_FundStatus `protobuf:"varint,1,opt,name=status,enum=data.FundStatusReply_FundStatus" json:"status,omitempty"`
+func (m *PayeeSpending) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
 }
 
-func (m *FundStatusReply) Reset()                    { *m = FundStatusReply{} }
-func (m *FundStatusReply) String() string            { return proto.CompactTextString(m) }
-func (*FundStatusReply) ProtoMessage()               {}
-func (*FundStatusReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
-
-func (m *FundStatusReply) GetStatus() FundStatusReply_FundStatus {
+func (m *PayeeSpending) GetLastPaymentTimestamp() int64 {
 	if m != nil {
-		return m.Status
+		return m.LastPaymentTimestamp
 	}
-	return FundStatusReply_NO_FUND
+	return 0
 }
 
-type RemoveFundRequest struct {
-	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
-	Amount  int64  `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
+type SpendingByPayee struct {
+	Payees []*PayeeSpending `protobuf:"bytes,1,rep,name=payees" json:"payees,omitempty"`
 }
 
-func (m *RemoveFundRequest) Reset()                    { *m = RemoveFundRequest{} }
-func (m *RemoveFundRequest) String() string            { return proto.CompactTextString(m) }
-func (*RemoveFundRequest) ProtoMessage()               {}
-func (*RemoveFundRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
-
-func (m *RemoveFundRequest) GetAddress() string {
-	if m != nil {
-		return m.Address
-	}
-	return ""
-}
+func (m *SpendingByPayee) Reset()                    { *m = SpendingByPayee{} }
+func (m *SpendingByPayee) String() string            { return proto.CompactTextString(m) }
+func (*SpendingByPayee) ProtoMessage()               {}
+func (*SpendingByPayee) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
 
-func (m *RemoveFundRequest) GetAmount() int64 {
+func (m *SpendingByPayee) GetPayees() []*PayeeSpending {
 	if m != nil {
-		return m.Amount
+		return m.Payees
 	}
-	return 0
+	return nil
 }
 
-type RemoveFundReply struct {
-	Txid         string `protobuf:"bytes,1,opt,name=txid" json:"txid,omitempty"`
-	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage" json:"errorMessage,omitempty"`
+type PaymentsPage struct {
+	PaymentsList []*Payment `protobuf:"bytes,1,rep,name=paymentsList" json:"paymentsList,omitempty"`
+	NextCursor   string     `protobuf:"bytes,2,opt,name=nextCursor" json:"nextCursor,omitempty"`
 }
 
-func (m *RemoveFundReply) Reset()                    { *m = RemoveFundReply{} }
-func (m *RemoveFundReply) String() string            { return proto.CompactTextString(m) }
-func (*RemoveFundReply) ProtoMessage()               {}
-func (*RemoveFundReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (m *PaymentsPage) Reset()                    { *m = PaymentsPage{} }
+func (m *PaymentsPage) String() string            { return proto.CompactTextString(m) }
+func (*PaymentsPage) ProtoMessage()               {}
+func (*PaymentsPage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
-func (m *RemoveFundReply) GetTxid() string {
+func (m *PaymentsPage) GetPaymentsList() []*Payment {
 	if m != nil {
-		return m.Txid
+		return m.PaymentsList
 	}
-	return ""
+	return nil
 }
 
-func (m *RemoveFundReply) GetErrorMessage() string {
+func (m *PaymentsPage) GetNextCursor() string {
 	if m != nil {
-		return m.ErrorMessage
+		return m.NextCursor
 	}
 	return ""
 }
 
-type SwapAddressInfo struct {
-	Address                 string   `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
-	PaymentHash             string   `protobuf:"bytes,2,opt,name=PaymentHash" json:"PaymentHash,omitempty"`
-	ConfirmedAmount         int64    `protobuf:"varint,3,opt,name=ConfirmedAmount" json:"ConfirmedAmount,omitempty"`
-	ConfirmedTransactionIds []string `protobuf:"bytes,4,rep,name=ConfirmedTransactionIds" json:"ConfirmedTransactionIds,omitempty"`
-	PaidAmount              int64    `protobuf:"varint,5,opt,name=PaidAmount" json:"PaidAmount,omitempty"`
-	LockHeight              uint32   `protobuf:"varint,6,opt,name=lockHeight" json:"lockHeight,omitempty"`
-	ErrorMessage            string   `protobuf:"bytes,7,opt,name=errorMessage" json:"errorMessage,omitempty"`
-	LastRefundTxID          string   `protobuf:"bytes,8,opt,name=lastRefundTxID" json:"lastRefundTxID,omitempty"`
+type SendWalletCoinsRequest struct {
+	Address       string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	Amount        int64  `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
+	SatPerByteFee int64  `protobuf:"varint,3,opt,name=satPerByteFee" json:"satPerByteFee,omitempty"`
 }
 
-func (m *SwapAddressInfo) Reset()                    { *m = SwapAddressInfo{} }
-func (m *SwapAddressInfo) String() string            { return proto.CompactTextString(m) }
-func (*SwapAddressInfo) ProtoMessage()               {}
-func (*SwapAddressInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (m *SendWalletCoinsRequest) Reset()                    { *m = SendWalletCoinsRequest{} }
+func (m *SendWalletCoinsRequest) String() string            { return proto.CompactTextString(m) }
+func (*SendWalletCoinsRequest) ProtoMessage()               {}
+func (*SendWalletCoinsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
 
-func (m *SwapAddressInfo) GetAddress() string {
+func (m *SendWalletCoinsRequest) GetAddress() string {
 	if m != nil {
 		return m.Address
 	}
 	return ""
 }
 
-func (m *SwapAddressInfo) GetPaymentHash() string {
+func (m *SendWalletCoinsRequest) GetAmount() int64 {
 	if m != nil {
-		return m.PaymentHash
+		return m.Amount
 	}
-	return ""
+	return 0
 }
 
-func (m *SwapAddressInfo) GetConfirmedAmount() int64 {
+func (m *SendWalletCoinsRequest) GetSatPerByteFee() int64 {
 	if m != nil {
-		return m.ConfirmedAmount
+		return m.SatPerByteFee
 	}
 	return 0
 }
 
-func (m *SwapAddressInfo) GetConfirmedTransactionIds() []string {
-	if m != nil {
-		return m.ConfirmedTransactionIds
-	}
-	return nil
+type PayInvoiceRequest struct {
+	Amount         int64     `protobuf:"varint,1,opt,name=amount" json:"amount,omitempty"`
+	PaymentRequest string    `protobuf:"bytes,2,opt,name=paymentRequest" json:"paymentRequest,omitempty"`
+	FeeLimit       *FeeLimit `protobuf:"bytes,3,opt,name=feeLimit" json:"feeLimit,omitempty"`
 }
 
-func (m *SwapAddressInfo) GetPaidAmount() int64 {
-	if m != nil {
-		return m.PaidAmount
-	}
-	return 0
-}
+func (m *PayInvoiceRequest) Reset()                    { *m = PayInvoiceRequest{} }
+func (m *PayInvoiceRequest) String() string            { return proto.CompactTextString(m) }
+func (*PayInvoiceRequest) ProtoMessage()               {}
+func (*PayInvoiceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
-func (m *SwapAddressInfo) GetLockHeight() uint32 {
+func (m *PayInvoiceRequest) GetAmount() int64 {
 	if m != nil {
-		return m.LockHeight
+		return m.Amount
 	}
 	return 0
 }
 
-func (m *SwapAddressInfo) GetErrorMessage() string {
+func (m *PayInvoiceRequest) GetPaymentRequest() string {
 	if m != nil {
-		return m.ErrorMessage
+		return m.PaymentRequest
 	}
 	return ""
 }
 
-func (m *SwapAddressInfo) GetLastRefundTxID() string {
+func (m *PayInvoiceRequest) GetFeeLimit() *FeeLimit {
 	if m != nil {
-		return m.LastRefundTxID
+		return m.FeeLimit
 	}
-	return ""
+	return nil
 }
 
-type SwapAddressList struct {
-	Addresses []*SwapAddressInfo `protobuf:"bytes,1,rep,name=addresses" json:"addresses,omitempty"`
+type FeeLimit struct {
+	Sat     int64   `protobuf:"varint,1,opt,name=sat" json:"sat,omitempty"`
+	Percent float64 `protobuf:"fixed64,2,opt,name=percent" json:"percent,omitempty"`
 }
 
-func (m *SwapAddressList) Reset()                    { *m = SwapAddressList{} }
-func (m *SwapAddressList) String() string            { return proto.CompactTextString(m) }
-func (*SwapAddressList) ProtoMessage()               {}
-func (*SwapAddressList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+func (m *FeeLimit) Reset()                    { *m = FeeLimit{} }
+func (m *FeeLimit) String() string            { return proto.CompactTextString(m) }
+func (*FeeLimit) ProtoMessage()               {}
+func (*FeeLimit) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
-func (m *SwapAddressList) GetAddresses() []*SwapAddressInfo {
+func (m *FeeLimit) GetSat() int64 {
 	if m != nil {
-		return m.Addresses
+		return m.Sat
 	}
-	return nil
+	return 0
 }
 
-type CreateRatchetSessionRequest struct {
-	Secret       string `protobuf:"bytes,1,opt,name=secret" json:"secret,omitempty"`
-	RemotePubKey string `protobuf:"bytes,2,opt,name=remotePubKey" json:"remotePubKey,omitempty"`
-	SessionID    string `protobuf:"bytes,3,opt,name=sessionID" json:"sessionID,omitempty"`
-	Expiry       uint64 `protobuf:"varint,4,opt,name=expiry" json:"expiry,omitempty"`
+func (m *FeeLimit) GetPercent() float64 {
+	if m != nil {
+		return m.Percent
+	}
+	return 0
 }
 
-func (m *CreateRatchetSessionRequest) Reset()                    { *m = CreateRatchetSessionRequest{} }
-func (m *CreateRatchetSessionRequest) String() string            { return proto.CompactTextString(m) }
-func (*CreateRatchetSessionRequest) ProtoMessage()               {}
-func (*CreateRatchetSessionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+type InvoiceMemo struct {
+	Description     string   `protobuf:"bytes,1,opt,name=description" json:"description,omitempty"`
+	Amount          int64    `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
+	PayeeName       string   `protobuf:"bytes,3,opt,name=payeeName" json:"payeeName,omitempty"`
+	PayeeImageURL   string   `protobuf:"bytes,4,opt,name=payeeImageURL" json:"payeeImageURL,omitempty"`
+	PayerName       string   `protobuf:"bytes,5,opt,name=payerName" json:"payerName,omitempty"`
+	PayerImageURL   string   `protobuf:"bytes,6,opt,name=payerImageURL" json:"payerImageURL,omitempty"`
+	TransferRequest bool     `protobuf:"varint,7,opt,name=transferRequest" json:"transferRequest,omitempty"`
+	Expiry          int64    `protobuf:"varint,8,opt,name=expiry" json:"expiry,omitempty"`
+	PayeeSignature  string   `protobuf:"bytes,9,opt,name=payeeSignature" json:"payeeSignature,omitempty"`
+	Verified        bool     `protobuf:"varint,10,opt,name=verified" json:"verified,omitempty"`
+	PayerComment    string   `protobuf:"bytes,11,opt,name=payerComment" json:"payerComment,omitempty"`
+	Tax             *TaxInfo `protobuf:"bytes,12,opt,name=tax" json:"tax,omitempty"`
+	DeviceName      string   `protobuf:"bytes,13,opt,name=deviceName" json:"deviceName,omitempty"`
+	DeviceProof     string   `protobuf:"bytes,14,opt,name=deviceProof" json:"deviceProof,omitempty"`
+	OwnDevice       bool     `protobuf:"varint,15,opt,name=ownDevice" json:"ownDevice,omitempty"`
+}
 
-func (m *CreateRatchetSessionRequest) GetSecret() string {
+func (m *InvoiceMemo) Reset()                    { *m = InvoiceMemo{} }
+func (m *InvoiceMemo) String() string            { return proto.CompactTextString(m) }
+func (*InvoiceMemo) ProtoMessage()               {}
+func (*InvoiceMemo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *InvoiceMemo) GetDescription() string {
 	if m != nil {
-		return m.Secret
+		return m.Description
 	}
 	return ""
 }
 
-func (m *CreateRatchetSessionRequest) GetRemotePubKey() string {
+func (m *InvoiceMemo) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *InvoiceMemo) GetPayeeName() string {
+	if m != nil {
+		return m.PayeeName
+	}
+	return ""
+}
+
+func (m *InvoiceMemo) GetPayeeImageURL() string {
+	if m != nil {
+		return m.PayeeImageURL
+	}
+	return ""
+}
+
+func (m *InvoiceMemo) GetPayerName() string {
+	if m != nil {
+		return m.PayerName
+	}
+	return ""
+}
+
+func (m *InvoiceMemo) GetPayerImageURL() string {
+	if m != nil {
+		return m.PayerImageURL
+	}
+	return ""
+}
+
+func (m *InvoiceMemo) GetTransferRequest() bool {
+	if m != nil {
+		return m.TransferRequest
+	}
+	return false
+}
+
+func (m *InvoiceMemo) GetExpiry() int64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *InvoiceMemo) GetPayeeSignature() string {
+	if m != nil {
+		return m.PayeeSignature
+	}
+	return ""
+}
+
+func (m *InvoiceMemo) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+func (m *InvoiceMemo) GetPayerComment() string {
+	if m != nil {
+		return m.PayerComment
+	}
+	return ""
+}
+
+func (m *InvoiceMemo) GetTax() *TaxInfo {
+	if m != nil {
+		return m.Tax
+	}
+	return nil
+}
+
+func (m *InvoiceMemo) GetDeviceName() string {
+	if m != nil {
+		return m.DeviceName
+	}
+	return ""
+}
+
+func (m *InvoiceMemo) GetDeviceProof() string {
+	if m != nil {
+		return m.DeviceProof
+	}
+	return ""
+}
+
+func (m *InvoiceMemo) GetOwnDevice() bool {
+	if m != nil {
+		return m.OwnDevice
+	}
+	return false
+}
+
+type TaxInfo struct {
+	VatRate       float64 `protobuf:"fixed64,1,opt,name=vatRate" json:"vatRate,omitempty"`
+	TaxAmount     int64   `protobuf:"varint,2,opt,name=taxAmount" json:"taxAmount,omitempty"`
+	MerchantTaxID string  `protobuf:"bytes,3,opt,name=merchantTaxID" json:"merchantTaxID,omitempty"`
+}
+
+func (m *TaxInfo) Reset()                    { *m = TaxInfo{} }
+func (m *TaxInfo) String() string            { return proto.CompactTextString(m) }
+func (*TaxInfo) ProtoMessage()               {}
+func (*TaxInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *TaxInfo) GetVatRate() float64 {
+	if m != nil {
+		return m.VatRate
+	}
+	return 0
+}
+
+func (m *TaxInfo) GetTaxAmount() int64 {
+	if m != nil {
+		return m.TaxAmount
+	}
+	return 0
+}
+
+func (m *TaxInfo) GetMerchantTaxID() string {
+	if m != nil {
+		return m.MerchantTaxID
+	}
+	return ""
+}
+
+type AddInvoiceRequest struct {
+	InvoiceMemo     *InvoiceMemo `protobuf:"bytes,1,opt,name=invoiceMemo" json:"invoiceMemo,omitempty"`
+	Preimage        string       `protobuf:"bytes,2,opt,name=preimage" json:"preimage,omitempty"`
+	FallbackAddress bool         `protobuf:"varint,3,opt,name=fallbackAddress" json:"fallbackAddress,omitempty"`
+}
+
+func (m *AddInvoiceRequest) Reset()                    { *m = AddInvoiceRequest{} }
+func (m *AddInvoiceRequest) String() string            { return proto.CompactTextString(m) }
+func (*AddInvoiceRequest) ProtoMessage()               {}
+func (*AddInvoiceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *AddInvoiceRequest) GetInvoiceMemo() *InvoiceMemo {
+	if m != nil {
+		return m.InvoiceMemo
+	}
+	return nil
+}
+
+func (m *AddInvoiceRequest) GetPreimage() string {
+	if m != nil {
+		return m.Preimage
+	}
+	return ""
+}
+
+func (m *AddInvoiceRequest) GetFallbackAddress() bool {
+	if m != nil {
+		return m.FallbackAddress
+	}
+	return false
+}
+
+type AddInvoiceReply struct {
+	PaymentRequest  string `protobuf:"bytes,1,opt,name=paymentRequest" json:"paymentRequest,omitempty"`
+	PaymentHash     string `protobuf:"bytes,2,opt,name=paymentHash" json:"paymentHash,omitempty"`
+	Preimage        string `protobuf:"bytes,3,opt,name=preimage" json:"preimage,omitempty"`
+	FallbackAddress string `protobuf:"bytes,4,opt,name=fallbackAddress" json:"fallbackAddress,omitempty"`
+}
+
+func (m *AddInvoiceReply) Reset()                    { *m = AddInvoiceReply{} }
+func (m *AddInvoiceReply) String() string            { return proto.CompactTextString(m) }
+func (*AddInvoiceReply) ProtoMessage()               {}
+func (*AddInvoiceReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+
+func (m *AddInvoiceReply) GetPaymentRequest() string {
+	if m != nil {
+		return m.PaymentRequest
+	}
+	return ""
+}
+
+func (m *AddInvoiceReply) GetPaymentHash() string {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return ""
+}
+
+func (m *AddInvoiceReply) GetPreimage() string {
+	if m != nil {
+		return m.Preimage
+	}
+	return ""
+}
+
+func (m *AddInvoiceReply) GetFallbackAddress() string {
+	if m != nil {
+		return m.FallbackAddress
+	}
+	return ""
+}
+
+type Invoice struct {
+	Memo    *InvoiceMemo `protobuf:"bytes,1,opt,name=memo" json:"memo,omitempty"`
+	Settled bool         `protobuf:"varint,2,opt,name=settled" json:"settled,omitempty"`
+	AmtPaid int64        `protobuf:"varint,3,opt,name=amtPaid" json:"amtPaid,omitempty"`
+}
+
+func (m *Invoice) Reset()                    { *m = Invoice{} }
+func (m *Invoice) String() string            { return proto.CompactTextString(m) }
+func (*Invoice) ProtoMessage()               {}
+func (*Invoice) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+
+func (m *Invoice) GetMemo() *InvoiceMemo {
+	if m != nil {
+		return m.Memo
+	}
+	return nil
+}
+
+func (m *Invoice) GetSettled() bool {
+	if m != nil {
+		return m.Settled
+	}
+	return false
+}
+
+func (m *Invoice) GetAmtPaid() int64 {
+	if m != nil {
+		return m.AmtPaid
+	}
+	return 0
+}
+
+type NotificationEvent struct {
+	Type NotificationEvent_NotificationType `protobuf:"varint,1,opt,name=type,enum=data.NotificationEvent_NotificationType" json:"type,omitempty"`
+	Data []string                           `protobuf:"bytes,2,rep,name=data" json:"data,omitempty"`
+	Id   uint64                             `protobuf:"varint,3,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *NotificationEvent) Reset()                    { *m = NotificationEvent{} }
+func (m *NotificationEvent) String() string            { return proto.CompactTextString(m) }
+func (*NotificationEvent) ProtoMessage()               {}
+func (*NotificationEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+func (m *NotificationEvent) GetType() NotificationEvent_NotificationType {
+	if m != nil {
+		return m.Type
+	}
+	return NotificationEvent_READY
+}
+
+func (m *NotificationEvent) GetData() []string {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *NotificationEvent) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type AddFundInitReply struct {
+	Address           string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	MaxAllowedDeposit int64  `protobuf:"varint,2,opt,name=maxAllowedDeposit" json:"maxAllowedDeposit,omitempty"`
+	ErrorMessage      string `protobuf:"bytes,3,opt,name=errorMessage" json:"errorMessage,omitempty"`
+	BackupJson        string `protobuf:"bytes,4,opt,name=backupJson" json:"backupJson,omitempty"`
+}
+
+func (m *AddFundInitReply) Reset()                    { *m = AddFundInitReply{} }
+func (m *AddFundInitReply) String() string            { return proto.CompactTextString(m) }
+func (*AddFundInitReply) ProtoMessage()               {}
+func (*AddFundInitReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+func (m *AddFundInitReply) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AddFundInitReply) GetMaxAllowedDeposit() int64 {
+	if m != nil {
+		return m.MaxAllowedDeposit
+	}
+	return 0
+}
+
+func (m *AddFundInitReply) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func (m *AddFundInitReply) GetBackupJson() string {
+	if m != nil {
+		return m.BackupJson
+	}
+	return ""
+}
+
+type AddFundReply struct {
+	ErrorMessage string `protobuf:"bytes,1,opt,name=errorMessage" json:"errorMessage,omitempty"`
+}
+
+func (m *AddFundReply) Reset()                    { *m = AddFundReply{} }
+func (m *AddFundReply) String() string            { return proto.CompactTextString(m) }
+func (*AddFundReply) ProtoMessage()               {}
+func (*AddFundReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+
+func (m *AddFundReply) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+type RefundRequest struct {
+	Address       string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	RefundAddress string `protobuf:"bytes,2,opt,name=refundAddress" json:"refundAddress,omitempty"`
+}
+
+func (m *RefundRequest) Reset()                    { *m = RefundRequest{} }
+func (m *RefundRequest) String() string            { return proto.CompactTextString(m) }
+func (*RefundRequest) ProtoMessage()               {}
+func (*RefundRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+
+func (m *RefundRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RefundRequest) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
+}
+
+type FundStatusReply struct {
+	Status FundStatusReply_FundStatus `protobuf:"varint,1,opt,name=status,enum=data.FundStatusReply_FundStatus" json:"status,omitempty"`
+}
+
+func (m *FundStatusReply) Reset()                    { *m = FundStatusReply{} }
+func (m *FundStatusReply) String() string            { return proto.CompactTextString(m) }
+func (*FundStatusReply) ProtoMessage()               {}
+func (*FundStatusReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+
+func (m *FundStatusReply) GetStatus() FundStatusReply_FundStatus {
+	if m != nil {
+		return m.Status
+	}
+	return FundStatusReply_NO_FUND
+}
+
+type RemoveFundRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	Amount  int64  `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
+}
+
+func (m *RemoveFundRequest) Reset()                    { *m = RemoveFundRequest{} }
+func (m *RemoveFundRequest) String() string            { return proto.CompactTextString(m) }
+func (*RemoveFundRequest) ProtoMessage()               {}
+func (*RemoveFundRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+
+func (m *RemoveFundRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RemoveFundRequest) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type RemoveFundReply struct {
+	Txid         string `protobuf:"bytes,1,opt,name=txid" json:"txid,omitempty"`
+	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage" json:"errorMessage,omitempty"`
+}
+
+func (m *RemoveFundReply) Reset()                    { *m = RemoveFundReply{} }
+func (m *RemoveFundReply) String() string            { return proto.CompactTextString(m) }
+func (*RemoveFundReply) ProtoMessage()               {}
+func (*RemoveFundReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+
+func (m *RemoveFundReply) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+func (m *RemoveFundReply) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+type SwapAddressInfo struct {
+	Address                 string   `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	PaymentHash             string   `protobuf:"bytes,2,opt,name=PaymentHash" json:"PaymentHash,omitempty"`
+	ConfirmedAmount         int64    `protobuf:"varint,3,opt,name=ConfirmedAmount" json:"ConfirmedAmount,omitempty"`
+	ConfirmedTransactionIds []string `protobuf:"bytes,4,rep,name=ConfirmedTransactionIds" json:"ConfirmedTransactionIds,omitempty"`
+	PaidAmount              int64    `protobuf:"varint,5,opt,name=PaidAmount" json:"PaidAmount,omitempty"`
+	LockHeight              uint32   `protobuf:"varint,6,opt,name=lockHeight" json:"lockHeight,omitempty"`
+	ErrorMessage            string   `protobuf:"bytes,7,opt,name=errorMessage" json:"errorMessage,omitempty"`
+	LastRefundTxID          string   `protobuf:"bytes,8,opt,name=lastRefundTxID" json:"lastRefundTxID,omitempty"`
+	Source                  string   `protobuf:"bytes,9,opt,name=source" json:"source,omitempty"`
+}
+
+func (m *SwapAddressInfo) Reset()                    { *m = SwapAddressInfo{} }
+func (m *SwapAddressInfo) String() string            { return proto.CompactTextString(m) }
+func (*SwapAddressInfo) ProtoMessage()               {}
+func (*SwapAddressInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+
+func (m *SwapAddressInfo) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SwapAddressInfo) GetPaymentHash() string {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return ""
+}
+
+func (m *SwapAddressInfo) GetConfirmedAmount() int64 {
+	if m != nil {
+		return m.ConfirmedAmount
+	}
+	return 0
+}
+
+func (m *SwapAddressInfo) GetConfirmedTransactionIds() []string {
+	if m != nil {
+		return m.ConfirmedTransactionIds
+	}
+	return nil
+}
+
+func (m *SwapAddressInfo) GetPaidAmount() int64 {
+	if m != nil {
+		return m.PaidAmount
+	}
+	return 0
+}
+
+func (m *SwapAddressInfo) GetLockHeight() uint32 {
+	if m != nil {
+		return m.LockHeight
+	}
+	return 0
+}
+
+func (m *SwapAddressInfo) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func (m *SwapAddressInfo) GetLastRefundTxID() string {
+	if m != nil {
+		return m.LastRefundTxID
+	}
+	return ""
+}
+
+func (m *SwapAddressInfo) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+type SwapAddressList struct {
+	Addresses []*SwapAddressInfo `protobuf:"bytes,1,rep,name=addresses" json:"addresses,omitempty"`
+}
+
+func (m *SwapAddressList) Reset()                    { *m = SwapAddressList{} }
+func (m *SwapAddressList) String() string            { return proto.CompactTextString(m) }
+func (*SwapAddressList) ProtoMessage()               {}
+func (*SwapAddressList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+
+func (m *SwapAddressList) GetAddresses() []*SwapAddressInfo {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+type SwapLimits struct {
+	MinSwapIn  int64 `protobuf:"varint,1,opt,name=minSwapIn" json:"minSwapIn,omitempty"`
+	MaxSwapIn  int64 `protobuf:"varint,2,opt,name=maxSwapIn" json:"maxSwapIn,omitempty"`
+	MinSwapOut int64 `protobuf:"varint,3,opt,name=minSwapOut" json:"minSwapOut,omitempty"`
+	MaxSwapOut int64 `protobuf:"varint,4,opt,name=maxSwapOut" json:"maxSwapOut,omitempty"`
+}
+
+func (m *SwapLimits) Reset()                    { *m = SwapLimits{} }
+func (m *SwapLimits) String() string            { return proto.CompactTextString(m) }
+func (*SwapLimits) ProtoMessage()               {}
+func (*SwapLimits) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+
+func (m *SwapLimits) GetMinSwapIn() int64 {
+	if m != nil {
+		return m.MinSwapIn
+	}
+	return 0
+}
+
+func (m *SwapLimits) GetMaxSwapIn() int64 {
+	if m != nil {
+		return m.MaxSwapIn
+	}
+	return 0
+}
+
+func (m *SwapLimits) GetMinSwapOut() int64 {
+	if m != nil {
+		return m.MinSwapOut
+	}
+	return 0
+}
+
+func (m *SwapLimits) GetMaxSwapOut() int64 {
+	if m != nil {
+		return m.MaxSwapOut
+	}
+	return 0
+}
+
+type CreateRatchetSessionRequest struct {
+	Secret       string `protobuf:"bytes,1,opt,name=secret" json:"secret,omitempty"`
+	RemotePubKey string `protobuf:"bytes,2,opt,name=remotePubKey" json:"remotePubKey,omitempty"`
+	SessionID    string `protobuf:"bytes,3,opt,name=sessionID" json:"sessionID,omitempty"`
+	Expiry       uint64 `protobuf:"varint,4,opt,name=expiry" json:"expiry,omitempty"`
+}
+
+func (m *CreateRatchetSessionRequest) Reset()                    { *m = CreateRatchetSessionRequest{} }
+func (m *CreateRatchetSessionRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateRatchetSessionRequest) ProtoMessage()               {}
+func (*CreateRatchetSessionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+func (m *CreateRatchetSessionRequest) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *CreateRatchetSessionRequest) GetRemotePubKey() string {
 	if m != nil {
 		return m.RemotePubKey
 	}
 	return ""
 }
 
-func (m *CreateRatchetSessionRequest) GetSessionID() string {
+func (m *CreateRatchetSessionRequest) GetSessionID() string {
+	if m != nil {
+		return m.SessionID
+	}
+	return ""
+}
+
+func (m *CreateRatchetSessionRequest) GetExpiry() uint64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+type CreateRatchetSessionReply struct {
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID" json:"sessionID,omitempty"`
+	Secret    string `protobuf:"bytes,2,opt,name=secret" json:"secret,omitempty"`
+	PubKey    string `protobuf:"bytes,3,opt,name=pubKey" json:"pubKey,omitempty"`
+}
+
+func (m *CreateRatchetSessionReply) Reset()                    { *m = CreateRatchetSessionReply{} }
+func (m *CreateRatchetSessionReply) String() string            { return proto.CompactTextString(m) }
+func (*CreateRatchetSessionReply) ProtoMessage()               {}
+func (*CreateRatchetSessionReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+func (m *CreateRatchetSessionReply) GetSessionID() string {
+	if m != nil {
+		return m.SessionID
+	}
+	return ""
+}
+
+func (m *CreateRatchetSessionReply) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *CreateRatchetSessionReply) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+type RatchetSessionInfoReply struct {
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID" json:"sessionID,omitempty"`
+	Initiated bool   `protobuf:"varint,2,opt,name=initiated" json:"initiated,omitempty"`
+	UserInfo  string `protobuf:"bytes,3,opt,name=userInfo" json:"userInfo,omitempty"`
+}
+
+func (m *RatchetSessionInfoReply) Reset()                    { *m = RatchetSessionInfoReply{} }
+func (m *RatchetSessionInfoReply) String() string            { return proto.CompactTextString(m) }
+func (*RatchetSessionInfoReply) ProtoMessage()               {}
+func (*RatchetSessionInfoReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *RatchetSessionInfoReply) GetSessionID() string {
+	if m != nil {
+		return m.SessionID
+	}
+	return ""
+}
+
+func (m *RatchetSessionInfoReply) GetInitiated() bool {
+	if m != nil {
+		return m.Initiated
+	}
+	return false
+}
+
+func (m *RatchetSessionInfoReply) GetUserInfo() string {
+	if m != nil {
+		return m.UserInfo
+	}
+	return ""
+}
+
+type RatchetSessionSetInfoRequest struct {
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID" json:"sessionID,omitempty"`
+	UserInfo  string `protobuf:"bytes,2,opt,name=userInfo" json:"userInfo,omitempty"`
+}
+
+func (m *RatchetSessionSetInfoRequest) Reset()                    { *m = RatchetSessionSetInfoRequest{} }
+func (m *RatchetSessionSetInfoRequest) String() string            { return proto.CompactTextString(m) }
+func (*RatchetSessionSetInfoRequest) ProtoMessage()               {}
+func (*RatchetSessionSetInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *RatchetSessionSetInfoRequest) GetSessionID() string {
+	if m != nil {
+		return m.SessionID
+	}
+	return ""
+}
+
+func (m *RatchetSessionSetInfoRequest) GetUserInfo() string {
+	if m != nil {
+		return m.UserInfo
+	}
+	return ""
+}
+
+type RatchetEncryptRequest struct {
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID" json:"sessionID,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *RatchetEncryptRequest) Reset()                    { *m = RatchetEncryptRequest{} }
+func (m *RatchetEncryptRequest) String() string            { return proto.CompactTextString(m) }
+func (*RatchetEncryptRequest) ProtoMessage()               {}
+func (*RatchetEncryptRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+func (m *RatchetEncryptRequest) GetSessionID() string {
+	if m != nil {
+		return m.SessionID
+	}
+	return ""
+}
+
+func (m *RatchetEncryptRequest) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type RatchetDecryptRequest struct {
+	SessionID        string `protobuf:"bytes,1,opt,name=sessionID" json:"sessionID,omitempty"`
+	EncryptedMessage string `protobuf:"bytes,2,opt,name=encryptedMessage" json:"encryptedMessage,omitempty"`
+}
+
+func (m *RatchetDecryptRequest) Reset()                    { *m = RatchetDecryptRequest{} }
+func (m *RatchetDecryptRequest) String() string            { return proto.CompactTextString(m) }
+func (*RatchetDecryptRequest) ProtoMessage()               {}
+func (*RatchetDecryptRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+func (m *RatchetDecryptRequest) GetSessionID() string {
+	if m != nil {
+		return m.SessionID
+	}
+	return ""
+}
+
+func (m *RatchetDecryptRequest) GetEncryptedMessage() string {
+	if m != nil {
+		return m.EncryptedMessage
+	}
+	return ""
+}
+
+type BootstrapFilesRequest struct {
+	WorkingDir string   `protobuf:"bytes,1,opt,name=WorkingDir" json:"WorkingDir,omitempty"`
+	FullPaths  []string `protobuf:"bytes,2,rep,name=FullPaths" json:"FullPaths,omitempty"`
+}
+
+func (m *BootstrapFilesRequest) Reset()                    { *m = BootstrapFilesRequest{} }
+func (m *BootstrapFilesRequest) String() string            { return proto.CompactTextString(m) }
+func (*BootstrapFilesRequest) ProtoMessage()               {}
+func (*BootstrapFilesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *BootstrapFilesRequest) GetWorkingDir() string {
+	if m != nil {
+		return m.WorkingDir
+	}
+	return ""
+}
+
+func (m *BootstrapFilesRequest) GetFullPaths() []string {
+	if m != nil {
+		return m.FullPaths
+	}
+	return nil
+}
+
+type SavingsInfo struct {
+	Amount          int64 `protobuf:"varint,1,opt,name=amount" json:"amount,omitempty"`
+	UnlockTimestamp int64 `protobuf:"varint,2,opt,name=unlockTimestamp" json:"unlockTimestamp,omitempty"`
+	UnlockAmount    int64 `protobuf:"varint,3,opt,name=unlockAmount" json:"unlockAmount,omitempty"`
+}
+
+func (m *SavingsInfo) Reset()                    { *m = SavingsInfo{} }
+func (m *SavingsInfo) String() string            { return proto.CompactTextString(m) }
+func (*SavingsInfo) ProtoMessage()               {}
+func (*SavingsInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
+func (m *SavingsInfo) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *SavingsInfo) GetUnlockTimestamp() int64 {
+	if m != nil {
+		return m.UnlockTimestamp
+	}
+	return 0
+}
+
+func (m *SavingsInfo) GetUnlockAmount() int64 {
+	if m != nil {
+		return m.UnlockAmount
+	}
+	return 0
+}
+
+type MoveFundsOperation struct {
+	Id           uint64                       `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Direction    MoveFundsOperation_Direction `protobuf:"varint,2,opt,name=direction,enum=data.MoveFundsOperation_Direction" json:"direction,omitempty"`
+	Amount       int64                        `protobuf:"varint,3,opt,name=amount" json:"amount,omitempty"`
+	Mechanism    string                       `protobuf:"bytes,4,opt,name=mechanism" json:"mechanism,omitempty"`
+	Address      string                       `protobuf:"bytes,5,opt,name=address" json:"address,omitempty"`
+	Txid         string                       `protobuf:"bytes,6,opt,name=txid" json:"txid,omitempty"`
+	Status       MoveFundsOperation_Status    `protobuf:"varint,7,opt,name=status,enum=data.MoveFundsOperation_Status" json:"status,omitempty"`
+	ErrorMessage string                       `protobuf:"bytes,8,opt,name=errorMessage" json:"errorMessage,omitempty"`
+	Timestamp    int64                        `protobuf:"varint,9,opt,name=timestamp" json:"timestamp,omitempty"`
+	Fee          int64                        `protobuf:"varint,10,opt,name=fee" json:"fee,omitempty"`
+}
+
+func (m *MoveFundsOperation) Reset()                    { *m = MoveFundsOperation{} }
+func (m *MoveFundsOperation) String() string            { return proto.CompactTextString(m) }
+func (*MoveFundsOperation) ProtoMessage()               {}
+func (*MoveFundsOperation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+func (m *MoveFundsOperation) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MoveFundsOperation) GetDirection() MoveFundsOperation_Direction {
+	if m != nil {
+		return m.Direction
+	}
+	return MoveFundsOperation_TO_LIGHTNING
+}
+
+func (m *MoveFundsOperation) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *MoveFundsOperation) GetMechanism() string {
+	if m != nil {
+		return m.Mechanism
+	}
+	return ""
+}
+
+func (m *MoveFundsOperation) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MoveFundsOperation) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+func (m *MoveFundsOperation) GetStatus() MoveFundsOperation_Status {
+	if m != nil {
+		return m.Status
+	}
+	return MoveFundsOperation_PENDING
+}
+
+func (m *MoveFundsOperation) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func (m *MoveFundsOperation) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *MoveFundsOperation) GetFee() int64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+type MoveFundsOperationsList struct {
+	Operations []*MoveFundsOperation `protobuf:"bytes,1,rep,name=operations" json:"operations,omitempty"`
+}
+
+func (m *MoveFundsOperationsList) Reset()                    { *m = MoveFundsOperationsList{} }
+func (m *MoveFundsOperationsList) String() string            { return proto.CompactTextString(m) }
+func (*MoveFundsOperationsList) ProtoMessage()               {}
+func (*MoveFundsOperationsList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+
+func (m *MoveFundsOperationsList) GetOperations() []*MoveFundsOperation {
+	if m != nil {
+		return m.Operations
+	}
+	return nil
+}
+
+type CloseFeeOption struct {
+	Strategy     CloseFeeStrategy `protobuf:"varint,1,opt,name=strategy,enum=data.CloseFeeStrategy" json:"strategy,omitempty"`
+	SatPerByte   int64            `protobuf:"varint,2,opt,name=satPerByte" json:"satPerByte,omitempty"`
+	EstimatedFee int64            `protobuf:"varint,3,opt,name=estimatedFee" json:"estimatedFee,omitempty"`
+}
+
+func (m *CloseFeeOption) Reset()                    { *m = CloseFeeOption{} }
+func (m *CloseFeeOption) String() string            { return proto.CompactTextString(m) }
+func (*CloseFeeOption) ProtoMessage()               {}
+func (*CloseFeeOption) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+
+func (m *CloseFeeOption) GetStrategy() CloseFeeStrategy {
+	if m != nil {
+		return m.Strategy
+	}
+	return CloseFeeStrategy_NORMAL
+}
+
+func (m *CloseFeeOption) GetSatPerByte() int64 {
+	if m != nil {
+		return m.SatPerByte
+	}
+	return 0
+}
+
+func (m *CloseFeeOption) GetEstimatedFee() int64 {
+	if m != nil {
+		return m.EstimatedFee
+	}
+	return 0
+}
+
+type CloseFeeOptions struct {
+	Options []*CloseFeeOption `protobuf:"bytes,1,rep,name=options" json:"options,omitempty"`
+}
+
+func (m *CloseFeeOptions) Reset()                    { *m = CloseFeeOptions{} }
+func (m *CloseFeeOptions) String() string            { return proto.CompactTextString(m) }
+func (*CloseFeeOptions) ProtoMessage()               {}
+func (*CloseFeeOptions) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+
+func (m *CloseFeeOptions) GetOptions() []*CloseFeeOption {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type CloseChannelRequest struct {
+	ChannelPoint string           `protobuf:"bytes,1,opt,name=channelPoint" json:"channelPoint,omitempty"`
+	Strategy     CloseFeeStrategy `protobuf:"varint,2,opt,name=strategy,enum=data.CloseFeeStrategy" json:"strategy,omitempty"`
+	SatPerByte   int64            `protobuf:"varint,3,opt,name=satPerByte" json:"satPerByte,omitempty"`
+}
+
+func (m *CloseChannelRequest) Reset()                    { *m = CloseChannelRequest{} }
+func (m *CloseChannelRequest) String() string            { return proto.CompactTextString(m) }
+func (*CloseChannelRequest) ProtoMessage()               {}
+func (*CloseChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+
+func (m *CloseChannelRequest) GetChannelPoint() string {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return ""
+}
+
+func (m *CloseChannelRequest) GetStrategy() CloseFeeStrategy {
+	if m != nil {
+		return m.Strategy
+	}
+	return CloseFeeStrategy_NORMAL
+}
+
+func (m *CloseChannelRequest) GetSatPerByte() int64 {
+	if m != nil {
+		return m.SatPerByte
+	}
+	return 0
+}
+
+type ObserverCredential struct {
+	NodeID string `protobuf:"bytes,1,opt,name=nodeID" json:"nodeID,omitempty"`
+	Key    []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (m *ObserverCredential) Reset()                    { *m = ObserverCredential{} }
+func (m *ObserverCredential) String() string            { return proto.CompactTextString(m) }
+func (*ObserverCredential) ProtoMessage()               {}
+func (*ObserverCredential) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+
+func (m *ObserverCredential) GetNodeID() string {
+	if m != nil {
+		return m.NodeID
+	}
+	return ""
+}
+
+func (m *ObserverCredential) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+type ObserverSnapshot struct {
+	Account   *Account      `protobuf:"bytes,1,opt,name=account" json:"account,omitempty"`
+	Payments  *PaymentsList `protobuf:"bytes,2,opt,name=payments" json:"payments,omitempty"`
+	Timestamp int64         `protobuf:"varint,3,opt,name=timestamp" json:"timestamp,omitempty"`
+}
+
+func (m *ObserverSnapshot) Reset()                    { *m = ObserverSnapshot{} }
+func (m *ObserverSnapshot) String() string            { return proto.CompactTextString(m) }
+func (*ObserverSnapshot) ProtoMessage()               {}
+func (*ObserverSnapshot) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+
+func (m *ObserverSnapshot) GetAccount() *Account {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *ObserverSnapshot) GetPayments() *PaymentsList {
+	if m != nil {
+		return m.Payments
+	}
+	return nil
+}
+
+func (m *ObserverSnapshot) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type QuarantinedPayment struct {
+	Id      uint64   `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Payment *Payment `protobuf:"bytes,2,opt,name=payment" json:"payment,omitempty"`
+	Reason  string   `protobuf:"bytes,3,opt,name=reason" json:"reason,omitempty"`
+}
+
+func (m *QuarantinedPayment) Reset()                    { *m = QuarantinedPayment{} }
+func (m *QuarantinedPayment) String() string            { return proto.CompactTextString(m) }
+func (*QuarantinedPayment) ProtoMessage()               {}
+func (*QuarantinedPayment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+
+func (m *QuarantinedPayment) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QuarantinedPayment) GetPayment() *Payment {
+	if m != nil {
+		return m.Payment
+	}
+	return nil
+}
+
+func (m *QuarantinedPayment) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type QuarantinedPaymentsList struct {
+	Payments []*QuarantinedPayment `protobuf:"bytes,1,rep,name=payments" json:"payments,omitempty"`
+}
+
+func (m *QuarantinedPaymentsList) Reset()                    { *m = QuarantinedPaymentsList{} }
+func (m *QuarantinedPaymentsList) String() string            { return proto.CompactTextString(m) }
+func (*QuarantinedPaymentsList) ProtoMessage()               {}
+func (*QuarantinedPaymentsList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+
+func (m *QuarantinedPaymentsList) GetPayments() []*QuarantinedPayment {
+	if m != nil {
+		return m.Payments
+	}
+	return nil
+}
+
+type ResolveQuarantinedPaymentRequest struct {
+	Id         uint64               `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Resolution QuarantineResolution `protobuf:"varint,2,opt,name=resolution,enum=data.QuarantineResolution" json:"resolution,omitempty"`
+}
+
+func (m *ResolveQuarantinedPaymentRequest) Reset()         { *m = ResolveQuarantinedPaymentRequest{} }
+func (m *ResolveQuarantinedPaymentRequest) String() string { return proto.CompactTextString(m) }
+func (*ResolveQuarantinedPaymentRequest) ProtoMessage()    {}
+func (*ResolveQuarantinedPaymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{45}
+}
+
+func (m *ResolveQuarantinedPaymentRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ResolveQuarantinedPaymentRequest) GetResolution() QuarantineResolution {
+	if m != nil {
+		return m.Resolution
+	}
+	return QuarantineResolution_DISCARD
+}
+
+type PairingPermissions struct {
+	Read          bool `protobuf:"varint,1,opt,name=read" json:"read,omitempty"`
+	CreateInvoice bool `protobuf:"varint,2,opt,name=createInvoice" json:"createInvoice,omitempty"`
+	Backup        bool `protobuf:"varint,3,opt,name=backup" json:"backup,omitempty"`
+}
+
+func (m *PairingPermissions) Reset()                    { *m = PairingPermissions{} }
+func (m *PairingPermissions) String() string            { return proto.CompactTextString(m) }
+func (*PairingPermissions) ProtoMessage()               {}
+func (*PairingPermissions) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+
+func (m *PairingPermissions) GetRead() bool {
+	if m != nil {
+		return m.Read
+	}
+	return false
+}
+
+func (m *PairingPermissions) GetCreateInvoice() bool {
+	if m != nil {
+		return m.CreateInvoice
+	}
+	return false
+}
+
+func (m *PairingPermissions) GetBackup() bool {
+	if m != nil {
+		return m.Backup
+	}
+	return false
+}
+
+type PairingSession struct {
+	SessionID        string              `protobuf:"bytes,1,opt,name=sessionID" json:"sessionID,omitempty"`
+	Permissions      *PairingPermissions `protobuf:"bytes,2,opt,name=permissions" json:"permissions,omitempty"`
+	CreatedTimestamp int64               `protobuf:"varint,3,opt,name=createdTimestamp" json:"createdTimestamp,omitempty"`
+	ExpiryTimestamp  int64               `protobuf:"varint,4,opt,name=expiryTimestamp" json:"expiryTimestamp,omitempty"`
+	Revoked          bool                `protobuf:"varint,5,opt,name=revoked" json:"revoked,omitempty"`
+	Suspended        bool                `protobuf:"varint,6,opt,name=suspended" json:"suspended,omitempty"`
+	FailedAttempts   int32               `protobuf:"varint,7,opt,name=failedAttempts" json:"failedAttempts,omitempty"`
+}
+
+func (m *PairingSession) Reset()                    { *m = PairingSession{} }
+func (m *PairingSession) String() string            { return proto.CompactTextString(m) }
+func (*PairingSession) ProtoMessage()               {}
+func (*PairingSession) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+
+func (m *PairingSession) GetSessionID() string {
+	if m != nil {
+		return m.SessionID
+	}
+	return ""
+}
+
+func (m *PairingSession) GetPermissions() *PairingPermissions {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *PairingSession) GetCreatedTimestamp() int64 {
+	if m != nil {
+		return m.CreatedTimestamp
+	}
+	return 0
+}
+
+func (m *PairingSession) GetExpiryTimestamp() int64 {
+	if m != nil {
+		return m.ExpiryTimestamp
+	}
+	return 0
+}
+
+func (m *PairingSession) GetRevoked() bool {
+	if m != nil {
+		return m.Revoked
+	}
+	return false
+}
+
+func (m *PairingSession) GetSuspended() bool {
+	if m != nil {
+		return m.Suspended
+	}
+	return false
+}
+
+func (m *PairingSession) GetFailedAttempts() int32 {
+	if m != nil {
+		return m.FailedAttempts
+	}
+	return 0
+}
+
+type PairingSessionsList struct {
+	Sessions []*PairingSession `protobuf:"bytes,1,rep,name=sessions" json:"sessions,omitempty"`
+}
+
+func (m *PairingSessionsList) Reset()                    { *m = PairingSessionsList{} }
+func (m *PairingSessionsList) String() string            { return proto.CompactTextString(m) }
+func (*PairingSessionsList) ProtoMessage()               {}
+func (*PairingSessionsList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+
+func (m *PairingSessionsList) GetSessions() []*PairingSession {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
+type StartPairingReply struct {
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID" json:"sessionID,omitempty"`
+	Secret    string `protobuf:"bytes,2,opt,name=secret" json:"secret,omitempty"`
+	PubKey    string `protobuf:"bytes,3,opt,name=pubKey" json:"pubKey,omitempty"`
+}
+
+func (m *StartPairingReply) Reset()                    { *m = StartPairingReply{} }
+func (m *StartPairingReply) String() string            { return proto.CompactTextString(m) }
+func (*StartPairingReply) ProtoMessage()               {}
+func (*StartPairingReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+
+func (m *StartPairingReply) GetSessionID() string {
+	if m != nil {
+		return m.SessionID
+	}
+	return ""
+}
+
+func (m *StartPairingReply) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *StartPairingReply) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+type PairingRequest struct {
+	Type       PairingRequest_Type `protobuf:"varint,1,opt,name=type,enum=data.PairingRequest_Type" json:"type,omitempty"`
+	Amount     int64               `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
+	Memo       string              `protobuf:"bytes,3,opt,name=memo" json:"memo,omitempty"`
+	BackupID   string              `protobuf:"bytes,4,opt,name=backupID" json:"backupID,omitempty"`
+	ChunkIndex int32               `protobuf:"varint,5,opt,name=chunkIndex" json:"chunkIndex,omitempty"`
+}
+
+func (m *PairingRequest) Reset()                    { *m = PairingRequest{} }
+func (m *PairingRequest) String() string            { return proto.CompactTextString(m) }
+func (*PairingRequest) ProtoMessage()               {}
+func (*PairingRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+
+func (m *PairingRequest) GetType() PairingRequest_Type {
+	if m != nil {
+		return m.Type
+	}
+	return PairingRequest_GET_ACCOUNT
+}
+
+func (m *PairingRequest) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *PairingRequest) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *PairingRequest) GetBackupID() string {
+	if m != nil {
+		return m.BackupID
+	}
+	return ""
+}
+
+func (m *PairingRequest) GetChunkIndex() int32 {
+	if m != nil {
+		return m.ChunkIndex
+	}
+	return 0
+}
+
+type PairingReply struct {
+	ErrorMessage   string        `protobuf:"bytes,1,opt,name=errorMessage" json:"errorMessage,omitempty"`
+	Account        *Account      `protobuf:"bytes,2,opt,name=account" json:"account,omitempty"`
+	Payments       *PaymentsList `protobuf:"bytes,3,opt,name=payments" json:"payments,omitempty"`
+	PaymentRequest string        `protobuf:"bytes,4,opt,name=paymentRequest" json:"paymentRequest,omitempty"`
+}
+
+func (m *PairingReply) Reset()                    { *m = PairingReply{} }
+func (m *PairingReply) String() string            { return proto.CompactTextString(m) }
+func (*PairingReply) ProtoMessage()               {}
+func (*PairingReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+
+func (m *PairingReply) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func (m *PairingReply) GetAccount() *Account {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *PairingReply) GetPayments() *PaymentsList {
+	if m != nil {
+		return m.Payments
+	}
+	return nil
+}
+
+func (m *PairingReply) GetPaymentRequest() string {
+	if m != nil {
+		return m.PaymentRequest
+	}
+	return ""
+}
+
+type SplitRecipient struct {
+	Name        string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Percent     int32  `protobuf:"varint,2,opt,name=percent" json:"percent,omitempty"`
+	CallbackURL string `protobuf:"bytes,3,opt,name=callbackURL" json:"callbackURL,omitempty"`
+}
+
+func (m *SplitRecipient) Reset()                    { *m = SplitRecipient{} }
+func (m *SplitRecipient) String() string            { return proto.CompactTextString(m) }
+func (*SplitRecipient) ProtoMessage()               {}
+func (*SplitRecipient) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+
+func (m *SplitRecipient) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SplitRecipient) GetPercent() int32 {
+	if m != nil {
+		return m.Percent
+	}
+	return 0
+}
+
+func (m *SplitRecipient) GetCallbackURL() string {
+	if m != nil {
+		return m.CallbackURL
+	}
+	return ""
+}
+
+type SplitForward struct {
+	Recipient        string `protobuf:"bytes,1,opt,name=recipient" json:"recipient,omitempty"`
+	Amount           int64  `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
+	ChildPaymentHash string `protobuf:"bytes,3,opt,name=childPaymentHash" json:"childPaymentHash,omitempty"`
+	ErrorMessage     string `protobuf:"bytes,4,opt,name=errorMessage" json:"errorMessage,omitempty"`
+}
+
+func (m *SplitForward) Reset()                    { *m = SplitForward{} }
+func (m *SplitForward) String() string            { return proto.CompactTextString(m) }
+func (*SplitForward) ProtoMessage()               {}
+func (*SplitForward) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+
+func (m *SplitForward) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *SplitForward) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *SplitForward) GetChildPaymentHash() string {
+	if m != nil {
+		return m.ChildPaymentHash
+	}
+	return ""
+}
+
+func (m *SplitForward) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+type PaymentSplit struct {
+	PaymentHash string            `protobuf:"bytes,1,opt,name=paymentHash" json:"paymentHash,omitempty"`
+	Recipients  []*SplitRecipient `protobuf:"bytes,2,rep,name=recipients" json:"recipients,omitempty"`
+	Forwards    []*SplitForward   `protobuf:"bytes,3,rep,name=forwards" json:"forwards,omitempty"`
+}
+
+func (m *PaymentSplit) Reset()                    { *m = PaymentSplit{} }
+func (m *PaymentSplit) String() string            { return proto.CompactTextString(m) }
+func (*PaymentSplit) ProtoMessage()               {}
+func (*PaymentSplit) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+
+func (m *PaymentSplit) GetPaymentHash() string {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return ""
+}
+
+func (m *PaymentSplit) GetRecipients() []*SplitRecipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+func (m *PaymentSplit) GetForwards() []*SplitForward {
+	if m != nil {
+		return m.Forwards
+	}
+	return nil
+}
+
+type AddSplitInvoiceRequest struct {
+	Invoice    *InvoiceMemo      `protobuf:"bytes,1,opt,name=invoice" json:"invoice,omitempty"`
+	Recipients []*SplitRecipient `protobuf:"bytes,2,rep,name=recipients" json:"recipients,omitempty"`
+}
+
+func (m *AddSplitInvoiceRequest) Reset()                    { *m = AddSplitInvoiceRequest{} }
+func (m *AddSplitInvoiceRequest) String() string            { return proto.CompactTextString(m) }
+func (*AddSplitInvoiceRequest) ProtoMessage()               {}
+func (*AddSplitInvoiceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+
+func (m *AddSplitInvoiceRequest) GetInvoice() *InvoiceMemo {
+	if m != nil {
+		return m.Invoice
+	}
+	return nil
+}
+
+func (m *AddSplitInvoiceRequest) GetRecipients() []*SplitRecipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+type SettlementRule struct {
+	Id        uint64                `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Action    SettlementRule_Action `protobuf:"varint,2,opt,name=action,enum=data.SettlementRule_Action" json:"action,omitempty"`
+	Enabled   bool                  `protobuf:"varint,3,opt,name=enabled" json:"enabled,omitempty"`
+	MinAmount int64                 `protobuf:"varint,4,opt,name=minAmount" json:"minAmount,omitempty"`
+	Percent   int32                 `protobuf:"varint,5,opt,name=percent" json:"percent,omitempty"`
+	Url       string                `protobuf:"bytes,6,opt,name=url" json:"url,omitempty"`
+}
+
+func (m *SettlementRule) Reset()                    { *m = SettlementRule{} }
+func (m *SettlementRule) String() string            { return proto.CompactTextString(m) }
+func (*SettlementRule) ProtoMessage()               {}
+func (*SettlementRule) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+
+func (m *SettlementRule) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SettlementRule) GetAction() SettlementRule_Action {
+	if m != nil {
+		return m.Action
+	}
+	return SettlementRule_NOTIFY_SERVICE
+}
+
+func (m *SettlementRule) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *SettlementRule) GetMinAmount() int64 {
+	if m != nil {
+		return m.MinAmount
+	}
+	return 0
+}
+
+func (m *SettlementRule) GetPercent() int32 {
+	if m != nil {
+		return m.Percent
+	}
+	return 0
+}
+
+func (m *SettlementRule) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+type SettlementRulesList struct {
+	Rules []*SettlementRule `protobuf:"bytes,1,rep,name=rules" json:"rules,omitempty"`
+}
+
+func (m *SettlementRulesList) Reset()                    { *m = SettlementRulesList{} }
+func (m *SettlementRulesList) String() string            { return proto.CompactTextString(m) }
+func (*SettlementRulesList) ProtoMessage()               {}
+func (*SettlementRulesList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+
+func (m *SettlementRulesList) GetRules() []*SettlementRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+type SettlementAuditEntry struct {
+	RuleID       uint64                `protobuf:"varint,1,opt,name=ruleID" json:"ruleID,omitempty"`
+	Action       SettlementRule_Action `protobuf:"varint,2,opt,name=action,enum=data.SettlementRule_Action" json:"action,omitempty"`
+	PaymentHash  string                `protobuf:"bytes,3,opt,name=paymentHash" json:"paymentHash,omitempty"`
+	Result       string                `protobuf:"bytes,4,opt,name=result" json:"result,omitempty"`
+	ErrorMessage string                `protobuf:"bytes,5,opt,name=errorMessage" json:"errorMessage,omitempty"`
+	Timestamp    int64                 `protobuf:"varint,6,opt,name=timestamp" json:"timestamp,omitempty"`
+}
+
+func (m *SettlementAuditEntry) Reset()                    { *m = SettlementAuditEntry{} }
+func (m *SettlementAuditEntry) String() string            { return proto.CompactTextString(m) }
+func (*SettlementAuditEntry) ProtoMessage()               {}
+func (*SettlementAuditEntry) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+
+func (m *SettlementAuditEntry) GetRuleID() uint64 {
+	if m != nil {
+		return m.RuleID
+	}
+	return 0
+}
+
+func (m *SettlementAuditEntry) GetAction() SettlementRule_Action {
+	if m != nil {
+		return m.Action
+	}
+	return SettlementRule_NOTIFY_SERVICE
+}
+
+func (m *SettlementAuditEntry) GetPaymentHash() string {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return ""
+}
+
+func (m *SettlementAuditEntry) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *SettlementAuditEntry) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func (m *SettlementAuditEntry) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type SettlementAuditList struct {
+	Entries []*SettlementAuditEntry `protobuf:"bytes,1,rep,name=entries" json:"entries,omitempty"`
+}
+
+func (m *SettlementAuditList) Reset()                    { *m = SettlementAuditList{} }
+func (m *SettlementAuditList) String() string            { return proto.CompactTextString(m) }
+func (*SettlementAuditList) ProtoMessage()               {}
+func (*SettlementAuditList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+
+func (m *SettlementAuditList) GetEntries() []*SettlementAuditEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type SupportBundle struct {
+	Path string `protobuf:"bytes,1,opt,name=path" json:"path,omitempty"`
+	Key  string `protobuf:"bytes,2,opt,name=key" json:"key,omitempty"`
+}
+
+func (m *SupportBundle) Reset()                    { *m = SupportBundle{} }
+func (m *SupportBundle) String() string            { return proto.CompactTextString(m) }
+func (*SupportBundle) ProtoMessage()               {}
+func (*SupportBundle) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+
+func (m *SupportBundle) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *SupportBundle) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type LNURLPayParams struct {
+	Callback       string       `protobuf:"bytes,1,opt,name=callback" json:"callback,omitempty"`
+	MinSendable    int64        `protobuf:"varint,2,opt,name=minSendable" json:"minSendable,omitempty"`
+	MaxSendable    int64        `protobuf:"varint,3,opt,name=maxSendable" json:"maxSendable,omitempty"`
+	Metadata       string       `protobuf:"bytes,4,opt,name=metadata" json:"metadata,omitempty"`
+	Domain         string       `protobuf:"bytes,5,opt,name=domain" json:"domain,omitempty"`
+	InvoiceMemo    *InvoiceMemo `protobuf:"bytes,6,opt,name=invoiceMemo" json:"invoiceMemo,omitempty"`
+	CommentAllowed int64        `protobuf:"varint,7,opt,name=commentAllowed" json:"commentAllowed,omitempty"`
+}
+
+func (m *LNURLPayParams) Reset()                    { *m = LNURLPayParams{} }
+func (m *LNURLPayParams) String() string            { return proto.CompactTextString(m) }
+func (*LNURLPayParams) ProtoMessage()               {}
+func (*LNURLPayParams) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+
+func (m *LNURLPayParams) GetCallback() string {
+	if m != nil {
+		return m.Callback
+	}
+	return ""
+}
+
+func (m *LNURLPayParams) GetMinSendable() int64 {
+	if m != nil {
+		return m.MinSendable
+	}
+	return 0
+}
+
+func (m *LNURLPayParams) GetMaxSendable() int64 {
+	if m != nil {
+		return m.MaxSendable
+	}
+	return 0
+}
+
+func (m *LNURLPayParams) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+func (m *LNURLPayParams) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *LNURLPayParams) GetInvoiceMemo() *InvoiceMemo {
+	if m != nil {
+		return m.InvoiceMemo
+	}
+	return nil
+}
+
+func (m *LNURLPayParams) GetCommentAllowed() int64 {
+	if m != nil {
+		return m.CommentAllowed
+	}
+	return 0
+}
+
+type PayLNURLRequest struct {
+	Params  *LNURLPayParams `protobuf:"bytes,1,opt,name=params" json:"params,omitempty"`
+	Amount  int64           `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
+	Comment string          `protobuf:"bytes,3,opt,name=comment" json:"comment,omitempty"`
+}
+
+func (m *PayLNURLRequest) Reset()                    { *m = PayLNURLRequest{} }
+func (m *PayLNURLRequest) String() string            { return proto.CompactTextString(m) }
+func (*PayLNURLRequest) ProtoMessage()               {}
+func (*PayLNURLRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
+
+func (m *PayLNURLRequest) GetParams() *LNURLPayParams {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+func (m *PayLNURLRequest) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *PayLNURLRequest) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+type LightningAddressInvoice struct {
+	PaymentRequest string       `protobuf:"bytes,1,opt,name=paymentRequest" json:"paymentRequest,omitempty"`
+	InvoiceMemo    *InvoiceMemo `protobuf:"bytes,2,opt,name=invoiceMemo" json:"invoiceMemo,omitempty"`
+}
+
+func (m *LightningAddressInvoice) Reset()                    { *m = LightningAddressInvoice{} }
+func (m *LightningAddressInvoice) String() string            { return proto.CompactTextString(m) }
+func (*LightningAddressInvoice) ProtoMessage()               {}
+func (*LightningAddressInvoice) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
+
+func (m *LightningAddressInvoice) GetPaymentRequest() string {
+	if m != nil {
+		return m.PaymentRequest
+	}
+	return ""
+}
+
+func (m *LightningAddressInvoice) GetInvoiceMemo() *InvoiceMemo {
+	if m != nil {
+		return m.InvoiceMemo
+	}
+	return nil
+}
+
+type InvoiceReminderRequest struct {
+	PaymentRequest string `protobuf:"bytes,1,opt,name=paymentRequest" json:"paymentRequest,omitempty"`
+	RemindBefore   int64  `protobuf:"varint,2,opt,name=remindBefore" json:"remindBefore,omitempty"`
+	Locale         string `protobuf:"bytes,3,opt,name=locale" json:"locale,omitempty"`
+}
+
+func (m *InvoiceReminderRequest) Reset()                    { *m = InvoiceReminderRequest{} }
+func (m *InvoiceReminderRequest) String() string            { return proto.CompactTextString(m) }
+func (*InvoiceReminderRequest) ProtoMessage()               {}
+func (*InvoiceReminderRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
+
+func (m *InvoiceReminderRequest) GetPaymentRequest() string {
+	if m != nil {
+		return m.PaymentRequest
+	}
+	return ""
+}
+
+func (m *InvoiceReminderRequest) GetRemindBefore() int64 {
+	if m != nil {
+		return m.RemindBefore
+	}
+	return 0
+}
+
+func (m *InvoiceReminderRequest) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
+}
+
+type HealthCheckResult struct {
+	Name    string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Healthy bool   `protobuf:"varint,2,opt,name=healthy" json:"healthy,omitempty"`
+	Error   string `protobuf:"bytes,3,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *HealthCheckResult) Reset()                    { *m = HealthCheckResult{} }
+func (m *HealthCheckResult) String() string            { return proto.CompactTextString(m) }
+func (*HealthCheckResult) ProtoMessage()               {}
+func (*HealthCheckResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
+
+func (m *HealthCheckResult) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *HealthCheckResult) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
+	}
+	return false
+}
+
+func (m *HealthCheckResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type HealthStatus struct {
+	Healthy   bool                 `protobuf:"varint,1,opt,name=healthy" json:"healthy,omitempty"`
+	Checks    []*HealthCheckResult `protobuf:"bytes,2,rep,name=checks" json:"checks,omitempty"`
+	Timestamp int64                `protobuf:"varint,3,opt,name=timestamp" json:"timestamp,omitempty"`
+}
+
+func (m *HealthStatus) Reset()                    { *m = HealthStatus{} }
+func (m *HealthStatus) String() string            { return proto.CompactTextString(m) }
+func (*HealthStatus) ProtoMessage()               {}
+func (*HealthStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
+
+func (m *HealthStatus) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
+	}
+	return false
+}
+
+func (m *HealthStatus) GetChecks() []*HealthCheckResult {
+	if m != nil {
+		return m.Checks
+	}
+	return nil
+}
+
+func (m *HealthStatus) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type Rate struct {
+	Currency  string  `protobuf:"bytes,1,opt,name=currency" json:"currency,omitempty"`
+	Value     float64 `protobuf:"fixed64,2,opt,name=value" json:"value,omitempty"`
+	Timestamp int64   `protobuf:"varint,3,opt,name=timestamp" json:"timestamp,omitempty"`
+}
+
+func (m *Rate) Reset()                    { *m = Rate{} }
+func (m *Rate) String() string            { return proto.CompactTextString(m) }
+func (*Rate) ProtoMessage()               {}
+func (*Rate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
+
+func (m *Rate) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *Rate) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *Rate) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type Rates struct {
+	Rates []*Rate `protobuf:"bytes,1,rep,name=rates" json:"rates,omitempty"`
+}
+
+func (m *Rates) Reset()                    { *m = Rates{} }
+func (m *Rates) String() string            { return proto.CompactTextString(m) }
+func (*Rates) ProtoMessage()               {}
+func (*Rates) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
+
+func (m *Rates) GetRates() []*Rate {
+	if m != nil {
+		return m.Rates
+	}
+	return nil
+}
+
+type FaultInjectionRule struct {
+	Fault       FaultInjectionRule_Fault `protobuf:"varint,1,opt,name=fault,enum=data.FaultInjectionRule_Fault" json:"fault,omitempty"`
+	Probability float64                  `protobuf:"fixed64,2,opt,name=probability" json:"probability,omitempty"`
+	PaymentHash string                   `protobuf:"bytes,3,opt,name=paymentHash" json:"paymentHash,omitempty"`
+	Destination string                   `protobuf:"bytes,4,opt,name=destination" json:"destination,omitempty"`
+}
+
+func (m *FaultInjectionRule) Reset()                    { *m = FaultInjectionRule{} }
+func (m *FaultInjectionRule) String() string            { return proto.CompactTextString(m) }
+func (*FaultInjectionRule) ProtoMessage()               {}
+func (*FaultInjectionRule) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
+
+func (m *FaultInjectionRule) GetFault() FaultInjectionRule_Fault {
+	if m != nil {
+		return m.Fault
+	}
+	return FaultInjectionRule_NO_ROUTE
+}
+
+func (m *FaultInjectionRule) GetProbability() float64 {
+	if m != nil {
+		return m.Probability
+	}
+	return 0
+}
+
+func (m *FaultInjectionRule) GetPaymentHash() string {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return ""
+}
+
+func (m *FaultInjectionRule) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+type FaultInjectionRules struct {
+	Rules []*FaultInjectionRule `protobuf:"bytes,1,rep,name=rules" json:"rules,omitempty"`
+}
+
+func (m *FaultInjectionRules) Reset()                    { *m = FaultInjectionRules{} }
+func (m *FaultInjectionRules) String() string            { return proto.CompactTextString(m) }
+func (*FaultInjectionRules) ProtoMessage()               {}
+func (*FaultInjectionRules) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
+
+func (m *FaultInjectionRules) GetRules() []*FaultInjectionRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+type QueuePaymentRequest struct {
+	PaymentRequest string `protobuf:"bytes,1,opt,name=paymentRequest" json:"paymentRequest,omitempty"`
+	Amount         int64  `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
+	FeeLimit       int64  `protobuf:"varint,3,opt,name=feeLimit" json:"feeLimit,omitempty"`
+}
+
+func (m *QueuePaymentRequest) Reset()                    { *m = QueuePaymentRequest{} }
+func (m *QueuePaymentRequest) String() string            { return proto.CompactTextString(m) }
+func (*QueuePaymentRequest) ProtoMessage()               {}
+func (*QueuePaymentRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
+
+func (m *QueuePaymentRequest) GetPaymentRequest() string {
+	if m != nil {
+		return m.PaymentRequest
+	}
+	return ""
+}
+
+func (m *QueuePaymentRequest) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *QueuePaymentRequest) GetFeeLimit() int64 {
+	if m != nil {
+		return m.FeeLimit
+	}
+	return 0
+}
+
+type QueuedPayment struct {
+	PaymentHash       string               `protobuf:"bytes,1,opt,name=paymentHash" json:"paymentHash,omitempty"`
+	PaymentRequest    string               `protobuf:"bytes,2,opt,name=paymentRequest" json:"paymentRequest,omitempty"`
+	Amount            int64                `protobuf:"varint,3,opt,name=amount" json:"amount,omitempty"`
+	FeeLimit          int64                `protobuf:"varint,4,opt,name=feeLimit" json:"feeLimit,omitempty"`
+	CreationTimestamp int64                `protobuf:"varint,5,opt,name=creationTimestamp" json:"creationTimestamp,omitempty"`
+	ExpiryTimestamp   int64                `protobuf:"varint,6,opt,name=expiryTimestamp" json:"expiryTimestamp,omitempty"`
+	Status            QueuedPayment_Status `protobuf:"varint,7,opt,name=status,enum=data.QueuedPayment_Status" json:"status,omitempty"`
+	Error             string               `protobuf:"bytes,8,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *QueuedPayment) Reset()                    { *m = QueuedPayment{} }
+func (m *QueuedPayment) String() string            { return proto.CompactTextString(m) }
+func (*QueuedPayment) ProtoMessage()               {}
+func (*QueuedPayment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
+
+func (m *QueuedPayment) GetPaymentHash() string {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return ""
+}
+
+func (m *QueuedPayment) GetPaymentRequest() string {
+	if m != nil {
+		return m.PaymentRequest
+	}
+	return ""
+}
+
+func (m *QueuedPayment) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *QueuedPayment) GetFeeLimit() int64 {
+	if m != nil {
+		return m.FeeLimit
+	}
+	return 0
+}
+
+func (m *QueuedPayment) GetCreationTimestamp() int64 {
+	if m != nil {
+		return m.CreationTimestamp
+	}
+	return 0
+}
+
+func (m *QueuedPayment) GetExpiryTimestamp() int64 {
+	if m != nil {
+		return m.ExpiryTimestamp
+	}
+	return 0
+}
+
+func (m *QueuedPayment) GetStatus() QueuedPayment_Status {
+	if m != nil {
+		return m.Status
+	}
+	return QueuedPayment_QUEUED
+}
+
+func (m *QueuedPayment) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type QueuedPayments struct {
+	Payments []*QueuedPayment `protobuf:"bytes,1,rep,name=payments" json:"payments,omitempty"`
+}
+
+func (m *QueuedPayments) Reset()                    { *m = QueuedPayments{} }
+func (m *QueuedPayments) String() string            { return proto.CompactTextString(m) }
+func (*QueuedPayments) ProtoMessage()               {}
+func (*QueuedPayments) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
+
+func (m *QueuedPayments) GetPayments() []*QueuedPayment {
+	if m != nil {
+		return m.Payments
+	}
+	return nil
+}
+
+type SendMax struct {
+	Amount       int64 `protobuf:"varint,1,opt,name=amount" json:"amount,omitempty"`
+	EstimatedFee int64 `protobuf:"varint,2,opt,name=estimatedFee" json:"estimatedFee,omitempty"`
+}
+
+func (m *SendMax) Reset()                    { *m = SendMax{} }
+func (m *SendMax) String() string            { return proto.CompactTextString(m) }
+func (*SendMax) ProtoMessage()               {}
+func (*SendMax) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
+
+func (m *SendMax) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *SendMax) GetEstimatedFee() int64 {
+	if m != nil {
+		return m.EstimatedFee
+	}
+	return 0
+}
+
+type RouteHop struct {
+	PubKey           string `protobuf:"bytes,1,opt,name=pubKey" json:"pubKey,omitempty"`
+	ChanId           uint64 `protobuf:"varint,2,opt,name=chanId" json:"chanId,omitempty"`
+	AmtToForwardMsat int64  `protobuf:"varint,3,opt,name=amtToForwardMsat" json:"amtToForwardMsat,omitempty"`
+	FeeMsat          int64  `protobuf:"varint,4,opt,name=feeMsat" json:"feeMsat,omitempty"`
+	Expiry           uint32 `protobuf:"varint,5,opt,name=expiry" json:"expiry,omitempty"`
+}
+
+func (m *RouteHop) Reset()                    { *m = RouteHop{} }
+func (m *RouteHop) String() string            { return proto.CompactTextString(m) }
+func (*RouteHop) ProtoMessage()               {}
+func (*RouteHop) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
+
+func (m *RouteHop) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *RouteHop) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *RouteHop) GetAmtToForwardMsat() int64 {
+	if m != nil {
+		return m.AmtToForwardMsat
+	}
+	return 0
+}
+
+func (m *RouteHop) GetFeeMsat() int64 {
+	if m != nil {
+		return m.FeeMsat
+	}
+	return 0
+}
+
+func (m *RouteHop) GetExpiry() uint32 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+type PaymentRoute struct {
+	PaymentHash   string      `protobuf:"bytes,1,opt,name=paymentHash" json:"paymentHash,omitempty"`
+	TotalAmtMsat  int64       `protobuf:"varint,2,opt,name=totalAmtMsat" json:"totalAmtMsat,omitempty"`
+	TotalFeesMsat int64       `protobuf:"varint,3,opt,name=totalFeesMsat" json:"totalFeesMsat,omitempty"`
+	TotalTimeLock uint32      `protobuf:"varint,4,opt,name=totalTimeLock" json:"totalTimeLock,omitempty"`
+	Hops          []*RouteHop `protobuf:"bytes,5,rep,name=hops" json:"hops,omitempty"`
+}
+
+func (m *PaymentRoute) Reset()                    { *m = PaymentRoute{} }
+func (m *PaymentRoute) String() string            { return proto.CompactTextString(m) }
+func (*PaymentRoute) ProtoMessage()               {}
+func (*PaymentRoute) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
+
+func (m *PaymentRoute) GetPaymentHash() string {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return ""
+}
+
+func (m *PaymentRoute) GetTotalAmtMsat() int64 {
+	if m != nil {
+		return m.TotalAmtMsat
+	}
+	return 0
+}
+
+func (m *PaymentRoute) GetTotalFeesMsat() int64 {
+	if m != nil {
+		return m.TotalFeesMsat
+	}
+	return 0
+}
+
+func (m *PaymentRoute) GetTotalTimeLock() uint32 {
+	if m != nil {
+		return m.TotalTimeLock
+	}
+	return 0
+}
+
+func (m *PaymentRoute) GetHops() []*RouteHop {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+type FailedPayment struct {
+	PaymentHash    string                    `protobuf:"bytes,1,opt,name=paymentHash" json:"paymentHash,omitempty"`
+	PaymentRequest string                    `protobuf:"bytes,2,opt,name=paymentRequest" json:"paymentRequest,omitempty"`
+	Destination    string                    `protobuf:"bytes,3,opt,name=destination" json:"destination,omitempty"`
+	Description    string                    `protobuf:"bytes,4,opt,name=description" json:"description,omitempty"`
+	Amount         int64                     `protobuf:"varint,5,opt,name=amount" json:"amount,omitempty"`
+	Timestamp      int64                     `protobuf:"varint,6,opt,name=timestamp" json:"timestamp,omitempty"`
+	Reason         FailedPayment_Reason      `protobuf:"varint,7,opt,name=reason,enum=data.FailedPayment_Reason" json:"reason,omitempty"`
+	Error          string                    `protobuf:"bytes,8,opt,name=error" json:"error,omitempty"`
+	FailureCode    FailedPayment_FailureCode `protobuf:"varint,9,opt,name=failureCode,enum=data.FailedPayment_FailureCode" json:"failureCode,omitempty"`
+	FailingHop     FailedPayment_Hop         `protobuf:"varint,10,opt,name=failingHop,enum=data.FailedPayment_Hop" json:"failingHop,omitempty"`
+}
+
+func (m *FailedPayment) Reset()                    { *m = FailedPayment{} }
+func (m *FailedPayment) String() string            { return proto.CompactTextString(m) }
+func (*FailedPayment) ProtoMessage()               {}
+func (*FailedPayment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
+
+func (m *FailedPayment) GetPaymentHash() string {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return ""
+}
+
+func (m *FailedPayment) GetPaymentRequest() string {
+	if m != nil {
+		return m.PaymentRequest
+	}
+	return ""
+}
+
+func (m *FailedPayment) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *FailedPayment) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *FailedPayment) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *FailedPayment) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *FailedPayment) GetReason() FailedPayment_Reason {
+	if m != nil {
+		return m.Reason
+	}
+	return FailedPayment_UNKNOWN
+}
+
+func (m *FailedPayment) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *FailedPayment) GetFailureCode() FailedPayment_FailureCode {
+	if m != nil {
+		return m.FailureCode
+	}
+	return FailedPayment_NO_FAILURE_CODE
+}
+
+func (m *FailedPayment) GetFailingHop() FailedPayment_Hop {
+	if m != nil {
+		return m.FailingHop
+	}
+	return FailedPayment_UNKNOWN_HOP
+}
+
+type FailedPayments struct {
+	Payments []*FailedPayment `protobuf:"bytes,1,rep,name=payments" json:"payments,omitempty"`
+}
+
+func (m *FailedPayments) Reset()                    { *m = FailedPayments{} }
+func (m *FailedPayments) String() string            { return proto.CompactTextString(m) }
+func (*FailedPayments) ProtoMessage()               {}
+func (*FailedPayments) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
+
+func (m *FailedPayments) GetPayments() []*FailedPayment {
+	if m != nil {
+		return m.Payments
+	}
+	return nil
+}
+
+type DonationCampaign struct {
+	Id                 string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Title              string `protobuf:"bytes,2,opt,name=title" json:"title,omitempty"`
+	Description        string `protobuf:"bytes,3,opt,name=description" json:"description,omitempty"`
+	ImageURL           string `protobuf:"bytes,4,opt,name=imageURL" json:"imageURL,omitempty"`
+	Goal               int64  `protobuf:"varint,5,opt,name=goal" json:"goal,omitempty"`
+	CreationTimestamp  int64  `protobuf:"varint,6,opt,name=creationTimestamp" json:"creationTimestamp,omitempty"`
+	Received           int64  `protobuf:"varint,7,opt,name=received" json:"received,omitempty"`
+	ContributionsCount int64  `protobuf:"varint,8,opt,name=contributionsCount" json:"contributionsCount,omitempty"`
+}
+
+func (m *DonationCampaign) Reset()                    { *m = DonationCampaign{} }
+func (m *DonationCampaign) String() string            { return proto.CompactTextString(m) }
+func (*DonationCampaign) ProtoMessage()               {}
+func (*DonationCampaign) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
+
+func (m *DonationCampaign) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DonationCampaign) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *DonationCampaign) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *DonationCampaign) GetImageURL() string {
+	if m != nil {
+		return m.ImageURL
+	}
+	return ""
+}
+
+func (m *DonationCampaign) GetGoal() int64 {
+	if m != nil {
+		return m.Goal
+	}
+	return 0
+}
+
+func (m *DonationCampaign) GetCreationTimestamp() int64 {
+	if m != nil {
+		return m.CreationTimestamp
+	}
+	return 0
+}
+
+func (m *DonationCampaign) GetReceived() int64 {
+	if m != nil {
+		return m.Received
+	}
+	return 0
+}
+
+func (m *DonationCampaign) GetContributionsCount() int64 {
+	if m != nil {
+		return m.ContributionsCount
+	}
+	return 0
+}
+
+type DonationCampaigns struct {
+	Campaigns []*DonationCampaign `protobuf:"bytes,1,rep,name=campaigns" json:"campaigns,omitempty"`
+}
+
+func (m *DonationCampaigns) Reset()                    { *m = DonationCampaigns{} }
+func (m *DonationCampaigns) String() string            { return proto.CompactTextString(m) }
+func (*DonationCampaigns) ProtoMessage()               {}
+func (*DonationCampaigns) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
+
+func (m *DonationCampaigns) GetCampaigns() []*DonationCampaign {
+	if m != nil {
+		return m.Campaigns
+	}
+	return nil
+}
+
+type DonationInvoiceRequest struct {
+	CampaignId string `protobuf:"bytes,1,opt,name=campaignId" json:"campaignId,omitempty"`
+	Amount     int64  `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
+	DonorName  string `protobuf:"bytes,3,opt,name=donorName" json:"donorName,omitempty"`
+	Message    string `protobuf:"bytes,4,opt,name=message" json:"message,omitempty"`
+	Expiry     int64  `protobuf:"varint,5,opt,name=expiry" json:"expiry,omitempty"`
+}
+
+func (m *DonationInvoiceRequest) Reset()                    { *m = DonationInvoiceRequest{} }
+func (m *DonationInvoiceRequest) String() string            { return proto.CompactTextString(m) }
+func (*DonationInvoiceRequest) ProtoMessage()               {}
+func (*DonationInvoiceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
+
+func (m *DonationInvoiceRequest) GetCampaignId() string {
+	if m != nil {
+		return m.CampaignId
+	}
+	return ""
+}
+
+func (m *DonationInvoiceRequest) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *DonationInvoiceRequest) GetDonorName() string {
+	if m != nil {
+		return m.DonorName
+	}
+	return ""
+}
+
+func (m *DonationInvoiceRequest) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *DonationInvoiceRequest) GetExpiry() int64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+type DonationContribution struct {
+	PaymentHash     string `protobuf:"bytes,1,opt,name=paymentHash" json:"paymentHash,omitempty"`
+	Amount          int64  `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
+	DonorName       string `protobuf:"bytes,3,opt,name=donorName" json:"donorName,omitempty"`
+	Message         string `protobuf:"bytes,4,opt,name=message" json:"message,omitempty"`
+	SettleTimestamp int64  `protobuf:"varint,5,opt,name=settleTimestamp" json:"settleTimestamp,omitempty"`
+}
+
+func (m *DonationContribution) Reset()                    { *m = DonationContribution{} }
+func (m *DonationContribution) String() string            { return proto.CompactTextString(m) }
+func (*DonationContribution) ProtoMessage()               {}
+func (*DonationContribution) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
+
+func (m *DonationContribution) GetPaymentHash() string {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return ""
+}
+
+func (m *DonationContribution) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *DonationContribution) GetDonorName() string {
+	if m != nil {
+		return m.DonorName
+	}
+	return ""
+}
+
+func (m *DonationContribution) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *DonationContribution) GetSettleTimestamp() int64 {
+	if m != nil {
+		return m.SettleTimestamp
+	}
+	return 0
+}
+
+type DonationContributions struct {
+	Contributions []*DonationContribution `protobuf:"bytes,1,rep,name=contributions" json:"contributions,omitempty"`
+}
+
+func (m *DonationContributions) Reset()                    { *m = DonationContributions{} }
+func (m *DonationContributions) String() string            { return proto.CompactTextString(m) }
+func (*DonationContributions) ProtoMessage()               {}
+func (*DonationContributions) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{83} }
+
+func (m *DonationContributions) GetContributions() []*DonationContribution {
+	if m != nil {
+		return m.Contributions
+	}
+	return nil
+}
+
+type PaymentStatus struct {
+	PaymentHash string               `protobuf:"bytes,1,opt,name=paymentHash" json:"paymentHash,omitempty"`
+	Status      PaymentStatus_Status `protobuf:"varint,2,opt,name=status,enum=data.PaymentStatus_Status" json:"status,omitempty"`
+	Error       string               `protobuf:"bytes,3,opt,name=error" json:"error,omitempty"`
+	Attempt     int32                `protobuf:"varint,4,opt,name=attempt" json:"attempt,omitempty"`
+}
+
+func (m *PaymentStatus) Reset()                    { *m = PaymentStatus{} }
+func (m *PaymentStatus) String() string            { return proto.CompactTextString(m) }
+func (*PaymentStatus) ProtoMessage()               {}
+func (*PaymentStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84} }
+
+func (m *PaymentStatus) GetPaymentHash() string {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return ""
+}
+
+func (m *PaymentStatus) GetStatus() PaymentStatus_Status {
+	if m != nil {
+		return m.Status
+	}
+	return PaymentStatus_IN_FLIGHT
+}
+
+func (m *PaymentStatus) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *PaymentStatus) GetAttempt() int32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+type RetryPolicy struct {
+	MaxAttempts      int32 `protobuf:"varint,1,opt,name=maxAttempts" json:"maxAttempts,omitempty"`
+	InitialBackoffMs int64 `protobuf:"varint,2,opt,name=initialBackoffMs" json:"initialBackoffMs,omitempty"`
+	MaxBackoffMs     int64 `protobuf:"varint,3,opt,name=maxBackoffMs" json:"maxBackoffMs,omitempty"`
+}
+
+func (m *RetryPolicy) Reset()                    { *m = RetryPolicy{} }
+func (m *RetryPolicy) String() string            { return proto.CompactTextString(m) }
+func (*RetryPolicy) ProtoMessage()               {}
+func (*RetryPolicy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85} }
+
+func (m *RetryPolicy) GetMaxAttempts() int32 {
+	if m != nil {
+		return m.MaxAttempts
+	}
+	return 0
+}
+
+func (m *RetryPolicy) GetInitialBackoffMs() int64 {
+	if m != nil {
+		return m.InitialBackoffMs
+	}
+	return 0
+}
+
+func (m *RetryPolicy) GetMaxBackoffMs() int64 {
+	if m != nil {
+		return m.MaxBackoffMs
+	}
+	return 0
+}
+
+type RetryPaymentRequest struct {
+	Payment *PayInvoiceRequest `protobuf:"bytes,1,opt,name=payment" json:"payment,omitempty"`
+	Policy  *RetryPolicy       `protobuf:"bytes,2,opt,name=policy" json:"policy,omitempty"`
+}
+
+func (m *RetryPaymentRequest) Reset()                    { *m = RetryPaymentRequest{} }
+func (m *RetryPaymentRequest) String() string            { return proto.CompactTextString(m) }
+func (*RetryPaymentRequest) ProtoMessage()               {}
+func (*RetryPaymentRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{86} }
+
+func (m *RetryPaymentRequest) GetPayment() *PayInvoiceRequest {
+	if m != nil {
+		return m.Payment
+	}
+	return nil
+}
+
+func (m *RetryPaymentRequest) GetPolicy() *RetryPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
+type RatesProvider struct {
+	Name                string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Pinned              bool   `protobuf:"varint,2,opt,name=pinned" json:"pinned,omitempty"`
+	LastSuccess         int64  `protobuf:"varint,3,opt,name=lastSuccess" json:"lastSuccess,omitempty"`
+	LastError           string `protobuf:"bytes,4,opt,name=lastError" json:"lastError,omitempty"`
+	ConsecutiveFailures int64  `protobuf:"varint,5,opt,name=consecutiveFailures" json:"consecutiveFailures,omitempty"`
+	Outliers            int64  `protobuf:"varint,6,opt,name=outliers" json:"outliers,omitempty"`
+}
+
+func (m *RatesProvider) Reset()                    { *m = RatesProvider{} }
+func (m *RatesProvider) String() string            { return proto.CompactTextString(m) }
+func (*RatesProvider) ProtoMessage()               {}
+func (*RatesProvider) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{87} }
+
+func (m *RatesProvider) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RatesProvider) GetPinned() bool {
+	if m != nil {
+		return m.Pinned
+	}
+	return false
+}
+
+func (m *RatesProvider) GetLastSuccess() int64 {
+	if m != nil {
+		return m.LastSuccess
+	}
+	return 0
+}
+
+func (m *RatesProvider) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *RatesProvider) GetConsecutiveFailures() int64 {
+	if m != nil {
+		return m.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (m *RatesProvider) GetOutliers() int64 {
+	if m != nil {
+		return m.Outliers
+	}
+	return 0
+}
+
+type RatesProviders struct {
+	Providers []*RatesProvider `protobuf:"bytes,1,rep,name=providers" json:"providers,omitempty"`
+}
+
+func (m *RatesProviders) Reset()                    { *m = RatesProviders{} }
+func (m *RatesProviders) String() string            { return proto.CompactTextString(m) }
+func (*RatesProviders) ProtoMessage()               {}
+func (*RatesProviders) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{88} }
+
+func (m *RatesProviders) GetProviders() []*RatesProvider {
+	if m != nil {
+		return m.Providers
+	}
+	return nil
+}
+
+type SwapAddressReuse struct {
+	Address     string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	Source      string `protobuf:"bytes,2,opt,name=source" json:"source,omitempty"`
+	Used        bool   `protobuf:"varint,3,opt,name=used" json:"used,omitempty"`
+	Expired     bool   `protobuf:"varint,4,opt,name=expired" json:"expired,omitempty"`
+	OtherSource bool   `protobuf:"varint,5,opt,name=otherSource" json:"otherSource,omitempty"`
+	Reuse       bool   `protobuf:"varint,6,opt,name=reuse" json:"reuse,omitempty"`
+}
+
+func (m *SwapAddressReuse) Reset()                    { *m = SwapAddressReuse{} }
+func (m *SwapAddressReuse) String() string            { return proto.CompactTextString(m) }
+func (*SwapAddressReuse) ProtoMessage()               {}
+func (*SwapAddressReuse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
+
+func (m *SwapAddressReuse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SwapAddressReuse) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *SwapAddressReuse) GetUsed() bool {
+	if m != nil {
+		return m.Used
+	}
+	return false
+}
+
+func (m *SwapAddressReuse) GetExpired() bool {
+	if m != nil {
+		return m.Expired
+	}
+	return false
+}
+
+func (m *SwapAddressReuse) GetOtherSource() bool {
+	if m != nil {
+		return m.OtherSource
+	}
+	return false
+}
+
+func (m *SwapAddressReuse) GetReuse() bool {
+	if m != nil {
+		return m.Reuse
+	}
+	return false
+}
+
+type StatementItem struct {
+	Payment        *Payment `protobuf:"bytes,1,opt,name=payment" json:"payment,omitempty"`
+	BalanceChange  int64    `protobuf:"varint,2,opt,name=balanceChange" json:"balanceChange,omitempty"`
+	RunningBalance int64    `protobuf:"varint,3,opt,name=runningBalance" json:"runningBalance,omitempty"`
+}
+
+func (m *StatementItem) Reset()                    { *m = StatementItem{} }
+func (m *StatementItem) String() string            { return proto.CompactTextString(m) }
+func (*StatementItem) ProtoMessage()               {}
+func (*StatementItem) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{90} }
+
+func (m *StatementItem) GetPayment() *Payment {
+	if m != nil {
+		return m.Payment
+	}
+	return nil
+}
+
+func (m *StatementItem) GetBalanceChange() int64 {
+	if m != nil {
+		return m.BalanceChange
+	}
+	return 0
+}
+
+func (m *StatementItem) GetRunningBalance() int64 {
+	if m != nil {
+		return m.RunningBalance
+	}
+	return 0
+}
+
+type Statement struct {
+	Month          string           `protobuf:"bytes,1,opt,name=month" json:"month,omitempty"`
+	FromTimestamp  int64            `protobuf:"varint,2,opt,name=fromTimestamp" json:"fromTimestamp,omitempty"`
+	ToTimestamp    int64            `protobuf:"varint,3,opt,name=toTimestamp" json:"toTimestamp,omitempty"`
+	OpeningBalance int64            `protobuf:"varint,4,opt,name=openingBalance" json:"openingBalance,omitempty"`
+	ClosingBalance int64            `protobuf:"varint,5,opt,name=closingBalance" json:"closingBalance,omitempty"`
+	TotalCredits   int64            `protobuf:"varint,6,opt,name=totalCredits" json:"totalCredits,omitempty"`
+	TotalDebits    int64            `protobuf:"varint,7,opt,name=totalDebits" json:"totalDebits,omitempty"`
+	TotalFees      int64            `protobuf:"varint,8,opt,name=totalFees" json:"totalFees,omitempty"`
+	Items          []*StatementItem `protobuf:"bytes,9,rep,name=items" json:"items,omitempty"`
+	Reconciled     bool             `protobuf:"varint,10,opt,name=reconciled" json:"reconciled,omitempty"`
+	Discrepancy    int64            `protobuf:"varint,11,opt,name=discrepancy" json:"discrepancy,omitempty"`
+	Warnings       []string         `protobuf:"bytes,12,rep,name=warnings" json:"warnings,omitempty"`
+	TotalTax       int64            `protobuf:"varint,13,opt,name=totalTax" json:"totalTax,omitempty"`
+}
+
+func (m *Statement) Reset()                    { *m = Statement{} }
+func (m *Statement) String() string            { return proto.CompactTextString(m) }
+func (*Statement) ProtoMessage()               {}
+func (*Statement) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{91} }
+
+func (m *Statement) GetMonth() string {
+	if m != nil {
+		return m.Month
+	}
+	return ""
+}
+
+func (m *Statement) GetFromTimestamp() int64 {
+	if m != nil {
+		return m.FromTimestamp
+	}
+	return 0
+}
+
+func (m *Statement) GetToTimestamp() int64 {
+	if m != nil {
+		return m.ToTimestamp
+	}
+	return 0
+}
+
+func (m *Statement) GetOpeningBalance() int64 {
+	if m != nil {
+		return m.OpeningBalance
+	}
+	return 0
+}
+
+func (m *Statement) GetClosingBalance() int64 {
+	if m != nil {
+		return m.ClosingBalance
+	}
+	return 0
+}
+
+func (m *Statement) GetTotalCredits() int64 {
+	if m != nil {
+		return m.TotalCredits
+	}
+	return 0
+}
+
+func (m *Statement) GetTotalDebits() int64 {
+	if m != nil {
+		return m.TotalDebits
+	}
+	return 0
+}
+
+func (m *Statement) GetTotalFees() int64 {
+	if m != nil {
+		return m.TotalFees
+	}
+	return 0
+}
+
+func (m *Statement) GetItems() []*StatementItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *Statement) GetReconciled() bool {
+	if m != nil {
+		return m.Reconciled
+	}
+	return false
+}
+
+func (m *Statement) GetDiscrepancy() int64 {
+	if m != nil {
+		return m.Discrepancy
+	}
+	return 0
+}
+
+func (m *Statement) GetWarnings() []string {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
+func (m *Statement) GetTotalTax() int64 {
+	if m != nil {
+		return m.TotalTax
+	}
+	return 0
+}
+
+type PaymentFeeEstimate struct {
+	Amount             int64   `protobuf:"varint,1,opt,name=amount" json:"amount,omitempty"`
+	Fee                int64   `protobuf:"varint,2,opt,name=fee" json:"fee,omitempty"`
+	FeeMsat            int64   `protobuf:"varint,3,opt,name=feeMsat" json:"feeMsat,omitempty"`
+	SuccessProbability float64 `protobuf:"fixed64,4,opt,name=successProbability" json:"successProbability,omitempty"`
+	Routes             int32   `protobuf:"varint,5,opt,name=routes" json:"routes,omitempty"`
+}
+
+func (m *PaymentFeeEstimate) Reset()                    { *m = PaymentFeeEstimate{} }
+func (m *PaymentFeeEstimate) String() string            { return proto.CompactTextString(m) }
+func (*PaymentFeeEstimate) ProtoMessage()               {}
+func (*PaymentFeeEstimate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{92} }
+
+func (m *PaymentFeeEstimate) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *PaymentFeeEstimate) GetFee() int64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *PaymentFeeEstimate) GetFeeMsat() int64 {
+	if m != nil {
+		return m.FeeMsat
+	}
+	return 0
+}
+
+func (m *PaymentFeeEstimate) GetSuccessProbability() float64 {
+	if m != nil {
+		return m.SuccessProbability
+	}
+	return 0
+}
+
+func (m *PaymentFeeEstimate) GetRoutes() int32 {
+	if m != nil {
+		return m.Routes
+	}
+	return 0
+}
+
+type HTLCEvent struct {
+	Timestamp        int64               `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	Type             HTLCEvent_EventType `protobuf:"varint,2,opt,name=type,enum=data.HTLCEvent_EventType" json:"type,omitempty"`
+	Incoming         bool                `protobuf:"varint,3,opt,name=incoming" json:"incoming,omitempty"`
+	Forward          bool                `protobuf:"varint,4,opt,name=forward" json:"forward,omitempty"`
+	Amount           int64               `protobuf:"varint,5,opt,name=amount" json:"amount,omitempty"`
+	PaymentHash      string              `protobuf:"bytes,6,opt,name=paymentHash" json:"paymentHash,omitempty"`
+	ChanId           uint64              `protobuf:"varint,7,opt,name=chanId" json:"chanId,omitempty"`
+	ExpirationHeight uint32              `protobuf:"varint,8,opt,name=expirationHeight" json:"expirationHeight,omitempty"`
+}
+
+func (m *HTLCEvent) Reset()                    { *m = HTLCEvent{} }
+func (m *HTLCEvent) String() string            { return proto.CompactTextString(m) }
+func (*HTLCEvent) ProtoMessage()               {}
+func (*HTLCEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{93} }
+
+func (m *HTLCEvent) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *HTLCEvent) GetType() HTLCEvent_EventType {
+	if m != nil {
+		return m.Type
+	}
+	return HTLCEvent_ADDED
+}
+
+func (m *HTLCEvent) GetIncoming() bool {
+	if m != nil {
+		return m.Incoming
+	}
+	return false
+}
+
+func (m *HTLCEvent) GetForward() bool {
+	if m != nil {
+		return m.Forward
+	}
+	return false
+}
+
+func (m *HTLCEvent) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *HTLCEvent) GetPaymentHash() string {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return ""
+}
+
+func (m *HTLCEvent) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *HTLCEvent) GetExpirationHeight() uint32 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
+type HTLCEvents struct {
+	Events []*HTLCEvent `protobuf:"bytes,1,rep,name=events" json:"events,omitempty"`
+}
+
+func (m *HTLCEvents) Reset()                    { *m = HTLCEvents{} }
+func (m *HTLCEvents) String() string            { return proto.CompactTextString(m) }
+func (*HTLCEvents) ProtoMessage()               {}
+func (*HTLCEvents) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{94} }
+
+func (m *HTLCEvents) GetEvents() []*HTLCEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type ConsolidateChannelsRequest struct {
+	Strategy   CloseFeeStrategy `protobuf:"varint,1,opt,name=strategy,enum=data.CloseFeeStrategy" json:"strategy,omitempty"`
+	SatPerByte int64            `protobuf:"varint,2,opt,name=satPerByte" json:"satPerByte,omitempty"`
+}
+
+func (m *ConsolidateChannelsRequest) Reset()                    { *m = ConsolidateChannelsRequest{} }
+func (m *ConsolidateChannelsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ConsolidateChannelsRequest) ProtoMessage()               {}
+func (*ConsolidateChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{95} }
+
+func (m *ConsolidateChannelsRequest) GetStrategy() CloseFeeStrategy {
+	if m != nil {
+		return m.Strategy
+	}
+	return CloseFeeStrategy_NORMAL
+}
+
+func (m *ConsolidateChannelsRequest) GetSatPerByte() int64 {
+	if m != nil {
+		return m.SatPerByte
+	}
+	return 0
+}
+
+type ChannelConsolidationPreview struct {
+	MinChannelSize     int64    `protobuf:"varint,1,opt,name=minChannelSize" json:"minChannelSize,omitempty"`
+	Fragmented         bool     `protobuf:"varint,2,opt,name=fragmented" json:"fragmented,omitempty"`
+	ChannelPoints      []string `protobuf:"bytes,3,rep,name=channelPoints" json:"channelPoints,omitempty"`
+	Amount             int64    `protobuf:"varint,4,opt,name=amount" json:"amount,omitempty"`
+	EstimatedCloseFees int64    `protobuf:"varint,5,opt,name=estimatedCloseFees" json:"estimatedCloseFees,omitempty"`
+	EstimatedOpenFee   int64    `protobuf:"varint,6,opt,name=estimatedOpenFee" json:"estimatedOpenFee,omitempty"`
+	NewChannelSize     int64    `protobuf:"varint,7,opt,name=newChannelSize" json:"newChannelSize,omitempty"`
+}
+
+func (m *ChannelConsolidationPreview) Reset()                    { *m = ChannelConsolidationPreview{} }
+func (m *ChannelConsolidationPreview) String() string            { return proto.CompactTextString(m) }
+func (*ChannelConsolidationPreview) ProtoMessage()               {}
+func (*ChannelConsolidationPreview) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{96} }
+
+func (m *ChannelConsolidationPreview) GetMinChannelSize() int64 {
+	if m != nil {
+		return m.MinChannelSize
+	}
+	return 0
+}
+
+func (m *ChannelConsolidationPreview) GetFragmented() bool {
+	if m != nil {
+		return m.Fragmented
+	}
+	return false
+}
+
+func (m *ChannelConsolidationPreview) GetChannelPoints() []string {
+	if m != nil {
+		return m.ChannelPoints
+	}
+	return nil
+}
+
+func (m *ChannelConsolidationPreview) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ChannelConsolidationPreview) GetEstimatedCloseFees() int64 {
+	if m != nil {
+		return m.EstimatedCloseFees
+	}
+	return 0
+}
+
+func (m *ChannelConsolidationPreview) GetEstimatedOpenFee() int64 {
+	if m != nil {
+		return m.EstimatedOpenFee
+	}
+	return 0
+}
+
+func (m *ChannelConsolidationPreview) GetNewChannelSize() int64 {
+	if m != nil {
+		return m.NewChannelSize
+	}
+	return 0
+}
+
+type ChannelConsolidation struct {
+	Id            uint64                      `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Status        ChannelConsolidation_Status `protobuf:"varint,2,opt,name=status,enum=data.ChannelConsolidation_Status" json:"status,omitempty"`
+	ChannelPoints []string                    `protobuf:"bytes,3,rep,name=channelPoints" json:"channelPoints,omitempty"`
+	ClosingTxids  []string                    `protobuf:"bytes,4,rep,name=closingTxids" json:"closingTxids,omitempty"`
+	SatPerByte    int64                       `protobuf:"varint,5,opt,name=satPerByte" json:"satPerByte,omitempty"`
+	Amount        int64                       `protobuf:"varint,6,opt,name=amount" json:"amount,omitempty"`
+	FundingTxid   string                      `protobuf:"bytes,7,opt,name=fundingTxid" json:"fundingTxid,omitempty"`
+	ErrorMessage  string                      `protobuf:"bytes,8,opt,name=errorMessage" json:"errorMessage,omitempty"`
+	Timestamp     int64                       `protobuf:"varint,9,opt,name=timestamp" json:"timestamp,omitempty"`
+}
+
+func (m *ChannelConsolidation) Reset()                    { *m = ChannelConsolidation{} }
+func (m *ChannelConsolidation) String() string            { return proto.CompactTextString(m) }
+func (*ChannelConsolidation) ProtoMessage()               {}
+func (*ChannelConsolidation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{97} }
+
+func (m *ChannelConsolidation) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ChannelConsolidation) GetStatus() ChannelConsolidation_Status {
+	if m != nil {
+		return m.Status
+	}
+	return ChannelConsolidation_CLOSING
+}
+
+func (m *ChannelConsolidation) GetChannelPoints() []string {
+	if m != nil {
+		return m.ChannelPoints
+	}
+	return nil
+}
+
+func (m *ChannelConsolidation) GetClosingTxids() []string {
+	if m != nil {
+		return m.ClosingTxids
+	}
+	return nil
+}
+
+func (m *ChannelConsolidation) GetSatPerByte() int64 {
+	if m != nil {
+		return m.SatPerByte
+	}
+	return 0
+}
+
+func (m *ChannelConsolidation) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ChannelConsolidation) GetFundingTxid() string {
+	if m != nil {
+		return m.FundingTxid
+	}
+	return ""
+}
+
+func (m *ChannelConsolidation) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func (m *ChannelConsolidation) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type ChannelConsolidationsList struct {
+	Consolidations []*ChannelConsolidation `protobuf:"bytes,1,rep,name=consolidations" json:"consolidations,omitempty"`
+}
+
+func (m *ChannelConsolidationsList) Reset()                    { *m = ChannelConsolidationsList{} }
+func (m *ChannelConsolidationsList) String() string            { return proto.CompactTextString(m) }
+func (*ChannelConsolidationsList) ProtoMessage()               {}
+func (*ChannelConsolidationsList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{98} }
+
+func (m *ChannelConsolidationsList) GetConsolidations() []*ChannelConsolidation {
+	if m != nil {
+		return m.Consolidations
+	}
+	return nil
+}
+
+type IssuedInvoice struct {
+	PaymentHash       string              `protobuf:"bytes,1,opt,name=paymentHash" json:"paymentHash,omitempty"`
+	PaymentRequest    string              `protobuf:"bytes,2,opt,name=paymentRequest" json:"paymentRequest,omitempty"`
+	Memo              *InvoiceMemo        `protobuf:"bytes,3,opt,name=memo" json:"memo,omitempty"`
+	State             IssuedInvoice_State `protobuf:"varint,4,opt,name=state,enum=data.IssuedInvoice_State" json:"state,omitempty"`
+	Amount            int64               `protobuf:"varint,5,opt,name=amount" json:"amount,omitempty"`
+	AmountPaid        int64               `protobuf:"varint,6,opt,name=amountPaid" json:"amountPaid,omitempty"`
+	CreationTimestamp int64               `protobuf:"varint,7,opt,name=creationTimestamp" json:"creationTimestamp,omitempty"`
+	ExpiryTimestamp   int64               `protobuf:"varint,8,opt,name=expiryTimestamp" json:"expiryTimestamp,omitempty"`
+	SettleTimestamp   int64               `protobuf:"varint,9,opt,name=settleTimestamp" json:"settleTimestamp,omitempty"`
+}
+
+func (m *IssuedInvoice) Reset()                    { *m = IssuedInvoice{} }
+func (m *IssuedInvoice) String() string            { return proto.CompactTextString(m) }
+func (*IssuedInvoice) ProtoMessage()               {}
+func (*IssuedInvoice) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{99} }
+
+func (m *IssuedInvoice) GetPaymentHash() string {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return ""
+}
+
+func (m *IssuedInvoice) GetPaymentRequest() string {
+	if m != nil {
+		return m.PaymentRequest
+	}
+	return ""
+}
+
+func (m *IssuedInvoice) GetMemo() *InvoiceMemo {
+	if m != nil {
+		return m.Memo
+	}
+	return nil
+}
+
+func (m *IssuedInvoice) GetState() IssuedInvoice_State {
+	if m != nil {
+		return m.State
+	}
+	return IssuedInvoice_OPEN
+}
+
+func (m *IssuedInvoice) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *IssuedInvoice) GetAmountPaid() int64 {
+	if m != nil {
+		return m.AmountPaid
+	}
+	return 0
+}
+
+func (m *IssuedInvoice) GetCreationTimestamp() int64 {
+	if m != nil {
+		return m.CreationTimestamp
+	}
+	return 0
+}
+
+func (m *IssuedInvoice) GetExpiryTimestamp() int64 {
+	if m != nil {
+		return m.ExpiryTimestamp
+	}
+	return 0
+}
+
+func (m *IssuedInvoice) GetSettleTimestamp() int64 {
+	if m != nil {
+		return m.SettleTimestamp
+	}
+	return 0
+}
+
+type IssuedInvoices struct {
+	Invoices []*IssuedInvoice `protobuf:"bytes,1,rep,name=invoices" json:"invoices,omitempty"`
+}
+
+func (m *IssuedInvoices) Reset()                    { *m = IssuedInvoices{} }
+func (m *IssuedInvoices) String() string            { return proto.CompactTextString(m) }
+func (*IssuedInvoices) ProtoMessage()               {}
+func (*IssuedInvoices) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{100} }
+
+func (m *IssuedInvoices) GetInvoices() []*IssuedInvoice {
+	if m != nil {
+		return m.Invoices
+	}
+	return nil
+}
+
+type SpendPolicyCheck struct {
+	Name   string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Passed bool   `protobuf:"varint,2,opt,name=passed" json:"passed,omitempty"`
+	Reason string `protobuf:"bytes,3,opt,name=reason" json:"reason,omitempty"`
+}
+
+func (m *SpendPolicyCheck) Reset()                    { *m = SpendPolicyCheck{} }
+func (m *SpendPolicyCheck) String() string            { return proto.CompactTextString(m) }
+func (*SpendPolicyCheck) ProtoMessage()               {}
+func (*SpendPolicyCheck) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{101} }
+
+func (m *SpendPolicyCheck) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SpendPolicyCheck) GetPassed() bool {
+	if m != nil {
+		return m.Passed
+	}
+	return false
+}
+
+func (m *SpendPolicyCheck) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type SpendAuditEntry struct {
+	Id          uint64                    `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Timestamp   int64                     `protobuf:"varint,2,opt,name=timestamp" json:"timestamp,omitempty"`
+	Initiator   SpendAuditEntry_Initiator `protobuf:"varint,3,opt,name=initiator,enum=data.SpendAuditEntry_Initiator" json:"initiator,omitempty"`
+	InitiatorId string                    `protobuf:"bytes,4,opt,name=initiatorId" json:"initiatorId,omitempty"`
+	Kind        SpendAuditEntry_Kind      `protobuf:"varint,5,opt,name=kind,enum=data.SpendAuditEntry_Kind" json:"kind,omitempty"`
+	Destination string                    `protobuf:"bytes,6,opt,name=destination" json:"destination,omitempty"`
+	PaymentHash string                    `protobuf:"bytes,7,opt,name=paymentHash" json:"paymentHash,omitempty"`
+	Amount      int64                     `protobuf:"varint,8,opt,name=amount" json:"amount,omitempty"`
+	FeeLimit    int64                     `protobuf:"varint,9,opt,name=feeLimit" json:"feeLimit,omitempty"`
+	Checks      []*SpendPolicyCheck       `protobuf:"bytes,10,rep,name=checks" json:"checks,omitempty"`
+	Succeeded   bool                      `protobuf:"varint,11,opt,name=succeeded" json:"succeeded,omitempty"`
+	Error       string                    `protobuf:"bytes,12,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *SpendAuditEntry) Reset()                    { *m = SpendAuditEntry{} }
+func (m *SpendAuditEntry) String() string            { return proto.CompactTextString(m) }
+func (*SpendAuditEntry) ProtoMessage()               {}
+func (*SpendAuditEntry) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{102} }
+
+func (m *SpendAuditEntry) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SpendAuditEntry) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *SpendAuditEntry) GetInitiator() SpendAuditEntry_Initiator {
+	if m != nil {
+		return m.Initiator
+	}
+	return SpendAuditEntry_UI
+}
+
+func (m *SpendAuditEntry) GetInitiatorId() string {
+	if m != nil {
+		return m.InitiatorId
+	}
+	return ""
+}
+
+func (m *SpendAuditEntry) GetKind() SpendAuditEntry_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return SpendAuditEntry_LIGHTNING
+}
+
+func (m *SpendAuditEntry) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *SpendAuditEntry) GetPaymentHash() string {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return ""
+}
+
+func (m *SpendAuditEntry) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *SpendAuditEntry) GetFeeLimit() int64 {
+	if m != nil {
+		return m.FeeLimit
+	}
+	return 0
+}
+
+func (m *SpendAuditEntry) GetChecks() []*SpendPolicyCheck {
+	if m != nil {
+		return m.Checks
+	}
+	return nil
+}
+
+func (m *SpendAuditEntry) GetSucceeded() bool {
+	if m != nil {
+		return m.Succeeded
+	}
+	return false
+}
+
+func (m *SpendAuditEntry) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type SpendAuditFilter struct {
+	FromTimestamp int64                       `protobuf:"varint,1,opt,name=fromTimestamp" json:"fromTimestamp,omitempty"`
+	ToTimestamp   int64                       `protobuf:"varint,2,opt,name=toTimestamp" json:"toTimestamp,omitempty"`
+	Initiators    []SpendAuditEntry_Initiator `protobuf:"varint,3,rep,packed,name=initiators,enum=data.SpendAuditEntry_Initiator" json:"initiators,omitempty"`
+	FailedOnly    bool                        `protobuf:"varint,4,opt,name=failedOnly" json:"failedOnly,omitempty"`
+}
+
+func (m *SpendAuditFilter) Reset()                    { *m = SpendAuditFilter{} }
+func (m *SpendAuditFilter) String() string            { return proto.CompactTextString(m) }
+func (*SpendAuditFilter) ProtoMessage()               {}
+func (*SpendAuditFilter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{103} }
+
+func (m *SpendAuditFilter) GetFromTimestamp() int64 {
+	if m != nil {
+		return m.FromTimestamp
+	}
+	return 0
+}
+
+func (m *SpendAuditFilter) GetToTimestamp() int64 {
+	if m != nil {
+		return m.ToTimestamp
+	}
+	return 0
+}
+
+func (m *SpendAuditFilter) GetInitiators() []SpendAuditEntry_Initiator {
+	if m != nil {
+		return m.Initiators
+	}
+	return nil
+}
+
+func (m *SpendAuditFilter) GetFailedOnly() bool {
+	if m != nil {
+		return m.FailedOnly
+	}
+	return false
+}
+
+type SpendAuditLog struct {
+	Entries []*SpendAuditEntry `protobuf:"bytes,1,rep,name=entries" json:"entries,omitempty"`
+}
+
+func (m *SpendAuditLog) Reset()                    { *m = SpendAuditLog{} }
+func (m *SpendAuditLog) String() string            { return proto.CompactTextString(m) }
+func (*SpendAuditLog) ProtoMessage()               {}
+func (*SpendAuditLog) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{104} }
+
+func (m *SpendAuditLog) GetEntries() []*SpendAuditEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type PaymentSummary struct {
+	PaymentHash       string              `protobuf:"bytes,1,opt,name=paymentHash" json:"paymentHash,omitempty"`
+	Type              Payment_PaymentType `protobuf:"varint,2,opt,name=type,enum=data.Payment_PaymentType" json:"type,omitempty"`
+	Amount            int64               `protobuf:"varint,3,opt,name=amount" json:"amount,omitempty"`
+	Title             string              `protobuf:"bytes,4,opt,name=title" json:"title,omitempty"`
+	CreationTimestamp int64               `protobuf:"varint,5,opt,name=creationTimestamp" json:"creationTimestamp,omitempty"`
+}
+
+func (m *PaymentSummary) Reset()                    { *m = PaymentSummary{} }
+func (m *PaymentSummary) String() string            { return proto.CompactTextString(m) }
+func (*PaymentSummary) ProtoMessage()               {}
+func (*PaymentSummary) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{105} }
+
+func (m *PaymentSummary) GetPaymentHash() string {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return ""
+}
+
+func (m *PaymentSummary) GetType() Payment_PaymentType {
+	if m != nil {
+		return m.Type
+	}
+	return Payment_DEPOSIT
+}
+
+func (m *PaymentSummary) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *PaymentSummary) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *PaymentSummary) GetCreationTimestamp() int64 {
+	if m != nil {
+		return m.CreationTimestamp
+	}
+	return 0
+}
+
+type PaymentsSnapshot struct {
+	Payments         []*PaymentSummary `protobuf:"bytes,1,rep,name=payments" json:"payments,omitempty"`
+	UpdatedTimestamp int64             `protobuf:"varint,2,opt,name=updatedTimestamp" json:"updatedTimestamp,omitempty"`
+}
+
+func (m *PaymentsSnapshot) Reset()                    { *m = PaymentsSnapshot{} }
+func (m *PaymentsSnapshot) String() string            { return proto.CompactTextString(m) }
+func (*PaymentsSnapshot) ProtoMessage()               {}
+func (*PaymentsSnapshot) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{106} }
+
+func (m *PaymentsSnapshot) GetPayments() []*PaymentSummary {
+	if m != nil {
+		return m.Payments
+	}
+	return nil
+}
+
+func (m *PaymentsSnapshot) GetUpdatedTimestamp() int64 {
+	if m != nil {
+		return m.UpdatedTimestamp
+	}
+	return 0
+}
+
+type CreatePaymentCodeRequest struct {
+	Description   string `protobuf:"bytes,1,opt,name=description" json:"description,omitempty"`
+	Amount        int64  `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
+	PoolSize      int32  `protobuf:"varint,3,opt,name=poolSize" json:"poolSize,omitempty"`
+	InvoiceExpiry int64  `protobuf:"varint,4,opt,name=invoiceExpiry" json:"invoiceExpiry,omitempty"`
+}
+
+func (m *CreatePaymentCodeRequest) Reset()                    { *m = CreatePaymentCodeRequest{} }
+func (m *CreatePaymentCodeRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreatePaymentCodeRequest) ProtoMessage()               {}
+func (*CreatePaymentCodeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{107} }
+
+func (m *CreatePaymentCodeRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CreatePaymentCodeRequest) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *CreatePaymentCodeRequest) GetPoolSize() int32 {
+	if m != nil {
+		return m.PoolSize
+	}
+	return 0
+}
+
+func (m *CreatePaymentCodeRequest) GetInvoiceExpiry() int64 {
+	if m != nil {
+		return m.InvoiceExpiry
+	}
+	return 0
+}
+
+type PaymentCode struct {
+	Id                string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Description       string `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	Amount            int64  `protobuf:"varint,3,opt,name=amount" json:"amount,omitempty"`
+	PoolSize          int32  `protobuf:"varint,4,opt,name=poolSize" json:"poolSize,omitempty"`
+	InvoiceExpiry     int64  `protobuf:"varint,5,opt,name=invoiceExpiry" json:"invoiceExpiry,omitempty"`
+	CreationTimestamp int64  `protobuf:"varint,6,opt,name=creationTimestamp" json:"creationTimestamp,omitempty"`
+	AvailableInvoices int32  `protobuf:"varint,7,opt,name=availableInvoices" json:"availableInvoices,omitempty"`
+}
+
+func (m *PaymentCode) Reset()                    { *m = PaymentCode{} }
+func (m *PaymentCode) String() string            { return proto.CompactTextString(m) }
+func (*PaymentCode) ProtoMessage()               {}
+func (*PaymentCode) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{108} }
+
+func (m *PaymentCode) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PaymentCode) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *PaymentCode) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *PaymentCode) GetPoolSize() int32 {
+	if m != nil {
+		return m.PoolSize
+	}
+	return 0
+}
+
+func (m *PaymentCode) GetInvoiceExpiry() int64 {
+	if m != nil {
+		return m.InvoiceExpiry
+	}
+	return 0
+}
+
+func (m *PaymentCode) GetCreationTimestamp() int64 {
+	if m != nil {
+		return m.CreationTimestamp
+	}
+	return 0
+}
+
+func (m *PaymentCode) GetAvailableInvoices() int32 {
+	if m != nil {
+		return m.AvailableInvoices
+	}
+	return 0
+}
+
+type PaymentCodes struct {
+	Codes []*PaymentCode `protobuf:"bytes,1,rep,name=codes" json:"codes,omitempty"`
+}
+
+func (m *PaymentCodes) Reset()                    { *m = PaymentCodes{} }
+func (m *PaymentCodes) String() string            { return proto.CompactTextString(m) }
+func (*PaymentCodes) ProtoMessage()               {}
+func (*PaymentCodes) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{109} }
+
+func (m *PaymentCodes) GetCodes() []*PaymentCode {
+	if m != nil {
+		return m.Codes
+	}
+	return nil
+}
+
+type PaymentURI struct {
+	Address        string       `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	Amount         int64        `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
+	Label          string       `protobuf:"bytes,3,opt,name=label" json:"label,omitempty"`
+	Message        string       `protobuf:"bytes,4,opt,name=message" json:"message,omitempty"`
+	PaymentRequest string       `protobuf:"bytes,5,opt,name=paymentRequest" json:"paymentRequest,omitempty"`
+	InvoiceMemo    *InvoiceMemo `protobuf:"bytes,6,opt,name=invoiceMemo" json:"invoiceMemo,omitempty"`
+}
+
+func (m *PaymentURI) Reset()                    { *m = PaymentURI{} }
+func (m *PaymentURI) String() string            { return proto.CompactTextString(m) }
+func (*PaymentURI) ProtoMessage()               {}
+func (*PaymentURI) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{110} }
+
+func (m *PaymentURI) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PaymentURI) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *PaymentURI) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *PaymentURI) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *PaymentURI) GetPaymentRequest() string {
+	if m != nil {
+		return m.PaymentRequest
+	}
+	return ""
+}
+
+func (m *PaymentURI) GetInvoiceMemo() *InvoiceMemo {
+	if m != nil {
+		return m.InvoiceMemo
+	}
+	return nil
+}
+
+type SuggestedAmountsRequest struct {
+	Screen       SuggestedAmountsRequest_Screen `protobuf:"varint,1,opt,name=screen,enum=data.SuggestedAmountsRequest_Screen" json:"screen,omitempty"`
+	Counterparty string                         `protobuf:"bytes,2,opt,name=counterparty" json:"counterparty,omitempty"`
+	Limit        int32                          `protobuf:"varint,3,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *SuggestedAmountsRequest) Reset()                    { *m = SuggestedAmountsRequest{} }
+func (m *SuggestedAmountsRequest) String() string            { return proto.CompactTextString(m) }
+func (*SuggestedAmountsRequest) ProtoMessage()               {}
+func (*SuggestedAmountsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{111} }
+
+func (m *SuggestedAmountsRequest) GetScreen() SuggestedAmountsRequest_Screen {
+	if m != nil {
+		return m.Screen
+	}
+	return SuggestedAmountsRequest_SEND
+}
+
+func (m *SuggestedAmountsRequest) GetCounterparty() string {
+	if m != nil {
+		return m.Counterparty
+	}
+	return ""
+}
+
+func (m *SuggestedAmountsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type SuggestedAmounts struct {
+	Amounts    []int64 `protobuf:"varint,1,rep,packed,name=amounts" json:"amounts,omitempty"`
+	LastAmount int64   `protobuf:"varint,2,opt,name=lastAmount" json:"lastAmount,omitempty"`
+}
+
+func (m *SuggestedAmounts) Reset()                    { *m = SuggestedAmounts{} }
+func (m *SuggestedAmounts) String() string            { return proto.CompactTextString(m) }
+func (*SuggestedAmounts) ProtoMessage()               {}
+func (*SuggestedAmounts) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{112} }
+
+func (m *SuggestedAmounts) GetAmounts() []int64 {
+	if m != nil {
+		return m.Amounts
+	}
+	return nil
+}
+
+func (m *SuggestedAmounts) GetLastAmount() int64 {
+	if m != nil {
+		return m.LastAmount
+	}
+	return 0
+}
+
+type PaymentIntent struct {
+	PaymentHash       string `protobuf:"bytes,1,opt,name=paymentHash" json:"paymentHash,omitempty"`
+	PaymentRequest    string `protobuf:"bytes,2,opt,name=paymentRequest" json:"paymentRequest,omitempty"`
+	Amount            int64  `protobuf:"varint,3,opt,name=amount" json:"amount,omitempty"`
+	CreationTimestamp int64  `protobuf:"varint,4,opt,name=creationTimestamp" json:"creationTimestamp,omitempty"`
+}
+
+func (m *PaymentIntent) Reset()                    { *m = PaymentIntent{} }
+func (m *PaymentIntent) String() string            { return proto.CompactTextString(m) }
+func (*PaymentIntent) ProtoMessage()               {}
+func (*PaymentIntent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{113} }
+
+func (m *PaymentIntent) GetPaymentHash() string {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return ""
+}
+
+func (m *PaymentIntent) GetPaymentRequest() string {
+	if m != nil {
+		return m.PaymentRequest
+	}
+	return ""
+}
+
+func (m *PaymentIntent) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *PaymentIntent) GetCreationTimestamp() int64 {
+	if m != nil {
+		return m.CreationTimestamp
+	}
+	return 0
+}
+
+type PaymentIntents struct {
+	Intents []*PaymentIntent `protobuf:"bytes,1,rep,name=intents" json:"intents,omitempty"`
+}
+
+func (m *PaymentIntents) Reset()                    { *m = PaymentIntents{} }
+func (m *PaymentIntents) String() string            { return proto.CompactTextString(m) }
+func (*PaymentIntents) ProtoMessage()               {}
+func (*PaymentIntents) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{114} }
+
+func (m *PaymentIntents) GetIntents() []*PaymentIntent {
+	if m != nil {
+		return m.Intents
+	}
+	return nil
+}
+
+type SpendingPolicy struct {
+	MaxPaymentAmount       int64 `protobuf:"varint,1,opt,name=maxPaymentAmount" json:"maxPaymentAmount,omitempty"`
+	DailyBudget            int64 `protobuf:"varint,2,opt,name=dailyBudget" json:"dailyBudget,omitempty"`
+	AuthorizationThreshold int64 `protobuf:"varint,3,opt,name=authorizationThreshold" json:"authorizationThreshold,omitempty"`
+	SpentToday             int64 `protobuf:"varint,4,opt,name=spentToday" json:"spentToday,omitempty"`
+}
+
+func (m *SpendingPolicy) Reset()                    { *m = SpendingPolicy{} }
+func (m *SpendingPolicy) String() string            { return proto.CompactTextString(m) }
+func (*SpendingPolicy) ProtoMessage()               {}
+func (*SpendingPolicy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{115} }
+
+func (m *SpendingPolicy) GetMaxPaymentAmount() int64 {
+	if m != nil {
+		return m.MaxPaymentAmount
+	}
+	return 0
+}
+
+func (m *SpendingPolicy) GetDailyBudget() int64 {
+	if m != nil {
+		return m.DailyBudget
+	}
+	return 0
+}
+
+func (m *SpendingPolicy) GetAuthorizationThreshold() int64 {
+	if m != nil {
+		return m.AuthorizationThreshold
+	}
+	return 0
+}
+
+func (m *SpendingPolicy) GetSpentToday() int64 {
+	if m != nil {
+		return m.SpentToday
+	}
+	return 0
+}
+
+type PaymentAuthorizationRequest struct {
+	PaymentHash string                    `protobuf:"bytes,1,opt,name=paymentHash" json:"paymentHash,omitempty"`
+	Destination string                    `protobuf:"bytes,2,opt,name=destination" json:"destination,omitempty"`
+	Description string                    `protobuf:"bytes,3,opt,name=description" json:"description,omitempty"`
+	Amount      int64                     `protobuf:"varint,4,opt,name=amount" json:"amount,omitempty"`
+	SpentToday  int64                     `protobuf:"varint,5,opt,name=spentToday" json:"spentToday,omitempty"`
+	Initiator   SpendAuditEntry_Initiator `protobuf:"varint,6,opt,name=initiator,enum=data.SpendAuditEntry_Initiator" json:"initiator,omitempty"`
+}
+
+func (m *PaymentAuthorizationRequest) Reset()                    { *m = PaymentAuthorizationRequest{} }
+func (m *PaymentAuthorizationRequest) String() string            { return proto.CompactTextString(m) }
+func (*PaymentAuthorizationRequest) ProtoMessage()               {}
+func (*PaymentAuthorizationRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{116} }
+
+func (m *PaymentAuthorizationRequest) GetPaymentHash() string {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return ""
+}
+
+func (m *PaymentAuthorizationRequest) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *PaymentAuthorizationRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *PaymentAuthorizationRequest) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *PaymentAuthorizationRequest) GetSpentToday() int64 {
+	if m != nil {
+		return m.SpentToday
+	}
+	return 0
+}
+
+func (m *PaymentAuthorizationRequest) GetInitiator() SpendAuditEntry_Initiator {
+	if m != nil {
+		return m.Initiator
+	}
+	return SpendAuditEntry_UI
+}
+
+type Webhook struct {
+	Id                uint64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Url               string `protobuf:"bytes,2,opt,name=url" json:"url,omitempty"`
+	PaymentHash       string `protobuf:"bytes,3,opt,name=paymentHash" json:"paymentHash,omitempty"`
+	Secret            string `protobuf:"bytes,4,opt,name=secret" json:"secret,omitempty"`
+	CreationTimestamp int64  `protobuf:"varint,5,opt,name=creationTimestamp" json:"creationTimestamp,omitempty"`
+}
+
+func (m *Webhook) Reset()                    { *m = Webhook{} }
+func (m *Webhook) String() string            { return proto.CompactTextString(m) }
+func (*Webhook) ProtoMessage()               {}
+func (*Webhook) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{117} }
+
+func (m *Webhook) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Webhook) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Webhook) GetPaymentHash() string {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return ""
+}
+
+func (m *Webhook) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *Webhook) GetCreationTimestamp() int64 {
+	if m != nil {
+		return m.CreationTimestamp
+	}
+	return 0
+}
+
+type Webhooks struct {
+	Webhooks []*Webhook `protobuf:"bytes,1,rep,name=webhooks" json:"webhooks,omitempty"`
+}
+
+func (m *Webhooks) Reset()                    { *m = Webhooks{} }
+func (m *Webhooks) String() string            { return proto.CompactTextString(m) }
+func (*Webhooks) ProtoMessage()               {}
+func (*Webhooks) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{118} }
+
+func (m *Webhooks) GetWebhooks() []*Webhook {
+	if m != nil {
+		return m.Webhooks
+	}
+	return nil
+}
+
+type WebhookDelivery struct {
+	Id                   uint64                 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	WebhookID            uint64                 `protobuf:"varint,2,opt,name=webhookID" json:"webhookID,omitempty"`
+	Url                  string                 `protobuf:"bytes,3,opt,name=url" json:"url,omitempty"`
+	PaymentHash          string                 `protobuf:"bytes,4,opt,name=paymentHash" json:"paymentHash,omitempty"`
+	Status               WebhookDelivery_Status `protobuf:"varint,5,opt,name=status,enum=data.WebhookDelivery_Status" json:"status,omitempty"`
+	Attempts             int32                  `protobuf:"varint,6,opt,name=attempts" json:"attempts,omitempty"`
+	LastAttemptTimestamp int64                  `protobuf:"varint,7,opt,name=lastAttemptTimestamp" json:"lastAttemptTimestamp,omitempty"`
+	NextAttemptTimestamp int64                  `protobuf:"varint,8,opt,name=nextAttemptTimestamp" json:"nextAttemptTimestamp,omitempty"`
+	LastError            string                 `protobuf:"bytes,9,opt,name=lastError" json:"lastError,omitempty"`
+}
+
+func (m *WebhookDelivery) Reset()                    { *m = WebhookDelivery{} }
+func (m *WebhookDelivery) String() string            { return proto.CompactTextString(m) }
+func (*WebhookDelivery) ProtoMessage()               {}
+func (*WebhookDelivery) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{119} }
+
+func (m *WebhookDelivery) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *WebhookDelivery) GetWebhookID() uint64 {
+	if m != nil {
+		return m.WebhookID
+	}
+	return 0
+}
+
+func (m *WebhookDelivery) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *WebhookDelivery) GetPaymentHash() string {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return ""
+}
+
+func (m *WebhookDelivery) GetStatus() WebhookDelivery_Status {
+	if m != nil {
+		return m.Status
+	}
+	return WebhookDelivery_PENDING
+}
+
+func (m *WebhookDelivery) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *WebhookDelivery) GetLastAttemptTimestamp() int64 {
+	if m != nil {
+		return m.LastAttemptTimestamp
+	}
+	return 0
+}
+
+func (m *WebhookDelivery) GetNextAttemptTimestamp() int64 {
+	if m != nil {
+		return m.NextAttemptTimestamp
+	}
+	return 0
+}
+
+func (m *WebhookDelivery) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+type WebhookDeliveries struct {
+	Deliveries []*WebhookDelivery `protobuf:"bytes,1,rep,name=deliveries" json:"deliveries,omitempty"`
+}
+
+func (m *WebhookDeliveries) Reset()                    { *m = WebhookDeliveries{} }
+func (m *WebhookDeliveries) String() string            { return proto.CompactTextString(m) }
+func (*WebhookDeliveries) ProtoMessage()               {}
+func (*WebhookDeliveries) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{120} }
+
+func (m *WebhookDeliveries) GetDeliveries() []*WebhookDelivery {
+	if m != nil {
+		return m.Deliveries
+	}
+	return nil
+}
+
+type WakeupResult struct {
+	SettledPaymentHashes []string `protobuf:"bytes,1,rep,name=settledPaymentHashes" json:"settledPaymentHashes,omitempty"`
+	TimedOut             bool     `protobuf:"varint,2,opt,name=timedOut" json:"timedOut,omitempty"`
+}
+
+func (m *WakeupResult) Reset()                    { *m = WakeupResult{} }
+func (m *WakeupResult) String() string            { return proto.CompactTextString(m) }
+func (*WakeupResult) ProtoMessage()               {}
+func (*WakeupResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{121} }
+
+func (m *WakeupResult) GetSettledPaymentHashes() []string {
+	if m != nil {
+		return m.SettledPaymentHashes
+	}
+	return nil
+}
+
+func (m *WakeupResult) GetTimedOut() bool {
+	if m != nil {
+		return m.TimedOut
+	}
+	return false
+}
+
+type DeviceBackupManifest struct {
+	BackupID    string `protobuf:"bytes,1,opt,name=backupID" json:"backupID,omitempty"`
+	Key         []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	ChunksCount int32  `protobuf:"varint,3,opt,name=chunksCount" json:"chunksCount,omitempty"`
+	Timestamp   int64  `protobuf:"varint,4,opt,name=timestamp" json:"timestamp,omitempty"`
+}
+
+func (m *DeviceBackupManifest) Reset()                    { *m = DeviceBackupManifest{} }
+func (m *DeviceBackupManifest) String() string            { return proto.CompactTextString(m) }
+func (*DeviceBackupManifest) ProtoMessage()               {}
+func (*DeviceBackupManifest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{122} }
+
+func (m *DeviceBackupManifest) GetBackupID() string {
+	if m != nil {
+		return m.BackupID
+	}
+	return ""
+}
+
+func (m *DeviceBackupManifest) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *DeviceBackupManifest) GetChunksCount() int32 {
+	if m != nil {
+		return m.ChunksCount
+	}
+	return 0
+}
+
+func (m *DeviceBackupManifest) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type DeviceBackupChunk struct {
+	BackupID string `protobuf:"bytes,1,opt,name=backupID" json:"backupID,omitempty"`
+	Index    int32  `protobuf:"varint,2,opt,name=index" json:"index,omitempty"`
+	Data     []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *DeviceBackupChunk) Reset()                    { *m = DeviceBackupChunk{} }
+func (m *DeviceBackupChunk) String() string            { return proto.CompactTextString(m) }
+func (*DeviceBackupChunk) ProtoMessage()               {}
+func (*DeviceBackupChunk) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{123} }
+
+func (m *DeviceBackupChunk) GetBackupID() string {
+	if m != nil {
+		return m.BackupID
+	}
+	return ""
+}
+
+func (m *DeviceBackupChunk) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *DeviceBackupChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type DeviceBackup struct {
+	SessionID string               `protobuf:"bytes,1,opt,name=sessionID" json:"sessionID,omitempty"`
+	Manifest  string               `protobuf:"bytes,2,opt,name=manifest" json:"manifest,omitempty"`
+	Chunks    []*DeviceBackupChunk `protobuf:"bytes,3,rep,name=chunks" json:"chunks,omitempty"`
+}
+
+func (m *DeviceBackup) Reset()                    { *m = DeviceBackup{} }
+func (m *DeviceBackup) String() string            { return proto.CompactTextString(m) }
+func (*DeviceBackup) ProtoMessage()               {}
+func (*DeviceBackup) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{124} }
+
+func (m *DeviceBackup) GetSessionID() string {
+	if m != nil {
+		return m.SessionID
+	}
+	return ""
+}
+
+func (m *DeviceBackup) GetManifest() string {
 	if m != nil {
-		return m.SessionID
+		return m.Manifest
 	}
 	return ""
 }
 
-func (m *CreateRatchetSessionRequest) GetExpiry() uint64 {
+func (m *DeviceBackup) GetChunks() []*DeviceBackupChunk {
 	if m != nil {
-		return m.Expiry
+		return m.Chunks
 	}
-	return 0
+	return nil
 }
 
-type CreateRatchetSessionReply struct {
-	SessionID string `protobuf:"bytes,1,opt,name=sessionID" json:"sessionID,omitempty"`
-	Secret    string `protobuf:"bytes,2,opt,name=secret" json:"secret,omitempty"`
-	PubKey    string `protobuf:"bytes,3,opt,name=pubKey" json:"pubKey,omitempty"`
+type DeviceBackupStatus struct {
+	SessionID             string `protobuf:"bytes,1,opt,name=sessionID" json:"sessionID,omitempty"`
+	BackupID              string `protobuf:"bytes,2,opt,name=backupID" json:"backupID,omitempty"`
+	ChunksCount           int32  `protobuf:"varint,3,opt,name=chunksCount" json:"chunksCount,omitempty"`
+	AckedChunks           int32  `protobuf:"varint,4,opt,name=ackedChunks" json:"ackedChunks,omitempty"`
+	BackupTimestamp       int64  `protobuf:"varint,5,opt,name=backupTimestamp" json:"backupTimestamp,omitempty"`
+	LastAckTimestamp      int64  `protobuf:"varint,6,opt,name=lastAckTimestamp" json:"lastAckTimestamp,omitempty"`
+	LastCompleteTimestamp int64  `protobuf:"varint,7,opt,name=lastCompleteTimestamp" json:"lastCompleteTimestamp,omitempty"`
+	Stale                 bool   `protobuf:"varint,8,opt,name=stale" json:"stale,omitempty"`
 }
 
-func (m *CreateRatchetSessionReply) Reset()                    { *m = CreateRatchetSessionReply{} }
-func (m *CreateRatchetSessionReply) String() string            { return proto.CompactTextString(m) }
-func (*CreateRatchetSessionReply) ProtoMessage()               {}
-func (*CreateRatchetSessionReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (m *DeviceBackupStatus) Reset()                    { *m = DeviceBackupStatus{} }
+func (m *DeviceBackupStatus) String() string            { return proto.CompactTextString(m) }
+func (*DeviceBackupStatus) ProtoMessage()               {}
+func (*DeviceBackupStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{125} }
 
-func (m *CreateRatchetSessionReply) GetSessionID() string {
+func (m *DeviceBackupStatus) GetSessionID() string {
 	if m != nil {
 		return m.SessionID
 	}
 	return ""
 }
 
-func (m *CreateRatchetSessionReply) GetSecret() string {
+func (m *DeviceBackupStatus) GetBackupID() string {
 	if m != nil {
-		return m.Secret
+		return m.BackupID
 	}
 	return ""
 }
 
-func (m *CreateRatchetSessionReply) GetPubKey() string {
+func (m *DeviceBackupStatus) GetChunksCount() int32 {
 	if m != nil {
-		return m.PubKey
+		return m.ChunksCount
 	}
-	return ""
+	return 0
 }
 
-type RatchetSessionInfoReply struct {
-	SessionID string `protobuf:"bytes,1,opt,name=sessionID" json:"sessionID,omitempty"`
-	Initiated bool   `protobuf:"varint,2,opt,name=initiated" json:"initiated,omitempty"`
-	UserInfo  string `protobuf:"bytes,3,opt,name=userInfo" json:"userInfo,omitempty"`
+func (m *DeviceBackupStatus) GetAckedChunks() int32 {
+	if m != nil {
+		return m.AckedChunks
+	}
+	return 0
 }
 
-func (m *RatchetSessionInfoReply) Reset()                    { *m = RatchetSessionInfoReply{} }
-func (m *RatchetSessionInfoReply) String() string            { return proto.CompactTextString(m) }
-func (*RatchetSessionInfoReply) ProtoMessage()               {}
-func (*RatchetSessionInfoReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (m *DeviceBackupStatus) GetBackupTimestamp() int64 {
+	if m != nil {
+		return m.BackupTimestamp
+	}
+	return 0
+}
 
-func (m *RatchetSessionInfoReply) GetSessionID() string {
+func (m *DeviceBackupStatus) GetLastAckTimestamp() int64 {
 	if m != nil {
-		return m.SessionID
+		return m.LastAckTimestamp
 	}
-	return ""
+	return 0
 }
 
-func (m *RatchetSessionInfoReply) GetInitiated() bool {
+func (m *DeviceBackupStatus) GetLastCompleteTimestamp() int64 {
 	if m != nil {
-		return m.Initiated
+		return m.LastCompleteTimestamp
+	}
+	return 0
+}
+
+func (m *DeviceBackupStatus) GetStale() bool {
+	if m != nil {
+		return m.Stale
 	}
 	return false
 }
 
-func (m *RatchetSessionInfoReply) GetUserInfo() string {
+type HubPolicy struct {
+	ChanId           uint64 `protobuf:"varint,1,opt,name=chanId" json:"chanId,omitempty"`
+	FeeBaseMsat      int64  `protobuf:"varint,2,opt,name=feeBaseMsat" json:"feeBaseMsat,omitempty"`
+	FeeRateMilliMsat int64  `protobuf:"varint,3,opt,name=feeRateMilliMsat" json:"feeRateMilliMsat,omitempty"`
+	MinHtlc          int64  `protobuf:"varint,4,opt,name=minHtlc" json:"minHtlc,omitempty"`
+	TimeLockDelta    uint32 `protobuf:"varint,5,opt,name=timeLockDelta" json:"timeLockDelta,omitempty"`
+	Disabled         bool   `protobuf:"varint,6,opt,name=disabled" json:"disabled,omitempty"`
+	Timestamp        int64  `protobuf:"varint,7,opt,name=timestamp" json:"timestamp,omitempty"`
+}
+
+func (m *HubPolicy) Reset()                    { *m = HubPolicy{} }
+func (m *HubPolicy) String() string            { return proto.CompactTextString(m) }
+func (*HubPolicy) ProtoMessage()               {}
+func (*HubPolicy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{126} }
+
+func (m *HubPolicy) GetChanId() uint64 {
 	if m != nil {
-		return m.UserInfo
+		return m.ChanId
 	}
-	return ""
+	return 0
 }
 
-type RatchetSessionSetInfoRequest struct {
-	SessionID string `protobuf:"bytes,1,opt,name=sessionID" json:"sessionID,omitempty"`
-	UserInfo  string `protobuf:"bytes,2,opt,name=userInfo" json:"userInfo,omitempty"`
+func (m *HubPolicy) GetFeeBaseMsat() int64 {
+	if m != nil {
+		return m.FeeBaseMsat
+	}
+	return 0
 }
 
-func (m *RatchetSessionSetInfoRequest) Reset()                    { *m = RatchetSessionSetInfoRequest{} }
-func (m *RatchetSessionSetInfoRequest) String() string            { return proto.CompactTextString(m) }
-func (*RatchetSessionSetInfoRequest) ProtoMessage()               {}
-func (*RatchetSessionSetInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (m *HubPolicy) GetFeeRateMilliMsat() int64 {
+	if m != nil {
+		return m.FeeRateMilliMsat
+	}
+	return 0
+}
 
-func (m *RatchetSessionSetInfoRequest) GetSessionID() string {
+func (m *HubPolicy) GetMinHtlc() int64 {
 	if m != nil {
-		return m.SessionID
+		return m.MinHtlc
 	}
-	return ""
+	return 0
 }
 
-func (m *RatchetSessionSetInfoRequest) GetUserInfo() string {
+func (m *HubPolicy) GetTimeLockDelta() uint32 {
 	if m != nil {
-		return m.UserInfo
+		return m.TimeLockDelta
 	}
-	return ""
+	return 0
 }
 
-type RatchetEncryptRequest struct {
-	SessionID string `protobuf:"bytes,1,opt,name=sessionID" json:"sessionID,omitempty"`
-	Message   string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
+func (m *HubPolicy) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
 }
 
-func (m *RatchetEncryptRequest) Reset()                    { *m = RatchetEncryptRequest{} }
-func (m *RatchetEncryptRequest) String() string            { return proto.CompactTextString(m) }
-func (*RatchetEncryptRequest) ProtoMessage()               {}
-func (*RatchetEncryptRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (m *HubPolicy) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
 
-func (m *RatchetEncryptRequest) GetSessionID() string {
+type MaintenanceTaskResult struct {
+	Name       string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Ok         bool   `protobuf:"varint,2,opt,name=ok" json:"ok,omitempty"`
+	Result     string `protobuf:"bytes,3,opt,name=result" json:"result,omitempty"`
+	Error      string `protobuf:"bytes,4,opt,name=error" json:"error,omitempty"`
+	DurationMs int64  `protobuf:"varint,5,opt,name=durationMs" json:"durationMs,omitempty"`
+}
+
+func (m *MaintenanceTaskResult) Reset()                    { *m = MaintenanceTaskResult{} }
+func (m *MaintenanceTaskResult) String() string            { return proto.CompactTextString(m) }
+func (*MaintenanceTaskResult) ProtoMessage()               {}
+func (*MaintenanceTaskResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{127} }
+
+func (m *MaintenanceTaskResult) GetName() string {
 	if m != nil {
-		return m.SessionID
+		return m.Name
 	}
 	return ""
 }
 
-func (m *RatchetEncryptRequest) GetMessage() string {
+func (m *MaintenanceTaskResult) GetOk() bool {
 	if m != nil {
-		return m.Message
+		return m.Ok
+	}
+	return false
+}
+
+func (m *MaintenanceTaskResult) GetResult() string {
+	if m != nil {
+		return m.Result
 	}
 	return ""
 }
 
-type RatchetDecryptRequest struct {
-	SessionID        string `protobuf:"bytes,1,opt,name=sessionID" json:"sessionID,omitempty"`
-	EncryptedMessage string `protobuf:"bytes,2,opt,name=encryptedMessage" json:"encryptedMessage,omitempty"`
+func (m *MaintenanceTaskResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
 
-func (m *RatchetDecryptRequest) Reset()                    { *m = RatchetDecryptRequest{} }
-func (m *RatchetDecryptRequest) String() string            { return proto.CompactTextString(m) }
-func (*RatchetDecryptRequest) ProtoMessage()               {}
-func (*RatchetDecryptRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (m *MaintenanceTaskResult) GetDurationMs() int64 {
+	if m != nil {
+		return m.DurationMs
+	}
+	return 0
+}
 
-func (m *RatchetDecryptRequest) GetSessionID() string {
+type MaintenanceReport struct {
+	StartTime int64                    `protobuf:"varint,1,opt,name=startTime" json:"startTime,omitempty"`
+	EndTime   int64                    `protobuf:"varint,2,opt,name=endTime" json:"endTime,omitempty"`
+	Forced    bool                     `protobuf:"varint,3,opt,name=forced" json:"forced,omitempty"`
+	Tasks     []*MaintenanceTaskResult `protobuf:"bytes,4,rep,name=tasks" json:"tasks,omitempty"`
+}
+
+func (m *MaintenanceReport) Reset()                    { *m = MaintenanceReport{} }
+func (m *MaintenanceReport) String() string            { return proto.CompactTextString(m) }
+func (*MaintenanceReport) ProtoMessage()               {}
+func (*MaintenanceReport) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{128} }
+
+func (m *MaintenanceReport) GetStartTime() int64 {
 	if m != nil {
-		return m.SessionID
+		return m.StartTime
 	}
-	return ""
+	return 0
 }
 
-func (m *RatchetDecryptRequest) GetEncryptedMessage() string {
+func (m *MaintenanceReport) GetEndTime() int64 {
 	if m != nil {
-		return m.EncryptedMessage
+		return m.EndTime
 	}
-	return ""
+	return 0
 }
 
-type BootstrapFilesRequest struct {
-	WorkingDir string   `protobuf:"bytes,1,opt,name=WorkingDir" json:"WorkingDir,omitempty"`
-	FullPaths  []string `protobuf:"bytes,2,rep,name=FullPaths" json:"FullPaths,omitempty"`
+func (m *MaintenanceReport) GetForced() bool {
+	if m != nil {
+		return m.Forced
+	}
+	return false
 }
 
-func (m *BootstrapFilesRequest) Reset()                    { *m = BootstrapFilesRequest{} }
-func (m *BootstrapFilesRequest) String() string            { return proto.CompactTextString(m) }
-func (*BootstrapFilesRequest) ProtoMessage()               {}
-func (*BootstrapFilesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (m *MaintenanceReport) GetTasks() []*MaintenanceTaskResult {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
 
-func (m *BootstrapFilesRequest) GetWorkingDir() string {
+type Empty struct {
+}
+
+func (m *Empty) Reset()                    { *m = Empty{} }
+func (m *Empty) String() string            { return proto.CompactTextString(m) }
+func (*Empty) ProtoMessage()               {}
+func (*Empty) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{129} }
+
+type PaymentRequest struct {
+	PaymentRequest string `protobuf:"bytes,1,opt,name=paymentRequest" json:"paymentRequest,omitempty"`
+}
+
+func (m *PaymentRequest) Reset()                    { *m = PaymentRequest{} }
+func (m *PaymentRequest) String() string            { return proto.CompactTextString(m) }
+func (*PaymentRequest) ProtoMessage()               {}
+func (*PaymentRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{130} }
+
+func (m *PaymentRequest) GetPaymentRequest() string {
 	if m != nil {
-		return m.WorkingDir
+		return m.PaymentRequest
 	}
 	return ""
 }
 
-func (m *BootstrapFilesRequest) GetFullPaths() []string {
+type SubscribeNotificationsRequest struct {
+	Types []NotificationEvent_NotificationType `protobuf:"varint,1,rep,packed,name=types,enum=data.NotificationEvent_NotificationType" json:"types,omitempty"`
+	Ack   bool                                 `protobuf:"varint,2,opt,name=ack" json:"ack,omitempty"`
+}
+
+func (m *SubscribeNotificationsRequest) Reset()         { *m = SubscribeNotificationsRequest{} }
+func (m *SubscribeNotificationsRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeNotificationsRequest) ProtoMessage()    {}
+func (*SubscribeNotificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{131}
+}
+
+func (m *SubscribeNotificationsRequest) GetTypes() []NotificationEvent_NotificationType {
 	if m != nil {
-		return m.FullPaths
+		return m.Types
 	}
 	return nil
 }
 
+func (m *SubscribeNotificationsRequest) GetAck() bool {
+	if m != nil {
+		return m.Ack
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*ChainStatus)(nil), "data.ChainStatus")
 	proto.RegisterType((*Account)(nil), "data.Account")
 	proto.RegisterType((*Payment)(nil), "data.Payment")
+	proto.RegisterType((*FundingSource)(nil), "data.FundingSource")
 	proto.RegisterType((*PaymentsList)(nil), "data.PaymentsList")
+	proto.RegisterType((*PaymentsPageRequest)(nil), "data.PaymentsPageRequest")
+	proto.RegisterType((*ExportPaymentsRequest)(nil), "data.ExportPaymentsRequest")
+	proto.RegisterType((*PayeeSpending)(nil), "data.PayeeSpending")
+	proto.RegisterType((*SpendingByPayee)(nil), "data.SpendingByPayee")
+	proto.RegisterType((*PaymentsPage)(nil), "data.PaymentsPage")
 	proto.RegisterType((*SendWalletCoinsRequest)(nil), "data.SendWalletCoinsRequest")
 	proto.RegisterType((*PayInvoiceRequest)(nil), "data.PayInvoiceRequest")
+	proto.RegisterType((*FeeLimit)(nil), "data.FeeLimit")
 	proto.RegisterType((*InvoiceMemo)(nil), "data.InvoiceMemo")
+	proto.RegisterType((*TaxInfo)(nil), "data.TaxInfo")
+	proto.RegisterType((*AddInvoiceRequest)(nil), "data.AddInvoiceRequest")
+	proto.RegisterType((*AddInvoiceReply)(nil), "data.AddInvoiceReply")
 	proto.RegisterType((*Invoice)(nil), "data.Invoice")
 	proto.RegisterType((*NotificationEvent)(nil), "data.NotificationEvent")
 	proto.RegisterType((*AddFundInitReply)(nil), "data.AddFundInitReply")
@@ -1004,6 +6187,7 @@ func init() {
 	proto.RegisterType((*RemoveFundReply)(nil), "data.RemoveFundReply")
 	proto.RegisterType((*SwapAddressInfo)(nil), "data.SwapAddressInfo")
 	proto.RegisterType((*SwapAddressList)(nil), "data.SwapAddressList")
+	proto.RegisterType((*SwapLimits)(nil), "data.SwapLimits")
 	proto.RegisterType((*CreateRatchetSessionRequest)(nil), "data.CreateRatchetSessionRequest")
 	proto.RegisterType((*CreateRatchetSessionReply)(nil), "data.CreateRatchetSessionReply")
 	proto.RegisterType((*RatchetSessionInfoReply)(nil), "data.RatchetSessionInfoReply")
@@ -1011,110 +6195,959 @@ func init() {
 	proto.RegisterType((*RatchetEncryptRequest)(nil), "data.RatchetEncryptRequest")
 	proto.RegisterType((*RatchetDecryptRequest)(nil), "data.RatchetDecryptRequest")
 	proto.RegisterType((*BootstrapFilesRequest)(nil), "data.BootstrapFilesRequest")
+	proto.RegisterType((*SavingsInfo)(nil), "data.SavingsInfo")
+	proto.RegisterType((*MoveFundsOperation)(nil), "data.MoveFundsOperation")
+	proto.RegisterType((*MoveFundsOperationsList)(nil), "data.MoveFundsOperationsList")
+	proto.RegisterType((*CloseFeeOption)(nil), "data.CloseFeeOption")
+	proto.RegisterType((*CloseFeeOptions)(nil), "data.CloseFeeOptions")
+	proto.RegisterType((*CloseChannelRequest)(nil), "data.CloseChannelRequest")
+	proto.RegisterType((*ObserverCredential)(nil), "data.ObserverCredential")
+	proto.RegisterType((*ObserverSnapshot)(nil), "data.ObserverSnapshot")
+	proto.RegisterType((*QuarantinedPayment)(nil), "data.QuarantinedPayment")
+	proto.RegisterType((*QuarantinedPaymentsList)(nil), "data.QuarantinedPaymentsList")
+	proto.RegisterType((*ResolveQuarantinedPaymentRequest)(nil), "data.ResolveQuarantinedPaymentRequest")
+	proto.RegisterType((*PairingPermissions)(nil), "data.PairingPermissions")
+	proto.RegisterType((*PairingSession)(nil), "data.PairingSession")
+	proto.RegisterType((*PairingSessionsList)(nil), "data.PairingSessionsList")
+	proto.RegisterType((*StartPairingReply)(nil), "data.StartPairingReply")
+	proto.RegisterType((*PairingRequest)(nil), "data.PairingRequest")
+	proto.RegisterType((*PairingReply)(nil), "data.PairingReply")
+	proto.RegisterType((*SplitRecipient)(nil), "data.SplitRecipient")
+	proto.RegisterType((*SplitForward)(nil), "data.SplitForward")
+	proto.RegisterType((*PaymentSplit)(nil), "data.PaymentSplit")
+	proto.RegisterType((*AddSplitInvoiceRequest)(nil), "data.AddSplitInvoiceRequest")
+	proto.RegisterType((*SettlementRule)(nil), "data.SettlementRule")
+	proto.RegisterType((*SettlementRulesList)(nil), "data.SettlementRulesList")
+	proto.RegisterType((*SettlementAuditEntry)(nil), "data.SettlementAuditEntry")
+	proto.RegisterType((*SettlementAuditList)(nil), "data.SettlementAuditList")
+	proto.RegisterType((*SupportBundle)(nil), "data.SupportBundle")
+	proto.RegisterType((*LNURLPayParams)(nil), "data.LNURLPayParams")
+	proto.RegisterType((*PayLNURLRequest)(nil), "data.PayLNURLRequest")
+	proto.RegisterType((*LightningAddressInvoice)(nil), "data.LightningAddressInvoice")
+	proto.RegisterType((*InvoiceReminderRequest)(nil), "data.InvoiceReminderRequest")
+	proto.RegisterType((*HealthCheckResult)(nil), "data.HealthCheckResult")
+	proto.RegisterType((*HealthStatus)(nil), "data.HealthStatus")
+	proto.RegisterType((*Rate)(nil), "data.Rate")
+	proto.RegisterType((*Rates)(nil), "data.Rates")
+	proto.RegisterType((*FaultInjectionRule)(nil), "data.FaultInjectionRule")
+	proto.RegisterType((*FaultInjectionRules)(nil), "data.FaultInjectionRules")
+	proto.RegisterType((*QueuePaymentRequest)(nil), "data.QueuePaymentRequest")
+	proto.RegisterType((*QueuedPayment)(nil), "data.QueuedPayment")
+	proto.RegisterType((*QueuedPayments)(nil), "data.QueuedPayments")
+	proto.RegisterType((*SendMax)(nil), "data.SendMax")
+	proto.RegisterType((*RouteHop)(nil), "data.RouteHop")
+	proto.RegisterType((*PaymentRoute)(nil), "data.PaymentRoute")
+	proto.RegisterType((*FailedPayment)(nil), "data.FailedPayment")
+	proto.RegisterType((*FailedPayments)(nil), "data.FailedPayments")
+	proto.RegisterType((*DonationCampaign)(nil), "data.DonationCampaign")
+	proto.RegisterType((*DonationCampaigns)(nil), "data.DonationCampaigns")
+	proto.RegisterType((*DonationInvoiceRequest)(nil), "data.DonationInvoiceRequest")
+	proto.RegisterType((*DonationContribution)(nil), "data.DonationContribution")
+	proto.RegisterType((*DonationContributions)(nil), "data.DonationContributions")
+	proto.RegisterType((*PaymentStatus)(nil), "data.PaymentStatus")
+	proto.RegisterType((*RetryPolicy)(nil), "data.RetryPolicy")
+	proto.RegisterType((*RetryPaymentRequest)(nil), "data.RetryPaymentRequest")
+	proto.RegisterType((*RatesProvider)(nil), "data.RatesProvider")
+	proto.RegisterType((*RatesProviders)(nil), "data.RatesProviders")
+	proto.RegisterType((*SwapAddressReuse)(nil), "data.SwapAddressReuse")
+	proto.RegisterType((*StatementItem)(nil), "data.StatementItem")
+	proto.RegisterType((*Statement)(nil), "data.Statement")
+	proto.RegisterType((*PaymentFeeEstimate)(nil), "data.PaymentFeeEstimate")
+	proto.RegisterType((*HTLCEvent)(nil), "data.HTLCEvent")
+	proto.RegisterType((*HTLCEvents)(nil), "data.HTLCEvents")
+	proto.RegisterType((*ConsolidateChannelsRequest)(nil), "data.ConsolidateChannelsRequest")
+	proto.RegisterType((*ChannelConsolidationPreview)(nil), "data.ChannelConsolidationPreview")
+	proto.RegisterType((*ChannelConsolidation)(nil), "data.ChannelConsolidation")
+	proto.RegisterType((*ChannelConsolidationsList)(nil), "data.ChannelConsolidationsList")
+	proto.RegisterType((*IssuedInvoice)(nil), "data.IssuedInvoice")
+	proto.RegisterType((*IssuedInvoices)(nil), "data.IssuedInvoices")
+	proto.RegisterType((*SpendPolicyCheck)(nil), "data.SpendPolicyCheck")
+	proto.RegisterType((*SpendAuditEntry)(nil), "data.SpendAuditEntry")
+	proto.RegisterType((*SpendAuditFilter)(nil), "data.SpendAuditFilter")
+	proto.RegisterType((*SpendAuditLog)(nil), "data.SpendAuditLog")
+	proto.RegisterType((*PaymentSummary)(nil), "data.PaymentSummary")
+	proto.RegisterType((*PaymentsSnapshot)(nil), "data.PaymentsSnapshot")
+	proto.RegisterType((*CreatePaymentCodeRequest)(nil), "data.CreatePaymentCodeRequest")
+	proto.RegisterType((*PaymentCode)(nil), "data.PaymentCode")
+	proto.RegisterType((*PaymentCodes)(nil), "data.PaymentCodes")
+	proto.RegisterType((*PaymentURI)(nil), "data.PaymentURI")
+	proto.RegisterType((*SuggestedAmountsRequest)(nil), "data.SuggestedAmountsRequest")
+	proto.RegisterType((*SuggestedAmounts)(nil), "data.SuggestedAmounts")
+	proto.RegisterType((*PaymentIntent)(nil), "data.PaymentIntent")
+	proto.RegisterType((*PaymentIntents)(nil), "data.PaymentIntents")
+	proto.RegisterType((*SpendingPolicy)(nil), "data.SpendingPolicy")
+	proto.RegisterType((*PaymentAuthorizationRequest)(nil), "data.PaymentAuthorizationRequest")
+	proto.RegisterType((*Webhook)(nil), "data.Webhook")
+	proto.RegisterType((*Webhooks)(nil), "data.Webhooks")
+	proto.RegisterType((*WebhookDelivery)(nil), "data.WebhookDelivery")
+	proto.RegisterType((*WebhookDeliveries)(nil), "data.WebhookDeliveries")
+	proto.RegisterType((*WakeupResult)(nil), "data.WakeupResult")
+	proto.RegisterType((*DeviceBackupManifest)(nil), "data.DeviceBackupManifest")
+	proto.RegisterType((*DeviceBackupChunk)(nil), "data.DeviceBackupChunk")
+	proto.RegisterType((*DeviceBackup)(nil), "data.DeviceBackup")
+	proto.RegisterType((*DeviceBackupStatus)(nil), "data.DeviceBackupStatus")
+	proto.RegisterType((*HubPolicy)(nil), "data.HubPolicy")
+	proto.RegisterType((*MaintenanceTaskResult)(nil), "data.MaintenanceTaskResult")
+	proto.RegisterType((*MaintenanceReport)(nil), "data.MaintenanceReport")
+	proto.RegisterType((*Empty)(nil), "data.Empty")
+	proto.RegisterType((*PaymentRequest)(nil), "data.PaymentRequest")
+	proto.RegisterType((*SubscribeNotificationsRequest)(nil), "data.SubscribeNotificationsRequest")
+	proto.RegisterEnum("data.CloseFeeStrategy", CloseFeeStrategy_name, CloseFeeStrategy_value)
+	proto.RegisterEnum("data.QuarantineResolution", QuarantineResolution_name, QuarantineResolution_value)
+	proto.RegisterEnum("data.RedactionLevel", RedactionLevel_name, RedactionLevel_value)
+	proto.RegisterEnum("data.ErrorCode", ErrorCode_name, ErrorCode_value)
 	proto.RegisterEnum("data.Account_AccountStatus", Account_AccountStatus_name, Account_AccountStatus_value)
 	proto.RegisterEnum("data.Payment_PaymentType", Payment_PaymentType_name, Payment_PaymentType_value)
+	proto.RegisterEnum("data.Payment_CloseReason", Payment_CloseReason_name, Payment_CloseReason_value)
 	proto.RegisterEnum("data.NotificationEvent_NotificationType", NotificationEvent_NotificationType_name, NotificationEvent_NotificationType_value)
 	proto.RegisterEnum("data.FundStatusReply_FundStatus", FundStatusReply_FundStatus_name, FundStatusReply_FundStatus_value)
+	proto.RegisterEnum("data.MoveFundsOperation_Direction", MoveFundsOperation_Direction_name, MoveFundsOperation_Direction_value)
+	proto.RegisterEnum("data.MoveFundsOperation_Status", MoveFundsOperation_Status_name, MoveFundsOperation_Status_value)
+	proto.RegisterEnum("data.PairingRequest_Type", PairingRequest_Type_name, PairingRequest_Type_value)
+	proto.RegisterEnum("data.SettlementRule_Action", SettlementRule_Action_name, SettlementRule_Action_value)
+	proto.RegisterEnum("data.FaultInjectionRule_Fault", FaultInjectionRule_Fault_name, FaultInjectionRule_Fault_value)
+	proto.RegisterEnum("data.QueuedPayment_Status", QueuedPayment_Status_name, QueuedPayment_Status_value)
+	proto.RegisterEnum("data.FailedPayment_Reason", FailedPayment_Reason_name, FailedPayment_Reason_value)
+	proto.RegisterEnum("data.FailedPayment_FailureCode", FailedPayment_FailureCode_name, FailedPayment_FailureCode_value)
+	proto.RegisterEnum("data.FailedPayment_Hop", FailedPayment_Hop_name, FailedPayment_Hop_value)
+	proto.RegisterEnum("data.PaymentStatus_Status", PaymentStatus_Status_name, PaymentStatus_Status_value)
+	proto.RegisterEnum("data.HTLCEvent_EventType", HTLCEvent_EventType_name, HTLCEvent_EventType_value)
+	proto.RegisterEnum("data.ChannelConsolidation_Status", ChannelConsolidation_Status_name, ChannelConsolidation_Status_value)
+	proto.RegisterEnum("data.IssuedInvoice_State", IssuedInvoice_State_name, IssuedInvoice_State_value)
+	proto.RegisterEnum("data.SpendAuditEntry_Initiator", SpendAuditEntry_Initiator_name, SpendAuditEntry_Initiator_value)
+	proto.RegisterEnum("data.SpendAuditEntry_Kind", SpendAuditEntry_Kind_name, SpendAuditEntry_Kind_value)
+	proto.RegisterEnum("data.SuggestedAmountsRequest_Screen", SuggestedAmountsRequest_Screen_name, SuggestedAmountsRequest_Screen_value)
+	proto.RegisterEnum("data.WebhookDelivery_Status", WebhookDelivery_Status_name, WebhookDelivery_Status_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for BreezAPI service
+
+type BreezAPIClient interface {
+	GetAccountInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Account, error)
+	GetPayments(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PaymentsList, error)
+	GetPaymentsPage(ctx context.Context, in *PaymentsPageRequest, opts ...grpc.CallOption) (*PaymentsPage, error)
+	SendPaymentForRequest(ctx context.Context, in *PayInvoiceRequest, opts ...grpc.CallOption) (*Empty, error)
+	AddInvoice(ctx context.Context, in *AddInvoiceRequest, opts ...grpc.CallOption) (*AddInvoiceReply, error)
+	DecodePaymentRequest(ctx context.Context, in *PaymentRequest, opts ...grpc.CallOption) (*InvoiceMemo, error)
+	HealthCheck(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*HealthStatus, error)
+	SubscribeNotifications(ctx context.Context, in *SubscribeNotificationsRequest, opts ...grpc.CallOption) (BreezAPI_SubscribeNotificationsClient, error)
+}
+
+type breezAPIClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBreezAPIClient(cc *grpc.ClientConn) BreezAPIClient {
+	return &breezAPIClient{cc}
+}
+
+func (c *breezAPIClient) GetAccountInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := grpc.Invoke(ctx, "/data.BreezAPI/GetAccountInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *breezAPIClient) GetPayments(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PaymentsList, error) {
+	out := new(PaymentsList)
+	err := grpc.Invoke(ctx, "/data.BreezAPI/GetPayments", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *breezAPIClient) GetPaymentsPage(ctx context.Context, in *PaymentsPageRequest, opts ...grpc.CallOption) (*PaymentsPage, error) {
+	out := new(PaymentsPage)
+	err := grpc.Invoke(ctx, "/data.BreezAPI/GetPaymentsPage", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *breezAPIClient) SendPaymentForRequest(ctx context.Context, in *PayInvoiceRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/data.BreezAPI/SendPaymentForRequest", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *breezAPIClient) AddInvoice(ctx context.Context, in *AddInvoiceRequest, opts ...grpc.CallOption) (*AddInvoiceReply, error) {
+	out := new(AddInvoiceReply)
+	err := grpc.Invoke(ctx, "/data.BreezAPI/AddInvoice", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *breezAPIClient) DecodePaymentRequest(ctx context.Context, in *PaymentRequest, opts ...grpc.CallOption) (*InvoiceMemo, error) {
+	out := new(InvoiceMemo)
+	err := grpc.Invoke(ctx, "/data.BreezAPI/DecodePaymentRequest", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *breezAPIClient) HealthCheck(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*HealthStatus, error) {
+	out := new(HealthStatus)
+	err := grpc.Invoke(ctx, "/data.BreezAPI/HealthCheck", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *breezAPIClient) SubscribeNotifications(ctx context.Context, in *SubscribeNotificationsRequest, opts ...grpc.CallOption) (BreezAPI_SubscribeNotificationsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_BreezAPI_serviceDesc.Streams[0], c.cc, "/data.BreezAPI/SubscribeNotifications", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &breezAPISubscribeNotificationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BreezAPI_SubscribeNotificationsClient interface {
+	Recv() (*NotificationEvent, error)
+	grpc.ClientStream
+}
+
+type breezAPISubscribeNotificationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *breezAPISubscribeNotificationsClient) Recv() (*NotificationEvent, error) {
+	m := new(NotificationEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for BreezAPI service
+
+type BreezAPIServer interface {
+	GetAccountInfo(context.Context, *Empty) (*Account, error)
+	GetPayments(context.Context, *Empty) (*PaymentsList, error)
+	GetPaymentsPage(context.Context, *PaymentsPageRequest) (*PaymentsPage, error)
+	SendPaymentForRequest(context.Context, *PayInvoiceRequest) (*Empty, error)
+	AddInvoice(context.Context, *AddInvoiceRequest) (*AddInvoiceReply, error)
+	DecodePaymentRequest(context.Context, *PaymentRequest) (*InvoiceMemo, error)
+	HealthCheck(context.Context, *Empty) (*HealthStatus, error)
+	SubscribeNotifications(*SubscribeNotificationsRequest, BreezAPI_SubscribeNotificationsServer) error
+}
+
+func RegisterBreezAPIServer(s *grpc.Server, srv BreezAPIServer) {
+	s.RegisterService(&_BreezAPI_serviceDesc, srv)
+}
+
+func _BreezAPI_GetAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BreezAPIServer).GetAccountInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/data.BreezAPI/GetAccountInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BreezAPIServer).GetAccountInfo(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BreezAPI_GetPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BreezAPIServer).GetPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/data.BreezAPI/GetPayments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BreezAPIServer).GetPayments(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BreezAPI_GetPaymentsPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentsPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BreezAPIServer).GetPaymentsPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/data.BreezAPI/GetPaymentsPage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BreezAPIServer).GetPaymentsPage(ctx, req.(*PaymentsPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BreezAPI_SendPaymentForRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BreezAPIServer).SendPaymentForRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/data.BreezAPI/SendPaymentForRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BreezAPIServer).SendPaymentForRequest(ctx, req.(*PayInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BreezAPI_AddInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BreezAPIServer).AddInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/data.BreezAPI/AddInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BreezAPIServer).AddInvoice(ctx, req.(*AddInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BreezAPI_DecodePaymentRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BreezAPIServer).DecodePaymentRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/data.BreezAPI/DecodePaymentRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BreezAPIServer).DecodePaymentRequest(ctx, req.(*PaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BreezAPI_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BreezAPIServer).HealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/data.BreezAPI/HealthCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BreezAPIServer).HealthCheck(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BreezAPI_SubscribeNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BreezAPIServer).SubscribeNotifications(m, &breezAPISubscribeNotificationsServer{stream})
+}
+
+type BreezAPI_SubscribeNotificationsServer interface {
+	Send(*NotificationEvent) error
+	grpc.ServerStream
+}
+
+type breezAPISubscribeNotificationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *breezAPISubscribeNotificationsServer) Send(m *NotificationEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _BreezAPI_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "data.BreezAPI",
+	HandlerType: (*BreezAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAccountInfo",
+			Handler:    _BreezAPI_GetAccountInfo_Handler,
+		},
+		{
+			MethodName: "GetPayments",
+			Handler:    _BreezAPI_GetPayments_Handler,
+		},
+		{
+			MethodName: "GetPaymentsPage",
+			Handler:    _BreezAPI_GetPaymentsPage_Handler,
+		},
+		{
+			MethodName: "SendPaymentForRequest",
+			Handler:    _BreezAPI_SendPaymentForRequest_Handler,
+		},
+		{
+			MethodName: "AddInvoice",
+			Handler:    _BreezAPI_AddInvoice_Handler,
+		},
+		{
+			MethodName: "DecodePaymentRequest",
+			Handler:    _BreezAPI_DecodePaymentRequest_Handler,
+		},
+		{
+			MethodName: "HealthCheck",
+			Handler:    _BreezAPI_HealthCheck_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeNotifications",
+			Handler:       _BreezAPI_SubscribeNotifications_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "messages.proto",
 }
 
 func init() { proto.RegisterFile("messages.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1532 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0xcd, 0x6e, 0xdb, 0xc6,
-	0x16, 0x0e, 0x25, 0x59, 0xb2, 0x8e, 0x6c, 0x99, 0x9e, 0x5c, 0x3b, 0x4a, 0x62, 0x24, 0x02, 0x6f,
-	0x6e, 0x60, 0x04, 0xb9, 0xc1, 0xbd, 0xf6, 0x26, 0x8b, 0xa2, 0x00, 0x25, 0x52, 0x31, 0x1b, 0x85,
-	0x12, 0x46, 0x92, 0xdd, 0x66, 0x23, 0x8c, 0xc5, 0xb1, 0x4d, 0x44, 0xfc, 0x09, 0x39, 0x72, 0xac,
-	0x97, 0x68, 0xd1, 0x17, 0xe8, 0x9b, 0x74, 0xdb, 0x6d, 0x57, 0xdd, 0xf6, 0x59, 0x8a, 0x19, 0x0e,
-	0x25, 0x52, 0xb2, 0xdd, 0x74, 0x25, 0xcf, 0x77, 0x0e, 0xbf, 0x73, 0xe6, 0xfc, 0x8e, 0xa1, 0xee,
-	0xd1, 0x38, 0x26, 0x97, 0x34, 0x7e, 0x13, 0x46, 0x01, 0x0b, 0x50, 0xc9, 0x21, 0x8c, 0x68, 0x23,
-	0xa8, 0xb5, 0xaf, 0x88, 0xeb, 0x0f, 0x18, 0x61, 0xb3, 0x18, 0x35, 0xa1, 0x76, 0x3e, 0x0d, 0x26,
-	0x9f, 0x4e, 0xa8, 0x7b, 0x79, 0xc5, 0x1a, 0x4a, 0x53, 0x39, 0xdc, 0xc6, 0x59, 0x08, 0xbd, 0x80,
-	0xed, 0x78, 0xee, 0x4f, 0xa8, 0x33, 0x0c, 0xc4, 0x87, 0x8d, 0x42, 0x53, 0x39, 0xdc, 0xc4, 0x79,
-	0x50, 0xfb, 0xbd, 0x08, 0x15, 0x7d, 0x32, 0x09, 0x66, 0x3e, 0x43, 0x75, 0x28, 0xb8, 0x8e, 0xa0,
-	0xaa, 0xe2, 0x82, 0xeb, 0xa0, 0x06, 0x54, 0xce, 0xc9, 0x94, 0xf8, 0x13, 0x2a, 0xbe, 0x2d, 0xe2,
-	0xf4, 0xc8, 0xb9, 0xbf, 0x90, 0xe9, 0x94, 0xb2, 0x96, 0x94, 0x17, 0x85, 0x3c, 0x0f, 0xa2, 0x63,
-	0x28, 0xc7, 0xc2, 0xdb, 0x46, 0xa9, 0xa9, 0x1c, 0xd6, 0x8f, 0x9e, 0xbe, 0xe1, 0x37, 0x79, 0x23,
-	0xcd, 0xa5, 0xbf, 0xc9, 0x85, 0xb0, 0x54, 0x45, 0xff, 0x83, 0x87, 0x1e, 0xb9, 0xd1, 0xa7, 0xd3,
-	0xe0, 0x0b, 0xf7, 0x12, 0xd3, 0x09, 0x75, 0xaf, 0x69, 0x63, 0x43, 0x18, 0xb8, 0x4d, 0x84, 0x0e,
-	0x61, 0x27, 0x0b, 0xf7, 0xc9, 0xbc, 0x51, 0x16, 0xda, 0xab, 0x30, 0x7a, 0x05, 0xaa, 0x47, 0x6e,
-	0xfa, 0x64, 0xee, 0x51, 0x9f, 0xe9, 0x1e, 0xb7, 0xde, 0xa8, 0x08, 0xd5, 0x35, 0x1c, 0xbd, 0x84,
-	0x7a, 0x14, 0xcc, 0x98, 0xeb, 0x5f, 0xda, 0x81, 0x43, 0x3b, 0x94, 0x36, 0x36, 0x85, 0xe6, 0x0a,
-	0xaa, 0xfd, 0xa8, 0xc0, 0x76, 0xee, 0x26, 0xe8, 0x21, 0xec, 0x9c, 0xe9, 0xd6, 0xd0, 0xb2, 0xdf,
-	0x8d, 0x0d, 0xb3, 0xdf, 0x1b, 0x58, 0x43, 0xf5, 0x01, 0x6a, 0xc2, 0xc1, 0x0a, 0x38, 0x6e, 0xf7,
-	0xec, 0x8e, 0x85, 0x3f, 0xe8, 0x43, 0xab, 0x67, 0xab, 0x0a, 0x7a, 0x0e, 0x4f, 0xfb, 0xb8, 0xd7,
-	0x36, 0x07, 0x03, 0xae, 0xd4, 0xc2, 0xa6, 0xf9, 0x91, 0xab, 0xd8, 0x66, 0x5b, 0x28, 0x14, 0xd0,
-	0x63, 0xd8, 0xcb, 0x28, 0x9c, 0x59, 0xc3, 0x13, 0x03, 0xeb, 0x67, 0x7a, 0x57, 0x2d, 0x22, 0x80,
-	0xb2, 0xde, 0x1e, 0x5a, 0xa7, 0xa6, 0x5a, 0xd2, 0xfe, 0x28, 0x42, 0x45, 0x5e, 0x05, 0xfd, 0x17,
-	0x4a, 0x6c, 0x1e, 0x52, 0x91, 0xd3, 0xfa, 0xd1, 0xe3, 0x24, 0xfe, 0x52, 0x98, 0xfe, 0x0e, 0xe7,
-	0x21, 0xc5, 0x42, 0x0d, 0xed, 0x43, 0x99, 0x24, 0x51, 0x49, 0xf2, 0x29, 0x4f, 0xe8, 0x35, 0xec,
-	0x4e, 0x22, 0x4a, 0x98, 0x1b, 0xf8, 0x43, 0xd7, 0xa3, 0x31, 0x23, 0x5e, 0x28, 0x72, 0x5a, 0xc4,
-	0xeb, 0x02, 0x74, 0x0c, 0x35, 0xd7, 0xbf, 0x0e, 0xdc, 0x09, 0xfd, 0x40, 0xbd, 0x40, 0xe4, 0xa2,
-	0x76, 0xb4, 0x9b, 0xd8, 0xb6, 0x96, 0x02, 0x9c, 0xd5, 0x42, 0xcf, 0x00, 0x22, 0xea, 0x50, 0xea,
-	0x0d, 0x6f, 0x2c, 0x43, 0x24, 0xa5, 0x8a, 0x33, 0x08, 0xaf, 0xf7, 0x30, 0xf1, 0xf7, 0x84, 0xc4,
-	0x57, 0x22, 0x17, 0x55, 0x9c, 0x85, 0xb8, 0x86, 0x43, 0x63, 0xe6, 0xfa, 0xc2, 0x9d, 0x46, 0x35,
-	0xd1, 0xc8, 0x40, 0xe8, 0x2d, 0x3c, 0xea, 0x53, 0xdf, 0x71, 0xfd, 0x4b, 0xf3, 0x26, 0x74, 0x23,
-	0x01, 0xca, 0xfe, 0x01, 0xd1, 0x3f, 0x77, 0x89, 0xd1, 0xb7, 0xf0, 0x64, 0x4d, 0xb4, 0x8c, 0x44,
-	0x4d, 0x44, 0xe2, 0x1e, 0x0d, 0xad, 0x05, 0xb5, 0x4c, 0xb4, 0x51, 0x0d, 0x2a, 0xcb, 0xca, 0xa8,
-	0x03, 0x64, 0x72, 0xa9, 0xa0, 0x4d, 0x28, 0x0d, 0x4c, 0x7b, 0xa8, 0x16, 0xd0, 0x16, 0x6c, 0x62,
-	0xb3, 0x6d, 0x5a, 0xa7, 0xa6, 0xa1, 0x16, 0x35, 0x1d, 0xb6, 0x24, 0x47, 0xdc, 0x75, 0x63, 0x86,
-	0xfe, 0x0f, 0x5b, 0x61, 0xe6, 0xdc, 0x50, 0x9a, 0xc5, 0xc3, 0xda, 0xd1, 0x76, 0x2e, 0xc7, 0x38,
-	0xa7, 0xa2, 0x85, 0xb0, 0x3f, 0xa0, 0xbe, 0x73, 0x26, 0xba, 0xb4, 0x1d, 0xb8, 0x7e, 0x8c, 0xe9,
-	0xe7, 0x19, 0x8d, 0x19, 0x6f, 0x75, 0xe2, 0x38, 0x11, 0x8d, 0x63, 0xd9, 0xff, 0xe9, 0x31, 0x53,
-	0x13, 0x85, 0x5c, 0x4d, 0xf0, 0xf1, 0x42, 0x58, 0x9f, 0x46, 0xad, 0x39, 0x13, 0xed, 0x21, 0x47,
-	0x40, 0x0e, 0xd4, 0x06, 0xb0, 0xdb, 0x27, 0x73, 0x99, 0xf5, 0xd4, 0xd8, 0x92, 0x52, 0xc9, 0x51,
-	0xbe, 0x84, 0xba, 0x74, 0x57, 0x6a, 0x0a, 0x93, 0x55, 0xbc, 0x82, 0x6a, 0x3f, 0x17, 0xa0, 0x96,
-	0x29, 0x24, 0x99, 0xf9, 0x49, 0xe4, 0x86, 0x22, 0xf3, 0xca, 0x22, 0xf3, 0x29, 0x74, 0xe7, 0x25,
-	0x0e, 0xa0, 0x1a, 0x92, 0x39, 0xa5, 0x36, 0xf1, 0x92, 0x0b, 0x54, 0xf1, 0x12, 0xe0, 0x57, 0x14,
-	0x07, 0xcb, 0x23, 0x97, 0x74, 0x84, 0xbb, 0xa2, 0xe4, 0xab, 0x38, 0x0f, 0xa6, 0x1c, 0x91, 0xe0,
-	0xd8, 0x58, 0x72, 0x44, 0x59, 0x8e, 0x68, 0xc1, 0x51, 0x5e, 0x72, 0x2c, 0x40, 0x3e, 0xc2, 0x58,
-	0x44, 0xfc, 0xf8, 0x82, 0x46, 0xe9, 0xd5, 0x2b, 0x62, 0x5a, 0xaf, 0xc2, 0xfc, 0x26, 0x94, 0x17,
-	0xd8, 0x5c, 0x8e, 0x23, 0x79, 0xd2, 0x1c, 0xa8, 0xc8, 0x90, 0xa0, 0xff, 0x40, 0xc9, 0xe3, 0x8d,
-	0xa7, 0xdc, 0xd5, 0x78, 0x42, 0xcc, 0x53, 0x1e, 0x53, 0xc6, 0xa6, 0xd4, 0x91, 0x9b, 0x21, 0x3d,
-	0x8a, 0x62, 0xf0, 0x58, 0x9f, 0xb8, 0x8e, 0x4c, 0x6a, 0x7a, 0xd4, 0x7e, 0x2b, 0xc0, 0xae, 0x1d,
-	0x30, 0xf7, 0xc2, 0x9d, 0x88, 0x0a, 0x37, 0xaf, 0xf9, 0x94, 0xf9, 0x26, 0x37, 0x65, 0x0e, 0x13,
-	0x83, 0x6b, 0x6a, 0x39, 0x24, 0x33, 0x74, 0x10, 0x88, 0x05, 0xd7, 0x28, 0x34, 0x8b, 0x87, 0x55,
-	0x9c, 0x2c, 0xbb, 0x3f, 0x15, 0x50, 0x57, 0xd5, 0x51, 0x15, 0x36, 0xb0, 0xa9, 0x1b, 0x3f, 0xa8,
-	0x0f, 0xf8, 0x28, 0xb4, 0x6c, 0x6b, 0x68, 0xe9, 0x5d, 0xeb, 0xa3, 0x98, 0x9f, 0xe3, 0x8e, 0x6e,
-	0x75, 0x4d, 0x43, 0x55, 0xf8, 0xf4, 0xd5, 0xdb, 0xed, 0xde, 0xc8, 0x1e, 0x8e, 0xdb, 0x27, 0xba,
-	0xfd, 0xce, 0x34, 0xd4, 0x02, 0x52, 0x61, 0xcb, 0xb2, 0x4f, 0x7b, 0x56, 0xdb, 0x1c, 0xf7, 0x75,
-	0xcb, 0x50, 0x8b, 0xe8, 0xdf, 0xf0, 0x1c, 0xf7, 0x46, 0x62, 0x1e, 0xdb, 0x3d, 0xc3, 0xcc, 0x4c,
-	0xda, 0xc5, 0x67, 0x25, 0xf4, 0x04, 0xf6, 0xbb, 0xd6, 0xbb, 0x93, 0xa1, 0xcd, 0xd5, 0x06, 0x26,
-	0x3e, 0xe5, 0x04, 0x46, 0xef, 0xcc, 0x56, 0x37, 0xf8, 0x40, 0xef, 0x8c, 0x6c, 0x63, 0xac, 0x1b,
-	0x06, 0x36, 0x07, 0x83, 0xf1, 0xc8, 0x1e, 0xf4, 0xcd, 0x8c, 0xd1, 0x32, 0xff, 0xba, 0xa5, 0xb7,
-	0xdf, 0x8f, 0xfa, 0xe3, 0x8e, 0xd5, 0x35, 0x07, 0x63, 0xfd, 0x54, 0xb7, 0xba, 0x7a, 0xab, 0x6b,
-	0xaa, 0x15, 0xed, 0x17, 0x05, 0x54, 0xdd, 0x71, 0x3a, 0x33, 0xdf, 0xb1, 0x7c, 0x97, 0x61, 0x1a,
-	0x4e, 0xe7, 0xf7, 0x34, 0xe1, 0x6b, 0xd8, 0x5d, 0xee, 0x32, 0x83, 0x86, 0x41, 0xec, 0xa6, 0xa5,
-	0xbc, 0x2e, 0x40, 0x1a, 0x6c, 0xd1, 0x28, 0x0a, 0xa2, 0x0f, 0xc9, 0x3b, 0x42, 0x16, 0x76, 0x0e,
-	0xe3, 0xf3, 0xf6, 0x9c, 0x4c, 0x3e, 0xcd, 0xc2, 0xef, 0xe2, 0xc0, 0x97, 0x85, 0x9d, 0x41, 0xb4,
-	0x23, 0xd8, 0x92, 0xfe, 0x25, 0xbe, 0xad, 0x72, 0x2a, 0xeb, 0x9c, 0x5a, 0x0f, 0xb6, 0x31, 0xbd,
-	0x10, 0x9f, 0xfc, 0xdd, 0x54, 0x79, 0x01, 0xdb, 0x91, 0x50, 0xd5, 0xa5, 0x3c, 0xe9, 0xf4, 0x3c,
-	0xa8, 0xfd, 0xa4, 0xc0, 0x0e, 0x77, 0x41, 0x3e, 0x11, 0x84, 0x23, 0x6f, 0x17, 0x8f, 0x8a, 0xa4,
-	0xdc, 0x9a, 0x49, 0xb9, 0xad, 0xa8, 0x65, 0xcf, 0x52, 0x5f, 0x6b, 0x01, 0x2c, 0x51, 0x3e, 0x83,
-	0xed, 0xde, 0x98, 0xa7, 0x50, 0x7d, 0x80, 0x1a, 0xf0, 0xaf, 0x74, 0x3b, 0xaf, 0x6c, 0xe5, 0x6d,
-	0xa8, 0x4a, 0x84, 0x17, 0x92, 0x66, 0xc2, 0x2e, 0xa6, 0x5e, 0x70, 0x4d, 0x3b, 0x5f, 0x75, 0xcd,
-	0x3b, 0xe6, 0x8e, 0x66, 0xc1, 0x4e, 0x96, 0x86, 0xdf, 0x0b, 0x41, 0x89, 0xdd, 0x2c, 0x9e, 0x5f,
-	0xe2, 0xef, 0xb5, 0xa0, 0x17, 0x6e, 0x09, 0xfa, 0xaf, 0x05, 0xd8, 0x19, 0x7c, 0x21, 0xa1, 0x8c,
-	0x99, 0xe5, 0x5f, 0x04, 0xf7, 0x38, 0xd4, 0x5c, 0x2c, 0x22, 0xb1, 0x46, 0x13, 0xc2, 0x2c, 0xc4,
-	0x47, 0x51, 0x3b, 0xf0, 0x2f, 0xdc, 0xc8, 0xa3, 0x8e, 0x9e, 0x7d, 0x0c, 0xac, 0xc2, 0x7c, 0x9d,
-	0x2e, 0xa0, 0x21, 0x1f, 0x53, 0x64, 0xc2, 0x7b, 0xd5, 0x72, 0xf8, 0x7b, 0x8f, 0xf7, 0xf2, 0x5d,
-	0x62, 0x5e, 0x7c, 0x7c, 0x9c, 0x48, 0xfa, 0xe4, 0x69, 0x97, 0x41, 0xb8, 0x3c, 0xf3, 0xb6, 0x2d,
-	0x8b, 0xdd, 0x9c, 0x41, 0xd6, 0xe2, 0x52, 0xb9, 0xa5, 0xc0, 0x5f, 0x42, 0x7d, 0x4a, 0x62, 0x96,
-	0x14, 0xa4, 0x78, 0x54, 0x24, 0x6f, 0x86, 0x15, 0x54, 0xeb, 0xe4, 0xc2, 0x27, 0x36, 0xeb, 0x31,
-	0x54, 0x65, 0xbc, 0x68, 0x2c, 0xd7, 0xea, 0x5e, 0x52, 0x65, 0x2b, 0x81, 0xc6, 0x4b, 0x3d, 0x5e,
-	0xab, 0x4f, 0xdb, 0xfc, 0x2d, 0x44, 0x31, 0x61, 0x93, 0x2b, 0xca, 0x06, 0x34, 0x8e, 0xdd, 0xc0,
-	0xcf, 0x0c, 0xee, 0x98, 0x4e, 0x22, 0xca, 0x64, 0x4a, 0xe4, 0x89, 0xdf, 0x25, 0xa2, 0x5e, 0xc0,
-	0x68, 0x7f, 0x76, 0xfe, 0x9e, 0xce, 0xd3, 0x1c, 0x67, 0x31, 0xbe, 0x62, 0xe2, 0x84, 0xcd, 0x32,
-	0xd2, 0x35, 0xb5, 0x00, 0x32, 0x2b, 0x81, 0xb7, 0x71, 0x69, 0xb1, 0x12, 0x5c, 0x78, 0x7c, 0xbb,
-	0x43, 0xbc, 0xdc, 0x72, 0x94, 0xca, 0x2d, 0x94, 0xd2, 0xd9, 0x42, 0xce, 0xd9, 0x7d, 0x28, 0x87,
-	0x89, 0x9b, 0x89, 0x17, 0xf2, 0xa4, 0x7d, 0x86, 0x47, 0x79, 0x23, 0x22, 0x3a, 0x5f, 0x61, 0xe8,
-	0x00, 0xaa, 0xae, 0xef, 0x32, 0x97, 0xb0, 0xc5, 0x1a, 0x5a, 0x02, 0xe8, 0x09, 0x6c, 0xce, 0x62,
-	0x1a, 0x71, 0x32, 0x69, 0x70, 0x71, 0xd6, 0xbe, 0x87, 0x83, 0xbc, 0xc9, 0x01, 0x65, 0x89, 0xd5,
-	0x24, 0xde, 0xf7, 0xdb, 0xcd, 0x32, 0x17, 0x56, 0x98, 0x7b, 0xb0, 0x27, 0x99, 0x4d, 0x7f, 0x12,
-	0xcd, 0x43, 0xf6, 0x75, 0x94, 0x0d, 0xa8, 0x78, 0xb9, 0x3e, 0x4d, 0x8f, 0x1a, 0x59, 0x10, 0x1a,
-	0xf4, 0x1f, 0x10, 0xbe, 0x02, 0x95, 0x26, 0x0e, 0x50, 0x27, 0x3f, 0x01, 0xd6, 0x70, 0x6d, 0x04,
-	0x7b, 0xad, 0x20, 0x60, 0x31, 0x8b, 0x48, 0xd8, 0x71, 0xa7, 0x74, 0xf1, 0xb0, 0x7b, 0x06, 0x70,
-	0x16, 0x44, 0x9f, 0x5c, 0xff, 0xd2, 0x70, 0x23, 0x69, 0x23, 0x83, 0x70, 0x17, 0x3a, 0xb3, 0xe9,
-	0xb4, 0x4f, 0xd8, 0x55, 0x2c, 0x57, 0xf0, 0x12, 0x38, 0x2f, 0x8b, 0xff, 0x40, 0x8f, 0xff, 0x0a,
-	0x00, 0x00, 0xff, 0xff, 0x94, 0x37, 0xeb, 0x38, 0x93, 0x0e, 0x00, 0x00,
+	// 7908 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xbd, 0x3c, 0x4d, 0x8f, 0x24, 0xc9,
+	0x55, 0x5b, 0x9f, 0xdd, 0x1d, 0xfd, 0x55, 0x9d, 0x3d, 0x3d, 0xd3, 0x3b, 0xbb, 0xf6, 0xae, 0xd3,
+	0x36, 0xd8, 0x6b, 0x7b, 0xbc, 0x3b, 0xeb, 0xf5, 0xae, 0x8d, 0xbd, 0x76, 0x76, 0x55, 0xf6, 0x74,
+	0x7a, 0xea, 0x6b, 0xa3, 0xaa, 0x67, 0x3c, 0x7b, 0xa0, 0xc8, 0xae, 0xca, 0x9e, 0x4e, 0xa6, 0xaa,
+	0xb2, 0xb6, 0xb2, 0xaa, 0x67, 0xda, 0x20, 0x59, 0x20, 0xcb, 0xb2, 0xf9, 0xf2, 0x01, 0x84, 0x38,
+	0x81, 0x41, 0x48, 0x48, 0xbe, 0xf1, 0x21, 0x84, 0x04, 0x1c, 0x40, 0x1c, 0x40, 0x3e, 0x70, 0xe2,
+	0xcc, 0x0f, 0x80, 0x03, 0x07, 0xcc, 0xc1, 0x08, 0x89, 0xf7, 0xe2, 0x2b, 0x23, 0xb2, 0xb2, 0x7a,
+	0x7a, 0x06, 0x9b, 0x4b, 0x77, 0xc5, 0x8b, 0x97, 0x91, 0x2f, 0x5e, 0xbc, 0x78, 0xf1, 0xbe, 0x22,
+	0xc9, 0xd6, 0x28, 0x88, 0x63, 0xff, 0x61, 0x10, 0xdf, 0x9a, 0x4c, 0xa3, 0x59, 0x64, 0x15, 0x07,
+	0xfe, 0xcc, 0xb7, 0x8f, 0xc9, 0x7a, 0xf5, 0xcc, 0x0f, 0xc7, 0x9d, 0x99, 0x3f, 0x9b, 0xc7, 0xd6,
+	0xab, 0x64, 0xfd, 0x64, 0x18, 0xf5, 0x1f, 0x1d, 0x05, 0xe1, 0xc3, 0xb3, 0xd9, 0x7e, 0xee, 0xd5,
+	0xdc, 0x27, 0x36, 0xa9, 0x0e, 0xb2, 0x3e, 0x46, 0x36, 0xe3, 0x8b, 0x71, 0x3f, 0x18, 0x74, 0x23,
+	0xf6, 0xe0, 0x7e, 0x1e, 0x70, 0x56, 0xa9, 0x09, 0xb4, 0xff, 0xb9, 0x40, 0x56, 0x9c, 0x7e, 0x3f,
+	0x9a, 0x8f, 0x67, 0xd6, 0x16, 0xc9, 0x87, 0x03, 0x36, 0xd4, 0x1a, 0x85, 0x5f, 0xd6, 0x3e, 0x59,
+	0x39, 0xf1, 0x87, 0x3e, 0xa0, 0xb3, 0x67, 0x0b, 0x54, 0x36, 0x71, 0xec, 0xc7, 0xfe, 0x70, 0x18,
+	0xcc, 0x0e, 0x44, 0x7f, 0x81, 0xf5, 0x9b, 0x40, 0xeb, 0x4d, 0x52, 0x8e, 0x19, 0xb5, 0xfb, 0x45,
+	0xe8, 0xde, 0xba, 0xfd, 0xd2, 0x2d, 0x9c, 0xc9, 0x2d, 0xf1, 0x3a, 0xf9, 0x9f, 0x4f, 0x88, 0x0a,
+	0x54, 0xeb, 0x75, 0xb2, 0x3b, 0xf2, 0x9f, 0x38, 0xc3, 0x61, 0xf4, 0x18, 0xa9, 0xa4, 0x41, 0x3f,
+	0x08, 0xcf, 0x83, 0xfd, 0x12, 0x7b, 0x41, 0x56, 0x97, 0xf5, 0x09, 0xb2, 0xad, 0x83, 0xdb, 0xfe,
+	0xc5, 0x7e, 0x99, 0x61, 0xa7, 0xc1, 0xd6, 0x6b, 0xa4, 0x02, 0x20, 0xf8, 0x35, 0x0a, 0xc6, 0x33,
+	0x67, 0x84, 0x6f, 0xdf, 0x5f, 0x61, 0xa8, 0x0b, 0x70, 0xeb, 0x67, 0xc8, 0xd6, 0x34, 0x9a, 0xcf,
+	0xc2, 0xf1, 0xc3, 0x66, 0x34, 0x08, 0x0e, 0x83, 0x60, 0x7f, 0x95, 0x61, 0xa6, 0xa0, 0xf6, 0x6f,
+	0xe5, 0xc8, 0xa6, 0x31, 0x13, 0x6b, 0x97, 0x6c, 0xdf, 0x77, 0xbc, 0xae, 0xd7, 0xbc, 0xd3, 0xab,
+	0xb9, 0xed, 0x56, 0xc7, 0xeb, 0x56, 0x5e, 0x80, 0xf5, 0x7a, 0x39, 0x05, 0xec, 0x55, 0x5b, 0xcd,
+	0x43, 0x8f, 0x36, 0x9c, 0xae, 0xd7, 0x6a, 0x56, 0x72, 0xd6, 0x2b, 0xe4, 0xa5, 0x36, 0x6d, 0x55,
+	0xdd, 0x4e, 0x07, 0x91, 0x0e, 0xa8, 0xeb, 0xbe, 0x8f, 0x28, 0x4d, 0xb7, 0xca, 0x10, 0xf2, 0xd6,
+	0x8b, 0x64, 0x4f, 0x43, 0xb8, 0xef, 0x75, 0x8f, 0x6a, 0xd4, 0xb9, 0xef, 0xd4, 0x2b, 0x05, 0x8b,
+	0x90, 0xb2, 0x03, 0x68, 0xf7, 0xdc, 0x4a, 0xd1, 0xfe, 0xf5, 0x55, 0xb2, 0x22, 0xa6, 0x62, 0x7d,
+	0x86, 0x14, 0x67, 0x17, 0x93, 0x80, 0xad, 0xe9, 0xd6, 0xed, 0x17, 0x39, 0xff, 0x45, 0xa7, 0xfc,
+	0xdf, 0x05, 0x04, 0xca, 0xd0, 0xac, 0xeb, 0xa4, 0xec, 0x73, 0xae, 0xf0, 0xf5, 0x14, 0x2d, 0xeb,
+	0xd3, 0x64, 0xa7, 0x3f, 0x0d, 0xfc, 0x59, 0x18, 0x8d, 0xbb, 0x21, 0x48, 0xe7, 0xcc, 0x1f, 0x4d,
+	0xd8, 0x9a, 0x16, 0xe8, 0x62, 0x07, 0x2c, 0xfb, 0x7a, 0x38, 0x3e, 0x8f, 0xc2, 0x7e, 0xd0, 0x08,
+	0x46, 0x11, 0x5b, 0x8b, 0xf5, 0xdb, 0x3b, 0xfc, 0xdd, 0x5e, 0xd2, 0x41, 0x75, 0x2c, 0xeb, 0xc3,
+	0x84, 0x4c, 0x83, 0x41, 0x10, 0x8c, 0xba, 0x4f, 0xbc, 0x1a, 0x5b, 0x94, 0x35, 0xaa, 0x41, 0x50,
+	0xde, 0x27, 0x9c, 0xde, 0x23, 0x3f, 0x3e, 0x63, 0x6b, 0xb1, 0x46, 0x75, 0x10, 0x62, 0x0c, 0x80,
+	0x82, 0x70, 0xcc, 0xc8, 0xd9, 0x5f, 0xe3, 0x18, 0x1a, 0xc8, 0x7a, 0x87, 0xdc, 0x68, 0x07, 0xe3,
+	0x01, 0x2c, 0x9e, 0xfb, 0x64, 0x12, 0x4e, 0x19, 0x50, 0xec, 0x1f, 0xc2, 0xf6, 0xcf, 0xb2, 0x6e,
+	0xeb, 0x5d, 0x72, 0x73, 0xa1, 0x2b, 0xe1, 0xc4, 0x3a, 0xe3, 0xc4, 0x25, 0x18, 0xc8, 0xc0, 0x89,
+	0x3f, 0x05, 0x4a, 0xdb, 0xda, 0x1c, 0x36, 0x18, 0x85, 0x8b, 0x1d, 0x96, 0x4d, 0x36, 0x4e, 0x83,
+	0x00, 0xc4, 0x3b, 0x9c, 0x84, 0x00, 0xdb, 0xdf, 0x64, 0x88, 0x06, 0xcc, 0xfa, 0x39, 0xb2, 0xde,
+	0x1f, 0x46, 0x31, 0x40, 0xfc, 0x18, 0x66, 0xbb, 0x95, 0xb5, 0xc0, 0xd5, 0x04, 0x81, 0xea, 0xd8,
+	0xc8, 0x2a, 0x6c, 0x02, 0xb1, 0x8c, 0xdb, 0xdb, 0x9c, 0x55, 0x1a, 0xc8, 0xba, 0x49, 0x56, 0xd9,
+	0x03, 0x28, 0xf7, 0x15, 0x36, 0x3d, 0xd5, 0xc6, 0xa5, 0x3a, 0x0d, 0x7d, 0xb9, 0x7f, 0x76, 0xa0,
+	0x37, 0x47, 0x35, 0x08, 0x23, 0x1f, 0x5a, 0xd5, 0xf9, 0x14, 0x26, 0xd6, 0xbf, 0xd8, 0xb7, 0x04,
+	0xf9, 0x1a, 0xcc, 0xaa, 0x90, 0x02, 0x4c, 0x67, 0x7f, 0x97, 0x0d, 0x8d, 0x3f, 0x51, 0xd9, 0xc0,
+	0xbf, 0x46, 0xec, 0xcf, 0xf6, 0xaf, 0x71, 0x65, 0x23, 0x9a, 0xd6, 0x17, 0xc8, 0xe6, 0xe9, 0x9c,
+	0xb1, 0xb6, 0x13, 0xcd, 0xa7, 0xa0, 0x6c, 0xf6, 0x98, 0x44, 0xed, 0xf2, 0xc9, 0x1e, 0xea, 0x5d,
+	0xd4, 0xc4, 0xb4, 0x63, 0xb2, 0xae, 0x49, 0xb9, 0xb5, 0x4e, 0x56, 0x92, 0x1d, 0xb9, 0x45, 0x88,
+	0xb6, 0x87, 0x72, 0xd6, 0x2a, 0x29, 0x76, 0xdc, 0x66, 0x17, 0x36, 0xda, 0x06, 0x59, 0xa5, 0x6e,
+	0xd5, 0x85, 0xed, 0x54, 0xe3, 0x7b, 0x8b, 0xba, 0x87, 0xc7, 0xcd, 0x5a, 0xa5, 0x68, 0x6d, 0x93,
+	0xf5, 0x8e, 0x4b, 0xef, 0x79, 0x55, 0xb7, 0x77, 0xe8, 0xba, 0x95, 0x92, 0x65, 0x91, 0xad, 0xea,
+	0x91, 0x03, 0x9b, 0xb4, 0xde, 0xab, 0xd6, 0x5b, 0x1d, 0x78, 0xa0, 0x6c, 0xff, 0x5a, 0x0e, 0x54,
+	0xb5, 0xc6, 0xed, 0x3d, 0xb2, 0x53, 0x6d, 0xb5, 0xda, 0x2e, 0x75, 0x70, 0x87, 0x72, 0x3c, 0x78,
+	0x3f, 0x80, 0xeb, 0xad, 0xaa, 0x53, 0xef, 0x1d, 0xb6, 0x68, 0x55, 0x82, 0x73, 0xb0, 0x07, 0x2d,
+	0xea, 0x36, 0x5a, 0x5d, 0xd7, 0x80, 0xe7, 0x81, 0x63, 0x1b, 0xa0, 0x13, 0x9c, 0xea, 0x91, 0x80,
+	0x14, 0xac, 0x6b, 0xa4, 0x82, 0x64, 0xa1, 0x32, 0xa8, 0x3a, 0xcd, 0xaa, 0x5b, 0x77, 0x91, 0xc4,
+	0x4d, 0xb2, 0xe6, 0x1c, 0x38, 0xcd, 0x5a, 0xab, 0x09, 0xcd, 0x92, 0xfd, 0x4d, 0xb2, 0x69, 0x70,
+	0x08, 0x57, 0x16, 0x8e, 0x95, 0xf3, 0x70, 0x10, 0x4c, 0x85, 0xaa, 0x57, 0x6d, 0x5c, 0x83, 0x68,
+	0x0a, 0x3f, 0x40, 0x26, 0xf2, 0xac, 0x4b, 0x36, 0x71, 0x4d, 0x99, 0x8a, 0x0b, 0xa6, 0x20, 0xae,
+	0xb3, 0x0b, 0xa6, 0x1f, 0x60, 0x4d, 0x75, 0x18, 0xd0, 0x53, 0x9a, 0x81, 0xec, 0xa0, 0xb6, 0x2f,
+	0x40, 0x27, 0x6f, 0xd8, 0x0e, 0xd9, 0x10, 0x4b, 0x10, 0xd7, 0xc3, 0x78, 0x66, 0xbd, 0x41, 0x36,
+	0x26, 0x5a, 0x1b, 0x68, 0x28, 0xc0, 0x62, 0x6e, 0x1a, 0x92, 0x4b, 0x0d, 0x14, 0xfb, 0x6f, 0x72,
+	0x64, 0x57, 0x8e, 0xd1, 0x86, 0x83, 0x91, 0x06, 0x1f, 0xcc, 0x61, 0x63, 0xa1, 0xba, 0xea, 0xcf,
+	0xa7, 0x71, 0x24, 0x27, 0x22, 0x5a, 0x48, 0xc8, 0x30, 0x1c, 0x85, 0x33, 0x36, 0x89, 0x12, 0xe5,
+	0x0d, 0xeb, 0xb3, 0x40, 0x1e, 0x08, 0x41, 0x0c, 0xb4, 0x17, 0x2e, 0x57, 0x86, 0x1c, 0x0f, 0x0f,
+	0xb9, 0xd3, 0x69, 0x34, 0x4a, 0x6b, 0x3c, 0x13, 0x88, 0x7b, 0x69, 0x16, 0x25, 0x38, 0xfc, 0x9c,
+	0xd2, 0x41, 0xf6, 0x3f, 0xe6, 0xc8, 0x1e, 0x28, 0x85, 0x68, 0x2a, 0x37, 0x79, 0x2c, 0x27, 0x60,
+	0x91, 0xe2, 0xc4, 0x9f, 0x9d, 0x09, 0xf2, 0xd9, 0xef, 0x84, 0xcc, 0xfc, 0xf3, 0x92, 0x59, 0xb8,
+	0x02, 0x99, 0xc5, 0x05, 0x32, 0x17, 0xb6, 0x6d, 0x69, 0x71, 0xdb, 0xda, 0x7f, 0x0a, 0x87, 0x1d,
+	0x90, 0x10, 0x04, 0x9d, 0x09, 0x57, 0x76, 0xd6, 0xcb, 0x64, 0x6d, 0x82, 0x80, 0xa6, 0x3f, 0x0a,
+	0xc4, 0x3c, 0x12, 0x40, 0x5a, 0x27, 0xe7, 0x17, 0x75, 0xf2, 0xb2, 0x23, 0x07, 0xd6, 0x90, 0x09,
+	0x97, 0xa0, 0x94, 0x37, 0xac, 0xdb, 0xe4, 0xda, 0xd0, 0x8f, 0x25, 0x1f, 0xd3, 0x5c, 0xcf, 0xec,
+	0xb3, 0xdf, 0x25, 0xdb, 0x92, 0xda, 0x83, 0x0b, 0x46, 0xbc, 0xf5, 0x29, 0x52, 0x66, 0x34, 0xc6,
+	0x42, 0xfa, 0x76, 0x15, 0x93, 0x93, 0x99, 0x51, 0x81, 0x62, 0xfb, 0x89, 0x00, 0xa3, 0xf0, 0x3d,
+	0x87, 0x00, 0xa3, 0xc6, 0x1c, 0x07, 0x4f, 0x90, 0x8d, 0x28, 0xac, 0x9c, 0x0b, 0x1a, 0xc4, 0x9e,
+	0x90, 0xeb, 0x1d, 0x78, 0xeb, 0x7d, 0x66, 0x3d, 0x55, 0xa3, 0x70, 0xac, 0x24, 0x04, 0x76, 0xa4,
+	0x3f, 0x18, 0x4c, 0xc1, 0x20, 0x14, 0xcc, 0x95, 0x4d, 0x8d, 0x71, 0x79, 0x83, 0x71, 0x68, 0xf6,
+	0xf9, 0xb3, 0x76, 0x30, 0x3d, 0xb8, 0x98, 0x31, 0xf5, 0x2d, 0xc4, 0xc1, 0x00, 0x82, 0x5a, 0xd8,
+	0x01, 0x52, 0xc5, 0x69, 0xac, 0xed, 0x27, 0x31, 0x64, 0xce, 0x18, 0x12, 0x4c, 0x21, 0x31, 0x1d,
+	0x81, 0x29, 0xa6, 0x90, 0x82, 0x82, 0x79, 0xb5, 0x0a, 0x3a, 0xbb, 0xce, 0xb6, 0x5e, 0x81, 0xe9,
+	0xe8, 0x2d, 0xa1, 0xa3, 0x05, 0x94, 0xaa, 0x7e, 0xfb, 0xf3, 0x64, 0x55, 0x42, 0xf1, 0x30, 0x40,
+	0xb5, 0xcf, 0x5f, 0x8a, 0x3f, 0x71, 0xda, 0x93, 0x00, 0xb4, 0x95, 0x98, 0x5d, 0x8e, 0xca, 0xa6,
+	0xfd, 0xe3, 0x02, 0x59, 0xd7, 0x8c, 0x08, 0x21, 0x61, 0xfd, 0x69, 0x38, 0x61, 0x12, 0x96, 0x53,
+	0x12, 0x26, 0x41, 0x4b, 0x19, 0x65, 0x48, 0x6e, 0x21, 0x2d, 0xb9, 0xc0, 0x46, 0xd6, 0xf0, 0x46,
+	0xb0, 0xe6, 0xc7, 0xb4, 0xce, 0xe4, 0x70, 0x8d, 0x9a, 0x40, 0x39, 0xc6, 0x94, 0x8d, 0x51, 0x4a,
+	0xc6, 0x98, 0xea, 0x63, 0x4c, 0xd5, 0x18, 0xe5, 0x64, 0x0c, 0x05, 0x44, 0xf3, 0x75, 0x36, 0xf5,
+	0xc7, 0xf1, 0x69, 0x30, 0x95, 0xec, 0x5d, 0x61, 0x96, 0x7a, 0x1a, 0x8c, 0x33, 0x09, 0xd0, 0xb8,
+	0xb8, 0x10, 0xa6, 0xa8, 0x68, 0x89, 0xf5, 0x01, 0xd1, 0x0d, 0x1f, 0xc2, 0xae, 0x9a, 0x4f, 0x03,
+	0x61, 0xfc, 0xa4, 0xa0, 0xa8, 0xfa, 0xcf, 0x83, 0x69, 0x78, 0x1a, 0x06, 0x03, 0x66, 0xf0, 0xac,
+	0x52, 0xd5, 0xc6, 0xdd, 0xcf, 0xc8, 0xaa, 0x46, 0x23, 0x5c, 0x52, 0x66, 0xd3, 0xac, 0x51, 0x03,
+	0x06, 0x16, 0x6a, 0x61, 0xe6, 0x3f, 0x61, 0x76, 0x8b, 0x12, 0xf8, 0xae, 0xff, 0xc4, 0x1b, 0x9f,
+	0x46, 0x14, 0x7b, 0x50, 0xce, 0x07, 0xc1, 0x39, 0x2c, 0x0d, 0xe3, 0x07, 0x37, 0x5b, 0x34, 0x08,
+	0x5f, 0x2c, 0x6c, 0xb5, 0xa7, 0x51, 0x74, 0xca, 0x8c, 0x16, 0xb6, 0x58, 0x0a, 0x84, 0x0c, 0x8d,
+	0x1e, 0x8f, 0x6b, 0x0c, 0xc2, 0xec, 0x92, 0x55, 0x9a, 0x00, 0xec, 0x87, 0x64, 0x45, 0xbc, 0x0f,
+	0x25, 0xe4, 0xdc, 0x9f, 0x51, 0x7f, 0xc6, 0xb5, 0x0e, 0x48, 0x88, 0x68, 0xe2, 0x10, 0x40, 0x8b,
+	0xa3, 0x2f, 0x79, 0x02, 0xc0, 0x35, 0x19, 0x81, 0x28, 0x9d, 0xf9, 0xa0, 0x22, 0x7c, 0x34, 0x7e,
+	0xf8, 0xca, 0x9b, 0x40, 0x34, 0xea, 0x77, 0x9c, 0xc1, 0x20, 0xb5, 0x3f, 0x52, 0x86, 0x6d, 0xee,
+	0x4a, 0x86, 0x2d, 0x3b, 0x6f, 0x83, 0x10, 0x57, 0x5b, 0x6c, 0x1b, 0xd5, 0xc6, 0xa5, 0x3f, 0x85,
+	0x3d, 0x7f, 0xe2, 0xf7, 0x1f, 0x39, 0x62, 0x97, 0x17, 0xf8, 0xd2, 0xa7, 0xc0, 0xf6, 0x1f, 0xe6,
+	0xc8, 0xb6, 0x4e, 0xd0, 0x64, 0x78, 0x91, 0xb1, 0x2d, 0x73, 0x99, 0xdb, 0x32, 0x65, 0x3a, 0xe7,
+	0x17, 0x4d, 0x67, 0x9d, 0xc6, 0xc2, 0xd3, 0x69, 0xe4, 0x5b, 0x61, 0x81, 0xc6, 0x01, 0x59, 0x11,
+	0xf4, 0x59, 0x1f, 0x27, 0xc5, 0xd1, 0xa5, 0x2c, 0x62, 0xdd, 0xb8, 0x88, 0x71, 0x30, 0x9b, 0x0d,
+	0x41, 0x1e, 0xb9, 0x73, 0x2a, 0x9b, 0x4c, 0xef, 0x8d, 0x40, 0x97, 0x83, 0x3f, 0xca, 0xf5, 0x97,
+	0x6c, 0xda, 0x7f, 0x54, 0x26, 0x3b, 0xcd, 0x68, 0x06, 0x52, 0xdb, 0x67, 0x27, 0x88, 0x7b, 0x8e,
+	0xa2, 0xf9, 0x25, 0xc3, 0xd1, 0xf9, 0x04, 0x7f, 0xe1, 0x02, 0x9a, 0x01, 0xd1, 0xfc, 0x1e, 0x38,
+	0x87, 0xf1, 0x01, 0x76, 0xe4, 0xc2, 0x39, 0x8c, 0xbf, 0x85, 0x33, 0x8c, 0x2f, 0x2f, 0xa2, 0x33,
+	0x6c, 0xff, 0xa0, 0x44, 0x2a, 0xe9, 0xc7, 0xad, 0x35, 0x52, 0x02, 0x9b, 0xac, 0xf6, 0x00, 0xcc,
+	0x39, 0xf0, 0xce, 0xbc, 0x26, 0x38, 0x78, 0x4e, 0xdd, 0x7b, 0x9f, 0xb9, 0x74, 0xbd, 0x43, 0xc7,
+	0x43, 0x93, 0x2c, 0x87, 0x0e, 0xa1, 0x53, 0xad, 0xb6, 0x8e, 0x9b, 0xe0, 0xf3, 0x81, 0xb1, 0x78,
+	0x07, 0x80, 0xcc, 0x9e, 0xf3, 0x9a, 0xf7, 0x5a, 0x68, 0x4a, 0xb6, 0x1d, 0x0f, 0x0d, 0xcd, 0x8f,
+	0x92, 0x57, 0x68, 0xeb, 0x98, 0xb9, 0x88, 0xcd, 0x56, 0xcd, 0xd5, 0x9c, 0x3f, 0xf5, 0x58, 0x11,
+	0x96, 0xea, 0x7a, 0xdd, 0xbb, 0x73, 0xd4, 0x6d, 0x22, 0x9a, 0xb4, 0x45, 0x6b, 0xad, 0xfb, 0x4d,
+	0x30, 0x46, 0xc1, 0xc7, 0x44, 0x83, 0xb0, 0xe7, 0xd4, 0x6a, 0x14, 0xbc, 0xc4, 0xde, 0x71, 0xb3,
+	0xd3, 0x76, 0xb5, 0x97, 0x96, 0xf1, 0xe9, 0x03, 0xa7, 0x7a, 0xf7, 0xb8, 0xdd, 0x3b, 0x04, 0xda,
+	0x3a, 0x3d, 0xe7, 0x1e, 0xd0, 0xe8, 0x1c, 0xd4, 0xdd, 0xca, 0x0a, 0x4e, 0xc0, 0x78, 0x9a, 0x1b,
+	0xbd, 0xf0, 0xd8, 0xaa, 0x75, 0x83, 0xec, 0x76, 0xdc, 0xea, 0x31, 0xf5, 0xba, 0x0f, 0x7a, 0x6d,
+	0x4f, 0xcd, 0x6c, 0x2d, 0xc3, 0xfc, 0x25, 0x68, 0x96, 0xca, 0x89, 0x81, 0x21, 0xeb, 0xc1, 0x10,
+	0xb4, 0xb2, 0x6e, 0xed, 0x90, 0x4d, 0xb0, 0x7f, 0xe1, 0x95, 0x92, 0x98, 0x0d, 0x24, 0xe6, 0xbd,
+	0x63, 0xf7, 0xd8, 0xad, 0x01, 0x03, 0x1e, 0x34, 0x74, 0x42, 0x37, 0x71, 0x60, 0x09, 0x14, 0x2f,
+	0xdb, 0x42, 0x83, 0x19, 0xac, 0x5a, 0xce, 0x5b, 0x65, 0x9f, 0x6f, 0xe3, 0x30, 0x12, 0xb5, 0xd3,
+	0x75, 0xba, 0xc7, 0xc9, 0x2b, 0x2a, 0x68, 0xe3, 0x03, 0x5d, 0xd5, 0xbb, 0xbd, 0xce, 0x5d, 0xf7,
+	0x7e, 0x65, 0xc7, 0xfa, 0x08, 0xf9, 0x90, 0xa2, 0xb7, 0xd5, 0xec, 0xb4, 0xea, 0x5e, 0xcd, 0x31,
+	0x18, 0x6c, 0xb1, 0xe1, 0x5c, 0x6e, 0x55, 0xbb, 0x5f, 0x6f, 0x7b, 0xf4, 0x81, 0xea, 0xbb, 0x86,
+	0x0b, 0x29, 0xa7, 0xc6, 0xfa, 0x00, 0xb8, 0x87, 0xa4, 0x2a, 0xe6, 0x38, 0x75, 0x97, 0x76, 0x2b,
+	0xd7, 0x91, 0x61, 0x09, 0x0f, 0xee, 0xb8, 0x4d, 0xb4, 0xfd, 0x01, 0xf9, 0x86, 0xf5, 0x12, 0xb8,
+	0xa0, 0x82, 0x58, 0xaf, 0xd9, 0xc5, 0x7f, 0xc0, 0xeb, 0x56, 0x1d, 0x67, 0xb2, 0x8f, 0x4f, 0x55,
+	0x61, 0x4c, 0x80, 0x82, 0x14, 0xc1, 0x02, 0xb4, 0xba, 0xec, 0xa9, 0x17, 0xb1, 0xa3, 0xe6, 0xb2,
+	0x95, 0x16, 0xab, 0xc7, 0x85, 0xee, 0x26, 0x3a, 0x0b, 0x47, 0xc7, 0x07, 0xbd, 0x36, 0x4c, 0xa4,
+	0x9a, 0x10, 0xfa, 0x92, 0xfd, 0xfb, 0x39, 0x52, 0x81, 0x6d, 0x89, 0x96, 0xbf, 0x37, 0x86, 0x73,
+	0x97, 0xe9, 0x8b, 0xe5, 0xb6, 0x04, 0xb8, 0xa7, 0x49, 0xa8, 0xa4, 0x16, 0x4c, 0xc0, 0x0f, 0x94,
+	0xaa, 0x73, 0xb1, 0x03, 0x8f, 0x8a, 0x60, 0x3a, 0x8d, 0xa6, 0x0d, 0x1e, 0xa6, 0x92, 0xbe, 0x80,
+	0x0e, 0xc3, 0x93, 0x00, 0x55, 0xc3, 0x7c, 0xf2, 0x35, 0xf4, 0x4e, 0xb9, 0xc2, 0xd0, 0x20, 0xf6,
+	0x6d, 0xb2, 0x21, 0xe8, 0xe3, 0xb4, 0xa5, 0xc7, 0xcc, 0x2d, 0x8e, 0x69, 0xb7, 0x40, 0x84, 0x82,
+	0x53, 0xf6, 0xc8, 0xd3, 0x8c, 0x23, 0xd0, 0xf2, 0x53, 0x86, 0x2a, 0x55, 0x16, 0x57, 0x7a, 0x26,
+	0xd0, 0xfe, 0x1e, 0x28, 0x55, 0x24, 0x41, 0x44, 0xa0, 0x18, 0x21, 0xef, 0xa8, 0x98, 0x15, 0x57,
+	0x25, 0xaf, 0x26, 0x5e, 0xa6, 0x86, 0xa6, 0xb7, 0x05, 0xbe, 0x7d, 0x40, 0x48, 0x02, 0x45, 0x57,
+	0xb3, 0xd9, 0xea, 0x31, 0xb7, 0xf1, 0x05, 0x20, 0xf4, 0x9a, 0x0c, 0xfe, 0xa4, 0x82, 0x3e, 0xe0,
+	0xad, 0x09, 0x08, 0x2a, 0x05, 0xdb, 0x25, 0x3b, 0x14, 0x14, 0xe3, 0x79, 0x70, 0x78, 0xa5, 0x69,
+	0x2e, 0x31, 0x6d, 0x6c, 0x8f, 0x6c, 0xeb, 0xc3, 0xe0, 0xbc, 0x40, 0xc5, 0xcd, 0x9e, 0xa8, 0xe8,
+	0x1e, 0xfb, 0xbd, 0xc0, 0xf4, 0x7c, 0x06, 0xd3, 0xff, 0x25, 0x0f, 0xe6, 0xf3, 0x63, 0x7f, 0x22,
+	0x78, 0x26, 0xcf, 0xde, 0x25, 0x04, 0xbd, 0xaa, 0xfc, 0x6d, 0xfd, 0xa8, 0xd1, 0x63, 0x1b, 0x70,
+	0x9c, 0x54, 0xa3, 0xf1, 0x69, 0x38, 0x1d, 0x05, 0x03, 0x47, 0x37, 0xfc, 0xd3, 0x60, 0x8c, 0xd6,
+	0x28, 0x50, 0x17, 0x2d, 0x21, 0xbf, 0x8f, 0x7a, 0xd7, 0x1b, 0x48, 0x07, 0x73, 0x59, 0x37, 0x0a,
+	0x1f, 0x1e, 0x15, 0x62, 0x78, 0xee, 0x1b, 0x68, 0x10, 0xec, 0xd7, 0x42, 0xa7, 0x65, 0x16, 0xfa,
+	0xd1, 0x20, 0x0b, 0x7c, 0x59, 0xc9, 0x10, 0x70, 0x38, 0x7c, 0xd1, 0xdb, 0xe0, 0x02, 0xc9, 0xa2,
+	0x28, 0x3c, 0x24, 0x95, 0x82, 0xe2, 0x12, 0xc5, 0x3c, 0x6a, 0xc1, 0x6d, 0x32, 0xd1, 0xb2, 0x0f,
+	0x0d, 0xb6, 0x32, 0x2f, 0xe1, 0x4d, 0xb2, 0x26, 0xf8, 0xa8, 0x1c, 0x93, 0x3d, 0x2e, 0x7d, 0xa9,
+	0x05, 0xa0, 0x09, 0x9e, 0xfd, 0x9d, 0x1c, 0x21, 0xd8, 0xcd, 0x2c, 0xe9, 0x18, 0x8d, 0x9f, 0x51,
+	0x38, 0x46, 0x80, 0x37, 0x16, 0x06, 0x75, 0x02, 0x60, 0xbd, 0xfe, 0x13, 0xd1, 0x2b, 0x4c, 0x23,
+	0x05, 0x40, 0xb6, 0x08, 0xd4, 0xd6, 0x5c, 0xae, 0x8a, 0x06, 0x61, 0xfd, 0x1c, 0x19, 0xfb, 0x8b,
+	0xa2, 0x5f, 0x41, 0x70, 0x3b, 0xbd, 0x54, 0xc5, 0x68, 0x60, 0x00, 0x76, 0x58, 0xff, 0x2c, 0x98,
+	0x75, 0x80, 0x44, 0x58, 0x12, 0xcd, 0x7c, 0x8d, 0x83, 0xfe, 0x34, 0x90, 0x76, 0x8a, 0x68, 0x21,
+	0xbb, 0xa7, 0x20, 0xad, 0xb3, 0xa0, 0x3d, 0x3f, 0xb9, 0x1b, 0x5c, 0x48, 0x31, 0xd4, 0x61, 0x48,
+	0x79, 0xcc, 0x47, 0x53, 0x26, 0x5b, 0x02, 0xd0, 0x0c, 0xe3, 0x22, 0x3b, 0xaf, 0x45, 0xcb, 0x0e,
+	0xc9, 0x8b, 0xd9, 0x04, 0xe1, 0x8e, 0x30, 0x86, 0xcc, 0x65, 0x0c, 0x29, 0x88, 0xcd, 0x1b, 0xc4,
+	0x02, 0x7c, 0xc2, 0xc9, 0xe4, 0x54, 0x88, 0x96, 0xfd, 0x01, 0xb9, 0x61, 0xbe, 0x84, 0x2d, 0xd4,
+	0x15, 0x5e, 0x04, 0xbd, 0x21, 0xa8, 0x68, 0xf0, 0xb2, 0x95, 0x15, 0x94, 0x00, 0xd0, 0x32, 0x9b,
+	0xc7, 0xe0, 0x2c, 0xc0, 0x60, 0xd2, 0x32, 0x93, 0x6d, 0xfb, 0xeb, 0xe4, 0x65, 0xf3, 0x95, 0x9d,
+	0x60, 0xc6, 0xdf, 0xca, 0xf9, 0x7d, 0xf9, 0x7b, 0xf5, 0x91, 0xf3, 0xa9, 0x91, 0x5b, 0x64, 0x4f,
+	0x8c, 0xec, 0x8e, 0xfb, 0xd3, 0x8b, 0xc9, 0xec, 0x6a, 0x43, 0x82, 0x5e, 0x18, 0x19, 0xaa, 0x44,
+	0x36, 0xc1, 0x87, 0x96, 0x03, 0xd6, 0x82, 0x67, 0x18, 0xf0, 0x35, 0x52, 0x09, 0x38, 0x01, 0xc1,
+	0xc0, 0x54, 0x52, 0x0b, 0x70, 0xfb, 0x98, 0xec, 0x1d, 0x44, 0xd1, 0x2c, 0x06, 0xa7, 0x69, 0x72,
+	0x18, 0x0e, 0x03, 0xe5, 0x42, 0x83, 0xd8, 0xde, 0x8f, 0xa6, 0x8f, 0xc0, 0xa5, 0xaf, 0x85, 0x32,
+	0x52, 0xa4, 0x41, 0x90, 0x84, 0xc3, 0xf9, 0x70, 0xd8, 0xf6, 0x67, 0x67, 0xb1, 0xb0, 0x00, 0x13,
+	0x00, 0x46, 0x10, 0x3b, 0xfe, 0x39, 0xa0, 0x72, 0xd5, 0xb7, 0xcc, 0x45, 0x06, 0xb5, 0x36, 0x1f,
+	0xa3, 0x0a, 0x49, 0x62, 0x12, 0x7c, 0x7f, 0xa5, 0xc1, 0x28, 0xed, 0x1c, 0x64, 0x68, 0x3f, 0x03,
+	0x66, 0xff, 0x55, 0x81, 0x58, 0x0d, 0xa1, 0xbe, 0xe3, 0x16, 0xb8, 0xbe, 0x3c, 0x56, 0x92, 0xe4,
+	0x67, 0x98, 0x49, 0x6a, 0x7d, 0x95, 0xac, 0x0d, 0xc2, 0x69, 0xd0, 0x57, 0xb1, 0x95, 0xad, 0xdb,
+	0x36, 0x57, 0x18, 0x8b, 0x0f, 0xdf, 0xaa, 0x49, 0x4c, 0x9a, 0x3c, 0xb4, 0x34, 0xfa, 0x82, 0x8a,
+	0x22, 0x40, 0x7f, 0x28, 0x8c, 0x47, 0xe2, 0xf4, 0x4e, 0x00, 0xba, 0xfe, 0x2f, 0x99, 0xfa, 0x5f,
+	0x9e, 0x32, 0x65, 0xed, 0x94, 0x79, 0x5b, 0x9d, 0xa8, 0x2b, 0x8c, 0xc4, 0x57, 0x96, 0x92, 0x98,
+	0xca, 0x04, 0xa5, 0xd5, 0xf0, 0x6a, 0x86, 0x1a, 0x46, 0x67, 0x4f, 0x71, 0x7c, 0x4d, 0x38, 0x7b,
+	0x8a, 0xd7, 0x22, 0xca, 0x4c, 0x54, 0x94, 0xd9, 0xfe, 0x0c, 0x59, 0x53, 0x8c, 0x40, 0x13, 0xbc,
+	0xdb, 0xea, 0x29, 0x73, 0x9a, 0xc7, 0x84, 0x01, 0xd2, 0x6a, 0x82, 0x25, 0xe5, 0xc1, 0xf1, 0x6c,
+	0xbf, 0x4e, 0xca, 0xc9, 0x79, 0x2e, 0xcc, 0x42, 0x40, 0x63, 0xa7, 0x76, 0xa3, 0x5d, 0x77, 0xbb,
+	0xcc, 0xbe, 0x27, 0xa4, 0x2c, 0x8c, 0xd4, 0xbc, 0xdd, 0x21, 0x37, 0x16, 0x67, 0xc6, 0xf5, 0xfb,
+	0x3b, 0x84, 0x44, 0x0a, 0x22, 0x14, 0xfc, 0xfe, 0x32, 0x66, 0x50, 0x0d, 0x17, 0x95, 0xfc, 0x56,
+	0x55, 0x84, 0xdf, 0x5b, 0x3c, 0xaa, 0x71, 0x9b, 0xac, 0xa2, 0xa8, 0xcf, 0x82, 0x87, 0x17, 0xc2,
+	0x52, 0xb9, 0xce, 0x87, 0x92, 0x78, 0x1d, 0xd1, 0x4b, 0x15, 0x1e, 0xee, 0x84, 0x24, 0x0a, 0x24,
+	0xe4, 0x53, 0x83, 0x30, 0x86, 0xc7, 0xc0, 0x3d, 0xd4, 0x3c, 0x49, 0xe4, 0xc8, 0x80, 0xd9, 0x0e,
+	0x9c, 0xdf, 0x06, 0x25, 0xb1, 0x75, 0x8b, 0xac, 0x44, 0x13, 0x7d, 0x52, 0xd7, 0x4c, 0x4a, 0x38,
+	0x1e, 0x95, 0x48, 0xf6, 0x6f, 0xe6, 0xc0, 0xce, 0xc5, 0xbe, 0x2a, 0xc8, 0xd3, 0x38, 0x18, 0xca,
+	0x8d, 0x8a, 0x31, 0x66, 0x0e, 0x69, 0x47, 0xe1, 0x58, 0x9e, 0x12, 0x06, 0xcc, 0x98, 0x76, 0xfe,
+	0xb9, 0xa6, 0x5d, 0x48, 0x4f, 0xdb, 0x7e, 0x97, 0x58, 0xad, 0x13, 0x50, 0x7d, 0xe7, 0xc1, 0xb4,
+	0x8a, 0x19, 0xa7, 0x31, 0xe8, 0xde, 0x21, 0x6e, 0x8d, 0x71, 0x34, 0x08, 0x94, 0x5a, 0x12, 0x2d,
+	0x94, 0xa9, 0x47, 0xe2, 0x90, 0xda, 0xa0, 0xf8, 0xd3, 0xfe, 0x2e, 0x18, 0xdb, 0x72, 0x80, 0xce,
+	0xd8, 0x9f, 0xc4, 0x67, 0xd1, 0xcc, 0xfa, 0x59, 0xd8, 0x23, 0x3c, 0x2b, 0x28, 0x9c, 0xe0, 0x4d,
+	0x23, 0xf9, 0x49, 0x65, 0x2f, 0x70, 0x6f, 0x55, 0xc6, 0x0a, 0xd9, 0xa0, 0xeb, 0xb7, 0x2d, 0x23,
+	0x94, 0xc8, 0x64, 0x87, 0x2a, 0x1c, 0x53, 0xe2, 0x0b, 0x29, 0x89, 0xb7, 0x03, 0x62, 0xbd, 0x37,
+	0xf7, 0xc1, 0x1c, 0x9a, 0x85, 0xe3, 0x60, 0x20, 0xd3, 0x80, 0x69, 0xc5, 0x01, 0xc4, 0x89, 0xf1,
+	0xc4, 0x2b, 0x53, 0xd1, 0x4b, 0xd9, 0x8b, 0x4c, 0x98, 0xf2, 0x04, 0x93, 0x38, 0xed, 0x78, 0x0b,
+	0x0e, 0x88, 0x1b, 0x8b, 0xaf, 0xe1, 0x52, 0xfe, 0x39, 0x6d, 0x3e, 0x86, 0x8c, 0x2f, 0x3e, 0x90,
+	0xcc, 0xca, 0x1e, 0x93, 0x57, 0x69, 0x10, 0x47, 0xc3, 0xf3, 0x20, 0x03, 0x4d, 0xc8, 0x47, 0x7a,
+	0x16, 0x5f, 0xc4, 0x94, 0x21, 0x3c, 0x33, 0xd7, 0xf4, 0xdf, 0xcd, 0xf4, 0xbb, 0xa8, 0xc2, 0xa0,
+	0x1a, 0xb6, 0x7d, 0x4a, 0x2c, 0x30, 0x08, 0xa7, 0xa0, 0xd7, 0x41, 0x0a, 0x46, 0x21, 0x3b, 0x70,
+	0x98, 0xfa, 0x82, 0x09, 0xf2, 0x77, 0xac, 0x52, 0xf6, 0x1b, 0x5d, 0x09, 0x96, 0xe2, 0x0c, 0x44,
+	0xf8, 0x42, 0xa6, 0xd1, 0x0d, 0x20, 0x32, 0x8a, 0x7b, 0x37, 0x22, 0x80, 0x23, 0x5a, 0xf6, 0xf7,
+	0xf3, 0xe0, 0xc8, 0xf2, 0x17, 0x89, 0x43, 0xfa, 0x29, 0x47, 0xde, 0x17, 0xc9, 0xfa, 0x24, 0xa1,
+	0x48, 0x2c, 0xcf, 0xbe, 0x5c, 0x9e, 0x34, 0xc5, 0x54, 0x47, 0xc6, 0xe3, 0x92, 0x53, 0x35, 0x48,
+	0x27, 0x03, 0x16, 0xe0, 0x78, 0x60, 0x71, 0x23, 0x29, 0x9d, 0x13, 0x48, 0x83, 0x51, 0xdb, 0x4f,
+	0x83, 0xf3, 0xe8, 0x11, 0x98, 0x27, 0x25, 0x1e, 0xa4, 0x11, 0x4d, 0x36, 0x93, 0x79, 0x8c, 0xf1,
+	0xf2, 0x80, 0xab, 0x7c, 0x30, 0x5d, 0x14, 0x00, 0x2d, 0xe4, 0x53, 0x1f, 0x0e, 0xe2, 0x81, 0x33,
+	0x9b, 0x05, 0xa3, 0xc9, 0x8c, 0xeb, 0xff, 0x12, 0x4d, 0x41, 0xed, 0x3b, 0x98, 0xdc, 0xd1, 0x39,
+	0xc4, 0xe5, 0xe8, 0x75, 0xd8, 0xe9, 0xa2, 0x6d, 0xaa, 0x15, 0x13, 0x99, 0x2a, 0x2c, 0x30, 0x32,
+	0x76, 0x40, 0x59, 0x63, 0x96, 0x85, 0x21, 0xfc, 0x34, 0xac, 0xbc, 0xff, 0xca, 0xa9, 0xe5, 0x94,
+	0x52, 0xb9, 0x24, 0xc5, 0xae, 0xe3, 0xdc, 0xca, 0x4c, 0xb1, 0x9b, 0xd1, 0x68, 0x4b, 0x44, 0xcc,
+	0xf8, 0xfb, 0x78, 0x78, 0x0c, 0x4c, 0x34, 0x2e, 0x46, 0x40, 0x3a, 0x3f, 0x84, 0x55, 0x1b, 0x95,
+	0x5a, 0xff, 0x6c, 0x3e, 0x7e, 0xe4, 0x01, 0xaf, 0x9f, 0xb0, 0x85, 0x29, 0x51, 0x0d, 0x62, 0x37,
+	0x48, 0x91, 0x45, 0xa8, 0xb6, 0xc9, 0xfa, 0x1d, 0xb7, 0xdb, 0x13, 0xf1, 0x27, 0x38, 0xbb, 0xe0,
+	0xd0, 0x43, 0x80, 0x88, 0x42, 0x74, 0xe0, 0xf8, 0xc2, 0x20, 0x0e, 0x75, 0x9d, 0xae, 0xdb, 0x13,
+	0x31, 0x8b, 0x4a, 0x1e, 0x0f, 0x42, 0x11, 0x6a, 0x80, 0xbf, 0x95, 0x82, 0xfd, 0xe7, 0x39, 0xcc,
+	0x82, 0x68, 0x7c, 0xbd, 0x82, 0xc3, 0xae, 0xeb, 0xc0, 0xfc, 0x95, 0x75, 0x60, 0xe1, 0x0a, 0x3a,
+	0x70, 0x31, 0xf2, 0x59, 0xcc, 0x8a, 0x7c, 0xda, 0xbf, 0x40, 0xb6, 0x3a, 0x93, 0x21, 0xc6, 0x3f,
+	0x64, 0xda, 0x1c, 0xd8, 0x3c, 0x4e, 0x32, 0x55, 0xec, 0x77, 0x3a, 0xd9, 0x50, 0x52, 0xc9, 0x06,
+	0x96, 0x27, 0x17, 0x41, 0x4e, 0x0c, 0xdf, 0x17, 0x44, 0x9e, 0x3c, 0x01, 0xd9, 0xbf, 0x03, 0x7c,
+	0x61, 0xaf, 0x38, 0x8c, 0xa6, 0x8f, 0xfd, 0x29, 0xdb, 0x13, 0x53, 0x95, 0xb8, 0x17, 0xf2, 0xa6,
+	0x00, 0x4b, 0x57, 0x1f, 0x77, 0xee, 0x59, 0x38, 0x1c, 0xe8, 0xce, 0x33, 0x7f, 0xdb, 0x02, 0x7c,
+	0x81, 0xf3, 0xc5, 0x0c, 0xaf, 0xfd, 0x77, 0x73, 0x2a, 0x69, 0xc5, 0xa8, 0x4b, 0xc7, 0x80, 0x73,
+	0x8b, 0x31, 0xe0, 0xcf, 0xa1, 0x36, 0x15, 0x74, 0x72, 0x3b, 0x58, 0xed, 0x38, 0x93, 0x87, 0x54,
+	0xc3, 0xc3, 0x95, 0x3b, 0xe5, 0x33, 0xe7, 0x79, 0x55, 0xb5, 0x72, 0x3a, 0x53, 0xa8, 0xc2, 0xb1,
+	0x7f, 0x89, 0x5c, 0x07, 0x47, 0x96, 0x75, 0xa6, 0x82, 0xeb, 0x9f, 0x22, 0x2b, 0x22, 0x6c, 0xbe,
+	0x3c, 0x6a, 0x2c, 0x31, 0x9e, 0x8f, 0x58, 0xfb, 0xdf, 0x61, 0xf7, 0x76, 0x58, 0x80, 0x99, 0x09,
+	0xc9, 0x7c, 0x18, 0x2c, 0x9c, 0x29, 0x6f, 0xc2, 0x02, 0xe9, 0xf6, 0xb4, 0x28, 0x59, 0x32, 0x9f,
+	0x02, 0x01, 0x66, 0x07, 0x8a, 0x40, 0x45, 0x01, 0x0a, 0xc6, 0xfe, 0x09, 0x86, 0xb1, 0xb9, 0xf6,
+	0x97, 0x4d, 0xe1, 0x8e, 0x0b, 0x4b, 0xbf, 0xa8, 0xdc, 0x71, 0x11, 0x87, 0xd0, 0x04, 0xaf, 0x64,
+	0x0a, 0x1e, 0x18, 0x19, 0xf3, 0xe9, 0x50, 0x98, 0xd1, 0xf8, 0xd3, 0x7e, 0x83, 0x94, 0xf9, 0x5b,
+	0x71, 0xbb, 0x36, 0x5b, 0x5d, 0xef, 0xf0, 0x81, 0x0c, 0xff, 0xc2, 0xa6, 0xde, 0x25, 0xdb, 0x8d,
+	0xd6, 0x3d, 0xb7, 0x07, 0xc6, 0x6b, 0xc7, 0xb9, 0x07, 0x46, 0x2a, 0xec, 0x6b, 0x30, 0xd5, 0x76,
+	0x4d, 0xba, 0xb9, 0x62, 0x7d, 0x8d, 0x94, 0xa6, 0xd8, 0x30, 0xb5, 0xaa, 0x89, 0x49, 0x39, 0x8a,
+	0xfd, 0xaf, 0x39, 0x72, 0x2d, 0xe9, 0x71, 0xe6, 0x83, 0x10, 0x3c, 0xc2, 0xd9, 0xf4, 0x82, 0x19,
+	0x06, 0x80, 0x21, 0x74, 0x2a, 0x78, 0xdc, 0xbc, 0xf5, 0x7c, 0xfc, 0x4b, 0x09, 0x67, 0x61, 0x51,
+	0x38, 0x99, 0x1d, 0x12, 0xcf, 0x87, 0x72, 0xa3, 0x8b, 0xd6, 0xc2, 0x5e, 0x28, 0x3d, 0xcd, 0x45,
+	0x28, 0xa7, 0x0d, 0xa6, 0xbb, 0x3a, 0x93, 0xd8, 0x04, 0x85, 0x15, 0x03, 0x6b, 0x38, 0x9b, 0x86,
+	0x8a, 0x4d, 0x37, 0xd3, 0x13, 0x49, 0x98, 0x41, 0x25, 0xaa, 0xfd, 0x16, 0xd9, 0xec, 0xcc, 0x27,
+	0x98, 0xe9, 0x3f, 0x00, 0x63, 0x7e, 0x18, 0x64, 0x26, 0xf8, 0x35, 0x03, 0x72, 0x8d, 0x1b, 0x90,
+	0xbf, 0x02, 0x46, 0x42, 0xbd, 0x09, 0xea, 0x04, 0xb6, 0x6c, 0x1b, 0x0c, 0x97, 0x51, 0xcc, 0xea,
+	0x6f, 0x84, 0x9a, 0x91, 0x55, 0x1a, 0xb2, 0x8d, 0xec, 0xc2, 0xa8, 0x0c, 0x9c, 0xb2, 0x28, 0x64,
+	0x42, 0x93, 0xe8, 0x20, 0x86, 0xe1, 0x3f, 0x51, 0x18, 0x05, 0x81, 0x91, 0x80, 0x70, 0xfc, 0x51,
+	0x30, 0xf3, 0x59, 0xd6, 0x43, 0x1c, 0x2d, 0xb2, 0x8d, 0xcc, 0x1e, 0x44, 0x23, 0xac, 0x18, 0xe4,
+	0xec, 0x14, 0xad, 0xe7, 0xab, 0xeb, 0x02, 0x55, 0xdd, 0xe7, 0xe9, 0x43, 0x11, 0x45, 0x16, 0x05,
+	0x77, 0x29, 0xa8, 0xfd, 0x01, 0xd9, 0x86, 0xd9, 0x33, 0x2e, 0x48, 0x8d, 0xf0, 0x69, 0xcc, 0xd2,
+	0x23, 0x37, 0x84, 0x42, 0x10, 0x92, 0x6a, 0x72, 0x8a, 0x0a, 0x9c, 0xa5, 0xaa, 0x15, 0x36, 0x99,
+	0x78, 0x95, 0x10, 0x2c, 0xd9, 0xb4, 0xcf, 0xc9, 0x8d, 0x3a, 0xc6, 0xfb, 0xc6, 0x70, 0xa8, 0xa9,
+	0xe8, 0x1a, 0xd7, 0x2f, 0x57, 0x4d, 0xad, 0xa5, 0x58, 0x92, 0xbf, 0x0a, 0x4b, 0xec, 0x5f, 0x26,
+	0xd7, 0x95, 0xee, 0x83, 0x55, 0x1b, 0x24, 0x09, 0xde, 0xab, 0xbe, 0x96, 0x47, 0xcc, 0xe0, 0xd1,
+	0x83, 0x00, 0x34, 0xab, 0x14, 0x01, 0x03, 0x86, 0xfc, 0x18, 0x46, 0x20, 0x33, 0x32, 0x3e, 0x2f,
+	0x5a, 0xf6, 0x7d, 0xb2, 0x73, 0x14, 0xf8, 0xc3, 0xd9, 0x59, 0xf5, 0x2c, 0xe8, 0x3f, 0xa2, 0x7c,
+	0x1f, 0x2d, 0x39, 0x16, 0xcf, 0x18, 0xe2, 0x85, 0x4c, 0xce, 0x89, 0x26, 0xd6, 0x66, 0xb0, 0x1d,
+	0x26, 0x46, 0xe6, 0x0d, 0xfb, 0x31, 0xd9, 0xe0, 0x03, 0x0b, 0x8f, 0x59, 0x7b, 0x3e, 0x67, 0x3e,
+	0xff, 0x59, 0x52, 0xee, 0xe3, 0xcb, 0xa5, 0xe6, 0xbe, 0xc1, 0x19, 0xb6, 0x40, 0x16, 0x15, 0x68,
+	0x4f, 0xf1, 0x79, 0xee, 0x91, 0x22, 0x4b, 0xfc, 0xe2, 0x9e, 0x91, 0xc5, 0x2b, 0x72, 0xcf, 0xc8,
+	0x7a, 0x33, 0x20, 0xf9, 0xdc, 0x1f, 0xce, 0x03, 0x51, 0x4e, 0xc0, 0x1b, 0x4f, 0x19, 0xf7, 0x93,
+	0xa4, 0x84, 0xe3, 0x62, 0x54, 0xbb, 0x84, 0xae, 0xa4, 0x54, 0x05, 0x84, 0x93, 0x8b, 0x7d, 0x94,
+	0x77, 0xd8, 0xff, 0x9d, 0x23, 0xd6, 0xa1, 0x0f, 0x24, 0x7b, 0xe3, 0x5f, 0x14, 0x51, 0x16, 0x3c,
+	0x5d, 0x3e, 0x47, 0x4a, 0xa7, 0x08, 0x15, 0xc6, 0xe1, 0x87, 0x45, 0x2e, 0x61, 0x01, 0x91, 0x83,
+	0x28, 0x47, 0x66, 0xea, 0x70, 0x1a, 0x9d, 0xf8, 0x27, 0x21, 0x9c, 0x64, 0x17, 0x82, 0x62, 0x1d,
+	0x74, 0x05, 0x85, 0x99, 0x2a, 0xbc, 0x29, 0x2e, 0x14, 0xde, 0xd8, 0x1e, 0x29, 0xb1, 0xb7, 0x62,
+	0xb5, 0x5b, 0xb3, 0xd5, 0xc3, 0xcc, 0x23, 0x9e, 0x24, 0xeb, 0x64, 0xa5, 0xeb, 0x35, 0x5c, 0x68,
+	0x81, 0x65, 0x08, 0xb6, 0xe2, 0xa1, 0x8b, 0xa7, 0x4a, 0xab, 0x77, 0xe4, 0xdd, 0x39, 0x02, 0xbb,
+	0x30, 0x23, 0x03, 0x56, 0xb0, 0x5d, 0xb2, 0xbb, 0x38, 0x27, 0xb4, 0x0d, 0x8c, 0x83, 0x66, 0x7f,
+	0xd9, 0xec, 0xe5, 0x61, 0xf3, 0x01, 0xd9, 0x7d, 0x6f, 0x1e, 0xcc, 0x83, 0x94, 0xdb, 0x77, 0xd5,
+	0x4d, 0xb1, 0x4c, 0x01, 0xdc, 0x4c, 0x55, 0xa5, 0x14, 0xb4, 0x2a, 0x94, 0x1f, 0xe5, 0xc9, 0x26,
+	0x7b, 0xa7, 0x72, 0x95, 0x9f, 0x6e, 0x28, 0x5d, 0xb5, 0x1a, 0x66, 0x59, 0x6c, 0x4d, 0xa7, 0xa7,
+	0x68, 0xd2, 0x93, 0x5d, 0x68, 0x5b, 0x5a, 0x56, 0x68, 0x9b, 0xe1, 0xc3, 0x95, 0xb3, 0x7d, 0xb8,
+	0xdb, 0xa9, 0x18, 0x9c, 0x72, 0x93, 0xb5, 0xa9, 0xa7, 0xc3, 0x6f, 0x6a, 0x97, 0xaf, 0xea, 0xbb,
+	0xbc, 0xa6, 0x22, 0x62, 0x84, 0x94, 0x79, 0xfa, 0x96, 0x4b, 0x4d, 0x47, 0x44, 0xc7, 0xf4, 0x42,
+	0xca, 0x24, 0x30, 0x56, 0x40, 0x14, 0x29, 0x31, 0x45, 0x30, 0x4d, 0xb6, 0x8c, 0x77, 0xc7, 0xa0,
+	0x13, 0xd2, 0x61, 0x83, 0xdd, 0x0c, 0x1a, 0xb5, 0x88, 0x81, 0x0b, 0xaf, 0x84, 0xd3, 0xac, 0xe1,
+	0x3f, 0x59, 0x1a, 0x94, 0x4d, 0xc7, 0xb3, 0xf2, 0x19, 0xf1, 0xac, 0xdf, 0xcb, 0x91, 0x55, 0x1a,
+	0xcd, 0x67, 0xc1, 0x51, 0x34, 0xd1, 0xdc, 0xbe, 0x9c, 0xee, 0xf6, 0xb1, 0x42, 0xc3, 0x33, 0x7f,
+	0xec, 0xf1, 0x00, 0x7d, 0x91, 0x8a, 0x16, 0x9a, 0xed, 0xfe, 0x68, 0xd6, 0x8d, 0x84, 0x9d, 0xcb,
+	0x8a, 0x57, 0x85, 0xc3, 0x9d, 0x86, 0xeb, 0xf5, 0xad, 0x45, 0xb3, 0xbe, 0x35, 0xc9, 0x5e, 0x94,
+	0x58, 0x2a, 0x4a, 0x66, 0x2f, 0xfe, 0x21, 0x31, 0xe2, 0x19, 0x85, 0x57, 0x90, 0x4d, 0x98, 0xf1,
+	0x2c, 0x9a, 0xf9, 0x43, 0x67, 0x34, 0x63, 0x6f, 0x12, 0x33, 0xd6, 0x61, 0x18, 0xd0, 0x60, 0x6d,
+	0x98, 0x7d, 0xac, 0x51, 0x6c, 0x02, 0x15, 0x16, 0xca, 0x50, 0x3d, 0x02, 0x2b, 0xa4, 0xc8, 0x68,
+	0x33, 0x81, 0xf0, 0xbe, 0xe2, 0x59, 0x34, 0xc1, 0x30, 0x70, 0x21, 0xa9, 0xf6, 0x92, 0xec, 0xa4,
+	0xac, 0xcf, 0xfe, 0x3b, 0x42, 0x36, 0x0f, 0x99, 0xcb, 0xff, 0x93, 0xdf, 0x63, 0x29, 0x35, 0x57,
+	0x58, 0xac, 0x2f, 0x4c, 0xd5, 0x87, 0x15, 0x2f, 0xab, 0x0f, 0x2b, 0xa5, 0x63, 0xe0, 0xcb, 0xed,
+	0x46, 0xdc, 0x51, 0x22, 0x32, 0x66, 0xec, 0x28, 0x63, 0xa2, 0xb7, 0x44, 0xed, 0xb5, 0xc0, 0xcc,
+	0xde, 0x51, 0x96, 0x43, 0xd6, 0x31, 0x22, 0x32, 0x9f, 0x06, 0xd5, 0x68, 0xc0, 0xd3, 0x84, 0x2a,
+	0x48, 0x6e, 0x0e, 0x77, 0x98, 0xa0, 0x51, 0xfd, 0x19, 0xeb, 0x6d, 0x42, 0xb0, 0x09, 0x76, 0x0c,
+	0xb0, 0x9d, 0x85, 0xbb, 0xb7, 0xe4, 0xa1, 0x6a, 0x8e, 0x80, 0xab, 0xa2, 0xa1, 0xda, 0xff, 0x91,
+	0x23, 0x65, 0x51, 0xa5, 0x0c, 0xfb, 0xf3, 0xb8, 0x79, 0xb7, 0x89, 0x95, 0x24, 0x2f, 0x18, 0x67,
+	0x42, 0x0e, 0xd3, 0xd7, 0x5e, 0xb3, 0x73, 0x7c, 0x78, 0xe8, 0x55, 0x3d, 0x2c, 0x59, 0x38, 0x70,
+	0xea, 0x58, 0x6f, 0xbc, 0xe4, 0x38, 0xd0, 0x8f, 0x90, 0x22, 0x56, 0x28, 0xe0, 0x11, 0x52, 0xf7,
+	0x1a, 0x5e, 0x17, 0x70, 0xaa, 0xae, 0x8b, 0x25, 0x25, 0x25, 0xeb, 0x43, 0xe4, 0x45, 0xaf, 0x59,
+	0x6d, 0x51, 0xea, 0x56, 0x55, 0x30, 0xa2, 0x57, 0x73, 0xbb, 0xa0, 0x2e, 0x3a, 0x95, 0x32, 0xd6,
+	0x7a, 0x40, 0x8f, 0xd7, 0x66, 0xef, 0x6b, 0x1d, 0x1e, 0xd6, 0xbd, 0x26, 0xd6, 0xa8, 0x20, 0x18,
+	0x89, 0xea, 0x1d, 0x37, 0x93, 0xd2, 0x95, 0x55, 0x24, 0x90, 0x83, 0x53, 0x85, 0x10, 0x6b, 0x78,
+	0x82, 0xb1, 0x5a, 0x1a, 0x54, 0x43, 0xc7, 0xd4, 0xad, 0x10, 0xfb, 0x3f, 0x8b, 0x64, 0x5d, 0x63,
+	0x24, 0x4e, 0x01, 0x13, 0xf5, 0xbc, 0xbf, 0x57, 0x05, 0x64, 0x98, 0xff, 0x0e, 0xd9, 0x84, 0x79,
+	0x39, 0x75, 0xaf, 0x86, 0xa5, 0x16, 0xf5, 0x06, 0x30, 0xe1, 0x26, 0xb9, 0xde, 0x75, 0x1b, 0xed,
+	0x16, 0x75, 0xe8, 0x83, 0x9e, 0x31, 0x66, 0x9e, 0xd7, 0x8c, 0xd0, 0x86, 0xd3, 0x44, 0x6a, 0x8d,
+	0xbe, 0x02, 0x96, 0x9c, 0x50, 0xf7, 0xbd, 0x63, 0xe4, 0x8d, 0xe8, 0x72, 0x9d, 0x2e, 0xbe, 0xaa,
+	0xe1, 0xb1, 0x8b, 0x1c, 0xc0, 0x23, 0x56, 0x3a, 0xc4, 0xdf, 0xd6, 0x6a, 0x62, 0x35, 0xca, 0x3d,
+	0x97, 0x76, 0xb0, 0x3e, 0xa0, 0x84, 0xec, 0x33, 0xbb, 0x8e, 0x1a, 0x4e, 0x95, 0xf3, 0xc7, 0x84,
+	0xdf, 0x75, 0x1f, 0x00, 0x7f, 0x80, 0xab, 0x09, 0x91, 0xb2, 0xd2, 0x45, 0xd2, 0xb2, 0x8a, 0xdd,
+	0x09, 0x9d, 0xe9, 0xee, 0x35, 0xd8, 0xf3, 0xaf, 0x2a, 0x52, 0x55, 0x6f, 0x8a, 0x5a, 0x82, 0xaf,
+	0x16, 0x82, 0xd2, 0x6b, 0xba, 0x5f, 0x87, 0xc5, 0x73, 0x59, 0x81, 0x0f, 0xac, 0x81, 0xd3, 0x60,
+	0x35, 0x4e, 0x07, 0x6e, 0xbd, 0x75, 0x1f, 0x1e, 0x68, 0x7a, 0x8d, 0xe3, 0x46, 0x65, 0x83, 0xd5,
+	0xa9, 0xbb, 0x18, 0x5c, 0x4a, 0x44, 0xa8, 0xb2, 0xc9, 0x27, 0x2d, 0x05, 0xa0, 0x5a, 0xef, 0xde,
+	0x13, 0xe5, 0x36, 0x95, 0x2d, 0x5c, 0x12, 0x51, 0x7a, 0x83, 0x96, 0x47, 0xa7, 0x05, 0x9c, 0xd8,
+	0xc6, 0x51, 0x24, 0x4d, 0x35, 0xaf, 0x83, 0x0b, 0x8f, 0x05, 0x3e, 0xf0, 0x56, 0x49, 0x8c, 0x14,
+	0xa2, 0x23, 0xa7, 0x73, 0x54, 0xd9, 0x81, 0xed, 0xbb, 0xbf, 0x28, 0x60, 0x9c, 0xc2, 0x8a, 0xc5,
+	0x8a, 0x9d, 0xbc, 0xa6, 0x53, 0xef, 0xa5, 0x5f, 0xb4, 0x8b, 0xf7, 0x70, 0x78, 0x57, 0x36, 0x79,
+	0xd7, 0xb2, 0x10, 0x8e, 0xba, 0xf5, 0xaa, 0x1c, 0x9c, 0x55, 0x04, 0x69, 0xc3, 0x1e, 0x3a, 0xb4,
+	0x72, 0xdd, 0xfe, 0x12, 0x29, 0xe0, 0x09, 0xb3, 0x4d, 0xd6, 0x25, 0xbd, 0x47, 0xad, 0x36, 0x48,
+	0x1a, 0x1c, 0x91, 0x78, 0x72, 0x02, 0x0b, 0x73, 0xac, 0x9a, 0x8c, 0x6d, 0xb9, 0x3c, 0x66, 0x98,
+	0x94, 0xfc, 0x83, 0x85, 0x05, 0xe7, 0xa5, 0xb1, 0x91, 0x2f, 0x39, 0x2f, 0x0d, 0x3c, 0xed, 0xbc,
+	0xfc, 0x56, 0x9e, 0x54, 0x6a, 0x11, 0xd7, 0x8a, 0x55, 0xd0, 0x60, 0x7e, 0xf8, 0x70, 0xbc, 0x70,
+	0xe3, 0x0b, 0x4b, 0xf8, 0xc3, 0xd9, 0x50, 0x66, 0x59, 0x79, 0x23, 0xad, 0x43, 0x0b, 0x8b, 0x3a,
+	0x14, 0x6c, 0x9a, 0xd0, 0x2c, 0x94, 0x55, 0x6d, 0xf4, 0x2d, 0x1e, 0x46, 0xfe, 0x50, 0x68, 0x57,
+	0xf6, 0x3b, 0xdb, 0xce, 0x29, 0x2f, 0xb3, 0x73, 0x60, 0xf4, 0x29, 0xbf, 0xeb, 0x25, 0xbd, 0x47,
+	0xd5, 0x06, 0x23, 0xd3, 0xea, 0x47, 0xe8, 0x7e, 0x9f, 0xb0, 0xc0, 0x7e, 0x5c, 0x65, 0x9a, 0x9c,
+	0xd7, 0xc7, 0x66, 0xf4, 0x80, 0xd9, 0xbb, 0x93, 0xe6, 0x42, 0x0c, 0x76, 0xfa, 0x5a, 0x5f, 0x36,
+	0x04, 0x37, 0x45, 0x5a, 0x29, 0x8d, 0x4b, 0x13, 0x44, 0xfb, 0xfb, 0x39, 0x72, 0x5d, 0xf6, 0xa7,
+	0x82, 0x59, 0x18, 0x9d, 0x15, 0x78, 0x9e, 0xe4, 0xaf, 0x06, 0xb9, 0xac, 0x26, 0x79, 0x10, 0x8d,
+	0xa3, 0xa9, 0x5e, 0x93, 0xac, 0x00, 0x7a, 0x7e, 0xbd, 0x68, 0xe4, 0xd7, 0x53, 0x26, 0x84, 0xaa,
+	0x0c, 0xb6, 0xff, 0x2c, 0x47, 0xae, 0xa9, 0x29, 0x68, 0xcc, 0xb8, 0xc2, 0x11, 0xfc, 0x93, 0x26,
+	0x11, 0x8c, 0x55, 0x5e, 0xdc, 0x99, 0x36, 0x6c, 0xd3, 0x60, 0xfb, 0x01, 0xd9, 0xcb, 0xa2, 0x39,
+	0xb6, 0xbe, 0x4a, 0x36, 0x8d, 0x15, 0x35, 0x43, 0x33, 0x59, 0xcf, 0x50, 0xf3, 0x01, 0xfb, 0x9f,
+	0xf8, 0xfd, 0x05, 0x16, 0x17, 0x55, 0xf7, 0x28, 0x9f, 0xc2, 0x88, 0xc4, 0x76, 0x36, 0x52, 0x4c,
+	0xc6, 0x30, 0x4b, 0x6d, 0x67, 0xdd, 0x43, 0x66, 0x79, 0x73, 0x9e, 0xf5, 0x60, 0xcc, 0x29, 0x51,
+	0xd9, 0xb4, 0x6f, 0x2b, 0xab, 0x1a, 0x36, 0x3e, 0x16, 0x58, 0xb2, 0xa4, 0x34, 0xcf, 0x34, 0x77,
+	0x8e, 0xab, 0xe2, 0xd4, 0x34, 0x33, 0xcd, 0xdf, 0x24, 0xeb, 0x34, 0x98, 0x4d, 0x2f, 0xda, 0xd1,
+	0x30, 0xec, 0x5f, 0x88, 0x98, 0x8f, 0xca, 0xb5, 0xe4, 0xd8, 0x0b, 0x74, 0x10, 0x5a, 0xab, 0xbc,
+	0xb0, 0x64, 0x78, 0xe0, 0xf7, 0x1f, 0x45, 0xa7, 0xa7, 0x8d, 0x58, 0xac, 0xed, 0x02, 0x1c, 0x0d,
+	0x49, 0x78, 0x34, 0xc1, 0x13, 0xa9, 0x60, 0x1d, 0x66, 0xc7, 0x64, 0x97, 0x13, 0x60, 0xda, 0x64,
+	0x6f, 0x24, 0xc9, 0x45, 0x1e, 0xb7, 0xb9, 0xa1, 0x18, 0x66, 0xee, 0x92, 0x24, 0xcd, 0xf8, 0x49,
+	0xb0, 0xbb, 0xd9, 0x2c, 0xcc, 0x08, 0x8a, 0x36, 0x3d, 0x2a, 0x10, 0xd8, 0x0a, 0x32, 0xaf, 0xbc,
+	0x2d, 0x2f, 0x2d, 0x65, 0xc5, 0x2e, 0xd0, 0x90, 0x0f, 0xc7, 0x63, 0x55, 0x51, 0x23, 0x5a, 0xc8,
+	0x24, 0xac, 0xcf, 0xea, 0xcc, 0xfb, 0x7d, 0x59, 0x6c, 0x5d, 0xa0, 0x3a, 0x08, 0xc5, 0x1b, 0x9b,
+	0x2e, 0x5b, 0x3d, 0x51, 0xf9, 0xa0, 0x00, 0x78, 0x39, 0x15, 0x04, 0x2a, 0x0e, 0xfa, 0x20, 0x4f,
+	0xe7, 0x81, 0x30, 0x23, 0x62, 0x79, 0x39, 0x35, 0xa3, 0x0b, 0x75, 0x17, 0x98, 0xc3, 0xc3, 0x30,
+	0x98, 0xc6, 0x42, 0xc1, 0xa9, 0xb6, 0x5d, 0x25, 0x5b, 0xc6, 0x54, 0x62, 0xe0, 0xdd, 0x9a, 0xbc,
+	0x8c, 0x95, 0x52, 0xeb, 0x06, 0x22, 0x4d, 0xb0, 0xec, 0x1f, 0xe4, 0x48, 0x45, 0xab, 0x0f, 0xa3,
+	0xc1, 0x3c, 0x0e, 0x2e, 0x2f, 0x19, 0x14, 0xf5, 0x68, 0x79, 0xbd, 0x1e, 0x0d, 0xb9, 0x08, 0x0f,
+	0xca, 0x00, 0x36, 0xfb, 0xcd, 0xe2, 0xda, 0xa8, 0x47, 0x00, 0x5c, 0x14, 0x71, 0x6d, 0xde, 0x44,
+	0x3e, 0x46, 0xb3, 0xb3, 0x60, 0x2a, 0x2e, 0xe4, 0xf1, 0xbc, 0xa0, 0x0e, 0xc2, 0x1d, 0x30, 0x45,
+	0x52, 0x44, 0x5e, 0x90, 0x37, 0xec, 0x6f, 0xc3, 0xea, 0xa1, 0xa0, 0xb3, 0x08, 0xaa, 0x07, 0xff,
+	0xf4, 0x54, 0x74, 0xee, 0xd2, 0x54, 0x34, 0x38, 0x24, 0xe2, 0xf6, 0x31, 0x96, 0x0d, 0x3c, 0x94,
+	0xde, 0x9c, 0x09, 0x64, 0xb7, 0x76, 0xe7, 0x63, 0x8c, 0xe8, 0x99, 0x37, 0x93, 0x53, 0x50, 0xfb,
+	0xef, 0x0b, 0xb0, 0xb1, 0x24, 0x21, 0x48, 0xec, 0x08, 0xf4, 0x84, 0xdc, 0xfe, 0xbc, 0xb1, 0x78,
+	0xb1, 0x2a, 0x7f, 0x85, 0x8b, 0x55, 0x85, 0xc5, 0x8b, 0x55, 0x40, 0x53, 0x34, 0x09, 0x74, 0x9a,
+	0xb8, 0x03, 0x98, 0x82, 0xb2, 0x50, 0x29, 0xbf, 0x82, 0x29, 0xf1, 0x4a, 0x22, 0x54, 0x6a, 0x40,
+	0x95, 0x93, 0x87, 0xc5, 0x0a, 0xe1, 0x4c, 0x8a, 0x95, 0x01, 0xe3, 0x54, 0x41, 0xbb, 0x16, 0x9c,
+	0x84, 0x22, 0xf3, 0xca, 0xa8, 0x52, 0x20, 0xe6, 0xde, 0x48, 0x8f, 0x4f, 0x9c, 0x97, 0x09, 0x00,
+	0x76, 0x64, 0x29, 0x04, 0xe6, 0xc4, 0xe0, 0x8e, 0x68, 0x42, 0x68, 0x2c, 0x1d, 0xe5, 0x18, 0xfc,
+	0xe6, 0x2e, 0x88, 0x7e, 0x1f, 0xed, 0x0e, 0x71, 0xaf, 0x44, 0x83, 0x30, 0xeb, 0x21, 0x04, 0x53,
+	0x21, 0x98, 0xf8, 0x18, 0x99, 0xe3, 0x97, 0x65, 0x75, 0x10, 0xee, 0x11, 0x70, 0x93, 0x91, 0x15,
+	0xf1, 0xfe, 0x06, 0x2b, 0xc0, 0x52, 0x6d, 0xec, 0xe3, 0x2e, 0xa7, 0xff, 0x84, 0x5d, 0x28, 0x81,
+	0xfd, 0x23, 0xdb, 0x78, 0x00, 0x5b, 0x42, 0x4e, 0x80, 0x68, 0x57, 0xb8, 0xf5, 0x4b, 0xc3, 0x01,
+	0xa2, 0x1a, 0x28, 0x9f, 0x79, 0xe7, 0xb4, 0x60, 0xfa, 0xe4, 0x60, 0x56, 0xc4, 0x5c, 0x23, 0xb4,
+	0xb5, 0x50, 0x5c, 0x91, 0x85, 0xe2, 0x32, 0x7a, 0x58, 0x82, 0x02, 0xdd, 0xde, 0x58, 0x64, 0x72,
+	0x44, 0xcb, 0xfe, 0x61, 0x9e, 0xac, 0xa1, 0x71, 0xc8, 0x6f, 0x29, 0x18, 0x2e, 0x65, 0x2e, 0xed,
+	0x52, 0xca, 0x4c, 0x72, 0x5e, 0xcf, 0x24, 0xab, 0x87, 0x6f, 0xb1, 0xbf, 0x5a, 0x26, 0x19, 0x6d,
+	0xae, 0x71, 0x3f, 0x1a, 0x01, 0x9b, 0xc4, 0xae, 0x55, 0x6d, 0x36, 0x31, 0x1e, 0x7b, 0x90, 0x3b,
+	0x57, 0x34, 0x97, 0x7a, 0xbb, 0xa9, 0x73, 0xb0, 0x9c, 0x69, 0x10, 0x88, 0x20, 0xc8, 0x4a, 0x3a,
+	0x08, 0x12, 0xa4, 0xaf, 0x53, 0xaf, 0xb2, 0x60, 0xc1, 0x02, 0xdc, 0xfe, 0x32, 0x59, 0x53, 0xd3,
+	0x40, 0x73, 0xd7, 0xa9, 0xd5, 0x92, 0xf8, 0x51, 0xb7, 0x5b, 0x4f, 0x1f, 0x72, 0xfc, 0x2a, 0xae,
+	0x28, 0x90, 0x2f, 0xd8, 0x6f, 0x11, 0xa2, 0xf8, 0x11, 0x83, 0xea, 0x28, 0x07, 0xe7, 0x9a, 0x01,
+	0xbc, 0x9d, 0xe2, 0x18, 0x15, 0xdd, 0xf6, 0x84, 0xdc, 0x04, 0xa3, 0x20, 0x86, 0x03, 0x04, 0x10,
+	0x64, 0xd5, 0x91, 0xaa, 0x0f, 0xfc, 0x29, 0x54, 0x52, 0xd9, 0x7f, 0x92, 0x27, 0x2f, 0x89, 0xf7,
+	0x24, 0x6f, 0x06, 0x36, 0xb4, 0xa7, 0xc1, 0x79, 0x18, 0x3c, 0xc6, 0xad, 0x0e, 0xeb, 0x24, 0x30,
+	0x3a, 0xe1, 0x37, 0x02, 0x21, 0x0d, 0x29, 0x28, 0xbb, 0x6a, 0x3d, 0xf5, 0x1f, 0xe2, 0x1a, 0xa8,
+	0xb3, 0x4c, 0x83, 0xb0, 0xe2, 0x14, 0xad, 0x3c, 0x8a, 0xe7, 0x60, 0xd7, 0xa8, 0x09, 0xd4, 0xd6,
+	0xbc, 0x68, 0xac, 0x39, 0x08, 0xb9, 0x8a, 0x85, 0xc9, 0xc9, 0xca, 0xc3, 0x2c, 0xa3, 0x87, 0xad,
+	0xb4, 0x84, 0xb6, 0x40, 0x77, 0x61, 0x4c, 0x8d, 0x2b, 0x9f, 0x05, 0x38, 0xce, 0x70, 0x1c, 0x3c,
+	0xd6, 0x67, 0x28, 0xf2, 0x3e, 0x26, 0xd4, 0xfe, 0x76, 0x81, 0x5c, 0xcb, 0xe2, 0xd4, 0x42, 0x66,
+	0xf6, 0x0b, 0x29, 0x33, 0xec, 0x23, 0x62, 0x91, 0x32, 0x9e, 0x4d, 0x5b, 0x63, 0x57, 0xe3, 0x12,
+	0x96, 0x9f, 0xc9, 0x1b, 0xf0, 0xa1, 0x2a, 0x32, 0x37, 0x60, 0xa9, 0x75, 0x2f, 0x2d, 0x54, 0xd0,
+	0x25, 0x9c, 0x2e, 0xa7, 0x77, 0x97, 0xb8, 0x98, 0x8e, 0xe3, 0x88, 0x82, 0x72, 0x1d, 0xf4, 0x7f,
+	0x2f, 0x76, 0x84, 0xbd, 0xa5, 0xd5, 0x2a, 0xe2, 0x6d, 0x1c, 0x5e, 0xab, 0x08, 0x8d, 0x56, 0xdb,
+	0x6d, 0xf2, 0xd0, 0xac, 0x51, 0xb8, 0x68, 0xc4, 0x67, 0xed, 0x1e, 0x79, 0x31, 0x8b, 0x97, 0x3c,
+	0x67, 0x7c, 0x80, 0x59, 0x3c, 0x1d, 0x6a, 0x9a, 0xde, 0x59, 0x0f, 0xd2, 0xd4, 0x13, 0xf6, 0x1f,
+	0x17, 0xc8, 0xa6, 0x17, 0xc7, 0xf3, 0x40, 0xde, 0x62, 0xfb, 0x09, 0xc6, 0x01, 0x3f, 0xae, 0x55,
+	0xcf, 0x5c, 0x72, 0xdf, 0xec, 0xb3, 0xa4, 0x84, 0x22, 0x11, 0x88, 0xef, 0x91, 0x08, 0x15, 0x6b,
+	0x10, 0xc5, 0xcf, 0x38, 0xca, 0xf1, 0x96, 0x6a, 0x4b, 0x90, 0x03, 0xfe, 0x8b, 0xdd, 0x50, 0xe3,
+	0x6b, 0xad, 0x41, 0xb2, 0xfd, 0xdb, 0x95, 0x67, 0x88, 0xe3, 0xaf, 0x66, 0xc7, 0xf1, 0x33, 0x9c,
+	0xa8, 0xb5, 0x6c, 0x27, 0xea, 0x6d, 0x52, 0x62, 0x33, 0xc1, 0x68, 0x3c, 0xae, 0x7f, 0x5a, 0xc9,
+	0x6a, 0xe1, 0x78, 0x26, 0x07, 0x47, 0x1e, 0xa8, 0xe2, 0x26, 0x0f, 0x35, 0x18, 0x0c, 0x61, 0xa1,
+	0x06, 0x91, 0xbe, 0x4c, 0xd9, 0xa4, 0x06, 0x1e, 0x55, 0x48, 0xf6, 0x3d, 0xb0, 0x48, 0xb1, 0x08,
+	0x8c, 0x9b, 0xee, 0x2c, 0xa1, 0xb7, 0xd4, 0x4a, 0xf7, 0xe3, 0x58, 0xb3, 0xd2, 0x59, 0x6b, 0x69,
+	0xd5, 0xe1, 0xf7, 0x8a, 0xe2, 0x2a, 0xb7, 0x56, 0x88, 0x90, 0x56, 0x13, 0xc6, 0x1e, 0xc9, 0xa7,
+	0x8f, 0xd8, 0x2f, 0xab, 0x62, 0x7b, 0xe1, 0x9b, 0xa9, 0x48, 0x6b, 0x6a, 0xdc, 0x5b, 0x9e, 0x44,
+	0xa3, 0xc9, 0x13, 0x28, 0xb0, 0xaa, 0xe1, 0x0d, 0x64, 0x30, 0x59, 0x03, 0x81, 0x4a, 0x2d, 0x3e,
+	0x0a, 0xc7, 0xbc, 0x52, 0x4e, 0xb9, 0x8a, 0xe9, 0xb1, 0xef, 0x02, 0x06, 0x65, 0x78, 0xe9, 0x00,
+	0x76, 0x39, 0x33, 0x80, 0xad, 0x6f, 0x92, 0x95, 0xcb, 0x3c, 0xf5, 0xd5, 0xa5, 0x89, 0xa6, 0xb5,
+	0x54, 0xa2, 0xe9, 0x96, 0x4a, 0xc1, 0x12, 0x3d, 0xdc, 0x91, 0x5e, 0x36, 0x3d, 0x03, 0xcb, 0xac,
+	0x9e, 0x00, 0x4b, 0xfd, 0xd6, 0x65, 0xa9, 0x9f, 0x00, 0x24, 0xee, 0xee, 0x86, 0x9e, 0x2a, 0x02,
+	0x66, 0x2b, 0x2e, 0x5a, 0x65, 0x92, 0x3f, 0xf6, 0x84, 0x43, 0x5b, 0x3d, 0x72, 0x6b, 0xc7, 0x75,
+	0x16, 0xf2, 0x02, 0xc9, 0x6b, 0xd7, 0x8f, 0xef, 0x78, 0x4d, 0x1e, 0xf3, 0x72, 0xda, 0x5e, 0xaf,
+	0xdb, 0xba, 0xcb, 0x04, 0xd1, 0x26, 0x45, 0x64, 0x14, 0x82, 0xf5, 0x12, 0x6d, 0xd4, 0x67, 0xaa,
+	0x3e, 0xfb, 0xaf, 0x73, 0x42, 0xd4, 0x18, 0x77, 0x0f, 0xc3, 0xe1, 0x0c, 0x1c, 0xc2, 0x05, 0xbb,
+	0x3d, 0x77, 0x05, 0xbb, 0x3d, 0xbf, 0x68, 0xb7, 0x7f, 0x85, 0x10, 0xb5, 0xb4, 0xf2, 0xab, 0x11,
+	0x4f, 0x95, 0x16, 0xed, 0x11, 0x76, 0x7a, 0xb3, 0x68, 0x5c, 0x6b, 0x3c, 0xbc, 0x10, 0x86, 0x98,
+	0x06, 0xb1, 0xbf, 0x0a, 0xce, 0x90, 0x1a, 0xa8, 0x1e, 0x3d, 0x84, 0x9d, 0x96, 0xaa, 0x3a, 0xd9,
+	0xcb, 0x7c, 0x5d, 0x52, 0x70, 0xf2, 0xb7, 0xac, 0x1e, 0x91, 0x07, 0x22, 0xe6, 0xa3, 0x91, 0x3f,
+	0xbd, 0xb8, 0x82, 0x52, 0xcd, 0xb4, 0x33, 0x9f, 0xfd, 0xa3, 0x40, 0x2a, 0x56, 0x58, 0xd4, 0x63,
+	0x85, 0xcf, 0x94, 0xc1, 0x04, 0xcb, 0xac, 0x22, 0x23, 0x9a, 0xaa, 0x72, 0xfa, 0xf5, 0x85, 0xc8,
+	0xe6, 0x35, 0x33, 0xe2, 0xc2, 0x27, 0xaa, 0x95, 0x03, 0x82, 0x5d, 0x32, 0x9f, 0x0c, 0xcc, 0xba,
+	0x57, 0x11, 0xd8, 0x48, 0xc3, 0xb1, 0x32, 0x6e, 0x9f, 0xdf, 0x09, 0x12, 0xc3, 0xb1, 0x6c, 0x8a,
+	0x91, 0x4e, 0x7a, 0x9e, 0x8f, 0x09, 0xe0, 0x0d, 0xea, 0x28, 0xe2, 0x86, 0x4e, 0x81, 0x79, 0x00,
+	0xaa, 0x8d, 0xf2, 0x28, 0x54, 0xa3, 0x9b, 0x5c, 0x52, 0x02, 0x79, 0x34, 0x80, 0xf6, 0x8f, 0x72,
+	0xea, 0xee, 0x1c, 0xcb, 0x4b, 0xa4, 0x43, 0xb3, 0x29, 0xda, 0xf2, 0x97, 0xd1, 0x56, 0x58, 0x4a,
+	0x5b, 0xf1, 0x69, 0xb4, 0x95, 0x32, 0x68, 0x7b, 0xc6, 0x70, 0x2d, 0x60, 0xfb, 0xe7, 0x20, 0xe5,
+	0x58, 0x68, 0x24, 0x0f, 0x11, 0x51, 0xfb, 0xbb, 0xd8, 0x01, 0x07, 0xd5, 0x86, 0x36, 0x6d, 0xb4,
+	0xea, 0x4b, 0x7d, 0xfc, 0x21, 0xd6, 0x7e, 0xc7, 0x58, 0x7b, 0xb6, 0x58, 0xbc, 0xdf, 0xfe, 0x61,
+	0x0e, 0xaf, 0xf9, 0x31, 0xf0, 0x31, 0xf5, 0x9e, 0xe3, 0x4b, 0x19, 0xf8, 0x99, 0x18, 0xff, 0x24,
+	0x18, 0xca, 0x20, 0x1d, 0x6b, 0x5c, 0x12, 0xc1, 0x5c, 0x34, 0x46, 0x4a, 0x57, 0x29, 0x0a, 0xba,
+	0x52, 0x9d, 0x14, 0x46, 0x6a, 0x6f, 0x74, 0xe6, 0x0f, 0x1f, 0xc2, 0x00, 0xf2, 0x06, 0xa4, 0xf2,
+	0x50, 0xbe, 0x04, 0xa6, 0x2f, 0xb0, 0x39, 0x18, 0x0b, 0xff, 0xe4, 0x63, 0x42, 0x2b, 0x64, 0xa3,
+	0xdf, 0xea, 0x30, 0x5c, 0x2a, 0x9e, 0x59, 0xf8, 0x74, 0x4f, 0x3e, 0xfb, 0xd3, 0x3d, 0x43, 0x55,
+	0x20, 0x21, 0xbf, 0x98, 0x63, 0xbf, 0x02, 0x16, 0x25, 0x1f, 0x83, 0xa7, 0xf4, 0x85, 0xa7, 0x86,
+	0x39, 0x22, 0xb7, 0xd3, 0x05, 0xf5, 0x5b, 0x07, 0xed, 0x9b, 0x22, 0x82, 0xdf, 0xdc, 0x67, 0x3f,
+	0xd9, 0x0a, 0xb2, 0x9b, 0xfb, 0xbc, 0x07, 0xaf, 0x5d, 0xfa, 0xf1, 0xcc, 0xf8, 0x32, 0x83, 0x06,
+	0xb1, 0xff, 0x20, 0x09, 0xce, 0x7a, 0x40, 0xda, 0xff, 0x4b, 0x31, 0xc6, 0x33, 0x7d, 0xd9, 0xcc,
+	0xfe, 0x8a, 0xd2, 0xb6, 0x9c, 0xc0, 0x18, 0x74, 0xe9, 0x4a, 0xc8, 0x7f, 0x2e, 0x7c, 0x4a, 0x26,
+	0x41, 0xa3, 0x12, 0xc7, 0xfe, 0x4b, 0xac, 0x40, 0x15, 0x1f, 0x98, 0x11, 0x71, 0xdb, 0xac, 0x6f,
+	0xd2, 0xe5, 0x96, 0x7c, 0x93, 0x0e, 0x75, 0x00, 0xec, 0x9f, 0x8b, 0x83, 0xf9, 0xe0, 0x61, 0x20,
+	0x59, 0xa8, 0x83, 0xac, 0xcf, 0x93, 0xeb, 0xfe, 0x7c, 0x76, 0x16, 0x4d, 0xc3, 0x6f, 0x70, 0xda,
+	0xcf, 0x60, 0x0b, 0x9c, 0x45, 0x43, 0xf9, 0x19, 0x85, 0x25, 0xbd, 0xcc, 0xb1, 0x99, 0xa0, 0xde,
+	0x8f, 0x06, 0xbe, 0x54, 0x50, 0x1a, 0xc4, 0xfe, 0x71, 0x8e, 0xbc, 0x24, 0x69, 0xd1, 0x47, 0x58,
+	0xf2, 0x8d, 0x89, 0xdc, 0x53, 0x2b, 0x92, 0xf2, 0x4f, 0x4d, 0xd5, 0x17, 0x2e, 0xd3, 0x70, 0xc5,
+	0xb4, 0x39, 0xae, 0x51, 0x5f, 0x4a, 0x53, 0x6f, 0x9a, 0x7d, 0xe5, 0x67, 0x35, 0xfb, 0xec, 0xdf,
+	0xce, 0x91, 0x95, 0xfb, 0xc1, 0xc9, 0x59, 0x14, 0x3d, 0x5a, 0xb0, 0x37, 0x45, 0xa5, 0x6e, 0x5e,
+	0x55, 0xea, 0x5e, 0xad, 0x9a, 0x55, 0xdc, 0x3a, 0x28, 0x1a, 0xb7, 0x0e, 0x9e, 0xed, 0xec, 0x7c,
+	0x8b, 0xac, 0x0a, 0xa2, 0x30, 0x5c, 0xb7, 0xfa, 0x58, 0xfc, 0x36, 0xbf, 0x47, 0x24, 0x30, 0xa8,
+	0xea, 0xb6, 0xff, 0x27, 0x4f, 0xb6, 0x05, 0xb4, 0x16, 0x0c, 0xc3, 0xf3, 0x20, 0xdb, 0x88, 0x16,
+	0xf8, 0xe2, 0x4b, 0x60, 0x45, 0x9a, 0x00, 0xe4, 0x94, 0x0b, 0x4b, 0xa7, 0x5c, 0xcc, 0xaa, 0x2e,
+	0x97, 0xde, 0x3b, 0xb7, 0x8c, 0x5f, 0x36, 0xc8, 0x93, 0x84, 0xa4, 0x1d, 0x77, 0x38, 0xb9, 0x7c,
+	0x99, 0xd0, 0x28, 0xf3, 0x93, 0x4b, 0xb6, 0xe5, 0xa7, 0xa0, 0x44, 0x76, 0x23, 0xed, 0x65, 0x65,
+	0xf6, 0xe1, 0x33, 0xf8, 0xd5, 0xa5, 0x85, 0x67, 0xb8, 0xdd, 0x9c, 0xd9, 0x67, 0x26, 0x04, 0xd6,
+	0x52, 0x09, 0x81, 0x4b, 0x2e, 0x08, 0xd6, 0xdc, 0xba, 0x77, 0xcf, 0xa5, 0x0b, 0x69, 0x9b, 0xaf,
+	0x91, 0x1d, 0x73, 0xd6, 0x60, 0xc7, 0x59, 0x6f, 0xe1, 0x87, 0x73, 0x64, 0xcb, 0xb4, 0xfd, 0x52,
+	0x2c, 0xa2, 0x1a, 0xa2, 0xfd, 0xf3, 0x64, 0xe3, 0xbe, 0xff, 0x28, 0x98, 0x4f, 0x44, 0x19, 0x27,
+	0xcc, 0x4f, 0x7c, 0x40, 0x45, 0xbb, 0x30, 0x20, 0x06, 0x5c, 0xa3, 0x99, 0x7d, 0x2c, 0xc0, 0x0a,
+	0x93, 0x1d, 0xe0, 0x9d, 0x6e, 0xee, 0x86, 0xa9, 0xb6, 0xfd, 0x2d, 0x4c, 0x1f, 0xb2, 0x4f, 0xef,
+	0x1c, 0xb0, 0x7b, 0x27, 0x0d, 0x7f, 0x1c, 0x9e, 0xe2, 0x76, 0xd7, 0x6f, 0xa6, 0xe4, 0x52, 0x37,
+	0x53, 0x16, 0x2e, 0xc8, 0xb1, 0x6b, 0x14, 0x78, 0x33, 0x45, 0x24, 0x67, 0xf9, 0x19, 0xa3, 0x83,
+	0x4c, 0xaf, 0xad, 0x98, 0x8e, 0x6c, 0x3c, 0x20, 0x3b, 0x3a, 0x15, 0x55, 0x7c, 0xf0, 0x52, 0x12,
+	0xe0, 0x38, 0x0b, 0xd9, 0xbd, 0x18, 0xf1, 0x01, 0x38, 0xd6, 0x50, 0x5f, 0x79, 0x29, 0x30, 0xca,
+	0xf8, 0x57, 0x55, 0x2f, 0xc8, 0x86, 0x3e, 0xf4, 0xd3, 0xef, 0x4c, 0x8f, 0x04, 0x0b, 0xe4, 0x9d,
+	0x69, 0xd9, 0xe6, 0x45, 0xad, 0x38, 0x23, 0x71, 0x0f, 0x42, 0x64, 0xbd, 0x16, 0x08, 0xa7, 0x02,
+	0xcd, 0xfe, 0x8b, 0x3c, 0xb1, 0xf4, 0x5e, 0x21, 0x47, 0x4f, 0xa5, 0x40, 0xcd, 0x3a, 0x9f, 0x9a,
+	0xf5, 0xd3, 0xd9, 0x0c, 0x18, 0x80, 0x1c, 0x0c, 0xaa, 0x9c, 0x50, 0x6e, 0x0c, 0xea, 0x20, 0x0c,
+	0x30, 0xf0, 0xf1, 0x16, 0xb2, 0xb4, 0x29, 0x30, 0x9e, 0x5b, 0x6c, 0x8f, 0xe9, 0x57, 0x9e, 0x45,
+	0x30, 0x30, 0x0d, 0x87, 0xdd, 0xbf, 0x87, 0xb0, 0x6a, 0x34, 0x9a, 0x0c, 0x83, 0x59, 0x90, 0xde,
+	0xac, 0xd9, 0x9d, 0xb8, 0x8a, 0xf0, 0x63, 0xc8, 0x63, 0x61, 0xab, 0x94, 0x37, 0xec, 0x7f, 0xcb,
+	0x91, 0xb5, 0xa3, 0xf9, 0x89, 0x38, 0x3d, 0x93, 0xa0, 0x74, 0xce, 0x08, 0x4a, 0x63, 0xc0, 0x2d,
+	0x00, 0xc6, 0xc6, 0x81, 0x56, 0x07, 0xa7, 0x83, 0x90, 0x7e, 0xfc, 0xa0, 0x26, 0x38, 0x02, 0x8d,
+	0x70, 0x38, 0x0c, 0xf5, 0xda, 0xbd, 0x34, 0x9c, 0xd9, 0x84, 0xe1, 0xf8, 0x68, 0x36, 0xec, 0xcb,
+	0xda, 0x3d, 0xd1, 0x64, 0x65, 0x72, 0xa2, 0x18, 0x0e, 0x76, 0x28, 0x08, 0x57, 0x49, 0x94, 0xc9,
+	0xe9, 0x40, 0x5c, 0xb5, 0x41, 0x18, 0xf3, 0x1b, 0x22, 0x3c, 0x1f, 0xa6, 0xda, 0xa6, 0xe8, 0xaf,
+	0xa4, 0x45, 0xff, 0xbb, 0x39, 0xb2, 0xd7, 0xf0, 0x99, 0xf9, 0x80, 0xb9, 0x9f, 0xae, 0x1f, 0x5f,
+	0x56, 0xb2, 0x0d, 0x7a, 0x3c, 0x7a, 0x24, 0x76, 0x31, 0xfc, 0xd2, 0xae, 0x4d, 0x14, 0x8c, 0x6b,
+	0x13, 0xca, 0x5f, 0x2f, 0xea, 0xe9, 0x69, 0xfc, 0x7a, 0xd7, 0x9c, 0x87, 0xeb, 0x1b, 0x32, 0x0c,
+	0xac, 0x41, 0xd0, 0x75, 0xda, 0xd1, 0x68, 0xa1, 0x01, 0x5e, 0x74, 0x60, 0xf2, 0x8a, 0xb7, 0xee,
+	0x70, 0xdd, 0x64, 0x4e, 0x43, 0x01, 0xf8, 0xd5, 0x18, 0xe6, 0x7e, 0xc9, 0x4f, 0x08, 0x8b, 0x26,
+	0xd2, 0x76, 0x1a, 0x4d, 0xfb, 0x2a, 0xe5, 0x28, 0x5a, 0xd6, 0x1b, 0xe0, 0x56, 0xc2, 0x2c, 0x79,
+	0xfc, 0x75, 0x5d, 0x5e, 0x20, 0xc9, 0xe4, 0x01, 0xe5, 0x98, 0xf6, 0x0a, 0x29, 0xb9, 0xa0, 0xb3,
+	0x2f, 0xec, 0x77, 0x94, 0x7d, 0xf6, 0x8c, 0xc5, 0xc3, 0xf6, 0x07, 0xe4, 0x43, 0x9d, 0xf9, 0x09,
+	0x5a, 0x1a, 0x27, 0x81, 0xfe, 0x95, 0x27, 0x65, 0x83, 0xbf, 0x2b, 0x3f, 0xcb, 0x98, 0x63, 0x71,
+	0x80, 0xab, 0x7f, 0x61, 0x4a, 0x7c, 0xa5, 0x11, 0xb4, 0x22, 0xde, 0xe5, 0xe0, 0x6b, 0x83, 0x3f,
+	0x5f, 0x3b, 0x24, 0x95, 0x74, 0x86, 0x01, 0x0f, 0x8a, 0x66, 0x8b, 0x36, 0x9c, 0x3a, 0xbf, 0x8b,
+	0xee, 0x56, 0x5b, 0xcd, 0x56, 0xc3, 0xab, 0xb2, 0xef, 0x93, 0x42, 0xdf, 0x31, 0xbd, 0xa3, 0x0a,
+	0x6b, 0xab, 0xc7, 0x9d, 0x6e, 0xab, 0x51, 0x29, 0xbc, 0x76, 0x44, 0xae, 0x65, 0x5d, 0x77, 0x65,
+	0x1f, 0x3b, 0xf5, 0x3a, 0x55, 0x87, 0xa2, 0xd9, 0x7e, 0x8d, 0x54, 0xa8, 0xdb, 0xae, 0x3b, 0xac,
+	0x50, 0xcf, 0xeb, 0x74, 0x55, 0x38, 0xf8, 0xae, 0xeb, 0xb6, 0x7b, 0x07, 0xad, 0xee, 0x51, 0x25,
+	0xff, 0xda, 0xdb, 0x64, 0x8b, 0x06, 0x03, 0x7e, 0x29, 0xa7, 0x1e, 0x9c, 0x83, 0x33, 0x04, 0x63,
+	0xb0, 0x3a, 0x2e, 0x46, 0xd0, 0x06, 0x59, 0xed, 0x74, 0x9d, 0x66, 0x0d, 0x47, 0x64, 0xe4, 0x74,
+	0xba, 0xd4, 0xab, 0x02, 0x39, 0xaf, 0xfd, 0x46, 0x91, 0xac, 0xb1, 0xf3, 0x90, 0x79, 0xae, 0x3b,
+	0x64, 0x53, 0xd6, 0x38, 0xb9, 0x94, 0xb6, 0x28, 0x7f, 0x7d, 0xcd, 0x71, 0x1b, 0xad, 0x66, 0xaf,
+	0xd9, 0xea, 0x8a, 0xaf, 0x17, 0xe5, 0xb2, 0xaa, 0x07, 0xf3, 0x46, 0xe9, 0x61, 0x61, 0x69, 0xe9,
+	0xe1, 0xf2, 0xc2, 0x42, 0xad, 0xfa, 0xb0, 0x7c, 0x79, 0x95, 0xe1, 0x4a, 0x76, 0x95, 0xe1, 0x6a,
+	0x76, 0x95, 0xe1, 0xda, 0xd2, 0x2a, 0x43, 0xb2, 0x50, 0x65, 0xb8, 0x8e, 0x10, 0xa7, 0xce, 0xe6,
+	0xc9, 0xbf, 0xee, 0xb5, 0x81, 0x83, 0x26, 0x5f, 0x7e, 0x92, 0x05, 0x1e, 0x9b, 0xf8, 0x41, 0xa8,
+	0x8e, 0xfc, 0xde, 0x54, 0x6a, 0x2e, 0x5b, 0x58, 0xa5, 0x56, 0x83, 0x21, 0x1f, 0xf4, 0x0e, 0x8e,
+	0x6b, 0x78, 0x69, 0x53, 0x75, 0x19, 0x5f, 0xbd, 0x42, 0x96, 0x3a, 0xc7, 0xdd, 0xa3, 0x16, 0xf5,
+	0xde, 0x67, 0x45, 0x71, 0xb0, 0x00, 0x08, 0xeb, 0x1c, 0xb7, 0xdb, 0x2d, 0x8a, 0x91, 0xfe, 0x1d,
+	0x7c, 0x8d, 0xac, 0x17, 0x94, 0x8f, 0x49, 0x37, 0xce, 0xc2, 0x52, 0xb9, 0xaa, 0x4b, 0xbb, 0x1e,
+	0x30, 0x19, 0x6f, 0x81, 0xe2, 0x17, 0xbe, 0x1a, 0x5e, 0xa7, 0xe1, 0x74, 0xab, 0x47, 0x95, 0x5d,
+	0x9c, 0xb6, 0xfe, 0x55, 0x2a, 0xd5, 0x73, 0x0d, 0x97, 0xa0, 0xe6, 0x74, 0x9d, 0x03, 0xa7, 0x83,
+	0x85, 0x93, 0x94, 0x1e, 0xb7, 0xf1, 0x65, 0x7b, 0xb7, 0x7f, 0xb5, 0x48, 0x56, 0x0f, 0xc0, 0x6f,
+	0xfc, 0x86, 0xd3, 0xf6, 0xac, 0x5b, 0x64, 0xeb, 0x4e, 0x30, 0x13, 0x57, 0x3e, 0xd9, 0x37, 0x34,
+	0xd6, 0xf9, 0xde, 0x61, 0x5b, 0xf6, 0xa6, 0x79, 0x25, 0xd4, 0x7e, 0xc1, 0x7a, 0x9d, 0xac, 0x03,
+	0xbe, 0xaa, 0x73, 0x33, 0x90, 0x33, 0x6e, 0x85, 0xc2, 0x13, 0x07, 0x64, 0x5b, 0x7b, 0x82, 0x7d,
+	0xa3, 0xd3, 0x0c, 0x6a, 0xe9, 0x1f, 0x8d, 0x4d, 0x8f, 0x81, 0x5d, 0x30, 0xc6, 0x57, 0xc8, 0x1e,
+	0x56, 0x93, 0xcb, 0x6c, 0x72, 0xa4, 0xae, 0xe4, 0x2c, 0x2b, 0x5e, 0xb9, 0xa9, 0x13, 0x06, 0x03,
+	0xbc, 0x4b, 0x48, 0xf2, 0x7d, 0x3e, 0xf9, 0xd4, 0xc2, 0x27, 0x04, 0x6f, 0xee, 0x2d, 0x76, 0x4c,
+	0x86, 0xf8, 0xbc, 0x83, 0x96, 0x16, 0x06, 0x36, 0x52, 0x0a, 0xcc, 0x8c, 0x7d, 0xc9, 0x61, 0x16,
+	0xa3, 0x09, 0x9c, 0x73, 0xda, 0x15, 0x9a, 0x4c, 0xce, 0xe9, 0x17, 0x74, 0xe0, 0x89, 0xf7, 0xc9,
+	0xf5, 0x6c, 0xad, 0x67, 0x7d, 0x54, 0x86, 0x18, 0x2e, 0xd1, 0x89, 0x37, 0x6f, 0x2c, 0x51, 0x82,
+	0xf6, 0x0b, 0xaf, 0xe7, 0x4e, 0xca, 0xec, 0xe3, 0xf5, 0x6f, 0xfe, 0x2f, 0x6d, 0x81, 0x79, 0x02,
+	0xce, 0x5e, 0x00, 0x00,
 }
@@ -1,13 +1,20 @@
 package breez
 
 import (
+	"bytes"
 	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path"
 	"path/filepath"
+	"reflect"
+	"time"
 
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/zpay32"
+	"github.com/golang/protobuf/proto"
 	bolt "go.etcd.io/bbolt"
 )
 
@@ -28,19 +35,131 @@ const (
 	paymentsSyncInfoBucket = "paymentsSyncInfo"
 	accountBucket          = "account"
 
+	//payments ids ordered by creation time, used for paging
+	paymentsByTimeBucket = "paymentsByTime"
+
+	//payments ids by the words of their description and counterparties
+	paymentsSearchBucket = "paymentsSearch"
+
+	//payments with an empty or duplicate hash waiting for repair
+	paymentsQuarantineBucket = "paymentsQuarantine"
+
 	//encrypted sessions
 	encryptedSessionsBucket = "encrypted_sessions"
+
+	//invoices issued by the routing node while syncing
+	wrappedInvoicesBucket = "wrappedInvoices"
+
+	//balance moves between lightning and on-chain
+	moveFundsBucket = "moveFunds"
+
+	//desktop companion sessions
+	pairingSessionsBucket = "pairingSessions"
+
+	//split invoices and the forwarded shares
+	paymentSplitsBucket      = "paymentSplits"
+	paymentSplitsChildBucket = "paymentSplitsChildren"
+
+	//post settlement rules and their audit trail
+	settlementRulesBucket = "settlementRules"
+	settlementAuditBucket = "settlementAudit"
+
+	//metadata of payments made to LNURL-pay services
+	lnurlPayMemosBucket = "lnurlPayMemos"
+
+	//scheduled reminders of unpaid invoices
+	invoiceRemindersBucket = "invoiceReminders"
+
+	//notifications waiting for the app acknowledgment
+	notificationsOutboxBucket = "notificationsOutbox"
+
+	//last fetched bitcoin price by fiat currency
+	fiatRatesBucket = "fiatRates"
+
+	//payments waiting to be sent once online
+	paymentQueueBucket = "paymentQueue"
+
+	//routes of sent payments by payment hash
+	paymentRoutesBucket = "paymentRoutes"
+
+	//failed payment attempts ordered by time
+	failedPaymentsBucket = "failedPayments"
+
+	//donation campaigns and their contributions by payment hash
+	donationCampaignsBucket     = "donationCampaigns"
+	donationContributionsBucket = "donationContributions"
+
+	//recent HTLC events ordered by time
+	htlcEventsBucket = "htlcEvents"
+
+	//consolidations of small routing node channels
+	channelConsolidationsBucket = "channelConsolidations"
+
+	//time the invoices were hidden by payment hash
+	hiddenInvoicesBucket = "hiddenInvoices"
+
+	//append only log of the spending API calls
+	spendAuditBucket = "spendAudit"
+
+	//routing node channels referenced by the open invoices
+	invoiceHintsBucket = "invoiceHints"
+
+	//summary of the latest payments shown before the history is loaded
+	paymentsSnapshotBucket = "paymentsSnapshot"
+
+	//static payment codes and their invoice pools
+	paymentCodesBucket = "paymentCodes"
+
+	//fallback addresses embedded in invoices, watched for on-chain payments
+	fallbackAddressesBucket = "fallbackAddresses"
+
+	//outcome of the payments sent with an idempotency key
+	idempotencyKeysBucket = "idempotencyKeys"
+
+	//payments being sent, left behind when the app is killed while sending
+	paymentIntentsBucket = "paymentIntents"
+
+	//registered webhooks and their deliveries
+	webhooksBucket          = "webhooks"
+	webhookDeliveriesBucket = "webhookDeliveries"
 )
 
 var db *bolt.DB
 
-func openDB(dbPath string) error {
-	var err error
+func openDB(dbPath string) (err error) {
+	//bbolt panics on some corrupted pages instead of returning an error
+	defer func() {
+		if r := recover(); r != nil {
+			log.Criticalf("Database panicked while opening %v", r)
+			if db != nil {
+				db.Close()
+			}
+			err = &dbPanicError{value: r}
+		}
+	}()
+	info, err := os.Stat(dbPath)
+	newDB := err != nil || info.Size() == 0
 	db, err = bolt.Open(dbPath, 0600, nil)
 	if err != nil {
 		log.Criticalf("Failed to open database %v", err)
 		return err
 	}
+	encrypted, err := initDBEncryption()
+	if err != nil {
+		log.Criticalf("Failed to open encrypted database %v", err)
+		db.Close()
+		return err
+	}
+	if encrypted {
+		if err := compactEncryptedDB(dbPath); err != nil {
+			log.Criticalf("Failed to compact the encrypted database %v", err)
+			//release the file lock whichever step of the compaction failed
+			if db != nil {
+				db.Close()
+			}
+			return err
+		}
+	}
 	err = db.Update(func(tx *bolt.Tx) error {
 		var err error
 		_, err = tx.CreateBucketIfNotExists([]byte(incmoingPayReqBucket))
@@ -59,6 +178,22 @@ func openDB(dbPath string) error {
 		if err != nil {
 			return err
 		}
+		if tx.Bucket([]byte(paymentsByTimeBucket)) == nil {
+			if _, err := tx.CreateBucket([]byte(paymentsByTimeBucket)); err != nil {
+				return err
+			}
+			if err := indexAllPayments(tx, indexPaymentTime); err != nil {
+				return err
+			}
+		}
+		if tx.Bucket([]byte(paymentsSearchBucket)) == nil {
+			if _, err := tx.CreateBucket([]byte(paymentsSearchBucket)); err != nil {
+				return err
+			}
+			if err := indexAllPayments(tx, indexPaymentSearch); err != nil {
+				return err
+			}
+		}
 		_, err = tx.CreateBucketIfNotExists([]byte(addressesBucket))
 		if err != nil {
 			return err
@@ -79,20 +214,147 @@ func openDB(dbPath string) error {
 		if err != nil {
 			return err
 		}
+		_, err = tx.CreateBucketIfNotExists([]byte(paymentsQuarantineBucket))
+		if err != nil {
+			return err
+		}
 		_, err = tx.CreateBucketIfNotExists([]byte(encryptedSessionsBucket))
 		if err != nil {
 			return err
 		}
+		_, err = tx.CreateBucketIfNotExists([]byte(wrappedInvoicesBucket))
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateBucketIfNotExists([]byte(moveFundsBucket))
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateBucketIfNotExists([]byte(pairingSessionsBucket))
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateBucketIfNotExists([]byte(paymentSplitsBucket))
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateBucketIfNotExists([]byte(paymentSplitsChildBucket))
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateBucketIfNotExists([]byte(settlementRulesBucket))
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateBucketIfNotExists([]byte(settlementAuditBucket))
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateBucketIfNotExists([]byte(lnurlPayMemosBucket))
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateBucketIfNotExists([]byte(invoiceRemindersBucket))
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateBucketIfNotExists([]byte(notificationsOutboxBucket))
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateBucketIfNotExists([]byte(fiatRatesBucket))
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateBucketIfNotExists([]byte(paymentQueueBucket))
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateBucketIfNotExists([]byte(paymentRoutesBucket))
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateBucketIfNotExists([]byte(failedPaymentsBucket))
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateBucketIfNotExists([]byte(donationCampaignsBucket))
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateBucketIfNotExists([]byte(donationContributionsBucket))
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateBucketIfNotExists([]byte(htlcEventsBucket))
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateBucketIfNotExists([]byte(channelConsolidationsBucket))
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateBucketIfNotExists([]byte(hiddenInvoicesBucket))
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateBucketIfNotExists([]byte(spendAuditBucket))
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateBucketIfNotExists([]byte(invoiceHintsBucket))
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateBucketIfNotExists([]byte(paymentCodesBucket))
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateBucketIfNotExists([]byte(fallbackAddressesBucket))
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateBucketIfNotExists([]byte(idempotencyKeysBucket))
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateBucketIfNotExists([]byte(paymentIntentsBucket))
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateBucketIfNotExists([]byte(webhooksBucket))
+		if err != nil {
+			return err
+		}
+		_, err = tx.CreateBucketIfNotExists([]byte(webhookDeliveriesBucket))
+		if err != nil {
+			return err
+		}
+		snapshotB, err := tx.CreateBucketIfNotExists([]byte(paymentsSnapshotBucket))
+		if err != nil {
+			return err
+		}
+		if snapshotB.Get(paymentsSnapshotKey) == nil {
+			if err := refreshPaymentsSnapshot(tx); err != nil {
+				return err
+			}
+		}
 
 		return nil
 	})
 	if err != nil {
 		return err
 	}
+	if err := migrateDB(dbPath, newDB); err != nil {
+		log.Criticalf("Failed to migrate database %v", err)
+		db.Close()
+		return err
+	}
 	return nil
 }
 
 func closeDB() error {
+	dbKey = nil
 	return db.Close()
 }
 
@@ -148,28 +410,76 @@ func updateRedeemTxForPayment(hash string, txID string) error {
 	})
 }
 
-func addAccountPayment(accPayment *paymentInfo, receivedIndex uint64, sentTime uint64) error {
-	log.Infof("addAccountPayment hash = %v", accPayment.PaymentHash)
+// updateRefundPayment records the transaction of a refund broadcast again and
+// the amount it refunds.
+func updateRefundPayment(hash, txID string, amount, fee int64) error {
 	return db.Update(func(tx *bolt.Tx) error {
-		paymentBuf, err := serializePaymentInfo(accPayment)
+		paymentB := tx.Bucket([]byte(paymentsBucket))
+		paymentIndex := tx.Bucket([]byte(paymentsHashBucket)).Get([]byte(hash))
+		if paymentIndex == nil {
+			return fmt.Errorf("payment doesn't exist for hash %v", hash)
+		}
+		payment, err := deserializePaymentInfo(paymentB.Get(paymentIndex))
 		if err != nil {
 			return err
 		}
-
-		b := tx.Bucket([]byte(paymentsBucket))
-		id, err := b.NextSequence()
+		payment.RedeemTxID = txID
+		payment.Amount = amount
+		payment.Fee = fee
+		paymentBuf, err := serializePaymentInfo(payment)
 		if err != nil {
 			return err
 		}
+		return paymentB.Put(paymentIndex, paymentBuf)
+	})
+}
 
-		//write the payment value with the next sequence as key
-		if err := b.Put(itob(id), paymentBuf); err != nil {
+func hasPayment(hash string) (bool, error) {
+	value, err := fetchItem([]byte(paymentsHashBucket), []byte(hash))
+	return value != nil, err
+}
+
+func addAccountPayment(accPayment *paymentInfo, receivedIndex uint64, sentTime uint64) error {
+	log.Infof("addAccountPayment hash = %v", accPayment.PaymentHash)
+	defer observeDBOperation("addAccountPayment", time.Now())
+	return db.Update(func(tx *bolt.Tx) error {
+		paymentBuf, err := serializePaymentInfo(accPayment)
+		if err != nil {
 			return err
 		}
 
+		b := tx.Bucket([]byte(paymentsBucket))
 		hashB := tx.Bucket([]byte(paymentsHashBucket))
-		if err := hashB.Put([]byte(accPayment.PaymentHash), itob(id)); err != nil {
-			return err
+
+		//records we can't index are quarantined instead of overwriting or duplicating list entries
+		var reason string
+		if accPayment.PaymentHash == "" {
+			reason = "empty payment hash"
+		} else if existing := hashB.Get([]byte(accPayment.PaymentHash)); existing != nil {
+			reason = fmt.Sprintf("duplicate payment hash of payment %v", btoi(existing))
+		}
+		if reason != "" {
+			log.Errorf("addAccountPayment - quarantining payment hash = %v: %v", accPayment.PaymentHash, reason)
+			if err := quarantinePayment(tx, accPayment, reason); err != nil {
+				return err
+			}
+		} else {
+			id, err := b.NextSequence()
+			if err != nil {
+				return err
+			}
+
+			//write the payment value with the next sequence as key
+			if err := b.Put(itob(id), paymentBuf); err != nil {
+				return err
+			}
+
+			if err := hashB.Put([]byte(accPayment.PaymentHash), itob(id)); err != nil {
+				return err
+			}
+			if err := indexPayment(tx, id, accPayment); err != nil {
+				return err
+			}
 		}
 
 		syncInfoBucket := b.Bucket([]byte(paymentsSyncInfoBucket))
@@ -194,11 +504,15 @@ func addAccountPayment(accPayment *paymentInfo, receivedIndex uint64, sentTime u
 				return err
 			}
 		}
-		return nil
+		if err := chaosAbort("addAccountPayment"); err != nil {
+			return err
+		}
+		return refreshPaymentsSnapshot(tx)
 	})
 }
 
 func fetchAllAccountPayments() ([]*paymentInfo, error) {
+	defer observeDBOperation("fetchAllAccountPayments", time.Now())
 	var payments []*paymentInfo
 	err := db.View(func(tx *bolt.Tx) error {
 		// Assume bucket exists and has keys
@@ -223,72 +537,1755 @@ func fetchAllAccountPayments() ([]*paymentInfo, error) {
 	return payments, err
 }
 
-func fetchPaymentsSyncInfo() (lastTime int64, lastSetteledIndex uint64) {
-	lastPaymentTime := int64(0)
-	lastInvoiceSettledIndex := uint64(0)
-	db.View(func(tx *bolt.Tx) error {
-		b := tx.Bucket([]byte(paymentsBucket))
-		syncInfoBucket := b.Bucket([]byte(paymentsSyncInfoBucket))
-		lastPaymentTimeBuf := syncInfoBucket.Get([]byte("lastSentPaymentTime"))
-		if lastPaymentTimeBuf != nil {
-			lastPaymentTime = int64(btoi(lastPaymentTimeBuf))
-		}
-		lastInvoiceSettledIndexBuf := syncInfoBucket.Get([]byte("lastSettledIndex"))
-		if lastInvoiceSettledIndexBuf != nil {
-			lastInvoiceSettledIndex = btoi(lastInvoiceSettledIndexBuf)
+// paymentTimeKey is the key of a payment in the time index: the creation
+// timestamp followed by the payment id, both big endian so keys sort by time.
+func paymentTimeKey(id uint64, payment *paymentInfo) []byte {
+	return append(itob(uint64(payment.CreationTimestamp)), itob(id)...)
+}
+
+func indexPaymentTime(tx *bolt.Tx, id uint64, payment *paymentInfo) error {
+	return tx.Bucket([]byte(paymentsByTimeBucket)).Put(paymentTimeKey(id, payment), itob(id))
+}
+
+// paymentSearchKeys returns the search index keys of a payment: every word of
+// its description and counterparties followed by a zero byte and the payment id.
+func paymentSearchKeys(id uint64, payment *paymentInfo) [][]byte {
+	var keys [][]byte
+	seen := make(map[string]bool)
+	for _, field := range []string{payment.Description, payment.PayeeName, payment.PayerName, payment.PayerComment, payment.Destination,
+		payment.FundingOrderID, payment.FundingCounterparty} {
+		for _, word := range searchWords(field) {
+			if seen[word] {
+				continue
+			}
+			seen[word] = true
+			keys = append(keys, append(append([]byte(word), 0), itob(id)...))
 		}
-		return nil
-	})
-	return lastPaymentTime, lastInvoiceSettledIndex
+	}
+	return keys
 }
 
-func saveAccount(account []byte) error {
-	return saveItem([]byte(accountBucket), []byte("account"), account)
+func indexPaymentSearch(tx *bolt.Tx, id uint64, payment *paymentInfo) error {
+	//the index would reveal the words of the payments of an encrypted database
+	if dbKey != nil {
+		return nil
+	}
+	b := tx.Bucket([]byte(paymentsSearchBucket))
+	for _, k := range paymentSearchKeys(id, payment) {
+		if err := b.Put(k, itob(id)); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func fetchAccount() ([]byte, error) {
-	return fetchItem([]byte(accountBucket), []byte("account"))
+// indexPayment adds the payment to the time and search indexes.
+func indexPayment(tx *bolt.Tx, id uint64, payment *paymentInfo) error {
+	if err := indexPaymentTime(tx, id, payment); err != nil {
+		return err
+	}
+	return indexPaymentSearch(tx, id, payment)
 }
 
-func savePaymentRequest(payReqHash string, payReq []byte) error {
-	return saveItem([]byte(incmoingPayReqBucket), []byte(payReqHash), payReq)
+// unindexPayment removes the payment from the time and search indexes.
+func unindexPayment(tx *bolt.Tx, id uint64, payment *paymentInfo) error {
+	if err := tx.Bucket([]byte(paymentsByTimeBucket)).Delete(paymentTimeKey(id, payment)); err != nil {
+		return err
+	}
+	b := tx.Bucket([]byte(paymentsSearchBucket))
+	for _, k := range paymentSearchKeys(id, payment) {
+		if err := b.Delete(k); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func fetchPaymentRequest(payReqHash string) ([]byte, error) {
-	return fetchItem([]byte(incmoingPayReqBucket), []byte(payReqHash))
+//indexAllPayments builds an index of the payments stored before it existed.
+func indexAllPayments(tx *bolt.Tx, index func(tx *bolt.Tx, id uint64, payment *paymentInfo) error) error {
+	return tx.Bucket([]byte(paymentsBucket)).ForEach(func(k, v []byte) error {
+		if v == nil {
+			return nil
+		}
+		payment, err := deserializePaymentInfo(v)
+		if err != nil {
+			return err
+		}
+		return index(tx, btoi(k), payment)
+	})
 }
 
-/**
-Swap addresses
-**/
-func fetchAllSwapAddresses() ([]*SwapAddressInfo, error) {
-	return fetchSwapAddresses(func(addr *SwapAddressInfo) bool {
-		return true
+// searchPaymentIDs returns the ids of the payments that have a word starting with
+// each of the given words.
+func searchPaymentIDs(words []string) ([]uint64, error) {
+	if dbKey != nil {
+		return scanPaymentIDs(words)
+	}
+	var ids []uint64
+	err := db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket([]byte(paymentsSearchBucket)).Cursor()
+		var matches map[uint64]bool
+		for _, word := range words {
+			wordMatches := make(map[uint64]bool)
+			prefix := []byte(word)
+			for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+				id := btoi(v)
+				if matches == nil || matches[id] {
+					wordMatches[id] = true
+				}
+			}
+			matches = wordMatches
+		}
+		for id := range matches {
+			ids = append(ids, id)
+		}
+		return nil
 	})
+	return ids, err
 }
 
-func fetchSwapAddresses(filterFunc func(addr *SwapAddressInfo) bool) ([]*SwapAddressInfo, error) {
-	var addresses []*SwapAddressInfo
+// scanPaymentIDs is searchPaymentIDs for an encrypted database, which has no
+// search index.
+func scanPaymentIDs(words []string) ([]uint64, error) {
+	var ids []uint64
 	err := db.View(func(tx *bolt.Tx) error {
-		b := tx.Bucket([]byte(addressesBucket))
-		b.ForEach(func(k, v []byte) error {
-			var address SwapAddressInfo
-			err := json.Unmarshal(v, &address)
+		return tx.Bucket([]byte(paymentsBucket)).ForEach(func(k, v []byte) error {
+			if v == nil {
+				return nil
+			}
+			payment, err := deserializePaymentInfo(v)
 			if err != nil {
 				return err
 			}
-			if filterFunc(&address) {
-				addresses = append(addresses, &address)
+			id := btoi(k)
+			keys := paymentSearchKeys(id, payment)
+			for _, word := range words {
+				matched := false
+				for _, key := range keys {
+					if bytes.HasPrefix(key, []byte(word)) {
+						matched = true
+						break
+					}
+				}
+				if !matched {
+					return nil
+				}
 			}
+			ids = append(ids, id)
 			return nil
 		})
-		return nil
 	})
-	return addresses, err
+	return ids, err
 }
 
-func saveSwapAddressInfo(address *SwapAddressInfo) error {
-	return db.Update(func(tx *bolt.Tx) error {
+func fetchPaymentsByIDs(ids []uint64) ([]*paymentInfo, error) {
+	var payments []*paymentInfo
+	err := db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(paymentsBucket))
+		for _, id := range ids {
+			v := b.Get(itob(id))
+			if v == nil {
+				continue
+			}
+			payment, err := deserializePaymentInfo(v)
+			if err != nil {
+				return err
+			}
+			payments = append(payments, payment)
+		}
+		return nil
+	})
+	return payments, err
+}
+
+// paymentsFilter selects payments by type and by creation time, inclusive.
+// Empty fields match every payment.
+type paymentsFilter struct {
+	Types         []paymentType
+	FromTimestamp int64
+	ToTimestamp   int64
+}
+
+func (f *paymentsFilter) match(payment *paymentInfo) bool {
+	if f == nil {
+		return true
+	}
+	if f.FromTimestamp > 0 && payment.CreationTimestamp < f.FromTimestamp {
+		return false
+	}
+	if f.ToTimestamp > 0 && payment.CreationTimestamp > f.ToTimestamp {
+		return false
+	}
+	if len(f.Types) == 0 {
+		return true
+	}
+	for _, t := range f.Types {
+		if payment.Type == t {
+			return true
+		}
+	}
+	return false
+}
+
+// fetchPaymentsPage returns up to limit payments matching the filter, newest first,
+// created before the given time index key, or the newest ones if it is nil. It also
+// returns the key to pass for the next page, which is nil when there are no more payments.
+// The time range is resolved using the index so only payments in range are read.
+func fetchPaymentsPage(before []byte, limit int, filter *paymentsFilter) ([]*paymentInfo, []byte, error) {
+	defer observeDBOperation("fetchPaymentsPage", time.Now())
+	var payments []*paymentInfo
+	var next []byte
+	if filter != nil && filter.ToTimestamp > 0 {
+		toKey := itob(uint64(filter.ToTimestamp + 1))
+		if before == nil || bytes.Compare(toKey, before) < 0 {
+			before = toKey
+		}
+	}
+	var from []byte
+	if filter != nil && filter.FromTimestamp > 0 {
+		from = itob(uint64(filter.FromTimestamp))
+	}
+	err := db.View(func(tx *bolt.Tx) error {
+		paymentsB := tx.Bucket([]byte(paymentsBucket))
+		c := tx.Bucket([]byte(paymentsByTimeBucket)).Cursor()
+		var k, v []byte
+		if before == nil {
+			k, v = c.Last()
+		} else {
+			//Seek lands on the first key equal or greater so step back to the one before
+			if k, v = c.Seek(before); k == nil {
+				k, v = c.Last()
+			} else {
+				k, v = c.Prev()
+			}
+		}
+		var lastKey []byte
+		for ; k != nil && len(payments) < limit; k, v = c.Prev() {
+			if from != nil && bytes.Compare(k, from) < 0 {
+				k = nil
+				break
+			}
+			payment, err := deserializePaymentInfo(paymentsB.Get(v))
+			if err != nil {
+				return err
+			}
+			lastKey = append([]byte{}, k...)
+			if filter.match(payment) {
+				payments = append(payments, payment)
+			}
+		}
+		if k != nil && (from == nil || bytes.Compare(k, from) >= 0) {
+			next = lastKey
+		}
+		return nil
+	})
+	return payments, next, err
+}
+
+type quarantinedPayment struct {
+	ID      uint64
+	Payment *paymentInfo
+	Reason  string
+}
+
+func quarantinePayment(tx *bolt.Tx, accPayment *paymentInfo, reason string) error {
+	b := tx.Bucket([]byte(paymentsQuarantineBucket))
+	id, err := b.NextSequence()
+	if err != nil {
+		return err
+	}
+	buf, err := json.Marshal(&quarantinedPayment{ID: id, Payment: accPayment, Reason: reason})
+	if err != nil {
+		return err
+	}
+	if buf, err = sealDBValue(buf); err != nil {
+		return err
+	}
+	return b.Put(itob(id), buf)
+}
+
+func fetchQuarantinedPayments() ([]*quarantinedPayment, error) {
+	var payments []*quarantinedPayment
+	err := db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(paymentsQuarantineBucket)).ForEach(func(k, v []byte) error {
+			v, err := openDBValue(v)
+			if err != nil {
+				return err
+			}
+			var p quarantinedPayment
+			if err := json.Unmarshal(v, &p); err != nil {
+				return err
+			}
+			payments = append(payments, &p)
+			return nil
+		})
+	})
+	return payments, err
+}
+
+/*
+resolveQuarantinedPayment removes the quarantined record and applies the resolution:
+discard it, replace the existing payment with the same hash or keep both under a new hash.
+*/
+func resolveQuarantinedPayment(id uint64, resolution data.QuarantineResolution) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		quarantineB := tx.Bucket([]byte(paymentsQuarantineBucket))
+		v := quarantineB.Get(itob(id))
+		if v == nil {
+			return fmt.Errorf("quarantined payment %v not found", id)
+		}
+		v, err := openDBValue(v)
+		if err != nil {
+			return err
+		}
+		var p quarantinedPayment
+		if err := json.Unmarshal(v, &p); err != nil {
+			return err
+		}
+
+		b := tx.Bucket([]byte(paymentsBucket))
+		hashB := tx.Bucket([]byte(paymentsHashBucket))
+		switch resolution {
+		case data.QuarantineResolution_DISCARD:
+		case data.QuarantineResolution_REPLACE_EXISTING:
+			existing := hashB.Get([]byte(p.Payment.PaymentHash))
+			if p.Payment.PaymentHash == "" || existing == nil {
+				return fmt.Errorf("no existing payment to replace for quarantined payment %v", id)
+			}
+			paymentBuf, err := serializePaymentInfo(p.Payment)
+			if err != nil {
+				return err
+			}
+			existingPayment, err := deserializePaymentInfo(b.Get(existing))
+			if err != nil {
+				return err
+			}
+			if err := unindexPayment(tx, btoi(existing), existingPayment); err != nil {
+				return err
+			}
+			if err := b.Put(existing, paymentBuf); err != nil {
+				return err
+			}
+			if err := indexPayment(tx, btoi(existing), p.Payment); err != nil {
+				return err
+			}
+		case data.QuarantineResolution_KEEP_BOTH:
+			p.Payment.PaymentHash = fmt.Sprintf("%v:quarantine:%v", p.Payment.PaymentHash, id)
+			paymentBuf, err := serializePaymentInfo(p.Payment)
+			if err != nil {
+				return err
+			}
+			paymentID, err := b.NextSequence()
+			if err != nil {
+				return err
+			}
+			if err := b.Put(itob(paymentID), paymentBuf); err != nil {
+				return err
+			}
+			if err := hashB.Put([]byte(p.Payment.PaymentHash), itob(paymentID)); err != nil {
+				return err
+			}
+			if err := indexPayment(tx, paymentID, p.Payment); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unknown resolution %v", resolution)
+		}
+		if err := quarantineB.Delete(itob(id)); err != nil {
+			return err
+		}
+		return refreshPaymentsSnapshot(tx)
+	})
+}
+
+func fetchPaymentsSyncInfo() (lastTime int64, lastSetteledIndex uint64) {
+	lastPaymentTime := int64(0)
+	lastInvoiceSettledIndex := uint64(0)
+	db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(paymentsBucket))
+		syncInfoBucket := b.Bucket([]byte(paymentsSyncInfoBucket))
+		lastPaymentTimeBuf := syncInfoBucket.Get([]byte("lastSentPaymentTime"))
+		if lastPaymentTimeBuf != nil {
+			lastPaymentTime = int64(btoi(lastPaymentTimeBuf))
+		}
+		lastInvoiceSettledIndexBuf := syncInfoBucket.Get([]byte("lastSettledIndex"))
+		if lastInvoiceSettledIndexBuf != nil {
+			lastInvoiceSettledIndex = btoi(lastInvoiceSettledIndexBuf)
+		}
+		return nil
+	})
+	return lastPaymentTime, lastInvoiceSettledIndex
+}
+
+func saveAccount(account []byte) error {
+	return saveItem([]byte(accountBucket), []byte("account"), account)
+}
+
+func fetchAccount() ([]byte, error) {
+	return fetchItem([]byte(accountBucket), []byte("account"))
+}
+
+func savePinSet(p *pinSet) error {
+	pinSetBuf, err := serializePinSet(p)
+	if err != nil {
+		return err
+	}
+	return saveItem([]byte(accountBucket), []byte("pinset"), pinSetBuf)
+}
+
+func fetchPinSet() (*pinSet, error) {
+	pinSetBuf, err := fetchItem([]byte(accountBucket), []byte("pinset"))
+	if err != nil || pinSetBuf == nil {
+		return nil, err
+	}
+	return deserializePinSet(pinSetBuf)
+}
+
+func saveFirstUsePins(host string, p *pinSet) error {
+	pinSetBuf, err := serializePinSet(p)
+	if err != nil {
+		return err
+	}
+	return saveItem([]byte(accountBucket), []byte("firstUsePins:"+host), pinSetBuf)
+}
+
+func fetchFirstUsePins(host string) (*pinSet, error) {
+	pinSetBuf, err := fetchItem([]byte(accountBucket), []byte("firstUsePins:"+host))
+	if err != nil || pinSetBuf == nil {
+		return nil, err
+	}
+	return deserializePinSet(pinSetBuf)
+}
+
+func saveObserverKey(key []byte) error {
+	return saveItem([]byte(accountBucket), []byte("observerKey"), key)
+}
+
+func fetchObserverKey() ([]byte, error) {
+	return fetchItem([]byte(accountBucket), []byte("observerKey"))
+}
+
+func fetchSavings() (*savingsInfo, error) {
+	savingsBuf, err := fetchItem([]byte(accountBucket), []byte("savings"))
+	if err != nil || savingsBuf == nil {
+		return nil, err
+	}
+	return deserializeSavingsInfo(savingsBuf)
+}
+
+// updateSavings reads, updates and saves the savings in one transaction.
+func updateSavings(updateFunc func(*savingsInfo) error) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(accountBucket))
+		savings := &savingsInfo{}
+		if savingsBuf := b.Get([]byte("savings")); savingsBuf != nil {
+			var err error
+			if savings, err = deserializeSavingsInfo(savingsBuf); err != nil {
+				return err
+			}
+		}
+		if err := updateFunc(savings); err != nil {
+			return err
+		}
+		savingsBuf, err := serializeSavingsInfo(savings)
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte("savings"), savingsBuf)
+	})
+}
+
+func savePaymentRequest(payReqHash string, payReq []byte) error {
+	payReq, err := sealDBValue(payReq)
+	if err != nil {
+		return err
+	}
+	return saveItem([]byte(incmoingPayReqBucket), []byte(payReqHash), payReq)
+}
+
+// deletePaymentRequestsBefore deletes the payment requests created before the
+// given time whose payments were recorded, and returns how many were deleted.
+// The requests of the payments being sent, those with a payment intent or a
+// hash in inFlight, and the requests which can't be decoded are kept.
+func deletePaymentRequestsBefore(before int64, inFlight map[string]bool) (int, error) {
+	network, err := networkParams()
+	if err != nil {
+		return 0, err
+	}
+	var pruned int
+	err = db.Update(func(tx *bolt.Tx) error {
+		payReqB := tx.Bucket([]byte(incmoingPayReqBucket))
+		hashB := tx.Bucket([]byte(paymentsHashBucket))
+		intentsB := tx.Bucket([]byte(paymentIntentsBucket))
+		var keys [][]byte
+		err := payReqB.ForEach(func(k, v []byte) error {
+			//the payment is recorded from its request once it completes
+			if hashB.Get(k) == nil || intentsB.Get(k) != nil || inFlight[string(k)] {
+				return nil
+			}
+			payReq, err := openDBValue(v)
+			if err != nil {
+				return err
+			}
+			decoded, err := zpay32.Decode(string(payReq), network)
+			if err != nil {
+				log.Errorf("deletePaymentRequestsBefore - failed to decode payment request %x: %v", k, err)
+				return nil
+			}
+			if decoded.Timestamp.Unix() < before {
+				keys = append(keys, append([]byte{}, k...))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, k := range keys {
+			if err := payReqB.Delete(k); err != nil {
+				return err
+			}
+		}
+		pruned = len(keys)
+		return nil
+	})
+	return pruned, err
+}
+
+func fetchPaymentRequest(payReqHash string) ([]byte, error) {
+	payReq, err := fetchItem([]byte(incmoingPayReqBucket), []byte(payReqHash))
+	if err != nil {
+		return nil, err
+	}
+	return openDBValue(payReq)
+}
+
+func saveWrappedInvoice(invoice *wrappedInvoiceInfo) error {
+	invoiceBuf, err := serializeWrappedInvoiceInfo(invoice)
+	if err != nil {
+		return err
+	}
+	return saveItem([]byte(wrappedInvoicesBucket), []byte(invoice.PaymentHash), invoiceBuf)
+}
+
+func fetchWrappedInvoices() ([]*wrappedInvoiceInfo, error) {
+	var invoices []*wrappedInvoiceInfo
+	err := db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(wrappedInvoicesBucket))
+		return b.ForEach(func(k, v []byte) error {
+			invoice, err := deserializeWrappedInvoiceInfo(v)
+			if err != nil {
+				return err
+			}
+			invoices = append(invoices, invoice)
+			return nil
+		})
+	})
+	return invoices, err
+}
+
+func deleteWrappedInvoice(paymentHash string) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(wrappedInvoicesBucket)).Delete([]byte(paymentHash))
+	})
+}
+
+func addMoveFundsOperation(operation *data.MoveFundsOperation) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(moveFundsBucket))
+		id, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		operation.Id = id
+		operationBuf, err := serializeMoveFundsOperation(operation)
+		if err != nil {
+			return err
+		}
+		return b.Put(itob(id), operationBuf)
+	})
+}
+
+func saveMoveFundsOperation(operation *data.MoveFundsOperation) error {
+	operationBuf, err := serializeMoveFundsOperation(operation)
+	if err != nil {
+		return err
+	}
+	return saveItem([]byte(moveFundsBucket), itob(operation.Id), operationBuf)
+}
+
+func fetchMoveFundsOperations() ([]*data.MoveFundsOperation, error) {
+	var operations []*data.MoveFundsOperation
+	err := db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(moveFundsBucket))
+		return b.ForEach(func(k, v []byte) error {
+			operation, err := deserializeMoveFundsOperation(v)
+			if err != nil {
+				return err
+			}
+			operations = append(operations, operation)
+			return nil
+		})
+	})
+	return operations, err
+}
+
+func savePairingSession(session *data.PairingSession) error {
+	sessionBuf, err := serializePairingSession(session)
+	if err != nil {
+		return err
+	}
+	return saveItem([]byte(pairingSessionsBucket), []byte(session.SessionID), sessionBuf)
+}
+
+func fetchPairingSession(sessionID string) (*data.PairingSession, error) {
+	sessionBuf, err := fetchItem([]byte(pairingSessionsBucket), []byte(sessionID))
+	if err != nil || sessionBuf == nil {
+		return nil, err
+	}
+	return deserializePairingSession(sessionBuf)
+}
+
+func fetchPairingSessions() ([]*data.PairingSession, error) {
+	var sessions []*data.PairingSession
+	err := db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(pairingSessionsBucket)).ForEach(func(k, v []byte) error {
+			session, err := deserializePairingSession(v)
+			if err != nil {
+				return err
+			}
+			sessions = append(sessions, session)
+			return nil
+		})
+	})
+	return sessions, err
+}
+
+func savePaymentSplit(split *data.PaymentSplit) error {
+	splitBuf, err := serializePaymentSplit(split)
+	if err != nil {
+		return err
+	}
+	return saveItem([]byte(paymentSplitsBucket), []byte(split.PaymentHash), splitBuf)
+}
+
+func fetchPaymentSplit(paymentHash string) (*data.PaymentSplit, error) {
+	splitBuf, err := fetchItem([]byte(paymentSplitsBucket), []byte(paymentHash))
+	if err != nil || splitBuf == nil {
+		return nil, err
+	}
+	return deserializePaymentSplit(splitBuf)
+}
+
+func saveSplitChild(childHash, parentHash string) error {
+	return saveItem([]byte(paymentSplitsChildBucket), []byte(childHash), []byte(parentHash))
+}
+
+func fetchSplitParent(childHash string) (string, error) {
+	parent, err := fetchItem([]byte(paymentSplitsChildBucket), []byte(childHash))
+	return string(parent), err
+}
+
+func addSettlementRule(rule *data.SettlementRule) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(settlementRulesBucket))
+		id, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		rule.Id = id
+		ruleBuf, err := serializeSettlementRule(rule)
+		if err != nil {
+			return err
+		}
+		return b.Put(itob(id), ruleBuf)
+	})
+}
+
+func saveSettlementRule(rule *data.SettlementRule) error {
+	ruleBuf, err := serializeSettlementRule(rule)
+	if err != nil {
+		return err
+	}
+	return saveItem([]byte(settlementRulesBucket), itob(rule.Id), ruleBuf)
+}
+
+func deleteSettlementRule(id uint64) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(settlementRulesBucket)).Delete(itob(id))
+	})
+}
+
+func fetchSettlementRules() ([]*data.SettlementRule, error) {
+	var rules []*data.SettlementRule
+	err := db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(settlementRulesBucket)).ForEach(func(k, v []byte) error {
+			rule, err := deserializeSettlementRule(v)
+			if err != nil {
+				return err
+			}
+			rules = append(rules, rule)
+			return nil
+		})
+	})
+	return rules, err
+}
+
+func addSettlementAuditEntry(entry *data.SettlementAuditEntry) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(settlementAuditBucket))
+		id, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		entryBuf, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		if entryBuf, err = sealDBValue(entryBuf); err != nil {
+			return err
+		}
+		return b.Put(itob(id), entryBuf)
+	})
+}
+
+func fetchSettlementAudit() ([]*data.SettlementAuditEntry, error) {
+	var entries []*data.SettlementAuditEntry
+	err := db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(settlementAuditBucket)).ForEach(func(k, v []byte) error {
+			v, err := openDBValue(v)
+			if err != nil {
+				return err
+			}
+			var entry data.SettlementAuditEntry
+			if err := json.Unmarshal(v, &entry); err != nil {
+				return err
+			}
+			entries = append(entries, &entry)
+			return nil
+		})
+	})
+	return entries, err
+}
+
+func saveLNURLPayMemo(paymentHash string, memo *data.InvoiceMemo) error {
+	memoBuf, err := json.Marshal(memo)
+	if err != nil {
+		return err
+	}
+	if memoBuf, err = sealDBValue(memoBuf); err != nil {
+		return err
+	}
+	return saveItem([]byte(lnurlPayMemosBucket), []byte(paymentHash), memoBuf)
+}
+
+func fetchLNURLPayMemo(paymentHash string) (*data.InvoiceMemo, error) {
+	memoBuf, err := fetchItem([]byte(lnurlPayMemosBucket), []byte(paymentHash))
+	if err != nil || memoBuf == nil {
+		return nil, err
+	}
+	if memoBuf, err = openDBValue(memoBuf); err != nil {
+		return nil, err
+	}
+	var memo data.InvoiceMemo
+	err = json.Unmarshal(memoBuf, &memo)
+	return &memo, err
+}
+
+func saveInvoiceReminder(reminder *invoiceReminder) error {
+	reminderBuf, err := serializeInvoiceReminder(reminder)
+	if err != nil {
+		return err
+	}
+	return saveItem([]byte(invoiceRemindersBucket), []byte(reminder.PaymentHash), reminderBuf)
+}
+
+func deleteInvoiceReminder(paymentHash string) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(invoiceRemindersBucket)).Delete([]byte(paymentHash))
+	})
+}
+
+func fetchInvoiceReminders() ([]*invoiceReminder, error) {
+	var reminders []*invoiceReminder
+	err := db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(invoiceRemindersBucket)).ForEach(func(k, v []byte) error {
+			reminder, err := deserializeInvoiceReminder(v)
+			if err != nil {
+				return err
+			}
+			reminders = append(reminders, reminder)
+			return nil
+		})
+	})
+	return reminders, err
+}
+
+func addOutboxEvent(event *data.NotificationEvent) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(notificationsOutboxBucket))
+		id, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		event.Id = id
+		eventBuf, err := proto.Marshal(event)
+		if err != nil {
+			return err
+		}
+		if eventBuf, err = sealDBValue(eventBuf); err != nil {
+			return err
+		}
+		if err := b.Put(itob(id), eventBuf); err != nil {
+			return err
+		}
+		return trimOutbox(b)
+	})
+}
+
+// trimOutbox deletes the oldest events over outboxMaxEvents, which were never
+// acknowledged. The transient events are deleted first, then the other ones
+// except the settlement events, which are never deleted.
+func trimOutbox(b *bolt.Bucket) error {
+	var count int
+	c := b.Cursor()
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		count++
+	}
+	excess := count - outboxMaxEvents
+	if excess <= 0 {
+		return nil
+	}
+	var transient, other [][]byte
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		v, err := openDBValue(v)
+		if err != nil {
+			return err
+		}
+		event := &data.NotificationEvent{}
+		if err := proto.Unmarshal(v, event); err != nil {
+			return err
+		}
+		switch {
+		case transientNotifications[event.Type]:
+			transient = append(transient, append([]byte(nil), k...))
+		case !settlementNotifications[event.Type]:
+			other = append(other, append([]byte(nil), k...))
+		}
+	}
+	evicted := append(transient, other...)
+	if len(evicted) > excess {
+		evicted = evicted[:excess]
+	}
+	log.Warnf("trimOutbox - dropping %v of %v unacknowledged events over the limit", len(evicted), excess)
+	for _, k := range evicted {
+		if err := b.Delete(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deleteOutboxEvent(id uint64) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(notificationsOutboxBucket)).Delete(itob(id))
+	})
+}
+
+// fetchOutboxEvents returns the events in the outbox with an id greater than afterID.
+func fetchOutboxEvents(afterID uint64) ([]*data.NotificationEvent, error) {
+	var events []*data.NotificationEvent
+	err := db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket([]byte(notificationsOutboxBucket)).Cursor()
+		for k, v := c.Seek(itob(afterID + 1)); k != nil; k, v = c.Next() {
+			v, err := openDBValue(v)
+			if err != nil {
+				return err
+			}
+			event := &data.NotificationEvent{}
+			if err := proto.Unmarshal(v, event); err != nil {
+				return err
+			}
+			events = append(events, event)
+		}
+		return nil
+	})
+	return events, err
+}
+
+func saveFiatRate(rate *fiatRate) error {
+	rateBuf, err := serializeFiatRate(rate)
+	if err != nil {
+		return err
+	}
+	return saveItem([]byte(fiatRatesBucket), []byte(rate.Currency), rateBuf)
+}
+
+func fetchFiatRate(currency string) (*fiatRate, error) {
+	rateBuf, err := fetchItem([]byte(fiatRatesBucket), []byte(currency))
+	if err != nil || rateBuf == nil {
+		return nil, err
+	}
+	return deserializeFiatRate(rateBuf)
+}
+
+func fetchFiatRates() ([]*fiatRate, error) {
+	var rates []*fiatRate
+	err := db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(fiatRatesBucket)).ForEach(func(k, v []byte) error {
+			rate, err := deserializeFiatRate(v)
+			if err != nil {
+				return err
+			}
+			rates = append(rates, rate)
+			return nil
+		})
+	})
+	return rates, err
+}
+
+func saveFiatCurrency(currency string) error {
+	return saveItem([]byte(accountBucket), []byte("fiatCurrency"), []byte(currency))
+}
+
+func fetchFiatCurrency() (string, error) {
+	value, err := fetchItem([]byte(accountBucket), []byte("fiatCurrency"))
+	return string(value), err
+}
+
+func saveRatesSource(name string) error {
+	return saveItem([]byte(accountBucket), []byte("ratesSource"), []byte(name))
+}
+
+func fetchRatesSource() (string, error) {
+	value, err := fetchItem([]byte(accountBucket), []byte("ratesSource"))
+	return string(value), err
+}
+
+func saveTaxTemplate(template []byte) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(accountBucket))
+		if template == nil {
+			return b.Delete([]byte("taxTemplate"))
+		}
+		return b.Put([]byte("taxTemplate"), template)
+	})
+}
+
+func fetchTaxTemplate() ([]byte, error) {
+	return fetchItem([]byte(accountBucket), []byte("taxTemplate"))
+}
+
+func saveSpendingPolicy(policy []byte) error {
+	return saveItem([]byte(accountBucket), []byte("spendingPolicy"), policy)
+}
+
+func fetchSpendingPolicy() ([]byte, error) {
+	return fetchItem([]byte(accountBucket), []byte("spendingPolicy"))
+}
+
+func savePushToken(token string) error {
+	return saveItem([]byte(accountBucket), []byte("pushToken"), []byte(token))
+}
+
+func fetchPushToken() (string, error) {
+	token, err := fetchItem([]byte(accountBucket), []byte("pushToken"))
+	return string(token), err
+}
+
+func saveMacaroonRotation(pending bool) error {
+	var value byte
+	if pending {
+		value = 1
+	}
+	return saveItem([]byte(accountBucket), []byte("macaroonRotation"), []byte{value})
+}
+
+func fetchMacaroonRotation() (bool, error) {
+	value, err := fetchItem([]byte(accountBucket), []byte("macaroonRotation"))
+	return len(value) > 0 && value[0] == 1, err
+}
+
+func saveDeviceBackupState(s *deviceBackupState) error {
+	if s == nil {
+		return db.Update(func(tx *bolt.Tx) error {
+			return tx.Bucket([]byte(accountBucket)).Delete([]byte("deviceBackup"))
+		})
+	}
+	stateBuf, err := serializeDeviceBackupState(s)
+	if err != nil {
+		return err
+	}
+	return saveItem([]byte(accountBucket), []byte("deviceBackup"), stateBuf)
+}
+
+func fetchDeviceBackupState() (*deviceBackupState, error) {
+	stateBuf, err := fetchItem([]byte(accountBucket), []byte("deviceBackup"))
+	if err != nil || stateBuf == nil {
+		return nil, err
+	}
+	return deserializeDeviceBackupState(stateBuf)
+}
+
+func saveHubPolicy(policy *data.HubPolicy) error {
+	policyBuf, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+	return saveItem([]byte(accountBucket), []byte("hubPolicy"), policyBuf)
+}
+
+func fetchHubPolicy() (*data.HubPolicy, error) {
+	policyBuf, err := fetchItem([]byte(accountBucket), []byte("hubPolicy"))
+	if err != nil || policyBuf == nil {
+		return nil, err
+	}
+	var policy data.HubPolicy
+	err = json.Unmarshal(policyBuf, &policy)
+	return &policy, err
+}
+
+func saveMaintenanceReport(report *data.MaintenanceReport) error {
+	reportBuf, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+	return saveItem([]byte(accountBucket), []byte("maintenanceReport"), reportBuf)
+}
+
+func fetchMaintenanceReport() (*data.MaintenanceReport, error) {
+	reportBuf, err := fetchItem([]byte(accountBucket), []byte("maintenanceReport"))
+	if err != nil || reportBuf == nil {
+		return nil, err
+	}
+	var report data.MaintenanceReport
+	err = json.Unmarshal(reportBuf, &report)
+	return &report, err
+}
+
+func saveHTTPAPIToken(token string) error {
+	return saveItem([]byte(accountBucket), []byte("httpAPIToken"), []byte(token))
+}
+
+func fetchHTTPAPIToken() (string, error) {
+	token, err := fetchItem([]byte(accountBucket), []byte("httpAPIToken"))
+	return string(token), err
+}
+
+func saveGRPCToken(token string) error {
+	return saveItem([]byte(accountBucket), []byte("grpcToken"), []byte(token))
+}
+
+func fetchGRPCToken() (string, error) {
+	token, err := fetchItem([]byte(accountBucket), []byte("grpcToken"))
+	return string(token), err
+}
+
+func saveDeviceIdentityKey(key []byte) error {
+	return saveItem([]byte(accountBucket), []byte("deviceIdentityKey"), key)
+}
+
+func fetchDeviceIdentityKey() ([]byte, error) {
+	return fetchItem([]byte(accountBucket), []byte("deviceIdentityKey"))
+}
+
+func saveTransferAutoAccept(enabled bool) error {
+	var value byte
+	if enabled {
+		value = 1
+	}
+	return saveItem([]byte(accountBucket), []byte("transferAutoAccept"), []byte{value})
+}
+
+func fetchTransferAutoAccept() (bool, error) {
+	value, err := fetchItem([]byte(accountBucket), []byte("transferAutoAccept"))
+	return len(value) == 1 && value[0] == 1, err
+}
+
+func saveInvoiceExpiryScan(timestamp int64) error {
+	return saveItem([]byte(accountBucket), []byte("invoiceExpiryScan"), itob(uint64(timestamp)))
+}
+
+func fetchInvoiceExpiryScan() (int64, error) {
+	value, err := fetchItem([]byte(accountBucket), []byte("invoiceExpiryScan"))
+	if err != nil || value == nil {
+		return 0, err
+	}
+	return int64(btoi(value)), nil
+}
+
+func saveQueuedPayment(p *queuedPayment) error {
+	paymentBuf, err := serializeQueuedPayment(p)
+	if err != nil {
+		return err
+	}
+	return saveItem([]byte(paymentQueueBucket), []byte(p.PaymentHash), paymentBuf)
+}
+
+func fetchQueuedPayment(paymentHash string) (*queuedPayment, error) {
+	paymentBuf, err := fetchItem([]byte(paymentQueueBucket), []byte(paymentHash))
+	if err != nil || paymentBuf == nil {
+		return nil, err
+	}
+	return deserializeQueuedPayment(paymentBuf)
+}
+
+func deleteQueuedPayment(paymentHash string) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(paymentQueueBucket)).Delete([]byte(paymentHash))
+	})
+}
+
+func fetchQueuedPayments() ([]*queuedPayment, error) {
+	var payments []*queuedPayment
+	err := db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(paymentQueueBucket)).ForEach(func(k, v []byte) error {
+			p, err := deserializeQueuedPayment(v)
+			if err != nil {
+				return err
+			}
+			payments = append(payments, p)
+			return nil
+		})
+	})
+	return payments, err
+}
+
+func savePaymentCode(c *paymentCode) error {
+	codeBuf, err := serializePaymentCode(c)
+	if err != nil {
+		return err
+	}
+	return saveItem([]byte(paymentCodesBucket), []byte(c.ID), codeBuf)
+}
+
+func fetchPaymentCode(id string) (*paymentCode, error) {
+	codeBuf, err := fetchItem([]byte(paymentCodesBucket), []byte(id))
+	if err != nil || codeBuf == nil {
+		return nil, err
+	}
+	return deserializePaymentCode(codeBuf)
+}
+
+func deletePaymentCode(id string) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(paymentCodesBucket)).Delete([]byte(id))
+	})
+}
+
+func fetchPaymentCodes() ([]*paymentCode, error) {
+	var codes []*paymentCode
+	err := db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(paymentCodesBucket)).ForEach(func(k, v []byte) error {
+			c, err := deserializePaymentCode(v)
+			if err != nil {
+				return err
+			}
+			codes = append(codes, c)
+			return nil
+		})
+	})
+	return codes, err
+}
+
+func saveFallbackAddress(f *fallbackAddress) error {
+	addressBuf, err := serializeFallbackAddress(f)
+	if err != nil {
+		return err
+	}
+	return saveItem([]byte(fallbackAddressesBucket), []byte(f.PaymentHash), addressBuf)
+}
+
+func deleteFallbackAddress(paymentHash string) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(fallbackAddressesBucket)).Delete([]byte(paymentHash))
+	})
+}
+
+func fetchFallbackAddresses() ([]*fallbackAddress, error) {
+	var addresses []*fallbackAddress
+	err := db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(fallbackAddressesBucket)).ForEach(func(k, v []byte) error {
+			f, err := deserializeFallbackAddress(v)
+			if err != nil {
+				return err
+			}
+			addresses = append(addresses, f)
+			return nil
+		})
+	})
+	return addresses, err
+}
+
+func saveIdempotentPayment(key string, p *idempotentPayment) error {
+	paymentBuf, err := serializeIdempotentPayment(p)
+	if err != nil {
+		return err
+	}
+	return saveItem([]byte(idempotencyKeysBucket), []byte(key), paymentBuf)
+}
+
+func fetchIdempotentPayment(key string) (*idempotentPayment, error) {
+	paymentBuf, err := fetchItem([]byte(idempotencyKeysBucket), []byte(key))
+	if err != nil || paymentBuf == nil {
+		return nil, err
+	}
+	return deserializeIdempotentPayment(paymentBuf)
+}
+
+func savePaymentIntent(i *paymentIntent) error {
+	intentBuf, err := serializePaymentIntent(i)
+	if err != nil {
+		return err
+	}
+	return saveItem([]byte(paymentIntentsBucket), []byte(i.PaymentHash), intentBuf)
+}
+
+func deletePaymentIntent(paymentHash string) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(paymentIntentsBucket)).Delete([]byte(paymentHash))
+	})
+}
+
+func fetchPaymentIntents() ([]*paymentIntent, error) {
+	var intents []*paymentIntent
+	err := db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(paymentIntentsBucket)).ForEach(func(k, v []byte) error {
+			i, err := deserializePaymentIntent(v)
+			if err != nil {
+				return err
+			}
+			intents = append(intents, i)
+			return nil
+		})
+	})
+	return intents, err
+}
+
+func addWebhook(webhook *data.Webhook) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(webhooksBucket))
+		id, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		webhook.Id = id
+		webhookBuf, err := serializeWebhook(webhook)
+		if err != nil {
+			return err
+		}
+		return b.Put(itob(id), webhookBuf)
+	})
+}
+
+func deleteWebhook(id uint64) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(webhooksBucket)).Delete(itob(id))
+	})
+}
+
+func fetchWebhooks() ([]*data.Webhook, error) {
+	var webhooks []*data.Webhook
+	err := db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(webhooksBucket)).ForEach(func(k, v []byte) error {
+			webhook, err := deserializeWebhook(v)
+			if err != nil {
+				return err
+			}
+			webhooks = append(webhooks, webhook)
+			return nil
+		})
+	})
+	return webhooks, err
+}
+
+func addWebhookDelivery(d *webhookDelivery) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(webhookDeliveriesBucket))
+		id, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		d.ID = id
+		deliveryBuf, err := serializeWebhookDelivery(d)
+		if err != nil {
+			return err
+		}
+		return b.Put(itob(id), deliveryBuf)
+	})
+}
+
+func saveWebhookDelivery(d *webhookDelivery) error {
+	deliveryBuf, err := serializeWebhookDelivery(d)
+	if err != nil {
+		return err
+	}
+	return saveItem([]byte(webhookDeliveriesBucket), itob(d.ID), deliveryBuf)
+}
+
+func fetchWebhookDeliveries() ([]*webhookDelivery, error) {
+	var deliveries []*webhookDelivery
+	err := db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(webhookDeliveriesBucket)).ForEach(func(k, v []byte) error {
+			d, err := deserializeWebhookDelivery(v)
+			if err != nil {
+				return err
+			}
+			deliveries = append(deliveries, d)
+			return nil
+		})
+	})
+	return deliveries, err
+}
+
+// compactEncryptedDB rewrites a database that was just encrypted into a new
+// file, so the plaintext left in its free pages is gone, and deletes the copy
+// taken before the last migration, which is in plaintext.
+func compactEncryptedDB(dbPath string) error {
+	if err := db.Close(); err != nil {
+		return err
+	}
+	if err := compactDB(dbPath); err != nil {
+		return err
+	}
+	if err := os.Remove(dbPath + migrationBackupSuffix); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	var err error
+	db, err = bolt.Open(dbPath, 0600, nil)
+	return err
+}
+
+// compactDB rewrites the closed database at dbPath into a new file without
+// its free pages and replaces it.
+func compactDB(dbPath string) error {
+	src, err := bolt.Open(dbPath, 0600, &bolt.Options{ReadOnly: true, Timeout: time.Second})
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	compactPath := dbPath + ".compacted"
+	dst, err := bolt.Open(compactPath, 0600, nil)
+	if err != nil {
+		return err
+	}
+	err = src.View(func(srcTx *bolt.Tx) error {
+		return dst.Update(func(dstTx *bolt.Tx) error {
+			return srcTx.ForEach(func(name []byte, b *bolt.Bucket) error {
+				nb, err := dstTx.CreateBucket(name)
+				if err != nil {
+					return err
+				}
+				return copyBucket(b, nb)
+			})
+		})
+	})
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(compactPath)
+		return err
+	}
+	src.Close()
+	return os.Rename(compactPath, dbPath)
+}
+
+func copyBucket(src, dst *bolt.Bucket) error {
+	if err := dst.SetSequence(src.Sequence()); err != nil {
+		return err
+	}
+	return src.ForEach(func(k, v []byte) error {
+		if v != nil {
+			return dst.Put(k, v)
+		}
+		nb, err := dst.CreateBucket(k)
+		if err != nil {
+			return err
+		}
+		return copyBucket(src.Bucket(k), nb)
+	})
+}
+
+// countBackupPayments returns the number of payments in a database backup.
+func countBackupPayments(dbPath string) (int, error) {
+	backupDB, err := bolt.Open(dbPath, 0600, &bolt.Options{ReadOnly: true, Timeout: time.Second})
+	if err != nil {
+		return 0, err
+	}
+	defer backupDB.Close()
+	var count int
+	err = backupDB.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(paymentsBucket))
+		if b == nil {
+			return errors.New("payments bucket is missing")
+		}
+		return b.ForEach(func(k, v []byte) error {
+			if v != nil {
+				count++
+			}
+			return nil
+		})
+	})
+	return count, err
+}
+
+/**
+Swap addresses
+**/
+func savePaymentRoute(paymentHash string, r *paymentRoute) error {
+	routeBuf, err := serializePaymentRoute(r)
+	if err != nil {
+		return err
+	}
+	return saveItem([]byte(paymentRoutesBucket), []byte(paymentHash), routeBuf)
+}
+
+func fetchPaymentRoute(paymentHash string) (*paymentRoute, error) {
+	routeBuf, err := fetchItem([]byte(paymentRoutesBucket), []byte(paymentHash))
+	if err != nil || routeBuf == nil {
+		return nil, err
+	}
+	return deserializePaymentRoute(routeBuf)
+}
+
+func addFailedPayment(p *failedPayment) error {
+	paymentBuf, err := serializeFailedPayment(p)
+	if err != nil {
+		return err
+	}
+	return db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(failedPaymentsBucket))
+		id, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		return b.Put(append(itob(uint64(p.Timestamp)), itob(id)...), paymentBuf)
+	})
+}
+
+func fetchFailedPayments() ([]*failedPayment, error) {
+	var payments []*failedPayment
+	err := db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket([]byte(failedPaymentsBucket)).Cursor()
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			p, err := deserializeFailedPayment(v)
+			if err != nil {
+				return err
+			}
+			payments = append(payments, p)
+		}
+		return nil
+	})
+	return payments, err
+}
+
+func deleteFailedPayments() error {
+	return db.Update(func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket([]byte(failedPaymentsBucket)); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucket([]byte(failedPaymentsBucket))
+		return err
+	})
+}
+
+func saveDonationCampaign(c *donationCampaign) error {
+	campaignBuf, err := serializeDonationCampaign(c)
+	if err != nil {
+		return err
+	}
+	return saveItem([]byte(donationCampaignsBucket), []byte(c.ID), campaignBuf)
+}
+
+func fetchDonationCampaign(id string) (*donationCampaign, error) {
+	campaignBuf, err := fetchItem([]byte(donationCampaignsBucket), []byte(id))
+	if err != nil || campaignBuf == nil {
+		return nil, err
+	}
+	return deserializeDonationCampaign(campaignBuf)
+}
+
+func fetchDonationCampaigns() ([]*donationCampaign, error) {
+	var campaigns []*donationCampaign
+	err := db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(donationCampaignsBucket)).ForEach(func(k, v []byte) error {
+			c, err := deserializeDonationCampaign(v)
+			if err != nil {
+				return err
+			}
+			campaigns = append(campaigns, c)
+			return nil
+		})
+	})
+	return campaigns, err
+}
+
+func saveDonationContribution(c *donationContribution) error {
+	contributionBuf, err := serializeDonationContribution(c)
+	if err != nil {
+		return err
+	}
+	return saveItem([]byte(donationContributionsBucket), []byte(c.PaymentHash), contributionBuf)
+}
+
+func fetchDonationContribution(paymentHash string) (*donationContribution, error) {
+	contributionBuf, err := fetchItem([]byte(donationContributionsBucket), []byte(paymentHash))
+	if err != nil || contributionBuf == nil {
+		return nil, err
+	}
+	return deserializeDonationContribution(contributionBuf)
+}
+
+func fetchDonationContributions() ([]*donationContribution, error) {
+	var contributions []*donationContribution
+	err := db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(donationContributionsBucket)).ForEach(func(k, v []byte) error {
+			c, err := deserializeDonationContribution(v)
+			if err != nil {
+				return err
+			}
+			contributions = append(contributions, c)
+			return nil
+		})
+	})
+	return contributions, err
+}
+
+func deleteDonationCampaign(id string) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		contributionsB := tx.Bucket([]byte(donationContributionsBucket))
+		var hashes [][]byte
+		err := contributionsB.ForEach(func(k, v []byte) error {
+			c, err := deserializeDonationContribution(v)
+			if err != nil {
+				return err
+			}
+			if c.CampaignID == id {
+				hashes = append(hashes, k)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, hash := range hashes {
+			if err := contributionsB.Delete(hash); err != nil {
+				return err
+			}
+		}
+		return tx.Bucket([]byte(donationCampaignsBucket)).Delete([]byte(id))
+	})
+}
+
+// addHTLCEvent saves the event and deletes the oldest ones beyond keep.
+func addHTLCEvent(e *htlcEvent, keep int) error {
+	eventBuf, err := serializeHTLCEvent(e)
+	if err != nil {
+		return err
+	}
+	return db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(htlcEventsBucket))
+		id, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		if err := b.Put(append(itob(uint64(e.Timestamp)), itob(id)...), eventBuf); err != nil {
+			return err
+		}
+		extra := b.Stats().KeyN - keep
+		var oldest [][]byte
+		c := b.Cursor()
+		for k, _ := c.First(); k != nil && len(oldest) < extra; k, _ = c.Next() {
+			oldest = append(oldest, k)
+		}
+		for _, k := range oldest {
+			if err := b.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func fetchHTLCEvents() ([]*htlcEvent, error) {
+	var events []*htlcEvent
+	err := db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket([]byte(htlcEventsBucket)).Cursor()
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			e, err := deserializeHTLCEvent(v)
+			if err != nil {
+				return err
+			}
+			events = append(events, e)
+		}
+		return nil
+	})
+	return events, err
+}
+
+func addChannelConsolidation(c *data.ChannelConsolidation) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(channelConsolidationsBucket))
+		id, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		c.Id = id
+		consolidationBuf, err := serializeChannelConsolidation(c)
+		if err != nil {
+			return err
+		}
+		return b.Put(itob(id), consolidationBuf)
+	})
+}
+
+func saveChannelConsolidation(c *data.ChannelConsolidation) error {
+	consolidationBuf, err := serializeChannelConsolidation(c)
+	if err != nil {
+		return err
+	}
+	return saveItem([]byte(channelConsolidationsBucket), itob(c.Id), consolidationBuf)
+}
+
+func fetchChannelConsolidations() ([]*data.ChannelConsolidation, error) {
+	var consolidations []*data.ChannelConsolidation
+	err := db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(channelConsolidationsBucket)).ForEach(func(k, v []byte) error {
+			c, err := deserializeChannelConsolidation(v)
+			if err != nil {
+				return err
+			}
+			consolidations = append(consolidations, c)
+			return nil
+		})
+	})
+	return consolidations, err
+}
+
+func saveHiddenInvoice(paymentHash string, timestamp int64) error {
+	return saveItem([]byte(hiddenInvoicesBucket), []byte(paymentHash), itob(uint64(timestamp)))
+}
+
+func isInvoiceHidden(paymentHash string) (bool, error) {
+	hidden, err := fetchItem([]byte(hiddenInvoicesBucket), []byte(paymentHash))
+	return hidden != nil, err
+}
+
+func fetchHiddenInvoices() (map[string]int64, error) {
+	hidden := make(map[string]int64)
+	err := db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(hiddenInvoicesBucket)).ForEach(func(k, v []byte) error {
+			hidden[string(k)] = int64(btoi(v))
+			return nil
+		})
+	})
+	return hidden, err
+}
+
+func addSpendAuditEntry(entry *data.SpendAuditEntry) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(spendAuditBucket))
+		id, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		entry.Id = id
+		entryBuf, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		if entryBuf, err = sealDBValue(entryBuf); err != nil {
+			return err
+		}
+		return b.Put(itob(id), entryBuf)
+	})
+}
+
+func fetchSpendAuditEntries() ([]*data.SpendAuditEntry, error) {
+	var entries []*data.SpendAuditEntry
+	err := db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket([]byte(spendAuditBucket)).Cursor()
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			v, err := openDBValue(v)
+			if err != nil {
+				return err
+			}
+			var entry data.SpendAuditEntry
+			if err := json.Unmarshal(v, &entry); err != nil {
+				return err
+			}
+			entries = append(entries, &entry)
+		}
+		return nil
+	})
+	return entries, err
+}
+
+func saveInvoiceHints(h *invoiceHints) error {
+	hintsBuf, err := serializeInvoiceHints(h)
+	if err != nil {
+		return err
+	}
+	return saveItem([]byte(invoiceHintsBucket), []byte(h.PaymentHash), hintsBuf)
+}
+
+func deleteInvoiceHints(paymentHash string) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(invoiceHintsBucket)).Delete([]byte(paymentHash))
+	})
+}
+
+func fetchInvoiceHints() ([]*invoiceHints, error) {
+	var hints []*invoiceHints
+	err := db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(invoiceHintsBucket)).ForEach(func(k, v []byte) error {
+			h, err := deserializeInvoiceHints(v)
+			if err != nil {
+				return err
+			}
+			hints = append(hints, h)
+			return nil
+		})
+	})
+	return hints, err
+}
+
+func fetchAllSwapAddresses() ([]*SwapAddressInfo, error) {
+	return fetchSwapAddresses(func(addr *SwapAddressInfo) bool {
+		return true
+	})
+}
+
+func fetchSwapAddress(address string) (*SwapAddressInfo, error) {
+	addressBuf, err := fetchItem([]byte(addressesBucket), []byte(address))
+	if err != nil || addressBuf == nil {
+		return nil, err
+	}
+	return deserializeSwapAddressInfo(addressBuf)
+}
+
+func fetchSwapAddresses(filterFunc func(addr *SwapAddressInfo) bool) ([]*SwapAddressInfo, error) {
+	var addresses []*SwapAddressInfo
+	err := db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(addressesBucket))
+		b.ForEach(func(k, v []byte) error {
+			var address SwapAddressInfo
+			err := json.Unmarshal(v, &address)
+			if err != nil {
+				return err
+			}
+			if filterFunc(&address) {
+				addresses = append(addresses, &address)
+			}
+			return nil
+		})
+		return nil
+	})
+	return addresses, err
+}
+
+func saveSwapAddressInfo(address *SwapAddressInfo) error {
+	return db.Update(func(tx *bolt.Tx) error {
 		byHashBucket := tx.Bucket([]byte(swapAddressesByHashBucket))
 		addressesBucket := tx.Bucket([]byte(addressesBucket))
 		bytes, err := serializeSwapAddressInfo(address)
@@ -305,6 +2302,14 @@ func saveSwapAddressInfo(address *SwapAddressInfo) error {
 	})
 }
 
+func fetchSwapAddressByPaymentHash(pHash []byte) (*SwapAddressInfo, error) {
+	address, err := fetchItem([]byte(swapAddressesByHashBucket), pHash)
+	if err != nil || address == nil {
+		return nil, err
+	}
+	return fetchSwapAddress(string(address))
+}
+
 func updateSwapAddressByPaymentHash(pHash []byte, updateFunc func(*SwapAddressInfo) error) (bool, error) {
 
 	address, err := fetchItem([]byte(swapAddressesByHashBucket), pHash)
@@ -352,6 +2357,7 @@ func updateSwapAddress(address string, updateFunc func(*SwapAddressInfo) error)
 }
 
 func saveItem(bucket []byte, key []byte, value []byte) error {
+	defer observeDBOperation("saveItem", time.Now())
 	return db.Update(func(tx *bolt.Tx) error {
 		b := tx.Bucket(bucket)
 		return b.Put(key, value)
@@ -359,6 +2365,7 @@ func saveItem(bucket []byte, key []byte, value []byte) error {
 }
 
 func fetchItem(bucket []byte, key []byte) ([]byte, error) {
+	defer observeDBOperation("fetchItem", time.Now())
 	var value []byte
 	err := db.View(func(tx *bolt.Tx) error {
 		b := tx.Bucket(bucket)
@@ -392,5 +2399,159 @@ func backupDb(dir string) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	f1.Close()
+	excludeDetails, err := fetchBackupPrivacy()
+	if err != nil {
+		return "", err
+	}
+	if excludeDetails {
+		if err := stripBackupDetails(dbCopy); err != nil {
+			return "", err
+		}
+	}
 	return dbCopy, nil
 }
+
+// backupStrip is a bucket whose payment details are removed from the backups
+// excluding them.
+type backupStrip struct {
+	bucket string
+
+	//record is the type of the bucket values, nil when the bucket is emptied
+	record interface{}
+
+	//fields are the fields of the record cleared in the backup
+	fields []string
+}
+
+// backupStrips lists the buckets holding memos, payee and payer identities,
+// payment requests or destinations. A field added to a record must be added
+// to its fields unless it holds only amounts, hashes, times or states.
+var backupStrips = []backupStrip{
+	{paymentsBucket, paymentInfo{}, []string{"Description", "PayeeName", "PayeeImageURL", "PayerName",
+		"PayerImageURL", "PayerComment", "Destination", "MerchantTaxID", "DeviceName", "FundingCounterparty"}},
+	{failedPaymentsBucket, failedPayment{}, []string{"PaymentRequest", "Destination", "Description", "Error"}},
+	{spendAuditBucket, data.SpendAuditEntry{}, []string{"InitiatorId", "Destination", "Checks", "Error"}},
+	{donationContributionsBucket, donationContribution{}, []string{"DonorName", "Message"}},
+	{paymentCodesBucket, paymentCode{}, []string{"Description", "Invoices"}},
+	{incmoingPayReqBucket, nil, nil},
+	{wrappedInvoicesBucket, nil, nil},
+	{paymentsSearchBucket, nil, nil},
+	{paymentsSnapshotBucket, nil, nil},
+	{paymentsQuarantineBucket, nil, nil},
+	{lnurlPayMemosBucket, nil, nil},
+	{paymentRoutesBucket, nil, nil},
+	{notificationsOutboxBucket, nil, nil},
+	{paymentIntentsBucket, nil, nil},
+	{paymentQueueBucket, nil, nil},
+	{webhookDeliveriesBucket, nil, nil},
+}
+
+//stripBackupDetails removes descriptions, payee/payer identities, payment requests and
+//destinations from a db copy, leaving only amounts and hashes. The copy is then compacted
+//so the removed details aren't left in its free pages.
+func stripBackupDetails(dbPath string) error {
+	backupDB, err := bolt.Open(dbPath, 0600, nil)
+	if err != nil {
+		return err
+	}
+	err = backupDB.Update(func(tx *bolt.Tx) error {
+		for _, strip := range backupStrips {
+			if strip.record == nil {
+				if err := tx.DeleteBucket([]byte(strip.bucket)); err != nil && err != bolt.ErrBucketNotFound {
+					return err
+				}
+				if _, err := tx.CreateBucket([]byte(strip.bucket)); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := stripBucketFields(tx.Bucket([]byte(strip.bucket)), strip); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if closeErr := backupDB.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return compactDB(dbPath)
+}
+
+// stripBucketFields clears the fields of the bucket values, which are
+// encrypted JSON records.
+func stripBucketFields(b *bolt.Bucket, strip backupStrip) error {
+	if b == nil {
+		return nil
+	}
+	recordType := reflect.TypeOf(strip.record)
+	var keys, values [][]byte
+	err := b.ForEach(func(k, v []byte) error {
+		if v == nil {
+			//nested bucket
+			return nil
+		}
+		v, err := openDBValue(v)
+		if err != nil {
+			return err
+		}
+		record := reflect.New(recordType)
+		if err := json.Unmarshal(v, record.Interface()); err != nil {
+			return err
+		}
+		for _, field := range strip.fields {
+			f := record.Elem().FieldByName(field)
+			f.Set(reflect.Zero(f.Type()))
+		}
+		buf, err := json.Marshal(record.Interface())
+		if err != nil {
+			return err
+		}
+		if buf, err = sealDBValue(buf); err != nil {
+			return err
+		}
+		keys = append(keys, append([]byte{}, k...))
+		values = append(values, buf)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for i := range keys {
+		if err := b.Put(keys[i], values[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setBackupPrivacy(excludeDetails bool) error {
+	value := []byte{0}
+	if excludeDetails {
+		value = []byte{1}
+	}
+	return saveItem([]byte(accountBucket), []byte("backupPrivacy"), value)
+}
+
+func fetchBackupPrivacy() (bool, error) {
+	value, err := fetchItem([]byte(accountBucket), []byte("backupPrivacy"))
+	if err != nil {
+		return false, err
+	}
+	return len(value) == 1 && value[0] == 1, nil
+}
+
+func saveLastBackupTime(timestamp int64) error {
+	return saveItem([]byte(accountBucket), []byte("lastBackupTime"), itob(uint64(timestamp)))
+}
+
+func fetchLastBackupTime() (int64, error) {
+	value, err := fetchItem([]byte(accountBucket), []byte("lastBackupTime"))
+	if err != nil || value == nil {
+		return 0, err
+	}
+	return int64(btoi(value)), nil
+}
@@ -1,15 +1,44 @@
 package breez
 
 import (
+	"bytes"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/zpay32"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	bolt "go.etcd.io/bbolt"
 )
 
-func TestAddresses(t *testing.T) {
-	if err := openDB("testDB"); err != nil {
-		t.Error(err)
+// openTestDB opens a database of the test only in a new temporary file, the
+// returned function closes and deletes it. The tests share the package
+// database so they can't run in parallel.
+func openTestDB(t testing.TB) func() {
+	f, err := ioutil.TempFile("", "breez-db")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dbPath := f.Name()
+	f.Close()
+	if err := openDB(dbPath); err != nil {
+		os.Remove(dbPath)
+		t.Fatal(err)
+	}
+	return func() {
+		closeDB()
+		os.Remove(dbPath)
 	}
-	defer deleteDB()
+}
+
+func TestAddresses(t *testing.T) {
+	defer openTestDB(t)()
 	if err := saveSwapAddressInfo(&SwapAddressInfo{Address: "addr1", PaymentHash: []byte{1, 2, 3}}); err != nil {
 		t.Error(err)
 	}
@@ -57,8 +86,7 @@ func TestAddresses(t *testing.T) {
 
 func TestAddPayments(t *testing.T) {
 	var err error
-	openDB("testdb")
-	defer deleteDB()
+	defer openTestDB(t)()
 	err = addAccountPayment(&paymentInfo{PaymentHash: "h1"}, 1, 0)
 	if err != nil {
 		t.Error("failed to add payment", err)
@@ -80,8 +108,7 @@ func TestAddPayments(t *testing.T) {
 
 func TestPaymentsSyncInfo(t *testing.T) {
 	var err error
-	openDB("testdb")
-	defer deleteDB()
+	defer openTestDB(t)()
 	err = addAccountPayment(&paymentInfo{PaymentHash: "h1"}, 5, 0)
 	if err != nil {
 		t.Error("failed to add payment", err)
@@ -111,8 +138,7 @@ func TestPaymentsSyncInfo(t *testing.T) {
 
 func TestAccount(t *testing.T) {
 	var err error
-	openDB("testdb")
-	defer deleteDB()
+	defer openTestDB(t)()
 	acc, err := fetchAccount()
 	if err != nil {
 		t.Error("failed to add payment", err)
@@ -121,3 +147,255 @@ func TestAccount(t *testing.T) {
 		t.Error("account should be nil")
 	}
 }
+
+func TestBackupPrivacy(t *testing.T) {
+	defer openTestDB(t)()
+	err := addAccountPayment(&paymentInfo{PaymentHash: "h1", Amount: 10, Description: "coffee", PayeeName: "shop"}, 1, 0)
+	if err != nil {
+		t.Error("failed to add payment", err)
+	}
+	if err := savePaymentRequest("h1", []byte("lnbc1coffee")); err != nil {
+		t.Fatal(err)
+	}
+	if err := saveLNURLPayMemo("h1", &data.InvoiceMemo{Description: "coffee"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := addFailedPayment(&failedPayment{PaymentHash: "h2", Description: "coffee"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := setBackupPrivacy(true); err != nil {
+		t.Error("failed to set backup privacy", err)
+	}
+	dir, err := ioutil.TempDir("", "backup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	backupPath, err := backupDb(dir)
+	if err != nil {
+		t.Fatal("failed to backup db", err)
+	}
+
+	backupDB, err := bolt.Open(backupPath, 0600, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer backupDB.Close()
+	var payments []*paymentInfo
+	backupDB.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(paymentsBucket)).ForEach(func(k, v []byte) error {
+			if v == nil {
+				return nil
+			}
+			payment, err := deserializePaymentInfo(v)
+			payments = append(payments, payment)
+			return err
+		})
+	})
+	if len(payments) != 1 {
+		t.Fatal("payments length is ", len(payments))
+	}
+	if payments[0].Description != "" || payments[0].PayeeName != "" {
+		t.Errorf("payment details were not removed: %v", payments[0])
+	}
+	if payments[0].Amount != 10 || payments[0].PaymentHash != "h1" {
+		t.Errorf("payment amount and hash should be kept: %v", payments[0])
+	}
+	backupDB.Close()
+	backupBytes, err := ioutil.ReadFile(backupPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(backupBytes, []byte("coffee")) {
+		t.Error("the backup file still holds the payment details")
+	}
+}
+
+// backupKeptFields are the fields of the backupStrips records kept in the
+// backups excluding the payment details.
+var backupKeptFields = map[string][]string{
+	paymentsBucket: {"Type", "Amount", "CreationTimestamp", "TransferRequest", "PaymentHash", "RedeemTxID",
+		"PendingExpirationHeight", "PendingExpirationTimestamp", "ParentPaymentHash", "FeeRecipient",
+		"CloseReason", "ClosingTxID", "CloseFee", "FiatAmount", "FiatCurrency", "Fee", "FeeMsat",
+		"VatRate", "TaxAmount", "FundingProvider", "FundingOrderID", "FundingTxIDs"},
+	failedPaymentsBucket:        {"PaymentHash", "Amount", "Timestamp", "Reason", "FailureCode", "FailingHop"},
+	spendAuditBucket:            {"Id", "Timestamp", "Initiator", "Kind", "PaymentHash", "Amount", "FeeLimit", "Succeeded"},
+	donationContributionsBucket: {"CampaignID", "PaymentHash", "Amount", "Settled", "SettleTimestamp"},
+	paymentCodesBucket:          {"ID", "Amount", "PoolSize", "InvoiceExpiry", "CreationTimestamp"},
+}
+
+func TestBackupStripsCoverAllFields(t *testing.T) {
+	for _, strip := range backupStrips {
+		if strip.record == nil {
+			continue
+		}
+		listed := make(map[string]bool)
+		for _, f := range append(strip.fields, backupKeptFields[strip.bucket]...) {
+			listed[f] = true
+		}
+		recordType := reflect.TypeOf(strip.record)
+		for i := 0; i < recordType.NumField(); i++ {
+			if name := recordType.Field(i).Name; !listed[name] {
+				t.Errorf("field %v of %v is neither stripped from the backups nor kept, add it to backupStrips or backupKeptFields", name, recordType)
+			}
+		}
+		for _, f := range strip.fields {
+			if _, ok := recordType.FieldByName(f); !ok {
+				t.Errorf("stripped field %v isn't a field of %v", f, recordType)
+			}
+		}
+	}
+}
+
+func TestQuarantinePayments(t *testing.T) {
+	defer openTestDB(t)()
+	addAccountPayment(&paymentInfo{PaymentHash: "h1", Amount: 1}, 1, 0)
+	addAccountPayment(&paymentInfo{PaymentHash: "h1", Amount: 2}, 2, 0)
+	addAccountPayment(&paymentInfo{Amount: 3}, 3, 0)
+
+	payments, err := fetchAllAccountPayments()
+	if err != nil || len(payments) != 1 {
+		t.Fatalf("expected 1 payment, got %v err = %v", len(payments), err)
+	}
+	quarantined, err := fetchQuarantinedPayments()
+	if err != nil || len(quarantined) != 2 {
+		t.Fatalf("expected 2 quarantined payments, got %v err = %v", len(quarantined), err)
+	}
+	if _, settledIndex := fetchPaymentsSyncInfo(); settledIndex != 3 {
+		t.Error("settled index should be 3 and it is: ", settledIndex)
+	}
+
+	if err := resolveQuarantinedPayment(quarantined[0].ID, data.QuarantineResolution_KEEP_BOTH); err != nil {
+		t.Error("failed to resolve quarantined payment", err)
+	}
+	if err := resolveQuarantinedPayment(quarantined[1].ID, data.QuarantineResolution_REPLACE_EXISTING); err == nil {
+		t.Error("replacing a payment with an empty hash should fail")
+	}
+	if err := resolveQuarantinedPayment(quarantined[1].ID, data.QuarantineResolution_DISCARD); err != nil {
+		t.Error("failed to discard quarantined payment", err)
+	}
+	payments, _ = fetchAllAccountPayments()
+	if len(payments) != 2 {
+		t.Error("expected 2 payments after repair, got ", len(payments))
+	}
+	quarantined, _ = fetchQuarantinedPayments()
+	if len(quarantined) != 0 {
+		t.Error("quarantine should be empty, got ", len(quarantined))
+	}
+}
+
+// testPaymentRequest returns the hash and a testnet payment request created at
+// the given time.
+func testPaymentRequest(t testing.TB, created time.Time) (string, string) {
+	key, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, hash, err := newPreimage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	invoice, err := zpay32.NewInvoice(&chaincfg.TestNet3Params, hash, created, zpay32.Description("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	payReq, err := invoice.Encode(zpay32.MessageSigner{
+		SignCompact: func(hash []byte) ([]byte, error) {
+			return btcec.SignCompact(btcec.S256(), key, hash, true)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hex.EncodeToString(hash[:]), payReq
+}
+
+func TestDeletePaymentRequestsBefore(t *testing.T) {
+	defer openTestDB(t)()
+	previousCfg := cfg
+	cfg = &Config{Network: "testnet"}
+	defer func() { cfg = previousCfg }()
+
+	// Only the old recorded payment without an intent or an HTLC in flight
+	// is done with its request.
+	requests := map[string]struct {
+		created  int64
+		recorded bool
+		intent   bool
+		inFlight bool
+		kept     bool
+	}{
+		"recorded":   {10, true, false, false, false},
+		"unrecorded": {20, false, false, false, true},
+		"intent":     {30, true, true, false, true},
+		"inFlight":   {40, true, false, true, true},
+		"recent":     {100, true, false, false, true},
+	}
+	hashes := make(map[string]string)
+	inFlight := make(map[string]bool)
+	for name, r := range requests {
+		hash, payReq := testPaymentRequest(t, time.Unix(r.created, 0))
+		hashes[name] = hash
+		if err := savePaymentRequest(hash, []byte(payReq)); err != nil {
+			t.Fatal(err)
+		}
+		if r.recorded {
+			p := &paymentInfo{Type: sentPayment, PaymentHash: hash, CreationTimestamp: 200}
+			if err := addAccountPayment(p, 0, 0); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if r.intent {
+			if err := savePaymentIntent(&paymentIntent{PaymentHash: hash, PaymentRequest: payReq}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if r.inFlight {
+			inFlight[hash] = true
+		}
+	}
+	if err := savePaymentRequest("garbage", []byte("lnbcgarbage")); err != nil {
+		t.Fatal(err)
+	}
+	if err := addAccountPayment(&paymentInfo{Type: sentPayment, PaymentHash: "garbage"}, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	pruned, err := deletePaymentRequestsBefore(50, inFlight)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pruned != 1 {
+		t.Errorf("expected 1 pruned payment request, got %v", pruned)
+	}
+	for name, r := range requests {
+		payReq, err := fetchPaymentRequest(hashes[name])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if (payReq != nil) != r.kept {
+			t.Errorf("payment request %v: expected kept = %v", name, r.kept)
+		}
+	}
+	if payReq, _ := fetchPaymentRequest("garbage"); payReq == nil {
+		t.Error("expected the undecodable payment request to be kept")
+	}
+}
+
+func TestUpdateRefundPayment(t *testing.T) {
+	defer openTestDB(t)()
+	err := addAccountPayment(&paymentInfo{Type: refundPayment, Amount: 1000, PaymentHash: "refund", RedeemTxID: "tx1"}, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := updateRefundPayment("refund", "tx2", 900, 100); err != nil {
+		t.Fatal(err)
+	}
+	payments, err := fetchAllAccountPayments()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(payments) != 1 || payments[0].RedeemTxID != "tx2" || payments[0].Amount != 900 || payments[0].Fee != 100 {
+		t.Errorf("refund wasn't updated: %+v", payments)
+	}
+}
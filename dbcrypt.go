@@ -0,0 +1,252 @@
+package breez
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"sync"
+
+	bolt "go.etcd.io/bbolt"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	dbKeySize       = 32
+	dbKeyIterations = 100000
+)
+
+var (
+	// ErrDatabaseLocked is returned by Start when the database is encrypted
+	// and SetDatabaseSecret wasn't called.
+	ErrDatabaseLocked = errors.New("database is encrypted, the database secret is required")
+
+	// ErrDatabaseSecretMismatch is returned by Start when the database secret
+	// doesn't decrypt the database.
+	ErrDatabaseSecretMismatch = errors.New("database secret doesn't match the database")
+
+	dbEncryptionSaltKey   = []byte("encryptionSalt")
+	dbEncryptionCheckKey  = []byte("encryptionCheck")
+	dbEncryptedBucketsKey = []byte("encryptedBuckets")
+	dbEncryptionCheck     = []byte("breez")
+
+	dbSecretMu sync.Mutex
+	dbSecret   []byte
+
+	// dbKey is the key the values are encrypted with, nil when the database
+	// isn't encrypted.
+	dbKey []byte
+
+	// encryptedBuckets are the buckets whose values are encrypted, the ones
+	// holding payees, memos, payment requests, destinations, preimages or
+	// secrets.
+	encryptedBuckets = []string{
+		paymentsBucket,
+		incmoingPayReqBucket,
+		paymentsSnapshotBucket,
+		paymentsQuarantineBucket,
+		paymentIntentsBucket,
+		lnurlPayMemosBucket,
+		paymentRoutesBucket,
+		failedPaymentsBucket,
+		spendAuditBucket,
+		notificationsOutboxBucket,
+		idempotencyKeysBucket,
+		paymentSplitsBucket,
+		wrappedInvoicesBucket,
+		paymentQueueBucket,
+		donationContributionsBucket,
+		paymentCodesBucket,
+		fallbackAddressesBucket,
+		webhookDeliveriesBucket,
+		webhooksBucket,
+		invoiceRemindersBucket,
+		settlementAuditBucket,
+		moveFundsBucket,
+	}
+)
+
+/*
+SetDatabaseSecret sets the secret the database is encrypted with, derived by the app from the wallet seed
+or from a user passphrase. It must be called before Start. A plaintext database is encrypted when it is
+opened with a secret, after that it can only be opened with the same secret. The buckets holding
+payees, memos, payment requests or destinations are encrypted, the payments search then scans the
+decrypted payments instead of using the search index.
+*/
+func SetDatabaseSecret(secret []byte) {
+	dbSecretMu.Lock()
+	defer dbSecretMu.Unlock()
+	dbSecret = append([]byte{}, secret...)
+}
+
+// deriveDBKey derives the database key from the secret with PBKDF2-SHA256.
+func deriveDBKey(secret, salt []byte) []byte {
+	return pbkdf2.Key(secret, salt, dbKeyIterations, dbKeySize, sha256.New)
+}
+
+// initDBEncryption derives the database key when the database is encrypted,
+// and encrypts a plaintext database when a secret is set. The buckets added to
+// encryptedBuckets after the database was encrypted are encrypted too. It
+// returns true when values were encrypted, their plaintext is then left in the
+// free pages until the database is compacted.
+func initDBEncryption() (bool, error) {
+	dbSecretMu.Lock()
+	secret := dbSecret
+	dbSecretMu.Unlock()
+	dbKey = nil
+	var key []byte
+	var encrypted bool
+	err := db.Update(func(tx *bolt.Tx) error {
+		versionB, err := tx.CreateBucketIfNotExists([]byte(versionBucket))
+		if err != nil {
+			return err
+		}
+		check := versionB.Get(dbEncryptionCheckKey)
+		if check == nil && len(secret) == 0 {
+			return nil
+		}
+		if check == nil {
+			key, err = encryptDB(tx, secret)
+			encrypted = err == nil
+			return err
+		}
+		if len(secret) == 0 {
+			return ErrDatabaseLocked
+		}
+		key = deriveDBKey(secret, versionB.Get(dbEncryptionSaltKey))
+		if _, err := decryptWithKey(key, check); err != nil {
+			return ErrDatabaseSecretMismatch
+		}
+		encrypted, err = encryptNewBuckets(tx, key)
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+	dbKey = key
+	return encrypted, nil
+}
+
+// encryptDB encrypts the values of a plaintext database, empties the search
+// index, which holds the words of the payments, and returns the key.
+func encryptDB(tx *bolt.Tx, secret []byte) ([]byte, error) {
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	key := deriveDBKey(secret, salt)
+	for _, bucket := range encryptedBuckets {
+		if err := encryptBucketValues(tx.Bucket([]byte(bucket)), key); err != nil {
+			return nil, err
+		}
+	}
+	if err := saveEncryptedBuckets(tx); err != nil {
+		return nil, err
+	}
+	if tx.Bucket([]byte(paymentsSearchBucket)) != nil {
+		if err := tx.DeleteBucket([]byte(paymentsSearchBucket)); err != nil {
+			return nil, err
+		}
+		if _, err := tx.CreateBucket([]byte(paymentsSearchBucket)); err != nil {
+			return nil, err
+		}
+	}
+	check, err := encryptWithKey(key, dbEncryptionCheck)
+	if err != nil {
+		return nil, err
+	}
+	versionB := tx.Bucket([]byte(versionBucket))
+	if err := versionB.Put(dbEncryptionSaltKey, salt); err != nil {
+		return nil, err
+	}
+	if err := versionB.Put(dbEncryptionCheckKey, check); err != nil {
+		return nil, err
+	}
+	log.Infof("encryptDB - encrypted the database")
+	return key, nil
+}
+
+// encryptNewBuckets encrypts the buckets of encryptedBuckets the encrypted
+// database doesn't record as encrypted and returns true if it did.
+func encryptNewBuckets(tx *bolt.Tx, key []byte) (bool, error) {
+	bucketsBuf := tx.Bucket([]byte(versionBucket)).Get(dbEncryptedBucketsKey)
+	if bucketsBuf == nil {
+		return false, errors.New("the encrypted database doesn't record its encrypted buckets")
+	}
+	var encrypted []string
+	if err := json.Unmarshal(bucketsBuf, &encrypted); err != nil {
+		return false, err
+	}
+	done := make(map[string]bool)
+	for _, bucket := range encrypted {
+		done[bucket] = true
+	}
+	var updated bool
+	for _, bucket := range encryptedBuckets {
+		if done[bucket] {
+			continue
+		}
+		log.Infof("encryptNewBuckets - encrypting bucket %v", bucket)
+		if err := encryptBucketValues(tx.Bucket([]byte(bucket)), key); err != nil {
+			return false, err
+		}
+		updated = true
+	}
+	if !updated {
+		return false, nil
+	}
+	return true, saveEncryptedBuckets(tx)
+}
+
+func saveEncryptedBuckets(tx *bolt.Tx) error {
+	bucketsBuf, err := json.Marshal(encryptedBuckets)
+	if err != nil {
+		return err
+	}
+	return tx.Bucket([]byte(versionBucket)).Put(dbEncryptedBucketsKey, bucketsBuf)
+}
+
+func encryptBucketValues(b *bolt.Bucket, key []byte) error {
+	if b == nil {
+		return nil
+	}
+	var keys, values [][]byte
+	err := b.ForEach(func(k, v []byte) error {
+		if v == nil {
+			//nested bucket
+			return nil
+		}
+		encrypted, err := encryptWithKey(key, v)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, append([]byte{}, k...))
+		values = append(values, encrypted)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for i := range keys {
+		if err := b.Put(keys[i], values[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sealDBValue encrypts a value stored in an encrypted database.
+func sealDBValue(value []byte) ([]byte, error) {
+	if dbKey == nil {
+		return value, nil
+	}
+	return encryptWithKey(dbKey, value)
+}
+
+// openDBValue decrypts a value read from an encrypted database.
+func openDBValue(value []byte) ([]byte, error) {
+	if dbKey == nil || value == nil {
+		return value, nil
+	}
+	return decryptWithKey(dbKey, value)
+}
@@ -0,0 +1,214 @@
+package breez
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/breez/breez/data"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestEncryptedDB(t *testing.T) {
+	f, err := ioutil.TempFile("", "breez-db")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dbPath := f.Name()
+	f.Close()
+	defer os.Remove(dbPath)
+	defer SetDatabaseSecret(nil)
+
+	if err := openDB(dbPath); err != nil {
+		t.Fatal(err)
+	}
+	if err := addAccountPayment(&paymentInfo{Type: sentPayment, PaymentHash: "h1", Description: "Morning coffee"}, 0, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := saveLNURLPayMemo("h1", &data.InvoiceMemo{Description: "Morning coffee"}); err != nil {
+		t.Fatal(err)
+	}
+	closeDB()
+	if err := ioutil.WriteFile(dbPath+migrationBackupSuffix, []byte("Morning coffee"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	SetDatabaseSecret([]byte("seed secret"))
+	if err := openDB(dbPath); err != nil {
+		t.Fatal(err)
+	}
+	db.View(func(tx *bolt.Tx) error {
+		if v := tx.Bucket([]byte(paymentsBucket)).Get(itob(1)); v == nil || v[0] == '{' {
+			t.Errorf("payment wasn't encrypted: %q", v)
+		}
+		return nil
+	})
+	dbBytes, err := ioutil.ReadFile(dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(dbBytes, []byte("Morning coffee")) {
+		t.Error("the database file still holds the plaintext memo")
+	}
+	if _, err := os.Stat(dbPath + migrationBackupSuffix); !os.IsNotExist(err) {
+		t.Errorf("the plaintext copy of the database wasn't deleted: %v", err)
+	}
+	memo, err := fetchLNURLPayMemo("h1")
+	if err != nil || memo.Description != "Morning coffee" {
+		t.Errorf("unexpected memo %v: %v", memo, err)
+	}
+	payments, err := SearchPayments("coff")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(payments.PaymentsList) != 1 || payments.PaymentsList[0].PaymentHash != "h1" {
+		t.Errorf("unexpected search results %v", payments.PaymentsList)
+	}
+	closeDB()
+
+	SetDatabaseSecret([]byte("another secret"))
+	if err := openDB(dbPath); err != ErrDatabaseSecretMismatch {
+		t.Errorf("expected ErrDatabaseSecretMismatch got %v", err)
+	}
+	SetDatabaseSecret(nil)
+	if err := openDB(dbPath); err != ErrDatabaseLocked {
+		t.Errorf("expected ErrDatabaseLocked got %v", err)
+	}
+}
+
+func TestEncryptedDBBuckets(t *testing.T) {
+	f, err := ioutil.TempFile("", "breez-db")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dbPath := f.Name()
+	f.Close()
+	defer os.Remove(dbPath)
+	defer SetDatabaseSecret(nil)
+
+	SetDatabaseSecret([]byte("seed secret"))
+	if err := openDB(dbPath); err != nil {
+		t.Fatal(err)
+	}
+	defer closeDB()
+
+	const secret = "Morning coffee"
+	saves := []func() error{
+		func() error {
+			return addAccountPayment(&paymentInfo{Type: sentPayment, PaymentHash: "h1", Description: secret}, 0, 1)
+		},
+		func() error { return savePaymentRequest("h1", []byte(secret)) },
+		func() error { return saveLNURLPayMemo("h1", &data.InvoiceMemo{Description: secret}) },
+		func() error {
+			return saveWrappedInvoice(&wrappedInvoiceInfo{PaymentHash: "h2", Memo: secret, Preimage: []byte(secret)})
+		},
+		func() error { return saveQueuedPayment(&queuedPayment{PaymentHash: "h3", PaymentRequest: secret}) },
+		func() error {
+			return saveDonationContribution(&donationContribution{PaymentHash: "h4", DonorName: secret, Message: secret})
+		},
+		func() error { return savePaymentCode(&paymentCode{ID: "c1", Description: secret}) },
+		func() error { return saveFallbackAddress(&fallbackAddress{PaymentHash: "h5", Description: secret}) },
+		func() error { return addWebhook(&data.Webhook{Url: "https://example.com", Secret: secret}) },
+		func() error { return addWebhookDelivery(&webhookDelivery{PaymentHash: "h6", Body: []byte(secret)}) },
+		func() error { return saveInvoiceReminder(&invoiceReminder{PaymentHash: "h7", Locale: secret}) },
+		func() error {
+			return addSettlementAuditEntry(&data.SettlementAuditEntry{PaymentHash: "h8", Result: secret})
+		},
+		func() error { return addMoveFundsOperation(&data.MoveFundsOperation{Address: secret}) },
+	}
+	for i, save := range saves {
+		if err := save(); err != nil {
+			t.Fatalf("save %v failed: %v", i, err)
+		}
+	}
+
+	var readBucket func(name string, b *bolt.Bucket)
+	readBucket = func(name string, b *bolt.Bucket) {
+		b.ForEach(func(k, v []byte) error {
+			if v == nil {
+				readBucket(name+"/"+string(k), b.Bucket(k))
+				return nil
+			}
+			if bytes.Contains(v, []byte(secret)) {
+				t.Errorf("bucket %v holds the plaintext of key %q", name, k)
+			}
+			return nil
+		})
+	}
+	db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			readBucket(string(name), b)
+			return nil
+		})
+	})
+
+	wrapped, err := fetchWrappedInvoices()
+	if err != nil || len(wrapped) != 1 || wrapped[0].Memo != secret {
+		t.Errorf("unexpected wrapped invoices %v: %v", wrapped, err)
+	}
+	webhooks, err := fetchWebhooks()
+	if err != nil || len(webhooks) != 1 || webhooks[0].Secret != secret {
+		t.Errorf("unexpected webhooks %v: %v", webhooks, err)
+	}
+}
+
+func TestEncryptNewBuckets(t *testing.T) {
+	f, err := ioutil.TempFile("", "breez-db")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dbPath := f.Name()
+	f.Close()
+	defer os.Remove(dbPath)
+	defer SetDatabaseSecret(nil)
+
+	SetDatabaseSecret([]byte("seed secret"))
+	if err := openDB(dbPath); err != nil {
+		t.Fatal(err)
+	}
+	//a database encrypted before the wrapped invoices were encrypted
+	var previousBuckets []string
+	for _, bucket := range encryptedBuckets {
+		if bucket != wrappedInvoicesBucket {
+			previousBuckets = append(previousBuckets, bucket)
+		}
+	}
+	bucketsBuf, err := json.Marshal(previousBuckets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket([]byte(versionBucket)).Put(dbEncryptedBucketsKey, bucketsBuf); err != nil {
+			return err
+		}
+		return tx.Bucket([]byte(wrappedInvoicesBucket)).Put([]byte("h1"), []byte(`{"PaymentHash":"h1","Memo":"Morning coffee"}`))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	closeDB()
+
+	if err := openDB(dbPath); err != nil {
+		t.Fatal(err)
+	}
+	defer closeDB()
+	db.View(func(tx *bolt.Tx) error {
+		if v := tx.Bucket([]byte(wrappedInvoicesBucket)).Get([]byte("h1")); v == nil || v[0] == '{' {
+			t.Errorf("wrapped invoice wasn't encrypted: %q", v)
+		}
+		return nil
+	})
+	dbBytes, err := ioutil.ReadFile(dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(dbBytes, []byte("Morning coffee")) {
+		t.Error("the database file still holds the plaintext memo")
+	}
+	wrapped, err := fetchWrappedInvoices()
+	if err != nil || len(wrapped) != 1 || wrapped[0].Memo != "Morning coffee" {
+		t.Errorf("unexpected wrapped invoices %v: %v", wrapped, err)
+	}
+}
@@ -0,0 +1,86 @@
+package breez
+
+import (
+	"encoding/hex"
+	"errors"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
+	"github.com/breez/lightninglib/zpay32"
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+// networkParams returns the chain parameters of the configured network.
+func networkParams() (*chaincfg.Params, error) {
+	if cfg == nil {
+		return nil, errors.New("breez is not initialized")
+	}
+	switch cfg.Network {
+	case "testnet":
+		return &chaincfg.TestNet3Params, nil
+	case "simnet":
+		return &chaincfg.SimNetParams, nil
+	case "mainnet":
+		return &chaincfg.MainNetParams, nil
+	}
+	return nil, errors.New("unknown network type " + cfg.Network)
+}
+
+// decodePayReqLocally decodes a BOLT11 payment request locally, without a round-trip
+// to the daemon, so it can be used before the daemon is ready.
+// The result is the same as the daemon DecodePayReq.
+func decodePayReqLocally(paymentRequest string) (*lnrpc.PayReq, error) {
+	network, err := networkParams()
+	if err != nil {
+		return nil, err
+	}
+	payReq, err := zpay32.Decode(paymentRequest, network)
+	if err != nil {
+		return nil, newError(data.ErrorCode_INVALID_PAYMENT_REQUEST, err)
+	}
+
+	desc := ""
+	if payReq.Description != nil {
+		desc = *payReq.Description
+	}
+	descHash := []byte("")
+	if payReq.DescriptionHash != nil {
+		descHash = payReq.DescriptionHash[:]
+	}
+	fallbackAddr := ""
+	if payReq.FallbackAddr != nil {
+		fallbackAddr = payReq.FallbackAddr.String()
+	}
+	amt := int64(0)
+	if payReq.MilliSat != nil {
+		amt = int64(payReq.MilliSat.ToSatoshis())
+	}
+
+	var routeHints []*lnrpc.RouteHint
+	for _, route := range payReq.RouteHints {
+		hopHints := make([]*lnrpc.HopHint, 0, len(route))
+		for _, hop := range route {
+			hopHints = append(hopHints, &lnrpc.HopHint{
+				NodeId:                    hex.EncodeToString(hop.NodeID.SerializeCompressed()),
+				ChanId:                    hop.ChannelID,
+				FeeBaseMsat:               hop.FeeBaseMSat,
+				FeeProportionalMillionths: hop.FeeProportionalMillionths,
+				CltvExpiryDelta:           uint32(hop.CLTVExpiryDelta),
+			})
+		}
+		routeHints = append(routeHints, &lnrpc.RouteHint{HopHints: hopHints})
+	}
+
+	return &lnrpc.PayReq{
+		Destination:     hex.EncodeToString(payReq.Destination.SerializeCompressed()),
+		PaymentHash:     hex.EncodeToString(payReq.PaymentHash[:]),
+		NumSatoshis:     amt,
+		Timestamp:       payReq.Timestamp.Unix(),
+		Description:     desc,
+		DescriptionHash: hex.EncodeToString(descHash),
+		FallbackAddr:    fallbackAddr,
+		Expiry:          int64(payReq.Expiry().Seconds()),
+		CltvExpiry:      int64(payReq.MinFinalCLTVExpiry()),
+		RouteHints:      routeHints,
+	}, nil
+}
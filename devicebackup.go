@@ -0,0 +1,305 @@
+package breez
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/breez/doubleratchet"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	deviceBackupDir       = "devicebackup"
+	deviceBackupChunkSize = 512 * 1024
+	deviceBackupKeySize   = 32
+	deviceBackupMaxAge    = 7 * 24 * time.Hour
+)
+
+var deviceBackupMu sync.Mutex
+
+// deviceBackupState tracks the backup being sent to the backup device and when
+// the device last acknowledged a complete one.
+type deviceBackupState struct {
+	SessionID             string
+	BackupID              string
+	Manifest              string
+	ChunksCount           int32
+	Acked                 []bool
+	Timestamp             int64
+	LastAckTimestamp      int64
+	LastCompleteTimestamp int64
+}
+
+func serializeDeviceBackupState(s *deviceBackupState) ([]byte, error) {
+	return json.Marshal(s)
+}
+
+func deserializeDeviceBackupState(stateBytes []byte) (*deviceBackupState, error) {
+	var s deviceBackupState
+	err := json.Unmarshal(stateBytes, &s)
+	return &s, err
+}
+
+func (s *deviceBackupState) ackedChunks() int32 {
+	var acked int32
+	for _, a := range s.Acked {
+		if a {
+			acked++
+		}
+	}
+	return acked
+}
+
+func deviceBackupChunkPath(backupID string, index int32) string {
+	return filepath.Join(appWorkingDir, deviceBackupDir, backupID, fmt.Sprintf("%v", index))
+}
+
+/*
+SetBackupDevice makes the paired device of the session, which must have the backup permission, the target
+of the backups for users who don't want them in any cloud storage. Every backup is then encrypted with a new
+key, split in chunks and a DEVICE_BACKUP_READY notification is sent for the app to relay GetDeviceBackup over
+the pairing relay. The key is sent in a manifest encrypted for the session. The device acknowledges every chunk
+it stored. An empty session id stops the device backups.
+*/
+func SetBackupDevice(sessionID string) error {
+	deviceBackupMu.Lock()
+	defer deviceBackupMu.Unlock()
+	if sessionID == "" {
+		os.RemoveAll(filepath.Join(appWorkingDir, deviceBackupDir))
+		return saveDeviceBackupState(nil)
+	}
+	session, err := fetchPairingSession(sessionID)
+	if err != nil {
+		return err
+	}
+	if session == nil {
+		return fmt.Errorf("pairing session %v not found", sessionID)
+	}
+	if session.Revoked || session.Permissions == nil || !session.Permissions.Backup {
+		return errors.New("pairing session can't receive backups")
+	}
+	return saveDeviceBackupState(&deviceBackupState{SessionID: sessionID})
+}
+
+// prepareDeviceBackup packs and encrypts the backup files for the backup
+// device, replacing the chunks of a backup the device didn't receive yet.
+func prepareDeviceBackup(files []string) {
+	deviceBackupMu.Lock()
+	defer deviceBackupMu.Unlock()
+	state, err := fetchDeviceBackupState()
+	if err != nil || state == nil {
+		return
+	}
+	session, err := fetchPairingSession(state.SessionID)
+	if err != nil || session == nil || session.Revoked {
+		backupLog.Errorf("prepareDeviceBackup - backup device session %v is not usable: %v", state.SessionID, err)
+		return
+	}
+	archive, err := tarFiles(files)
+	if err != nil {
+		backupLog.Errorf("prepareDeviceBackup - failed to pack the backup: %v", err)
+		return
+	}
+	key := make([]byte, deviceBackupKeySize)
+	id := make([]byte, 16)
+	if _, err := rand.Read(key); err != nil {
+		return
+	}
+	if _, err := rand.Read(id); err != nil {
+		return
+	}
+	encrypted, err := encryptWithKey(key, archive)
+	if err != nil {
+		backupLog.Errorf("prepareDeviceBackup - failed to encrypt the backup: %v", err)
+		return
+	}
+
+	backupID := hex.EncodeToString(id)
+	os.RemoveAll(filepath.Join(appWorkingDir, deviceBackupDir))
+	if err := os.MkdirAll(filepath.Join(appWorkingDir, deviceBackupDir, backupID), 0700); err != nil {
+		backupLog.Errorf("prepareDeviceBackup - failed to create the chunks directory: %v", err)
+		return
+	}
+	var count int32
+	for start := 0; start < len(encrypted); start += deviceBackupChunkSize {
+		end := start + deviceBackupChunkSize
+		if end > len(encrypted) {
+			end = len(encrypted)
+		}
+		if err := ioutil.WriteFile(deviceBackupChunkPath(backupID, count), encrypted[start:end], 0600); err != nil {
+			backupLog.Errorf("prepareDeviceBackup - failed to write chunk %v: %v", count, err)
+			return
+		}
+		count++
+	}
+
+	timestamp := trustedNow().Unix()
+	manifest, err := proto.Marshal(&data.DeviceBackupManifest{
+		BackupID:    backupID,
+		Key:         key,
+		ChunksCount: count,
+		Timestamp:   timestamp,
+	})
+	if err != nil {
+		return
+	}
+	encryptedManifest, err := doubleratchet.RatchetEncrypt(state.SessionID, base64.StdEncoding.EncodeToString(manifest))
+	if err != nil {
+		backupLog.Errorf("prepareDeviceBackup - failed to encrypt the manifest: %v", err)
+		return
+	}
+	state.BackupID = backupID
+	state.Manifest = encryptedManifest
+	state.ChunksCount = count
+	state.Acked = make([]bool, count)
+	state.Timestamp = timestamp
+	if err := saveDeviceBackupState(state); err != nil {
+		backupLog.Errorf("prepareDeviceBackup - failed to save the backup state: %v", err)
+		return
+	}
+	notify(data.NotificationEvent{Type: data.NotificationEvent_DEVICE_BACKUP_READY, Data: []string{state.SessionID, backupID}})
+}
+
+func tarFiles(files []string) ([]byte, error) {
+	var buf bytes.Buffer
+	w := tar.NewWriter(&buf)
+	for _, f := range files {
+		content, err := ioutil.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+		header := &tar.Header{Name: filepath.Base(f), Mode: 0600, Size: int64(len(content))}
+		if err := w.WriteHeader(header); err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(content); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+/*
+GetDeviceBackup returns the manifest and the chunks of the current backup which the backup device didn't
+acknowledge yet, for the app to relay to it.
+*/
+func GetDeviceBackup() (*data.DeviceBackup, error) {
+	deviceBackupMu.Lock()
+	defer deviceBackupMu.Unlock()
+	state, err := fetchDeviceBackupState()
+	if err != nil {
+		return nil, err
+	}
+	if state == nil || state.BackupID == "" {
+		return nil, errors.New("no device backup to send")
+	}
+	backup := &data.DeviceBackup{SessionID: state.SessionID, Manifest: state.Manifest}
+	for i, acked := range state.Acked {
+		if acked {
+			continue
+		}
+		chunk, err := ioutil.ReadFile(deviceBackupChunkPath(state.BackupID, int32(i)))
+		if err != nil {
+			return nil, err
+		}
+		backup.Chunks = append(backup.Chunks, &data.DeviceBackupChunk{BackupID: state.BackupID, Index: int32(i), Data: chunk})
+	}
+	return backup, nil
+}
+
+/*
+GetDeviceBackupStatus returns the progress of the backup sent to the backup device and when it last
+had a complete backup. The status is stale when that is more than a week ago.
+*/
+func GetDeviceBackupStatus() (*data.DeviceBackupStatus, error) {
+	state, err := fetchDeviceBackupState()
+	if err != nil || state == nil {
+		return &data.DeviceBackupStatus{}, err
+	}
+	return &data.DeviceBackupStatus{
+		SessionID:             state.SessionID,
+		BackupID:              state.BackupID,
+		ChunksCount:           state.ChunksCount,
+		AckedChunks:           state.ackedChunks(),
+		BackupTimestamp:       state.Timestamp,
+		LastAckTimestamp:      state.LastAckTimestamp,
+		LastCompleteTimestamp: state.LastCompleteTimestamp,
+		Stale:                 state.LastCompleteTimestamp < trustedNow().Add(-deviceBackupMaxAge).Unix(),
+	}, nil
+}
+
+// onDeviceBackupAck records a chunk stored by the backup device, the chunks
+// are deleted once the device has all of them.
+func onDeviceBackupAck(sessionID, backupID string, index int32) error {
+	deviceBackupMu.Lock()
+	defer deviceBackupMu.Unlock()
+	state, err := fetchDeviceBackupState()
+	if err != nil {
+		return err
+	}
+	if state == nil || state.SessionID != sessionID {
+		return errors.New("not the backup device")
+	}
+	if state.BackupID != backupID {
+		//an acknowledgment of a replaced backup
+		return nil
+	}
+	if index < 0 || index >= state.ChunksCount {
+		return fmt.Errorf("invalid chunk index %v", index)
+	}
+	state.Acked[index] = true
+	state.LastAckTimestamp = trustedNow().Unix()
+	if state.ackedChunks() == state.ChunksCount {
+		backupLog.Infof("onDeviceBackupAck - backup %v is complete on the device", backupID)
+		state.LastCompleteTimestamp = state.Timestamp
+		os.RemoveAll(filepath.Join(appWorkingDir, deviceBackupDir, backupID))
+	}
+	return saveDeviceBackupState(state)
+}
+
+/*
+ReadDeviceBackup decrypts the chunks of a device backup, in order, with the key of its manifest and writes
+the backup files to destDir. It returns their paths to be passed to the bootstrap when restoring.
+*/
+func ReadDeviceBackup(key []byte, chunks [][]byte, destDir string) ([]string, error) {
+	archive, err := decryptWithKey(key, bytes.Join(chunks, nil))
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	r := tar.NewReader(bytes.NewReader(archive))
+	for {
+		header, err := r.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		f := filepath.Join(destDir, filepath.Base(header.Name))
+		content, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		if err := ioutil.WriteFile(f, content, 0600); err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+}
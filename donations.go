@@ -0,0 +1,251 @@
+package breez
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/breez/breez/data"
+)
+
+type donationCampaign struct {
+	ID                string
+	Title             string
+	Description       string
+	ImageURL          string
+	Goal              int64
+	CreationTimestamp int64
+}
+
+// donationContribution is a donation invoice, it counts towards the campaign
+// goal once it is settled.
+type donationContribution struct {
+	CampaignID      string
+	PaymentHash     string
+	Amount          int64
+	DonorName       string
+	Message         string
+	Settled         bool
+	SettleTimestamp int64
+}
+
+func serializeDonationCampaign(c *donationCampaign) ([]byte, error) {
+	return json.Marshal(c)
+}
+
+func deserializeDonationCampaign(campaignBytes []byte) (*donationCampaign, error) {
+	var c donationCampaign
+	err := json.Unmarshal(campaignBytes, &c)
+	return &c, err
+}
+
+func serializeDonationContribution(c *donationContribution) ([]byte, error) {
+	contributionBytes, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	return sealDBValue(contributionBytes)
+}
+
+func deserializeDonationContribution(contributionBytes []byte) (*donationContribution, error) {
+	var c donationContribution
+	contributionBytes, err := openDBValue(contributionBytes)
+	if err != nil {
+		return &c, err
+	}
+	err = json.Unmarshal(contributionBytes, &c)
+	return &c, err
+}
+
+/*
+CreateDonationCampaign creates a campaign collecting donations towards the given goal in satoshi.
+*/
+func CreateDonationCampaign(campaign *data.DonationCampaign) (*data.DonationCampaign, error) {
+	if campaign.Title == "" {
+		return nil, errors.New("campaign title is required")
+	}
+	if campaign.Goal <= 0 {
+		return nil, errors.New("campaign goal must be positive")
+	}
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	c := &donationCampaign{
+		ID:                hex.EncodeToString(id),
+		Title:             campaign.Title,
+		Description:       campaign.Description,
+		ImageURL:          campaign.ImageURL,
+		Goal:              campaign.Goal,
+		CreationTimestamp: trustedNow().Unix(),
+	}
+	if err := saveDonationCampaign(c); err != nil {
+		return nil, err
+	}
+	return donationCampaignToProto(c, nil), nil
+}
+
+/*
+AddDonationInvoice creates an invoice for a donation to a campaign. The donor name and message are kept
+with the contribution, the daemon doesn't support custom records so they are given when the invoice is
+requested instead of being sent with the payment.
+*/
+func AddDonationInvoice(request *data.DonationInvoiceRequest) (paymentRequest string, err error) {
+	c, err := fetchDonationCampaign(request.CampaignId)
+	if err != nil {
+		return "", err
+	}
+	if c == nil {
+		return "", fmt.Errorf("campaign %v not found", request.CampaignId)
+	}
+	if request.Amount <= 0 {
+		return "", errors.New("donation amount must be positive")
+	}
+	paymentRequest, err = AddInvoice(&data.InvoiceMemo{
+		Description:   c.Title,
+		Amount:        request.Amount,
+		PayeeImageURL: c.ImageURL,
+		PayerName:     request.DonorName,
+		PayerComment:  request.Message,
+		Expiry:        request.Expiry,
+	})
+	if err != nil {
+		return "", err
+	}
+	decodedReq, err := decodePayReqLocally(paymentRequest)
+	if err != nil {
+		return "", err
+	}
+	err = saveDonationContribution(&donationContribution{
+		CampaignID:  c.ID,
+		PaymentHash: decodedReq.PaymentHash,
+		Amount:      request.Amount,
+		DonorName:   request.DonorName,
+		Message:     request.Message,
+	})
+	if err != nil {
+		return "", err
+	}
+	return paymentRequest, nil
+}
+
+/*
+GetDonationCampaigns returns the campaigns with their progress, newest first.
+*/
+func GetDonationCampaigns() (*data.DonationCampaigns, error) {
+	campaigns, err := fetchDonationCampaigns()
+	if err != nil {
+		return nil, err
+	}
+	contributions, err := fetchDonationContributions()
+	if err != nil {
+		return nil, err
+	}
+	byCampaign := make(map[string][]*donationContribution)
+	for _, c := range contributions {
+		byCampaign[c.CampaignID] = append(byCampaign[c.CampaignID], c)
+	}
+	sort.Slice(campaigns, func(i, j int) bool {
+		return campaigns[i].CreationTimestamp > campaigns[j].CreationTimestamp
+	})
+	result := &data.DonationCampaigns{}
+	for _, c := range campaigns {
+		result.Campaigns = append(result.Campaigns, donationCampaignToProto(c, byCampaign[c.ID]))
+	}
+	return result, nil
+}
+
+/*
+GetDonationContributions returns the settled donations of a campaign, newest first.
+*/
+func GetDonationContributions(campaignID string) (*data.DonationContributions, error) {
+	contributions, err := fetchDonationContributions()
+	if err != nil {
+		return nil, err
+	}
+	var settled []*donationContribution
+	for _, c := range contributions {
+		if c.CampaignID == campaignID && c.Settled {
+			settled = append(settled, c)
+		}
+	}
+	sort.Slice(settled, func(i, j int) bool {
+		return settled[i].SettleTimestamp > settled[j].SettleTimestamp
+	})
+	result := &data.DonationContributions{}
+	for _, c := range settled {
+		result.Contributions = append(result.Contributions, &data.DonationContribution{
+			PaymentHash:     c.PaymentHash,
+			Amount:          c.Amount,
+			DonorName:       c.DonorName,
+			Message:         c.Message,
+			SettleTimestamp: c.SettleTimestamp,
+		})
+	}
+	return result, nil
+}
+
+/*
+DeleteDonationCampaign deletes a campaign and its contributions, the received payments are kept.
+*/
+func DeleteDonationCampaign(campaignID string) error {
+	return deleteDonationCampaign(campaignID)
+}
+
+func donationCampaignToProto(c *donationCampaign, contributions []*donationContribution) *data.DonationCampaign {
+	campaign := &data.DonationCampaign{
+		Id:                c.ID,
+		Title:             c.Title,
+		Description:       c.Description,
+		ImageURL:          c.ImageURL,
+		Goal:              c.Goal,
+		CreationTimestamp: c.CreationTimestamp,
+	}
+	for _, contribution := range contributions {
+		if contribution.Settled {
+			campaign.Received += contribution.Amount
+			campaign.ContributionsCount++
+		}
+	}
+	return campaign
+}
+
+// onDonationSettled adds a settled donation invoice to its campaign progress.
+// The notification data is the campaign id, the payment hash, the amount and
+// whether this donation reached the goal.
+func onDonationSettled(paymentHash string, amount, settleTimestamp int64) {
+	contribution, err := fetchDonationContribution(paymentHash)
+	if err != nil || contribution == nil || contribution.Settled {
+		return
+	}
+	campaign, err := fetchDonationCampaign(contribution.CampaignID)
+	if err != nil || campaign == nil {
+		return
+	}
+	contributions, err := fetchDonationContributions()
+	if err != nil {
+		log.Errorf("onDonationSettled - failed to fetch contributions: %v", err)
+		return
+	}
+	var received int64
+	for _, c := range contributions {
+		if c.CampaignID == campaign.ID && c.Settled {
+			received += c.Amount
+		}
+	}
+	contribution.Amount = amount
+	contribution.Settled = true
+	contribution.SettleTimestamp = settleTimestamp
+	if err := saveDonationContribution(contribution); err != nil {
+		log.Errorf("onDonationSettled - failed to save contribution %v: %v", paymentHash, err)
+		return
+	}
+	goalReached := received < campaign.Goal && received+amount >= campaign.Goal
+	notify(data.NotificationEvent{
+		Type: data.NotificationEvent_DONATION_RECEIVED,
+		Data: []string{campaign.ID, paymentHash, fmt.Sprintf("%v", amount), fmt.Sprintf("%v", goalReached)},
+	})
+}
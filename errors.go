@@ -0,0 +1,108 @@
+package breez
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/breez/breez/data"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrDaemonNotReady is returned by the calls that need the lightning daemon before it is ready.
+var ErrDaemonNotReady = errors.New("lightning daemon is not ready")
+
+/*
+Error is an error of the breez API carrying a machine readable code, its message is the one of the
+underlying error.
+*/
+type Error struct {
+	Code data.ErrorCode
+	err  error
+}
+
+func (e *Error) Error() string {
+	return e.err.Error()
+}
+
+/*
+Cause returns the underlying error.
+*/
+func (e *Error) Cause() error {
+	return e.err
+}
+
+func newError(code data.ErrorCode, err error) error {
+	return &Error{Code: code, err: err}
+}
+
+// errorCodes are the codes of the package errors.
+var errorCodes = map[error]data.ErrorCode{
+	ErrDaemonNotReady:        data.ErrorCode_DAEMON_NOT_READY,
+	ErrAlreadyPaid:           data.ErrorCode_ALREADY_PAID,
+	ErrPaymentInFlight:       data.ErrorCode_PAYMENT_IN_FLIGHT,
+	ErrPaymentLimitExceeded:  data.ErrorCode_SPENDING_LIMIT_EXCEEDED,
+	ErrDailyBudgetExceeded:   data.ErrorCode_DAILY_BUDGET_EXCEEDED,
+	ErrPaymentNotAuthorized:  data.ErrorCode_PAYMENT_NOT_AUTHORIZED,
+	ErrNoRouteWithinFeeLimit: data.ErrorCode_FEE_LIMIT_EXCEEDED,
+	ErrPinValidation:         data.ErrorCode_CERTIFICATE_PIN_MISMATCH,
+	ErrCredentialsMismatch:   data.ErrorCode_CREDENTIALS_MISMATCH,
+	ErrDatabaseCorrupted:     data.ErrorCode_DATABASE_CORRUPTED,
+}
+
+// paymentReasonCodes are the codes of the payment failures classified from the
+// daemon errors.
+var paymentReasonCodes = map[data.FailedPayment_Reason]data.ErrorCode{
+	data.FailedPayment_NO_ROUTE:                  data.ErrorCode_NO_ROUTE,
+	data.FailedPayment_INSUFFICIENT_BALANCE:      data.ErrorCode_INSUFFICIENT_BALANCE,
+	data.FailedPayment_INVOICE_EXPIRED:           data.ErrorCode_INVOICE_EXPIRED,
+	data.FailedPayment_TIMEOUT:                   data.ErrorCode_TIMEOUT,
+	data.FailedPayment_FEE_LIMIT_EXCEEDED:        data.ErrorCode_FEE_LIMIT_EXCEEDED,
+	data.FailedPayment_INCORRECT_PAYMENT_DETAILS: data.ErrorCode_INCORRECT_PAYMENT_DETAILS,
+	data.FailedPayment_RECIPIENT_OFFLINE:         data.ErrorCode_RECIPIENT_OFFLINE,
+	data.FailedPayment_ROUTE_UNAVAILABLE:         data.ErrorCode_ROUTE_UNAVAILABLE,
+	data.FailedPayment_ROUTE_POLICY_CHANGED:      data.ErrorCode_ROUTE_POLICY_CHANGED,
+	data.FailedPayment_NODE_FAILURE:              data.ErrorCode_NODE_FAILURE,
+}
+
+/*
+ErrorCodeOf returns the code of an error returned by the breez API: the code of an Error, of the package
+errors, of the daemon being unreachable or of the payment failure classified from a daemon error.
+It returns UNKNOWN_ERROR for the other errors.
+*/
+func ErrorCodeOf(err error) data.ErrorCode {
+	if err == nil {
+		return data.ErrorCode_UNKNOWN_ERROR
+	}
+	if e, ok := err.(*Error); ok {
+		return e.Code
+	}
+	if code, ok := errorCodes[err]; ok {
+		return code
+	}
+	if s, ok := status.FromError(err); ok {
+		switch s.Code() {
+		case codes.Unavailable:
+			return data.ErrorCode_DAEMON_NOT_READY
+		case codes.DeadlineExceeded:
+			return data.ErrorCode_TIMEOUT
+		}
+	}
+	if code, ok := paymentReasonCodes[classifyPaymentFailure(err).reason]; ok {
+		return code
+	}
+	return data.ErrorCode_UNKNOWN_ERROR
+}
+
+/*
+PrefixErrorCode returns err with its message prefixed by its code and a colon, e.g.
+"NO_ROUTE: unable to find a path to destination", for the bindings which only pass the error message
+to the apps. Errors without a known code are returned as is.
+*/
+func PrefixErrorCode(err error) error {
+	code := ErrorCodeOf(err)
+	if code == data.ErrorCode_UNKNOWN_ERROR {
+		return err
+	}
+	return fmt.Errorf("%v: %v", code, err)
+}
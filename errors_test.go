@@ -0,0 +1,45 @@
+package breez
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/breez/breez/data"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrorCodeOf(t *testing.T) {
+	tests := []struct {
+		err  error
+		code data.ErrorCode
+	}{
+		{ErrDaemonNotReady, data.ErrorCode_DAEMON_NOT_READY},
+		{ErrDailyBudgetExceeded, data.ErrorCode_DAILY_BUDGET_EXCEEDED},
+		{newError(data.ErrorCode_INVALID_PAYMENT_REQUEST, errors.New("invalid bech32 string")), data.ErrorCode_INVALID_PAYMENT_REQUEST},
+		{status.Error(codes.Unavailable, "transport is closing"), data.ErrorCode_DAEMON_NOT_READY},
+		{errors.New("unable to find a path to destination"), data.ErrorCode_NO_ROUTE},
+		{errors.New("invoice expired"), data.ErrorCode_INVOICE_EXPIRED},
+		{&PaymentError{Reason: data.FailedPayment_INSUFFICIENT_BALANCE, err: errors.New("insufficient")}, data.ErrorCode_INSUFFICIENT_BALANCE},
+		{errors.New("something else"), data.ErrorCode_UNKNOWN_ERROR},
+	}
+	for _, test := range tests {
+		if code := ErrorCodeOf(test.err); code != test.code {
+			t.Errorf("%q: expected %v got %v", test.err, test.code, code)
+		}
+	}
+}
+
+func TestPrefixErrorCode(t *testing.T) {
+	if err := PrefixErrorCode(errors.New("unable to find a path to destination")); err.Error() != "NO_ROUTE: unable to find a path to destination" {
+		t.Errorf("unexpected message %q", err)
+	}
+	unknown := fmt.Errorf("something else")
+	if err := PrefixErrorCode(unknown); err != unknown {
+		t.Errorf("unknown error was changed to %q", err)
+	}
+	if PrefixErrorCode(nil) != nil {
+		t.Error("nil error was changed")
+	}
+}
@@ -0,0 +1,81 @@
+package breez
+
+import (
+	"context"
+	"errors"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
+)
+
+const (
+	//estimateRoutes is the number of alternative routes considered when estimating a payment
+	estimateRoutes = 5
+)
+
+// routeSuccessProbability estimates the chance the route can carry the amount.
+// Our own channel balance is known, the remote channels are assumed to have
+// their balance uniformly distributed so a hop succeeds with 1 - amount/capacity.
+func routeSuccessProbability(route *lnrpc.Route, localMax int64) float64 {
+	if len(route.Hops) == 0 || route.TotalAmt > localMax {
+		return 0
+	}
+	probability := 1.0
+	for _, hop := range route.Hops[1:] {
+		if hop.ChanCapacity <= 0 {
+			continue
+		}
+		forwarded := hop.AmtToForward + hop.Fee
+		if forwarded >= hop.ChanCapacity {
+			return 0
+		}
+		probability *= 1 - float64(forwarded)/float64(hop.ChanCapacity)
+	}
+	return probability
+}
+
+/*
+EstimatePaymentFee queries the routes to the payment request destination without sending anything and
+returns the fee of the cheapest route and the probability the payment succeeds when the alternative
+routes are tried in turn. amount is only used for payment requests without an amount.
+*/
+func EstimatePaymentFee(paymentRequest string, amount int64) (*data.PaymentFeeEstimate, error) {
+	decodedReq, err := decodePayReqLocally(paymentRequest)
+	if err != nil {
+		return nil, err
+	}
+	if decodedReq.NumSatoshis > 0 {
+		amount = decodedReq.NumSatoshis
+	}
+	if amount <= 0 {
+		return nil, errors.New("amount is required for a payment request without an amount")
+	}
+	localMax, err := maxChannelPayment()
+	if err != nil {
+		return nil, err
+	}
+	estimate := &data.PaymentFeeEstimate{Amount: amount}
+	routes, err := lightningClient.QueryRoutes(context.Background(), &lnrpc.QueryRoutesRequest{
+		PubKey:    decodedReq.Destination,
+		Amt:       amount,
+		NumRoutes: estimateRoutes,
+	})
+	if err != nil {
+		if paymentFailureReason(err) == data.FailedPayment_NO_ROUTE {
+			return estimate, nil
+		}
+		return nil, err
+	}
+
+	failure := 1.0
+	for i, route := range routes.Routes {
+		if i == 0 || route.TotalFeesMsat < estimate.FeeMsat {
+			estimate.Fee = route.TotalFees
+			estimate.FeeMsat = route.TotalFeesMsat
+		}
+		failure *= 1 - routeSuccessProbability(route, localMax)
+	}
+	estimate.Routes = int32(len(routes.Routes))
+	estimate.SuccessProbability = 1 - failure
+	return estimate, nil
+}
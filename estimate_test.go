@@ -0,0 +1,24 @@
+package breez
+
+import (
+	"math"
+	"testing"
+
+	"github.com/breez/lightninglib/lnrpc"
+)
+
+func TestRouteSuccessProbability(t *testing.T) {
+	route := &lnrpc.Route{
+		TotalAmt: 1010,
+		Hops: []*lnrpc.Hop{
+			{ChanCapacity: 100000, AmtToForward: 1000, Fee: 10},
+			{ChanCapacity: 10000, AmtToForward: 1000},
+		},
+	}
+	if p := routeSuccessProbability(route, 5000); math.Abs(p-0.9) > 1e-9 {
+		t.Errorf("expected 0.9 got %v", p)
+	}
+	if p := routeSuccessProbability(route, 1000); p != 0 {
+		t.Errorf("expected 0 when the local balance is too low, got %v", p)
+	}
+}
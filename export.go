@@ -0,0 +1,122 @@
+package breez
+
+import (
+	"encoding/csv"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/breez/breez/data"
+)
+
+const (
+	//paymentsScanPageSize is the number of payments read at a time when scanning the history
+	paymentsScanPageSize = 500
+)
+
+var csvHeader = []string{"timestamp", "type", "amount_sat", "fee_sat", "payment_hash", "description", "destination",
+	"vat_rate", "tax_amount_sat", "merchant_tax_id"}
+
+// csvSafe prevents spreadsheet applications from evaluating a text cell as a formula.
+func csvSafe(value string) string {
+	if value != "" && strings.ContainsAny(value[:1], "=+-@\t\r") {
+		return "'" + value
+	}
+	return value
+}
+
+// paymentFee returns the fee paid on top of the payment amount: the routing fee
+// of sent payments or the on-chain fee of channel closes.
+func paymentFee(payment *paymentInfo) int64 {
+	return payment.Fee + payment.CloseFee
+}
+
+// paymentFiatValue returns the fiat value of the payment at the time it settled,
+// or empty if it wasn't recorded in the given currency.
+func paymentFiatValue(payment *paymentInfo, currency string) string {
+	if payment.FiatCurrency == "" || !strings.EqualFold(payment.FiatCurrency, currency) {
+		return ""
+	}
+	return strconv.FormatFloat(payment.FiatAmount, 'f', 2, 64)
+}
+
+// paymentTaxFields returns the VAT rate, tax amount and merchant tax ID columns,
+// empty for payments without tax metadata.
+func paymentTaxFields(payment *paymentInfo) []string {
+	if payment.VatRate == 0 && payment.TaxAmount == 0 && payment.MerchantTaxID == "" {
+		return []string{"", "", ""}
+	}
+	return []string{
+		strconv.FormatFloat(payment.VatRate, 'f', -1, 64),
+		strconv.FormatInt(payment.TaxAmount, 10),
+		csvSafe(payment.MerchantTaxID),
+	}
+}
+
+// paymentCSVRecord formats the payment independently of the device locale:
+// UTC RFC3339 timestamps and integer satoshi amounts without separators.
+func paymentCSVRecord(payment *paymentInfo, fiatCurrency string) []string {
+	record := []string{
+		time.Unix(payment.CreationTimestamp, 0).UTC().Format(time.RFC3339),
+		paymentInfoToProto(payment).Type.String(),
+		strconv.FormatInt(payment.Amount, 10),
+		strconv.FormatInt(paymentFee(payment), 10),
+		payment.PaymentHash,
+		csvSafe(payment.Description),
+		payment.Destination,
+	}
+	record = append(record, paymentTaxFields(payment)...)
+	if fiatCurrency != "" {
+		record = append(record, paymentFiatValue(payment, fiatCurrency))
+	}
+	return record
+}
+
+/*
+ExportPaymentsCSV writes the payments matching the request filter, newest first, to a CSV file
+at the request path for bookkeeping. When a fiat currency is requested a column with the payment
+fiat value in that currency is appended.
+*/
+func ExportPaymentsCSV(request *data.ExportPaymentsRequest) error {
+	filter := &paymentsFilter{FromTimestamp: request.FromTimestamp, ToTimestamp: request.ToTimestamp}
+	for _, t := range request.Types {
+		filter.Types = append(filter.Types, paymentTypeFromProto(t))
+	}
+
+	f, err := os.OpenFile(request.Path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w := csv.NewWriter(f)
+	header := csvHeader
+	if request.FiatCurrency != "" {
+		header = append(append([]string{}, csvHeader...), "fiat_"+strings.ToLower(request.FiatCurrency))
+	}
+	if err := w.Write(header); err != nil {
+		return err
+	}
+
+	var before []byte
+	for {
+		payments, next, err := fetchPaymentsPage(before, paymentsScanPageSize, filter)
+		if err != nil {
+			return err
+		}
+		for _, payment := range payments {
+			if err := w.Write(paymentCSVRecord(payment, request.FiatCurrency)); err != nil {
+				return err
+			}
+		}
+		if next == nil {
+			break
+		}
+		before = next
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return err
+	}
+	return f.Sync()
+}
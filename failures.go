@@ -0,0 +1,212 @@
+package breez
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
+	"github.com/breez/lightninglib/lnwire"
+)
+
+// paymentFailure is the classification of a failed payment. The onion failures
+// reported by a node of the route add these reasons to the local ones:
+// INCORRECT_PAYMENT_DETAILS when the recipient rejected the payment since the
+// invoice is unknown, already paid or the amount is wrong, RECIPIENT_OFFLINE
+// when a node couldn't reach the next one, most often the recipient being
+// offline, ROUTE_UNAVAILABLE when a channel has no capacity left or is disabled,
+// ROUTE_POLICY_CHANGED when a node changed its fees or time lock and
+// NODE_FAILURE when a node failed to process the payment. The code is the
+// BOLT 4 failure and the hop tells whether the recipient or a node of the route
+// reported it, the daemon doesn't report which node of the route it was.
+type paymentFailure struct {
+	code   data.FailedPayment_FailureCode
+	reason data.FailedPayment_Reason
+	hop    data.FailedPayment_Hop
+}
+
+// onionFailures maps the failure messages the daemon puts in the payment errors,
+// the final hop codes are first since their names contain the route ones.
+var onionFailures = []struct {
+	code lnwire.FailCode
+	paymentFailure
+}{
+	{lnwire.CodeFinalExpiryTooSoon, paymentFailure{data.FailedPayment_FINAL_EXPIRY_TOO_SOON, data.FailedPayment_INCORRECT_PAYMENT_DETAILS, data.FailedPayment_RECIPIENT}},
+	{lnwire.CodeFinalIncorrectCltvExpiry, paymentFailure{data.FailedPayment_FINAL_INCORRECT_CLTV_EXPIRY, data.FailedPayment_INCORRECT_PAYMENT_DETAILS, data.FailedPayment_RECIPIENT}},
+	{lnwire.CodeFinalIncorrectHtlcAmount, paymentFailure{data.FailedPayment_FINAL_INCORRECT_HTLC_AMOUNT, data.FailedPayment_INCORRECT_PAYMENT_DETAILS, data.FailedPayment_RECIPIENT}},
+	{lnwire.CodeUnknownPaymentHash, paymentFailure{data.FailedPayment_UNKNOWN_PAYMENT_HASH, data.FailedPayment_INCORRECT_PAYMENT_DETAILS, data.FailedPayment_RECIPIENT}},
+	{lnwire.CodeIncorrectPaymentAmount, paymentFailure{data.FailedPayment_INCORRECT_PAYMENT_AMOUNT, data.FailedPayment_INCORRECT_PAYMENT_DETAILS, data.FailedPayment_RECIPIENT}},
+	{lnwire.CodeUnknownNextPeer, paymentFailure{data.FailedPayment_UNKNOWN_NEXT_PEER, data.FailedPayment_RECIPIENT_OFFLINE, data.FailedPayment_ROUTE}},
+	{lnwire.CodeTemporaryChannelFailure, paymentFailure{data.FailedPayment_TEMPORARY_CHANNEL_FAILURE, data.FailedPayment_ROUTE_UNAVAILABLE, data.FailedPayment_ROUTE}},
+	{lnwire.CodePermanentChannelFailure, paymentFailure{data.FailedPayment_PERMANENT_CHANNEL_FAILURE, data.FailedPayment_ROUTE_UNAVAILABLE, data.FailedPayment_ROUTE}},
+	{lnwire.CodeRequiredChannelFeatureMissing, paymentFailure{data.FailedPayment_REQUIRED_CHANNEL_FEATURE_MISSING, data.FailedPayment_ROUTE_UNAVAILABLE, data.FailedPayment_ROUTE}},
+	{lnwire.CodeChannelDisabled, paymentFailure{data.FailedPayment_CHANNEL_DISABLED, data.FailedPayment_ROUTE_UNAVAILABLE, data.FailedPayment_ROUTE}},
+	{lnwire.CodeAmountBelowMinimum, paymentFailure{data.FailedPayment_AMOUNT_BELOW_MINIMUM, data.FailedPayment_ROUTE_UNAVAILABLE, data.FailedPayment_ROUTE}},
+	{lnwire.CodeFeeInsufficient, paymentFailure{data.FailedPayment_FEE_INSUFFICIENT, data.FailedPayment_ROUTE_POLICY_CHANGED, data.FailedPayment_ROUTE}},
+	{lnwire.CodeIncorrectCltvExpiry, paymentFailure{data.FailedPayment_INCORRECT_CLTV_EXPIRY, data.FailedPayment_ROUTE_POLICY_CHANGED, data.FailedPayment_ROUTE}},
+	{lnwire.CodeExpiryTooSoon, paymentFailure{data.FailedPayment_EXPIRY_TOO_SOON, data.FailedPayment_ROUTE_POLICY_CHANGED, data.FailedPayment_ROUTE}},
+	{lnwire.CodeExpiryTooFar, paymentFailure{data.FailedPayment_EXPIRY_TOO_FAR, data.FailedPayment_ROUTE_POLICY_CHANGED, data.FailedPayment_ROUTE}},
+	{lnwire.CodeTemporaryNodeFailure, paymentFailure{data.FailedPayment_TEMPORARY_NODE_FAILURE, data.FailedPayment_NODE_FAILURE, data.FailedPayment_ROUTE}},
+	{lnwire.CodePermanentNodeFailure, paymentFailure{data.FailedPayment_PERMANENT_NODE_FAILURE, data.FailedPayment_NODE_FAILURE, data.FailedPayment_ROUTE}},
+	{lnwire.CodeRequiredNodeFeatureMissing, paymentFailure{data.FailedPayment_REQUIRED_NODE_FEATURE_MISSING, data.FailedPayment_NODE_FAILURE, data.FailedPayment_ROUTE}},
+	{lnwire.CodeInvalidRealm, paymentFailure{data.FailedPayment_INVALID_REALM, data.FailedPayment_NODE_FAILURE, data.FailedPayment_ROUTE}},
+	{lnwire.CodeInvalidOnionVersion, paymentFailure{data.FailedPayment_INVALID_ONION_VERSION, data.FailedPayment_NODE_FAILURE, data.FailedPayment_ROUTE}},
+	{lnwire.CodeInvalidOnionHmac, paymentFailure{data.FailedPayment_INVALID_ONION_HMAC, data.FailedPayment_NODE_FAILURE, data.FailedPayment_ROUTE}},
+	{lnwire.CodeInvalidOnionKey, paymentFailure{data.FailedPayment_INVALID_ONION_KEY, data.FailedPayment_NODE_FAILURE, data.FailedPayment_ROUTE}},
+}
+
+// paymentFailureReasons maps fragments of the daemon and local payment errors to
+// the failure reason shown to the user, the first match wins.
+var paymentFailureReasons = []struct {
+	fragment string
+	reason   data.FailedPayment_Reason
+}{
+	{"fee limit", data.FailedPayment_FEE_LIMIT_EXCEEDED},
+	{"unable to find a path", data.FailedPayment_NO_ROUTE},
+	{"unable to route", data.FailedPayment_NO_ROUTE},
+	{"no route", data.FailedPayment_NO_ROUTE},
+	{"insufficient", data.FailedPayment_INSUFFICIENT_BALANCE},
+	{"exceeds spendable balance", data.FailedPayment_INSUFFICIENT_BALANCE},
+	{"expired", data.FailedPayment_INVOICE_EXPIRED},
+	{"timeout", data.FailedPayment_TIMEOUT},
+}
+
+type failedPayment struct {
+	PaymentHash    string
+	PaymentRequest string
+	Destination    string
+	Description    string
+	Amount         int64
+	Timestamp      int64
+	Reason         data.FailedPayment_Reason
+	Error          string
+	FailureCode    data.FailedPayment_FailureCode
+	FailingHop     data.FailedPayment_Hop
+}
+
+/*
+PaymentError is returned for a payment the daemon failed to send, with the failure classified as
+in the failed payments history.
+*/
+type PaymentError struct {
+	Reason      data.FailedPayment_Reason
+	FailureCode data.FailedPayment_FailureCode
+	FailingHop  data.FailedPayment_Hop
+	err         error
+}
+
+func (e *PaymentError) Error() string {
+	return e.err.Error()
+}
+
+// newPaymentError classifies err, the errors which are not onion failures are
+// returned as is since callers compare them to the package errors.
+func newPaymentError(err error) error {
+	f := classifyPaymentFailure(err)
+	if f.code == data.FailedPayment_NO_FAILURE_CODE {
+		return err
+	}
+	return &PaymentError{Reason: f.reason, FailureCode: f.code, FailingHop: f.hop, err: err}
+}
+
+func serializeFailedPayment(p *failedPayment) ([]byte, error) {
+	paymentBytes, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return sealDBValue(paymentBytes)
+}
+
+func deserializeFailedPayment(paymentBytes []byte) (*failedPayment, error) {
+	var p failedPayment
+	paymentBytes, err := openDBValue(paymentBytes)
+	if err != nil {
+		return &p, err
+	}
+	err = json.Unmarshal(paymentBytes, &p)
+	return &p, err
+}
+
+// paymentFailureReason classifies a payment error by its message since the
+// daemon doesn't return typed errors.
+func paymentFailureReason(err error) data.FailedPayment_Reason {
+	return classifyPaymentFailure(err).reason
+}
+
+// classifyPaymentFailure looks for an onion failure in the error first, the
+// other failures are local to the sender.
+func classifyPaymentFailure(err error) paymentFailure {
+	if e, ok := err.(*PaymentError); ok {
+		return paymentFailure{code: e.FailureCode, reason: e.Reason, hop: e.FailingHop}
+	}
+	for _, f := range onionFailures {
+		if strings.Contains(err.Error(), f.code.String()) {
+			return f.paymentFailure
+		}
+	}
+	message := strings.ToLower(err.Error())
+	for _, r := range paymentFailureReasons {
+		if strings.Contains(message, r.fragment) {
+			return paymentFailure{reason: r.reason, hop: data.FailedPayment_SENDER}
+		}
+	}
+	return paymentFailure{reason: data.FailedPayment_UNKNOWN}
+}
+
+// recordFailedPayment keeps a failed payment attempt and notifies the app.
+func recordFailedPayment(paymentRequest string, decodedReq *lnrpc.PayReq, amount int64, timestamp int64, paymentErr error) {
+	failure := classifyPaymentFailure(paymentErr)
+	p := &failedPayment{
+		PaymentHash:    decodedReq.PaymentHash,
+		PaymentRequest: paymentRequest,
+		Destination:    decodedReq.Destination,
+		Description:    decodedReq.Description,
+		Amount:         amount,
+		Timestamp:      timestamp,
+		Reason:         failure.reason,
+		Error:          paymentErr.Error(),
+		FailureCode:    failure.code,
+		FailingHop:     failure.hop,
+	}
+	if invoiceMemo, err := DecodePaymentRequest(paymentRequest); err == nil {
+		p.Description = invoiceMemo.Description
+	}
+	if err := addFailedPayment(p); err != nil {
+		paymentsLog.Errorf("recordFailedPayment - failed to save payment %v: %v", p.PaymentHash, err)
+		return
+	}
+	notify(data.NotificationEvent{Type: data.NotificationEvent_PAYMENT_FAILED, Data: []string{p.PaymentHash, p.Reason.String(), p.FailureCode.String(), p.FailingHop.String()}})
+}
+
+/*
+GetFailedPayments returns the failed payment attempts with the reason they failed, newest first.
+*/
+func GetFailedPayments() (*data.FailedPayments, error) {
+	payments, err := fetchFailedPayments()
+	if err != nil {
+		return nil, err
+	}
+	result := &data.FailedPayments{}
+	for _, p := range payments {
+		result.Payments = append(result.Payments, &data.FailedPayment{
+			PaymentHash:    p.PaymentHash,
+			PaymentRequest: p.PaymentRequest,
+			Destination:    p.Destination,
+			Description:    p.Description,
+			Amount:         p.Amount,
+			Timestamp:      p.Timestamp,
+			Reason:         p.Reason,
+			Error:          p.Error,
+			FailureCode:    p.FailureCode,
+			FailingHop:     p.FailingHop,
+		})
+	}
+	return result, nil
+}
+
+/*
+ClearFailedPayments deletes the history of failed payment attempts.
+*/
+func ClearFailedPayments() error {
+	return deleteFailedPayments()
+}
@@ -0,0 +1,50 @@
+package breez
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/breez/breez/data"
+)
+
+func TestPaymentFailureReason(t *testing.T) {
+	tests := map[string]data.FailedPayment_Reason{
+		"unable to find a path to destination":                   data.FailedPayment_NO_ROUTE,
+		"amount exceeds spendable balance, unlock savings first": data.FailedPayment_INSUFFICIENT_BALANCE,
+		"invoice expired": data.FailedPayment_INVOICE_EXPIRED,
+		"payment attempt not completed before timeout of 1m0s": data.FailedPayment_TIMEOUT,
+		"fee limit exceeded": data.FailedPayment_FEE_LIMIT_EXCEEDED,
+		"something else":     data.FailedPayment_UNKNOWN,
+		"unable to route payment to destination: UnknownNextPeer": data.FailedPayment_RECIPIENT_OFFLINE,
+	}
+	for message, reason := range tests {
+		if r := paymentFailureReason(errors.New(message)); r != reason {
+			t.Errorf("%q: expected %v got %v", message, reason, r)
+		}
+	}
+}
+
+func TestClassifyPaymentFailure(t *testing.T) {
+	tests := map[string]paymentFailure{
+		"unable to route payment to destination: FinalExpiryTooSoon": {
+			data.FailedPayment_FINAL_EXPIRY_TOO_SOON, data.FailedPayment_INCORRECT_PAYMENT_DETAILS, data.FailedPayment_RECIPIENT},
+		"unable to route payment to destination: ExpiryTooSoon(update=...)": {
+			data.FailedPayment_EXPIRY_TOO_SOON, data.FailedPayment_ROUTE_POLICY_CHANGED, data.FailedPayment_ROUTE},
+		"TemporaryChannelFailure": {
+			data.FailedPayment_TEMPORARY_CHANNEL_FAILURE, data.FailedPayment_ROUTE_UNAVAILABLE, data.FailedPayment_ROUTE},
+		"invoice expired": {
+			data.FailedPayment_NO_FAILURE_CODE, data.FailedPayment_INVOICE_EXPIRED, data.FailedPayment_SENDER},
+	}
+	for message, failure := range tests {
+		if f := classifyPaymentFailure(errors.New(message)); f != failure {
+			t.Errorf("%q: expected %v got %v", message, failure, f)
+		}
+	}
+	err := newPaymentError(errors.New("UnknownPaymentHash"))
+	if f := classifyPaymentFailure(err); f.code != data.FailedPayment_UNKNOWN_PAYMENT_HASH {
+		t.Errorf("expected the payment error code, got %v", f.code)
+	}
+	if err := errors.New("no route"); newPaymentError(err) != err {
+		t.Errorf("expected local failures to be returned as is")
+	}
+}
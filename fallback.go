@@ -0,0 +1,133 @@
+package breez
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
+)
+
+const (
+	//fallbackWatchPeriod is how long after the invoice expiry its fallback address is still watched
+	fallbackWatchPeriod = 30 * 24 * 3600
+
+	//fallbackFundingProvider attributes deposits paid on-chain to an invoice fallback address
+	fallbackFundingProvider = "fallback address"
+)
+
+type fallbackAddress struct {
+	PaymentHash     string
+	Address         string
+	Description     string
+	Amount          int64
+	ExpiryTimestamp int64
+}
+
+func serializeFallbackAddress(f *fallbackAddress) ([]byte, error) {
+	addressBytes, err := json.Marshal(f)
+	if err != nil {
+		return nil, err
+	}
+	return sealDBValue(addressBytes)
+}
+
+func deserializeFallbackAddress(addressBytes []byte) (*fallbackAddress, error) {
+	var f fallbackAddress
+	addressBytes, err := openDBValue(addressBytes)
+	if err != nil {
+		return &f, err
+	}
+	err = json.Unmarshal(addressBytes, &f)
+	return &f, err
+}
+
+// trackFallbackAddress watches the fallback address embedded in the invoice so
+// an on-chain payment to it is recorded as a deposit.
+func trackFallbackAddress(paymentRequest, address string) error {
+	decodedReq, err := decodePayReqLocally(paymentRequest)
+	if err != nil {
+		return err
+	}
+	invoiceMemo, err := DecodePaymentRequest(paymentRequest)
+	if err != nil {
+		return err
+	}
+	return saveFallbackAddress(&fallbackAddress{
+		PaymentHash:     decodedReq.PaymentHash,
+		Address:         address,
+		Description:     invoiceMemo.Description,
+		Amount:          decodedReq.NumSatoshis,
+		ExpiryTimestamp: decodedReq.Timestamp + decodedReq.Expiry,
+	})
+}
+
+// checkFallbackPayments records the confirmed wallet transactions paying an
+// invoice fallback address as deposits. The invoice is then marked as hidden
+// so paying it over lightning as well is reported.
+func checkFallbackPayments() {
+	addresses, err := fetchFallbackAddresses()
+	if err != nil || len(addresses) == 0 {
+		return
+	}
+	txs, err := lightningClient.GetTransactions(context.Background(), &lnrpc.GetTransactionsRequest{})
+	if err != nil {
+		log.Errorf("checkFallbackPayments - failed to get the wallet transactions: %v", err)
+		return
+	}
+	received := make(map[string]*lnrpc.Transaction)
+	for _, tx := range txs.Transactions {
+		if tx.NumConfirmations == 0 || tx.Amount <= 0 {
+			continue
+		}
+		for _, address := range tx.DestAddresses {
+			received[address] = tx
+		}
+	}
+
+	now := trustedNow().Unix()
+	for _, f := range addresses {
+		tx, ok := received[f.Address]
+		if !ok {
+			if f.ExpiryTimestamp+fallbackWatchPeriod < now {
+				deleteFallbackAddress(f.PaymentHash)
+			}
+			continue
+		}
+		if err := onFallbackPayment(f, tx); err != nil {
+			log.Errorf("checkFallbackPayments - failed to record the payment to %v: %v", f.Address, err)
+		}
+	}
+}
+
+func onFallbackPayment(f *fallbackAddress, tx *lnrpc.Transaction) error {
+	paid, err := hasPayment(f.PaymentHash)
+	if err != nil {
+		return err
+	}
+	if !paid {
+		log.Infof("onFallbackPayment - invoice %v was paid on-chain by %v", f.PaymentHash, tx.TxHash)
+		err = addAccountPayment(&paymentInfo{
+			Type:              depositPayment,
+			Amount:            tx.Amount,
+			CreationTimestamp: tx.TimeStamp,
+			Description:       f.Description,
+			PaymentHash:       f.PaymentHash,
+			RedeemTxID:        tx.TxHash,
+			Destination:       f.Address,
+			FundingProvider:   fallbackFundingProvider,
+			FundingOrderID:    f.Address,
+			FundingTxIDs:      []string{tx.TxHash},
+		}, 0, 0)
+		if err != nil {
+			return err
+		}
+		if err := saveHiddenInvoice(f.PaymentHash, trustedNow().Unix()); err != nil {
+			log.Errorf("onFallbackPayment - failed to hide invoice %v: %v", f.PaymentHash, err)
+		}
+		deleteInvoiceHints(f.PaymentHash)
+		notify(data.NotificationEvent{Type: data.NotificationEvent_INVOICE_PAID, Data: []string{f.PaymentHash, ""}})
+		onAccountChanged()
+	}
+	return deleteFallbackAddress(f.PaymentHash)
+}
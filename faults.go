@@ -0,0 +1,68 @@
+package breez
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
+)
+
+var (
+	faultsMu   sync.Mutex
+	faultRules []*data.FaultInjectionRule
+	faultRand  = rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	// faultErrors are the injected errors, worded like the daemon payment errors
+	// so the app error handling sees what it would see for a real failure.
+	faultErrors = map[data.FaultInjectionRule_Fault]string{
+		data.FaultInjectionRule_NO_ROUTE:        "unable to find a path to destination",
+		data.FaultInjectionRule_TIMEOUT:         "payment attempt not completed before timeout",
+		data.FaultInjectionRule_FEE_TOO_HIGH:    "fee limit exceeded",
+		data.FaultInjectionRule_INVOICE_EXPIRED: "invoice expired",
+	}
+)
+
+/*
+SetFaultInjection replaces the failures injected into the send pipeline, an empty list disables them.
+It is meant for testing the app error handling and is only available in developer mode.
+*/
+func SetFaultInjection(rules *data.FaultInjectionRules) error {
+	if cfg == nil || !cfg.DeveloperMode {
+		return errors.New("fault injection is only available in developer mode")
+	}
+	for _, r := range rules.Rules {
+		if r.Probability < 0 || r.Probability > 1 {
+			return fmt.Errorf("fault probability %v is not between 0 and 1", r.Probability)
+		}
+	}
+	faultsMu.Lock()
+	defer faultsMu.Unlock()
+	faultRules = rules.Rules
+	log.Infof("SetFaultInjection - %v fault rules set", len(faultRules))
+	return nil
+}
+
+// injectedSendFailure returns the failure of the first rule that targets the
+// payment and fires according to its probability.
+func injectedSendFailure(decodedReq *lnrpc.PayReq) error {
+	faultsMu.Lock()
+	defer faultsMu.Unlock()
+	for _, r := range faultRules {
+		if r.PaymentHash != "" && r.PaymentHash != decodedReq.PaymentHash {
+			continue
+		}
+		if r.Destination != "" && r.Destination != decodedReq.Destination {
+			continue
+		}
+		if faultRand.Float64() >= r.Probability {
+			continue
+		}
+		log.Infof("injectedSendFailure - injecting %v for payment %v", r.Fault, decodedReq.PaymentHash)
+		return errors.New(faultErrors[r.Fault])
+	}
+	return nil
+}
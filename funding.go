@@ -0,0 +1,24 @@
+package breez
+
+const (
+	//swapFundingProvider attributes deposits paid by the swap service for on-chain funds
+	swapFundingProvider = "swap"
+)
+
+// setDepositFundingSource records where a deposit came from so it can be traced
+// without the provider records. Swap deposits are paid to the swap payment hash
+// and reference the swap address, its counterparty and its funding transactions.
+func setDepositFundingSource(payment *paymentInfo, paymentHash []byte) {
+	swap, err := fetchSwapAddressByPaymentHash(paymentHash)
+	if err != nil {
+		log.Errorf("setDepositFundingSource - failed to fetch the swap of %v: %v", payment.PaymentHash, err)
+		return
+	}
+	if swap == nil {
+		return
+	}
+	payment.FundingProvider = swapFundingProvider
+	payment.FundingOrderID = swap.Address
+	payment.FundingCounterparty = swap.Source
+	payment.FundingTxIDs = swap.ConfirmedTransactionIds
+}
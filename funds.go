@@ -11,14 +11,26 @@ import (
 
 	"github.com/breez/breez/data"
 	"github.com/breez/lightninglib/lnrpc"
+	"github.com/breez/lightninglib/lnwallet"
 	"github.com/golang/protobuf/proto"
 	"golang.org/x/sync/singleflight"
 
 	breezservice "github.com/breez/breez/breez"
 )
 
+const (
+	refundCheckInterval = 10 * time.Minute
+
+	//refundRetryBlocks is the number of blocks after which an unconfirmed refund is broadcast again
+	refundRetryBlocks = 144
+
+	//approximate virtual size of the wallet transaction funding a swap address
+	swapTxVirtualSize = 200
+)
+
 var (
 	getPaymentGroup singleflight.Group
+	refundGroup     singleflight.Group
 )
 
 //SwapAddressInfo contains all the infromation regarding
@@ -44,7 +56,12 @@ type SwapAddressInfo struct {
 	EnteredMempool bool
 
 	//refund
-	LastRefundTxID string
+	LastRefundTxID    string
+	LastRefundAddress string
+	LastRefundHeight  uint32
+
+	//Source is the counterparty the address was handed out to
+	Source string
 }
 
 func serializeSwapAddressInfo(s *SwapAddressInfo) ([]byte, error) {
@@ -61,15 +78,30 @@ func deserializeSwapAddressInfo(addressBytes []byte) (*SwapAddressInfo, error) {
 AddFundsInit is responsible for topping up an existing channel
 */
 func AddFundsInit(notificationToken string) (*data.AddFundInitReply, error) {
+	return addFundsInit(notificationToken, "")
+}
+
+/*
+AddFundsInitForSource creates a new swap address dedicated to deposits from the given source, such as
+an exchange or a person, so deposits from different counterparties are never linked by a shared address.
+*/
+func AddFundsInitForSource(notificationToken, source string) (*data.AddFundInitReply, error) {
+	if source == "" {
+		return nil, errors.New("source is required")
+	}
+	return addFundsInit(notificationToken, source)
+}
+
+func addFundsInit(notificationToken, source string) (*data.AddFundInitReply, error) {
 	acc, err := calculateAccount()
 	if err != nil {
-		log.Errorf("Error in calculateAccount: %v", err)
+		chainLog.Errorf("Error in calculateAccount: %v", err)
 		return nil, err
 	}
 
 	swap, err := lightningClient.SubSwapClientInit(context.Background(), &lnrpc.SubSwapClientInitRequest{})
 	if err != nil {
-		log.Criticalf("Failed to call SubSwapClientInit %v", err)
+		chainLog.Criticalf("Failed to call SubSwapClientInit %v", err)
 		return nil, err
 	}
 
@@ -78,11 +110,11 @@ func AddFundsInit(notificationToken string) (*data.AddFundInitReply, error) {
 
 	r, err := c.AddFundInit(ctx, &breezservice.AddFundInitRequest{NodeID: acc.Id, NotificationToken: notificationToken, Pubkey: swap.Pubkey, Hash: swap.Hash})
 	if err != nil {
-		log.Errorf("Error in AddFundInit: %v", err)
+		chainLog.Errorf("Error in AddFundInit: %v", err)
 		return nil, err
 	}
 
-	log.Infof("AddFundInit response = %v", r)
+	chainLog.Infof("AddFundInit response = %v", r)
 
 	if r.ErrorMessage != "" {
 		return &data.AddFundInitReply{MaxAllowedDeposit: r.MaxAllowedDeposit, ErrorMessage: r.ErrorMessage}, nil
@@ -90,11 +122,11 @@ func AddFundsInit(notificationToken string) (*data.AddFundInitReply, error) {
 
 	client, err := lightningClient.SubSwapClientWatch(context.Background(), &lnrpc.SubSwapClientWatchRequest{Preimage: swap.Preimage, Key: swap.Key, ServicePubkey: r.Pubkey, LockHeight: r.LockHeight})
 	if err != nil {
-		log.Criticalf("Failed to call SubSwapClientWatch %v", err)
+		chainLog.Criticalf("Failed to call SubSwapClientWatch %v", err)
 		return nil, err
 	}
 
-	log.Infof("Finished watch: %v, %v", hex.EncodeToString(r.Pubkey), r.LockHeight)
+	chainLog.Infof("Finished watch: %v, %v", hex.EncodeToString(r.Pubkey), r.LockHeight)
 
 	// Verify we are on the same page
 	if client.Address != r.Address {
@@ -108,8 +140,9 @@ func AddFundsInit(notificationToken string) (*data.AddFundInitReply, error) {
 		PrivateKey:  swap.Key,
 		PublicKey:   swap.Pubkey,
 		Script:      client.Script,
+		Source:      source,
 	}
-	log.Infof("Saving new swap info %v", swapInfo)
+	chainLog.Infof("Saving new swap info %v", swapInfo)
 	saveSwapAddressInfo(swapInfo)
 
 	// Create JSON with the script and our private key (in case user wants to do the refund by himself)
@@ -126,6 +159,45 @@ func AddFundsInit(notificationToken string) (*data.AddFundInitReply, error) {
 	return &data.AddFundInitReply{Address: r.Address, MaxAllowedDeposit: r.MaxAllowedDeposit, ErrorMessage: r.ErrorMessage, BackupJson: string(jsonBytes[:])}, nil
 }
 
+// swapAddressUsed returns true if the address was already funded.
+func swapAddressUsed(a *SwapAddressInfo) bool {
+	return a.EnteredMempool || len(a.ConfirmedTransactionIds) > 0 || a.ConfirmedAmount > 0 || a.PaidAmount > 0
+}
+
+/*
+CheckSwapAddressReuse flags a swap address the user is about to deposit to again: an address which was
+already funded or expired, or which was created for a source other than the given one.
+*/
+func CheckSwapAddressReuse(address, source string) (*data.SwapAddressReuse, error) {
+	a, err := fetchSwapAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	if a == nil {
+		return nil, fmt.Errorf("%v is not a swap address", address)
+	}
+	result := &data.SwapAddressReuse{Address: address, Source: a.Source, Used: swapAddressUsed(a)}
+	if a.LockHeight > 0 && DaemonReady() {
+		info, err := lightningClient.GetInfo(context.Background(), &lnrpc.GetInfoRequest{})
+		if err != nil {
+			return nil, err
+		}
+		result.Expired = a.LockHeight <= info.BlockHeight
+	}
+	result.OtherSource = source != "" && a.Source != "" && a.Source != source
+	result.Reuse = result.Used || result.Expired || result.OtherSource
+	return result, nil
+}
+
+/*
+GetSwapAddressesBySource returns the swap addresses created for deposits from the given source.
+*/
+func GetSwapAddressesBySource(source string) ([]*SwapAddressInfo, error) {
+	return fetchSwapAddresses(func(a *SwapAddressInfo) bool {
+		return a.Source == source
+	})
+}
+
 //GetRefundableAddresses returns all addresses that are refundable, e.g: expired and not paid
 func GetRefundableAddresses() ([]*SwapAddressInfo, error) {
 	info, err := lightningClient.GetInfo(context.Background(), &lnrpc.GetInfoRequest{})
@@ -136,7 +208,7 @@ func GetRefundableAddresses() ([]*SwapAddressInfo, error) {
 	refundable, err := fetchSwapAddresses(func(a *SwapAddressInfo) bool {
 		refundable := a.LockHeight < info.BlockHeight && a.ConfirmedAmount > 0
 		if refundable {
-			log.Infof("found refundable address: %v lockHeight=%v, amount=%v, currentHeight=%v", a.Address, a.LockHeight, a.ConfirmedAmount, info.BlockHeight)
+			chainLog.Infof("found refundable address: %v lockHeight=%v, amount=%v, currentHeight=%v", a.Address, a.LockHeight, a.ConfirmedAmount, info.BlockHeight)
 		}
 		return refundable
 	})
@@ -149,6 +221,10 @@ func GetRefundableAddresses() ([]*SwapAddressInfo, error) {
 
 //Refund broadcast a refund transaction for a sub swap address.
 func Refund(address, refundAddress string) (string, error) {
+	info, err := lightningClient.GetInfo(context.Background(), &lnrpc.GetInfoRequest{})
+	if err != nil {
+		return "", err
+	}
 	res, err := lightningClient.SubSwapClientRefund(context.Background(), &lnrpc.SubSwapClientRefundRequest{
 		Address:       address,
 		RefundAddress: refundAddress,
@@ -158,6 +234,8 @@ func Refund(address, refundAddress string) (string, error) {
 	}
 	_, err = updateSwapAddress(address, func(a *SwapAddressInfo) error {
 		a.LastRefundTxID = res.Txid
+		a.LastRefundAddress = refundAddress
+		a.LastRefundHeight = info.BlockHeight
 		return nil
 	})
 	if err != nil {
@@ -167,6 +245,58 @@ func Refund(address, refundAddress string) (string, error) {
 	return res.Txid, nil
 }
 
+/*
+GetSwapLimits returns the minimum and maximum amounts that can currently be added (swap in)
+or removed (swap out) so the user can validate amounts before starting a swap.
+The limits are the swapper's, narrowed down by what the channels can receive and pay.
+*/
+func GetSwapLimits() (*data.SwapLimits, error) {
+	acc, err := calculateAccount()
+	if err != nil {
+		chainLog.Errorf("Error in calculateAccount: %v", err)
+		return nil, err
+	}
+
+	c, ctx, cancel := getFundManager()
+	defer cancel()
+	return querySwapLimits(ctx, c, acc)
+}
+
+// querySwapLimits asks the swapper for its limits and narrows them down to the
+// account limits.
+func querySwapLimits(ctx context.Context, c breezservice.FundManagerClient, acc *data.Account) (*data.SwapLimits, error) {
+	r, err := c.GetSwapLimits(ctx, &breezservice.GetSwapLimitsRequest{NodeID: acc.Id})
+	if err != nil {
+		chainLog.Errorf("Error in GetSwapLimits: %v", err)
+		return nil, err
+	}
+
+	limits := &data.SwapLimits{
+		MinSwapIn:  r.MinSwapIn,
+		MaxSwapIn:  r.MaxSwapIn,
+		MinSwapOut: r.MinSwapOut,
+		MaxSwapOut: r.MaxSwapOut,
+	}
+	dustLimit := int64(lnwallet.DefaultDustLimit())
+	if limits.MinSwapIn < dustLimit {
+		limits.MinSwapIn = dustLimit
+	}
+	if limits.MinSwapOut < dustLimit {
+		limits.MinSwapOut = dustLimit
+	}
+	for _, max := range []int64{acc.MaxAllowedToReceive, acc.MaxPaymentAmount} {
+		if limits.MaxSwapIn > max {
+			limits.MaxSwapIn = max
+		}
+	}
+	for _, max := range []int64{acc.MaxAllowedToPay, acc.MaxPaymentAmount} {
+		if limits.MaxSwapOut > max {
+			limits.MaxSwapOut = max
+		}
+	}
+	return limits, nil
+}
+
 /*
 RemoveFund transfers the user funds from the chanel to a supplied on-chain address
 It is executed in three steps:
@@ -175,57 +305,103 @@ It is executed in three steps:
 3. Redeem the removed funds from the server
 */
 func RemoveFund(amount int64, address string) (*data.RemoveFundReply, error) {
+	return removeFund(context.Background(), amount, address)
+}
+
+// removeFund is RemoveFund paying the swap invoice with sendCtx, which
+// attributes the payment in the audit log.
+func removeFund(sendCtx context.Context, amount int64, address string) (*data.RemoveFundReply, error) {
+	quote, err := quoteRemoveFund(amount, address)
+	if err != nil {
+		return nil, err
+	}
+	if quote.errorMessage != "" {
+		return &data.RemoveFundReply{ErrorMessage: quote.errorMessage}, nil
+	}
+	return payRemoveFund(sendCtx, quote)
+}
+
+// removeFundQuote is the payment request the server asks to be paid to send
+// amount on-chain.
+type removeFundQuote struct {
+	amount         int64
+	paymentRequest string
+	payreq         *lnrpc.PayReq
+	errorMessage   string
+}
+
+// fee returns the part of the payment request kept by the server.
+func (q *removeFundQuote) fee() int64 {
+	if fee := q.payreq.NumSatoshis - q.amount; fee > 0 {
+		return fee
+	}
+	return 0
+}
+
+// quoteRemoveFund sends the breez server an address and an amount and gets
+// the corresponding payment request.
+func quoteRemoveFund(amount int64, address string) (*removeFundQuote, error) {
 	c, ctx, cancel := getFundManager()
 	defer cancel()
 	reply, err := c.RemoveFund(ctx, &breezservice.RemoveFundRequest{Address: address, Amount: amount})
 	if err != nil {
-		log.Errorf("RemoveFund: server endpoint call failed: %v", err)
+		chainLog.Errorf("RemoveFund: server endpoint call failed: %v", err)
 		return nil, err
 	}
 	if reply.ErrorMessage != "" {
-		return &data.RemoveFundReply{ErrorMessage: reply.ErrorMessage}, nil
+		return &removeFundQuote{amount: amount, errorMessage: reply.ErrorMessage}, nil
 	}
 
-	log.Infof("RemoveFunds: got payment request: %v", reply.PaymentRequest)
-	payreq, err := lightningClient.DecodePayReq(context.Background(), &lnrpc.PayReqString{PayReq: reply.PaymentRequest})
+	chainLog.Infof("RemoveFunds: got payment request: %v", reply.PaymentRequest)
+	payreq, err := decodePayReqLocally(reply.PaymentRequest)
 	if err != nil {
-		log.Errorf("DecodePayReq of server response failed: %v", err)
+		chainLog.Errorf("DecodePayReq of server response failed: %v", err)
 		return nil, err
 	}
+	return &removeFundQuote{amount: amount, paymentRequest: reply.PaymentRequest, payreq: payreq}, nil
+}
 
+// payRemoveFund pays the quoted payment request and redeems the removed funds
+// from the server.
+func payRemoveFund(sendCtx context.Context, quote *removeFundQuote) (*data.RemoveFundReply, error) {
 	//mark this payment request as redeemable
-	addRedeemablePaymentHash(payreq.PaymentHash)
+	addRedeemablePaymentHash(quote.payreq.PaymentHash)
 
-	log.Infof("RemoveFunds: Sending payment...")
-	err = SendPaymentForRequest(reply.PaymentRequest, 0)
+	chainLog.Infof("RemoveFunds: Sending payment...")
+	err := SendPaymentForRequestContext(sendCtx, quote.paymentRequest, 0)
 	if err != nil {
-		log.Errorf("SendPaymentForRequest failed: %v", err)
+		chainLog.Errorf("SendPaymentForRequest failed: %v", err)
 		return nil, err
 	}
-	log.Infof("SendPaymentForRequest finished successfully")
-	txID, err := redeemRemovedFundsForHash(payreq.PaymentHash)
+	chainLog.Infof("SendPaymentForRequest finished successfully")
+	if fee := quote.fee(); fee > 0 {
+		if err := addServiceFeePayment(quote.payreq.PaymentHash, breezFeeRecipient, fee, "Withdrawal fee"); err != nil {
+			chainLog.Errorf("RemoveFund - failed to add service fee: %v", err)
+		}
+	}
+	txID, err := redeemRemovedFundsForHash(quote.payreq.PaymentHash)
 	if err != nil {
-		log.Errorf("RedeemRemovedFunds failed: %v", err)
+		chainLog.Errorf("RedeemRemovedFunds failed: %v", err)
 		return nil, err
 	}
-	log.Infof("RemoveFunds finished successfully")
+	chainLog.Infof("RemoveFunds finished successfully")
 	return &data.RemoveFundReply{ErrorMessage: "", Txid: txID}, err
 }
 
 func redeemAllRemovedFunds() error {
-	log.Infof("redeemAllRemovedFunds")
+	chainLog.Infof("redeemAllRemovedFunds")
 	hashes, err := fetchRedeemablePaymentHashes()
 	if err != nil {
-		log.Errorf("failed to fetchRedeemablePaymentHashes, %v", err)
+		chainLog.Errorf("failed to fetchRedeemablePaymentHashes, %v", err)
 		return err
 	}
 	for _, hash := range hashes {
-		log.Infof("Redeeming transaction for has %v", hash)
+		chainLog.Infof("Redeeming transaction for has %v", hash)
 		txID, err := redeemRemovedFundsForHash(hash)
 		if err != nil {
-			log.Errorf("failed to redeem funds for hash %v, %v", hash, err)
+			chainLog.Errorf("failed to redeem funds for hash %v, %v", hash, err)
 		} else {
-			log.Infof("successfully redeemed funds for hash %v, txid=%v", hash, txID)
+			chainLog.Infof("successfully redeemed funds for hash %v, txid=%v", hash, txID)
 		}
 	}
 	return err
@@ -236,7 +412,7 @@ func redeemRemovedFundsForHash(hash string) (string, error) {
 	defer cancel()
 	redeemReply, err := fundManager.RedeemRemovedFunds(ctx, &breezservice.RedeemRemovedFundsRequest{Paymenthash: hash})
 	if err != nil {
-		log.Errorf("RedeemRemovedFunds failed for hash: %v,   %v", hash, err)
+		chainLog.Errorf("RedeemRemovedFunds failed for hash: %v,   %v", hash, err)
 		return "", err
 	}
 	return redeemReply.Txid, updateRedeemTxForPayment(hash, redeemReply.Txid)
@@ -244,7 +420,7 @@ func redeemRemovedFundsForHash(hash string) (string, error) {
 
 func getFundManager() (breezservice.FundManagerClient, context.Context, context.CancelFunc) {
 	con := getBreezClientConnection()
-	log.Infof("getFundManager - connection state = %v", con.GetState())
+	chainLog.Infof("getFundManager - connection state = %v", con.GetState())
 	ctx, cancel := context.WithTimeout(context.Background(), endpointTimeout*time.Second)
 	return breezservice.NewFundManagerClient(con), ctx, cancel
 }
@@ -261,7 +437,7 @@ func GetFundStatus(notificationToken string) (*data.FundStatusReply, error) {
 	if err != nil {
 		return nil, err
 	}
-	log.Infof("GetFundStatus len = %v", len(addresses))
+	chainLog.Infof("GetFundStatus len = %v", len(addresses))
 	if len(addresses) == 0 {
 		return &data.FundStatusReply{Status: data.FundStatusReply_NO_FUND}, nil
 	}
@@ -272,7 +448,7 @@ func GetFundStatus(notificationToken string) (*data.FundStatusReply, error) {
 
 		if len(a.ConfirmedTransactionIds) > 0 {
 			confirmedAddresses = append(confirmedAddresses, a.Address)
-			log.Infof("GetFundStatus adding confirmed transaction for address %v", a.Address)
+			chainLog.Infof("GetFundStatus adding confirmed transaction for address %v", a.Address)
 		} else {
 			hasMempool = hasMempool || a.EnteredMempool
 			unConfirmedAddresses = append(unConfirmedAddresses, a.Address)
@@ -280,16 +456,16 @@ func GetFundStatus(notificationToken string) (*data.FundStatusReply, error) {
 	}
 
 	if len(confirmedAddresses) > 0 {
-		log.Infof("GetFundStatus return status 'confirmed'")
+		chainLog.Infof("GetFundStatus return status 'confirmed'")
 		return &data.FundStatusReply{Status: data.FundStatusReply_CONFIRMED}, nil
 	}
 
 	if hasMempool {
-		log.Infof("GetFundStatus return status 'waiting confirmation'")
+		chainLog.Infof("GetFundStatus return status 'waiting confirmation'")
 		return &data.FundStatusReply{Status: data.FundStatusReply_WAITING_CONFIRMATION}, nil
 	}
 
-	log.Infof("GetFundStatus checknig unConfirmedAddresses len=%v", len(unConfirmedAddresses))
+	chainLog.Infof("GetFundStatus checknig unConfirmedAddresses len=%v", len(unConfirmedAddresses))
 	if len(unConfirmedAddresses) > 0 {
 		c, ctx, cancel := getFundManager()
 		defer cancel()
@@ -301,7 +477,7 @@ func GetFundStatus(notificationToken string) (*data.FundStatusReply, error) {
 
 		var hasUnconfirmed bool
 		for addr, status := range statusesMap.Statuses {
-			log.Infof("GetFundStatus - got status for address %v", status)
+			chainLog.Infof("GetFundStatus - got status for address %v", status)
 			if !status.Confirmed && status.Tx != "" {
 				hasUnconfirmed = true
 				updateSwapAddress(addr, func(swapInfo *SwapAddressInfo) error {
@@ -311,12 +487,12 @@ func GetFundStatus(notificationToken string) (*data.FundStatusReply, error) {
 			}
 		}
 		if hasUnconfirmed {
-			log.Infof("GetFundStatus return status 'waiting confirmation")
+			chainLog.Infof("GetFundStatus return status 'waiting confirmation")
 			return &data.FundStatusReply{Status: data.FundStatusReply_WAITING_CONFIRMATION}, nil
 		}
 	}
 
-	log.Infof("GetFundStatus return status 'no funds")
+	chainLog.Infof("GetFundStatus return status 'no funds")
 	return &data.FundStatusReply{Status: data.FundStatusReply_NO_FUND}, nil
 }
 
@@ -324,6 +500,120 @@ func watchFundTransfers() {
 	go watchSettledSwapAddresses()
 	go watchSettlePendingTransfers()
 	go watchSwapAddressConfirmations()
+	go watchExpiredSwapAddresses()
+}
+
+//watchExpiredSwapAddresses periodically looks for swap addresses that were funded
+//but never paid before their lock height expired and refunds them to our wallet.
+func watchExpiredSwapAddresses() {
+	refundExpiredSwapAddresses()
+	ticker := time.NewTicker(refundCheckInterval)
+	for {
+		select {
+		case <-ticker.C:
+			refundExpiredSwapAddresses()
+		case <-quitChan:
+			ticker.Stop()
+			return
+		}
+	}
+}
+
+func refundExpiredSwapAddresses() {
+	refundGroup.Do("refundExpiredSwapAddresses", func() (interface{}, error) {
+		refundable, err := GetRefundableAddresses()
+		if err != nil {
+			chainLog.Errorf("refundExpiredSwapAddresses - failed to get refundable addresses %v", err)
+			return nil, err
+		}
+		info, err := lightningClient.GetInfo(context.Background(), &lnrpc.GetInfoRequest{})
+		if err != nil {
+			chainLog.Errorf("refundExpiredSwapAddresses - failed to get the block height %v", err)
+			return nil, err
+		}
+		var confirmed map[string]bool
+		for _, a := range refundable {
+			if a.PaidAmount > 0 {
+				continue
+			}
+			if a.LastRefundTxID != "" {
+				if info.BlockHeight < a.LastRefundHeight+refundRetryBlocks {
+					continue
+				}
+				if confirmed == nil {
+					if confirmed, err = confirmedTransactions(); err != nil {
+						chainLog.Errorf("refundExpiredSwapAddresses - failed to get transactions %v", err)
+						return nil, err
+					}
+				}
+				if confirmed[a.LastRefundTxID] {
+					continue
+				}
+				chainLog.Infof("refundExpiredSwapAddresses - refund %v of %v not confirmed after %v blocks, retrying", a.LastRefundTxID, a.Address, refundRetryBlocks)
+			}
+			if err := refundExpiredSwapAddress(a); err != nil {
+				chainLog.Errorf("refundExpiredSwapAddresses - failed to refund address %v: %v", a.Address, err)
+			}
+		}
+		return nil, nil
+	})
+}
+
+//refundExpiredSwapAddress broadcasts the refund transaction for an expired swap address
+//to a new address of our wallet, or to the address of the refund it retries, and records
+//it as a refund payment of the amount received, net of the on-chain fee.
+func refundExpiredSwapAddress(a *SwapAddressInfo) error {
+	chainLog.Infof("refundExpiredSwapAddress - refunding address %v, amount=%v", a.Address, a.ConfirmedAmount)
+	refundAddress := a.LastRefundAddress
+	if refundAddress == "" {
+		newAddress, err := lightningClient.NewAddress(context.Background(), &lnrpc.NewAddressRequest{Type: lnrpc.NewAddressRequest_WITNESS_PUBKEY_HASH})
+		if err != nil {
+			return err
+		}
+		refundAddress = newAddress.Address
+	}
+	txID, err := Refund(a.Address, refundAddress)
+	if err != nil {
+		return err
+	}
+	amount := refundedAmount(txID, a.ConfirmedAmount)
+	paymentHash := hex.EncodeToString(a.PaymentHash)
+	if a.LastRefundTxID != "" {
+		err = updateRefundPayment(paymentHash, txID, amount, a.ConfirmedAmount-amount)
+	} else {
+		err = addAccountPayment(&paymentInfo{
+			Type:              refundPayment,
+			Amount:            amount,
+			Fee:               a.ConfirmedAmount - amount,
+			CreationTimestamp: trustedNow().Unix(),
+			PaymentHash:       paymentHash,
+			RedeemTxID:        txID,
+			Destination:       refundAddress,
+		}, 0, 0)
+	}
+	if err != nil {
+		return err
+	}
+	notify(data.NotificationEvent{Type: data.NotificationEvent_FUND_ADDRESS_REFUNDED, Data: []string{a.Address, txID}})
+	onAccountChanged()
+	return nil
+}
+
+//refundedAmount returns the amount the wallet receives from the refund transaction,
+//the swapped amount when the wallet doesn't know the transaction.
+func refundedAmount(txID string, swapped int64) int64 {
+	txs, err := lightningClient.GetTransactions(context.Background(), &lnrpc.GetTransactionsRequest{})
+	if err != nil {
+		chainLog.Errorf("refundedAmount - failed to get transactions: %v", err)
+		return swapped
+	}
+	for _, tx := range txs.Transactions {
+		if tx.TxHash == txID && tx.Amount > 0 && tx.Amount <= swapped {
+			return tx.Amount
+		}
+	}
+	chainLog.Errorf("refundedAmount - refund %v not found in the wallet, recording %v", txID, swapped)
+	return swapped
 }
 
 //watchSwapAddressConfirmations subscribe to cofirmed transaction notifications in order
@@ -335,7 +625,7 @@ func watchSwapAddressConfirmations() {
 	//first of all subscribe to transaction so we won't loose any transaction on startup
 	stream, err := lightningClient.SubscribeTransactions(context.Background(), &lnrpc.GetTransactionsRequest{})
 	if err != nil {
-		log.Errorf("watchSwapAddressConfirmations - Failed to call SubscribeTransactions %v, %v", stream, err)
+		chainLog.Errorf("watchSwapAddressConfirmations - Failed to call SubscribeTransactions %v, %v", stream, err)
 		return
 	}
 
@@ -343,38 +633,38 @@ func watchSwapAddressConfirmations() {
 	addresses, err := fetchSwapAddresses(func(addr *SwapAddressInfo) bool {
 		return true
 	})
-	log.Infof("watchSwapAddressConfirmations got these addresses to check: %v", addresses)
+	chainLog.Infof("watchSwapAddressConfirmations got these addresses to check: %v", addresses)
 	if err != nil {
-		log.Errorf("failed to call fetchSwapAddresses %v", err)
+		chainLog.Errorf("failed to call fetchSwapAddresses %v", err)
 		return
 	}
 
 	for _, a := range addresses {
 		_, err = updateUnspentAmount(a.Address)
 		if err != nil {
-			log.Errorf("Failed to update unspent output for address %v", a.Address)
+			chainLog.Errorf("Failed to update unspent output for address %v", a.Address)
 		}
 	}
 
 	//Now enter the loopp of updating on each confirmed transaction
 	for {
 		_, err := stream.Recv()
-		log.Infof("watchSwapAddressConfirmations - transactions subscription received new transaction")
+		chainLog.Infof("watchSwapAddressConfirmations - transactions subscription received new transaction")
 		if err != nil {
-			log.Errorf("watchSwapAddressConfirmations - Failed to call SubscribeTransactions %v, %v", stream, err)
+			chainLog.Errorf("watchSwapAddressConfirmations - Failed to call SubscribeTransactions %v, %v", stream, err)
 			return
 		}
 		addresses, err := fetchAllSwapAddresses()
 		if err != nil {
-			log.Errorf("watchSwapAddressConfirmations - Failed to call fetchAllSwapAddresses %v", err)
+			chainLog.Errorf("watchSwapAddressConfirmations - Failed to call fetchAllSwapAddresses %v", err)
 			return
 		}
-		log.Infof("watchSwapAddressConfirmations updating swap addresses")
+		chainLog.Infof("watchSwapAddressConfirmations updating swap addresses")
 		var newConfirmation bool
 		for _, addr := range addresses {
 			updated, err := updateUnspentAmount(addr.Address)
 			if err != nil {
-				log.Criticalf("Unable to call updateUnspentAmount for address %v", addr.Address)
+				chainLog.Criticalf("Unable to call updateUnspentAmount for address %v", addr.Address)
 			}
 			newConfirmation = newConfirmation || updated
 		}
@@ -396,7 +686,7 @@ func updateUnspentAmount(address string) (bool, error) {
 
 		swapInfo.ConfirmedAmount = unspentResponse.Amount //get unsepnt amount
 		if len(unspentResponse.Utxos) > 0 {
-			log.Infof("Updating unspent amount %v for address %v", unspentResponse.Amount, address)
+			chainLog.Infof("Updating unspent amount %v for address %v", unspentResponse.Amount, address)
 			swapInfo.LockHeight = uint32(unspentResponse.LockHeight + unspentResponse.Utxos[0].BlockHeight)
 		}
 
@@ -414,23 +704,23 @@ func updateUnspentAmount(address string) (bool, error) {
 func watchSettledSwapAddresses() {
 	stream, err := lightningClient.SubscribeInvoices(context.Background(), &lnrpc.InvoiceSubscription{})
 	if err != nil {
-		log.Criticalf("watchSettledSwapAddresses failed to call SubscribeInvoices %v, %v", stream, err)
+		chainLog.Criticalf("watchSettledSwapAddresses failed to call SubscribeInvoices %v, %v", stream, err)
 	}
 
 	//then initiate an update for all swap addresses in the db
 	addresses, err := fetchSwapAddresses(func(addr *SwapAddressInfo) bool {
 		return addr.PaidAmount == 0
 	})
-	log.Infof("watchSettledSwapAddresses got these addresses to check: %v", addresses)
+	chainLog.Infof("watchSettledSwapAddresses got these addresses to check: %v", addresses)
 	if err != nil {
-		log.Errorf("failed to call fetchSwapAddresses %v", err)
+		chainLog.Errorf("failed to call fetchSwapAddresses %v", err)
 		return
 	}
 
 	for _, a := range addresses {
 		invoice, err := lightningClient.LookupInvoice(context.Background(), &lnrpc.PaymentHash{RHash: a.PaymentHash})
 		if err != nil {
-			log.Errorf("failed to lookup invoice, %v", err)
+			chainLog.Errorf("failed to lookup invoice, %v", err)
 			continue
 		}
 		if invoice != nil && invoice.Settled {
@@ -439,7 +729,7 @@ func watchSettledSwapAddresses() {
 				return nil
 			})
 			if err != nil {
-				log.Errorf("Failed to update paid amount for address %v", a.Address)
+				chainLog.Errorf("Failed to update paid amount for address %v", a.Address)
 			}
 		}
 	}
@@ -448,21 +738,22 @@ func watchSettledSwapAddresses() {
 	//swap address info
 	for {
 		invoice, err := stream.Recv()
-		log.Infof("watchSettledSwapAddresses - Invoice received by subscription")
+		chainLog.Infof("watchSettledSwapAddresses - Invoice received by subscription")
 		if err != nil {
-			log.Criticalf("watchSettledSwapAddresses - failed to receive an invoice : %v", err)
+			chainLog.Criticalf("watchSettledSwapAddresses - failed to receive an invoice : %v", err)
 			return
 		}
 		if invoice.Settled {
-			log.Infof("watchSettledSwapAddresses - removing paid SwapAddressInfo")
+			chainLog.Infof("watchSettledSwapAddresses - removing paid SwapAddressInfo")
 			_, err := updateSwapAddressByPaymentHash(invoice.RHash, func(addressInfo *SwapAddressInfo) error {
 				addressInfo.PaidAmount = invoice.AmtPaidSat
 				return nil
 			})
 			if err != nil {
-				log.Criticalf("watchSettledSwapAddresses - failed to call updateSwapAddressByPaymentHash : %v", err)
+				chainLog.Criticalf("watchSettledSwapAddresses - failed to call updateSwapAddressByPaymentHash : %v", err)
 				return
 			}
+			updateMoveFundsOperations()
 		}
 	}
 }
@@ -473,10 +764,10 @@ func watchSettledSwapAddresses() {
 //2. Ask the breez server to pay on-chain for funds were sent to him in lightning as part of the
 //   remove funds flow
 func watchSettlePendingTransfers() error {
-	log.Infof("askForIncomingTransfers started")
+	chainLog.Infof("askForIncomingTransfers started")
 	subscription, err := lightningClient.SubscribePeers(context.Background(), &lnrpc.PeerSubscription{})
 	if err != nil {
-		log.Errorf("askForIncomingTransfers - Failed to subscribe peers %v", err)
+		chainLog.Errorf("askForIncomingTransfers - Failed to subscribe peers %v", err)
 		return err
 	}
 	for {
@@ -485,7 +776,7 @@ func watchSettlePendingTransfers() error {
 			return err
 		}
 		if err != nil {
-			log.Errorf("askForIncomingTransfers - subscribe peers Failed to get notification %v", err)
+			chainLog.Errorf("askForIncomingTransfers - subscribe peers Failed to get notification %v", err)
 			continue
 		}
 
@@ -501,15 +792,15 @@ func settlePendingTransfers() {
 }
 
 func getPaymentsForConfirmedTransactions() {
-	log.Infof("getPaymentsForConfirmedTransactions: asking for pending payments")
+	chainLog.Infof("getPaymentsForConfirmedTransactions: asking for pending payments")
 	confirmedAddresses, err := fetchSwapAddresses(func(addr *SwapAddressInfo) bool {
 		return addr.ConfirmedAmount > 0 && addr.PaidAmount == 0
 	})
 	if err != nil {
-		log.Errorf("getPaymentsForConfirmedTransactions: failed to fetch swap addresses %v", err)
+		chainLog.Errorf("getPaymentsForConfirmedTransactions: failed to fetch swap addresses %v", err)
 		return
 	}
-	log.Infof("getPaymentsForConfirmedTransactions: confirmedAddresses length = %v", len(confirmedAddresses))
+	chainLog.Infof("getPaymentsForConfirmedTransactions: confirmedAddresses length = %v", len(confirmedAddresses))
 	for _, address := range confirmedAddresses {
 		getPaymentGroup.Do(fmt.Sprintf("getPayment - %v", address), func() (interface{}, error) {
 			retryGetPayment(address, 3)
@@ -522,10 +813,10 @@ func retryGetPayment(addressInfo *SwapAddressInfo, retries int) {
 	for i := 0; i < retries; i++ {
 		err := getPayment(addressInfo)
 		if err == nil {
-			log.Infof("succeed to get payment for address %v", addressInfo.Address)
+			chainLog.Infof("succeed to get payment for address %v", addressInfo.Address)
 			break
 		}
-		log.Errorf("retryGetPayment - error getting payment in attempt=%v %v", i, err)
+		chainLog.Errorf("retryGetPayment - error getting payment in attempt=%v %v", i, err)
 		time.Sleep(2 * time.Second)
 	}
 }
@@ -577,5 +868,5 @@ func getPayment(addressInfo *SwapAddressInfo) error {
 }
 
 func onUnspentChanged() {
-	notificationsChan <- data.NotificationEvent{Type: data.NotificationEvent_FUND_ADDRESS_UNSPENT_CHANGED}
+	notify(data.NotificationEvent{Type: data.NotificationEvent_FUND_ADDRESS_UNSPENT_CHANGED})
 }
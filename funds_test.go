@@ -0,0 +1,60 @@
+package breez
+
+import (
+	"context"
+	"testing"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnwallet"
+	"google.golang.org/grpc"
+
+	breezservice "github.com/breez/breez/breez"
+)
+
+// swapLimitsFundManager is a fund manager answering GetSwapLimits with fixed
+// limits. The other calls panic through the nil embedded client.
+type swapLimitsFundManager struct {
+	breezservice.FundManagerClient
+	reply  *breezservice.GetSwapLimitsReply
+	nodeID string
+}
+
+func (f *swapLimitsFundManager) GetSwapLimits(ctx context.Context, in *breezservice.GetSwapLimitsRequest, opts ...grpc.CallOption) (*breezservice.GetSwapLimitsReply, error) {
+	f.nodeID = in.NodeID
+	return f.reply, nil
+}
+
+func TestQuerySwapLimits(t *testing.T) {
+	dustLimit := int64(lnwallet.DefaultDustLimit())
+	acc := &data.Account{Id: "node", MaxAllowedToReceive: 50000, MaxAllowedToPay: 300000, MaxPaymentAmount: 200000}
+	tests := []struct {
+		reply    *breezservice.GetSwapLimitsReply
+		expected data.SwapLimits
+	}{
+		{
+			&breezservice.GetSwapLimitsReply{MinSwapIn: 10000, MaxSwapIn: 40000, MinSwapOut: 20000, MaxSwapOut: 100000},
+			data.SwapLimits{MinSwapIn: 10000, MaxSwapIn: 40000, MinSwapOut: 20000, MaxSwapOut: 100000},
+		},
+		{
+			&breezservice.GetSwapLimitsReply{MinSwapIn: 10000, MaxSwapIn: 1000000, MinSwapOut: 20000, MaxSwapOut: 1000000},
+			data.SwapLimits{MinSwapIn: 10000, MaxSwapIn: 50000, MinSwapOut: 20000, MaxSwapOut: 200000},
+		},
+		{
+			&breezservice.GetSwapLimitsReply{MaxSwapIn: 40000, MaxSwapOut: 100000},
+			data.SwapLimits{MinSwapIn: dustLimit, MaxSwapIn: 40000, MinSwapOut: dustLimit, MaxSwapOut: 100000},
+		},
+	}
+	for i, test := range tests {
+		fundManager := &swapLimitsFundManager{reply: test.reply}
+		limits, err := querySwapLimits(context.Background(), fundManager, acc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fundManager.nodeID != acc.Id {
+			t.Errorf("test %v: expected the limits of node %v to be queried, got %q", i, acc.Id, fundManager.nodeID)
+		}
+		if *limits != test.expected {
+			t.Errorf("test %v: expected %v, got %v", i, test.expected, *limits)
+		}
+	}
+}
@@ -10,8 +10,10 @@ require (
 	github.com/grpc-ecosystem/go-grpc-middleware v1.0.0 // indirect
 	github.com/jessevdk/go-flags v1.4.0
 	github.com/status-im/doubleratchet v0.0.0-20181102064121-4dcb6cba284a
+	github.com/tv42/zbase32 v0.0.0-20160707012821-501572607d02
 	github.com/urfave/cli v1.18.0
 	go.etcd.io/bbolt v1.3.0
+	golang.org/x/crypto v0.0.0-20180820150726-614d502a4dac
 	golang.org/x/mobile v0.0.0-20181026062114-a27dd33d354d // indirect
 	golang.org/x/net v0.0.0-20180911220305-26e67e76b6c3
 	golang.org/x/sync v0.0.0-20180314180146-1d60e4601c6f
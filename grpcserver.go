@@ -0,0 +1,259 @@
+package breez
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/breez/breez/data"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// grpcTokenID identifies the gRPC token in the audit log and the security
+// alerts.
+const grpcTokenID = "grpc"
+
+var (
+	grpcTokenMu sync.Mutex
+	grpcToken   string
+)
+
+// apiServer implements the BreezAPI gRPC service by delegating to the
+// package API.
+type apiServer struct{}
+
+func (s *apiServer) GetAccountInfo(ctx context.Context, _ *data.Empty) (*data.Account, error) {
+	return GetAccountInfo()
+}
+
+func (s *apiServer) GetPayments(ctx context.Context, _ *data.Empty) (*data.PaymentsList, error) {
+	return GetPaymentsContext(ctx)
+}
+
+func (s *apiServer) GetPaymentsPage(ctx context.Context, request *data.PaymentsPageRequest) (*data.PaymentsPage, error) {
+	return GetPaymentsPageContext(ctx, request)
+}
+
+func (s *apiServer) SendPaymentForRequest(ctx context.Context, request *data.PayInvoiceRequest) (*data.Empty, error) {
+	var err error
+	if request.FeeLimit != nil {
+		err = SendPaymentWithFeeLimitContext(ctx, request.PaymentRequest, request.Amount, request.FeeLimit)
+	} else {
+		err = SendPaymentForRequestContext(ctx, request.PaymentRequest, request.Amount)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &data.Empty{}, nil
+}
+
+func (s *apiServer) AddInvoice(ctx context.Context, request *data.AddInvoiceRequest) (*data.AddInvoiceReply, error) {
+	return AddInvoiceWithPreimage(request)
+}
+
+func (s *apiServer) DecodePaymentRequest(ctx context.Context, request *data.PaymentRequest) (*data.InvoiceMemo, error) {
+	return DecodePaymentRequest(request.PaymentRequest)
+}
+
+func (s *apiServer) HealthCheck(ctx context.Context, _ *data.Empty) (*data.HealthStatus, error) {
+	return HealthCheck(), nil
+}
+
+func (s *apiServer) SubscribeNotifications(request *data.SubscribeNotificationsRequest, stream data.BreezAPI_SubscribeNotificationsServer) error {
+	subscription := SubscribeNotifications(request.Types...)
+	defer subscription.Unsubscribe()
+	for {
+		select {
+		case event, ok := <-subscription.C:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(&event); err != nil {
+				return err
+			}
+			if request.Ack {
+				if err := AckNotification(event.Id); err != nil {
+					log.Errorf("SubscribeNotifications - failed to acknowledge %v: %v", event.Id, err)
+				}
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-quitChan:
+			return nil
+		}
+	}
+}
+
+/*
+GetGRPCToken returns the token clients must send as a bearer authorization header to call the gRPC
+server: the grpctoken option when it is set, otherwise a token generated on first use.
+*/
+func GetGRPCToken() (string, error) {
+	if cfg != nil && cfg.GRPCToken != "" {
+		return cfg.GRPCToken, nil
+	}
+	grpcTokenMu.Lock()
+	defer grpcTokenMu.Unlock()
+	if grpcToken != "" {
+		return grpcToken, nil
+	}
+	token, err := fetchGRPCToken()
+	if err != nil {
+		return "", err
+	}
+	if token == "" {
+		if token, err = newGRPCToken(); err != nil {
+			return "", err
+		}
+	}
+	grpcToken = token
+	return token, nil
+}
+
+/*
+RotateGRPCToken replaces the generated gRPC token, so clients holding the previous one can't call the
+server anymore, and returns the new token. It has no effect on a token set by the grpctoken option.
+*/
+func RotateGRPCToken() (string, error) {
+	grpcTokenMu.Lock()
+	defer grpcTokenMu.Unlock()
+	token, err := newGRPCToken()
+	if err != nil {
+		return "", err
+	}
+	grpcToken = token
+	return token, nil
+}
+
+// newGRPCToken generates and saves a gRPC token. grpcTokenMu must be held.
+func newGRPCToken() (string, error) {
+	token := make([]byte, httpAPITokenSize)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	encoded := hex.EncodeToString(token)
+	if err := saveGRPCToken(encoded); err != nil {
+		return "", err
+	}
+	return encoded, nil
+}
+
+// currentGRPCToken returns the token loaded by GetGRPCToken, it never creates
+// one so concurrent calls can't race on the creation.
+func currentGRPCToken() string {
+	if cfg != nil && cfg.GRPCToken != "" {
+		return cfg.GRPCToken
+	}
+	grpcTokenMu.Lock()
+	defer grpcTokenMu.Unlock()
+	return grpcToken
+}
+
+// authorizeAPICall checks the call carries the gRPC token as a bearer
+// authorization header. Failures are reported to the kill switch.
+func authorizeAPICall(ctx context.Context) error {
+	token := currentGRPCToken()
+	if token == "" {
+		return status.Error(codes.Unauthenticated, "invalid or missing token")
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	expected := []byte("Bearer " + token)
+	for _, value := range md.Get("authorization") {
+		if subtle.ConstantTimeCompare([]byte(value), expected) == 1 {
+			onAPITokenSuccess(grpcTokenID)
+			return nil
+		}
+	}
+	onAPITokenFailure(grpcTokenID, RotateGRPCToken)
+	return status.Error(codes.Unauthenticated, "invalid or missing token")
+}
+
+// authorizeUnaryCall authorizes the call and attributes the spending it does
+// to the API token in the audit log.
+func authorizeUnaryCall(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := authorizeAPICall(ctx); err != nil {
+		return nil, err
+	}
+	return handler(withSpendInitiator(ctx, data.SpendAuditEntry_API_TOKEN, grpcTokenID), req)
+}
+
+func isLoopbackAddress(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// startGRPCServer serves the BreezAPI service on the configured address until
+// the daemon stops.
+func startGRPCServer() {
+	if cfg.GRPCListen == "" {
+		return
+	}
+	if err := serveGRPC(cfg.GRPCListen); err != nil {
+		log.Errorf("gRPC server stopped: %v", err)
+	}
+}
+
+// grpcServerOptions returns the TLS credentials of the configured certificate.
+// TLS is required on a non loopback address so the token isn't sent in the
+// clear.
+func grpcServerOptions(address string) ([]grpc.ServerOption, error) {
+	if cfg.GRPCTLSCertPath == "" || cfg.GRPCTLSKeyPath == "" {
+		if !isLoopbackAddress(address) {
+			return nil, errors.New("a TLS certificate is required to listen on a non loopback address")
+		}
+		return nil, nil
+	}
+	creds, err := credentials.NewServerTLSFromFile(cfg.GRPCTLSCertPath, cfg.GRPCTLSKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the TLS certificate: %v", err)
+	}
+	return []grpc.ServerOption{grpc.Creds(creds)}, nil
+}
+
+func serveGRPC(address string) error {
+	options, err := grpcServerOptions(address)
+	if err != nil {
+		return err
+	}
+	// The token is loaded once here, calls only read it.
+	if _, err := GetGRPCToken(); err != nil {
+		return fmt.Errorf("failed to get the token: %v", err)
+	}
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %v: %v", address, err)
+	}
+	options = append(options,
+		grpc.UnaryInterceptor(authorizeUnaryCall),
+		grpc.StreamInterceptor(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if err := authorizeAPICall(ss.Context()); err != nil {
+				return err
+			}
+			return handler(srv, ss)
+		}),
+	)
+	server := grpc.NewServer(options...)
+	data.RegisterBreezAPIServer(server, &apiServer{})
+	go func() {
+		<-quitChan
+		server.Stop()
+	}()
+	log.Infof("gRPC server listening on %v", address)
+	return server.Serve(listener)
+}
@@ -0,0 +1,145 @@
+package breez
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
+	"github.com/golang/protobuf/jsonpb"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	defaultHealthMaxBlocksBehind = 3
+	defaultHealthMaxBackupAge    = 24 * time.Hour
+	healthCheckTimeout           = 5 * time.Second
+
+	//blockInterval is the expected time between blocks
+	blockInterval = 10 * time.Minute
+)
+
+var errHealthRollback = errors.New("health check rollback")
+
+func healthMaxBlocksBehind() int64 {
+	if cfg == nil || cfg.HealthMaxBlocksBehind <= 0 {
+		return defaultHealthMaxBlocksBehind
+	}
+	return cfg.HealthMaxBlocksBehind
+}
+
+func healthMaxBackupAge() time.Duration {
+	if cfg == nil || cfg.HealthMaxBackupAge <= 0 {
+		return defaultHealthMaxBackupAge
+	}
+	return cfg.HealthMaxBackupAge
+}
+
+// checkDBWritable writes to the DB in a transaction that is rolled back.
+func checkDBWritable() error {
+	if db == nil {
+		return errors.New("database is not open")
+	}
+	err := db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket([]byte(accountBucket)).Put([]byte("healthCheck"), []byte{1}); err != nil {
+			return err
+		}
+		return errHealthRollback
+	})
+	if err == errHealthRollback {
+		return nil
+	}
+	return err
+}
+
+func checkChain(info *lnrpc.GetInfoResponse) error {
+	behind := int64(time.Since(time.Unix(info.BestHeaderTimestamp, 0)) / blockInterval)
+	if !info.SyncedToChain && behind > healthMaxBlocksBehind() {
+		return fmt.Errorf("chain is about %v blocks behind at height %v", behind, info.BlockHeight)
+	}
+	return nil
+}
+
+func checkBackup() error {
+	lastBackup, err := fetchLastBackupTime()
+	if err != nil {
+		return err
+	}
+	if lastBackup == 0 {
+		return errors.New("no backup was created")
+	}
+	age := time.Since(time.Unix(lastBackup, 0))
+	if age > healthMaxBackupAge() {
+		return fmt.Errorf("last backup is %v old", age.Truncate(time.Second))
+	}
+	return nil
+}
+
+func healthCheckResult(name string, err error) *data.HealthCheckResult {
+	result := &data.HealthCheckResult{Name: name, Healthy: err == nil}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	return result
+}
+
+/*
+HealthCheck runs the composite health check used to monitor headless deployments: the database
+is writable, the lightning daemon responds, the chain is synced within the allowed number of blocks,
+the routing node is connected and the backup is fresh. Healthy is true only if all checks passed.
+*/
+func HealthCheck() *data.HealthStatus {
+	status := &data.HealthStatus{Timestamp: time.Now().Unix()}
+	status.Checks = append(status.Checks, healthCheckResult("database", checkDBWritable()))
+
+	var info *lnrpc.GetInfoResponse
+	lndErr := ErrDaemonNotReady
+	if DaemonReady() {
+		ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+		info, lndErr = lightningClient.GetInfo(ctx, &lnrpc.GetInfoRequest{})
+		cancel()
+	}
+	status.Checks = append(status.Checks, healthCheckResult("lightning", lndErr))
+	chainErr := lndErr
+	if lndErr == nil {
+		chainErr = checkChain(info)
+	}
+	status.Checks = append(status.Checks, healthCheckResult("chain", chainErr))
+	clockErr := lndErr
+	if lndErr == nil {
+		clockErr = checkClockSkew(info)
+	}
+	status.Checks = append(status.Checks, healthCheckResult("clock", clockErr))
+
+	var hubErr error
+	if !isConnectedToRoutingNode() {
+		hubErr = errors.New("not connected to the routing node")
+	}
+	status.Checks = append(status.Checks, healthCheckResult("routingNode", hubErr))
+	status.Checks = append(status.Checks, healthCheckResult("backup", checkBackup()))
+
+	status.Healthy = true
+	for _, c := range status.Checks {
+		status.Healthy = status.Healthy && c.Healthy
+	}
+	return status
+}
+
+/*
+HealthHandler serves the health check as JSON for load balancers and uptime monitors.
+It responds with 200 when healthy and 503 otherwise.
+*/
+func HealthHandler(w http.ResponseWriter, r *http.Request) {
+	status := HealthCheck()
+	w.Header().Set("Content-Type", "application/json")
+	if !status.Healthy {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	m := jsonpb.Marshaler{EmitDefaults: true}
+	if err := m.Marshal(w, status); err != nil {
+		log.Errorf("HealthHandler - failed to write status: %v", err)
+	}
+}
@@ -0,0 +1,157 @@
+package breez
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/breez/breez/data"
+)
+
+// settlementAction is executed for every received payment that matches a rule.
+// It returns a short description of what was done for the audit trail.
+type settlementAction interface {
+	execute(rule *data.SettlementRule, payment *paymentInfo) (string, error)
+}
+
+var settlementActions = map[data.SettlementRule_Action]settlementAction{
+	data.SettlementRule_NOTIFY_SERVICE:  notifyServiceAction{},
+	data.SettlementRule_MOVE_TO_SAVINGS: moveToSavingsAction{},
+}
+
+// notifyServiceAction posts the received payment to the rule URL, e.g. a hedging service.
+type notifyServiceAction struct{}
+
+func (notifyServiceAction) execute(rule *data.SettlementRule, payment *paymentInfo) (string, error) {
+	client, err := getHTTPClient()
+	if err != nil {
+		return "", err
+	}
+	body, err := json.Marshal(struct {
+		PaymentHash string `json:"paymentHash"`
+		Amount      int64  `json:"amount"`
+		Timestamp   int64  `json:"timestamp"`
+	}{payment.PaymentHash, payment.Amount, payment.CreationTimestamp})
+	if err != nil {
+		return "", err
+	}
+	resp, err := client.Post(rule.Url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return "", fmt.Errorf("service replied with status %v", resp.StatusCode)
+	}
+	return fmt.Sprintf("notified %v", rule.Url), nil
+}
+
+// moveToSavingsAction adds a percentage of the received amount to the savings sub-balance.
+type moveToSavingsAction struct{}
+
+func (moveToSavingsAction) execute(rule *data.SettlementRule, payment *paymentInfo) (string, error) {
+	share := payment.Amount * int64(rule.Percent) / 100
+	if share <= 0 {
+		return "nothing to move", nil
+	}
+	if err := addSavings(share); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("moved %v to savings", share), nil
+}
+
+func serializeSettlementRule(r *data.SettlementRule) ([]byte, error) {
+	return json.Marshal(r)
+}
+
+func deserializeSettlementRule(ruleBytes []byte) (*data.SettlementRule, error) {
+	var rule data.SettlementRule
+	err := json.Unmarshal(ruleBytes, &rule)
+	return &rule, err
+}
+
+/*
+AddSettlementRule adds a rule that triggers an action on every received payment
+of at least the rule minimum amount. It returns the rule id.
+*/
+func AddSettlementRule(rule *data.SettlementRule) (uint64, error) {
+	if _, ok := settlementActions[rule.Action]; !ok {
+		return 0, errors.New("unknown action")
+	}
+	if rule.Action == data.SettlementRule_MOVE_TO_SAVINGS && (rule.Percent <= 0 || rule.Percent > 100) {
+		return 0, errors.New("percent must be between 1 and 100")
+	}
+	if rule.Action == data.SettlementRule_NOTIFY_SERVICE && rule.Url == "" {
+		return 0, errors.New("missing service url")
+	}
+	if err := addSettlementRule(rule); err != nil {
+		return 0, err
+	}
+	return rule.Id, nil
+}
+
+/*
+RemoveSettlementRule removes the rule with the given id.
+*/
+func RemoveSettlementRule(id uint64) error {
+	return deleteSettlementRule(id)
+}
+
+/*
+GetSettlementRules returns all the configured settlement rules.
+*/
+func GetSettlementRules() (*data.SettlementRulesList, error) {
+	rules, err := fetchSettlementRules()
+	if err != nil {
+		return nil, err
+	}
+	return &data.SettlementRulesList{Rules: rules}, nil
+}
+
+/*
+GetSettlementAudit returns the audit trail of the actions executed by the settlement rules.
+*/
+func GetSettlementAudit() (*data.SettlementAuditList, error) {
+	entries, err := fetchSettlementAudit()
+	if err != nil {
+		return nil, err
+	}
+	return &data.SettlementAuditList{Entries: entries}, nil
+}
+
+// runSettlementHooks executes the matching rules for a received payment
+// and records the outcome of each one.
+func runSettlementHooks(payment *paymentInfo) {
+	rules, err := fetchSettlementRules()
+	if err != nil {
+		log.Errorf("runSettlementHooks - failed to fetch rules %v", err)
+		return
+	}
+	changed := false
+	for _, rule := range rules {
+		if !rule.Enabled || payment.Amount < rule.MinAmount {
+			continue
+		}
+		entry := &data.SettlementAuditEntry{
+			RuleID:      rule.Id,
+			Action:      rule.Action,
+			PaymentHash: payment.PaymentHash,
+			Timestamp:   trustedNow().Unix(),
+		}
+		result, err := settlementActions[rule.Action].execute(rule, payment)
+		if err != nil {
+			log.Errorf("runSettlementHooks - rule %v failed for %v: %v", rule.Id, payment.PaymentHash, err)
+			entry.ErrorMessage = err.Error()
+		} else {
+			entry.Result = result
+			changed = changed || rule.Action == data.SettlementRule_MOVE_TO_SAVINGS
+		}
+		if err := addSettlementAuditEntry(entry); err != nil {
+			log.Errorf("runSettlementHooks - failed to add audit entry %v", err)
+		}
+	}
+	if changed {
+		onAccountChanged()
+	}
+}
@@ -0,0 +1,251 @@
+package breez
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
+)
+
+const (
+	htlcPollInterval = 5 * time.Second
+
+	//htlcEventsKept is the number of recent events kept when persistence is enabled
+	htlcEventsKept = 500
+
+	//htlcEventsBuffer is the number of events a slow subscriber can lag behind before
+	//events are dropped for it
+	htlcEventsBuffer = 100
+)
+
+var (
+	htlcSubscribersMu sync.Mutex
+	htlcSubscribers   = make(map[chan *data.HTLCEvent]struct{})
+)
+
+// pendingHTLC identifies an HTLC pending in one of the channels.
+type pendingHTLC struct {
+	chanID      uint64
+	paymentHash string
+	incoming    bool
+}
+
+type htlcEvent struct {
+	Timestamp        int64
+	Type             data.HTLCEvent_EventType
+	Incoming         bool
+	Forward          bool
+	Amount           int64
+	PaymentHash      string
+	ChanID           uint64
+	ExpirationHeight uint32
+}
+
+func serializeHTLCEvent(e *htlcEvent) ([]byte, error) {
+	return json.Marshal(e)
+}
+
+func deserializeHTLCEvent(eventBytes []byte) (*htlcEvent, error) {
+	var e htlcEvent
+	err := json.Unmarshal(eventBytes, &e)
+	return &e, err
+}
+
+func (e *htlcEvent) toProto() *data.HTLCEvent {
+	return &data.HTLCEvent{
+		Timestamp:        e.Timestamp,
+		Type:             e.Type,
+		Incoming:         e.Incoming,
+		Forward:          e.Forward,
+		Amount:           e.Amount,
+		PaymentHash:      e.PaymentHash,
+		ChanId:           e.ChanID,
+		ExpirationHeight: e.ExpirationHeight,
+	}
+}
+
+/*
+SubscribeHTLCEvents returns a channel receiving the HTLC events of the node: an HTLC added to a channel,
+settled or failed. The lightning daemon doesn't stream HTLC events so they are derived from the pending
+HTLCs of the channels, which means failures don't carry the failure code. The returned function cancels
+the subscription.
+*/
+func SubscribeHTLCEvents() (<-chan *data.HTLCEvent, func()) {
+	c := make(chan *data.HTLCEvent, htlcEventsBuffer)
+	htlcSubscribersMu.Lock()
+	htlcSubscribers[c] = struct{}{}
+	htlcSubscribersMu.Unlock()
+	return c, func() {
+		htlcSubscribersMu.Lock()
+		defer htlcSubscribersMu.Unlock()
+		if _, ok := htlcSubscribers[c]; ok {
+			delete(htlcSubscribers, c)
+			close(c)
+		}
+	}
+}
+
+/*
+GetRecentHTLCEvents returns the persisted HTLC events, newest first. Events are persisted only when the
+persisthtlcevents configuration is set.
+*/
+func GetRecentHTLCEvents() (*data.HTLCEvents, error) {
+	events, err := fetchHTLCEvents()
+	if err != nil {
+		return nil, err
+	}
+	result := &data.HTLCEvents{}
+	for _, e := range events {
+		result.Events = append(result.Events, e.toProto())
+	}
+	return result, nil
+}
+
+func persistHTLCEvents() bool {
+	return cfg != nil && cfg.PersistHTLCEvents
+}
+
+func hasHTLCSubscribers() bool {
+	htlcSubscribersMu.Lock()
+	defer htlcSubscribersMu.Unlock()
+	return len(htlcSubscribers) > 0
+}
+
+func publishHTLCEvent(e *htlcEvent) {
+	if persistHTLCEvents() {
+		if err := addHTLCEvent(e, htlcEventsKept); err != nil {
+			paymentsLog.Errorf("publishHTLCEvent - failed to save event: %v", err)
+		}
+	}
+	htlcSubscribersMu.Lock()
+	defer htlcSubscribersMu.Unlock()
+	for c := range htlcSubscribers {
+		select {
+		case c <- e.toProto():
+		default:
+			paymentsLog.Warnf("publishHTLCEvent - subscriber is lagging, dropping event for %v", e.PaymentHash)
+		}
+	}
+}
+
+// watchHTLCEvents polls the pending HTLCs while someone listens to the events
+// or they are persisted, and publishes the HTLCs that were added or resolved.
+func watchHTLCEvents() {
+	ticker := time.NewTicker(htlcPollInterval)
+	defer ticker.Stop()
+	var pending map[pendingHTLC]*lnrpc.HTLC
+	for {
+		select {
+		case <-ticker.C:
+		case <-quitChan:
+			return
+		}
+		if !DaemonReady() || (!hasHTLCSubscribers() && !persistHTLCEvents()) {
+			pending = nil
+			continue
+		}
+		current, err := fetchPendingHTLCs()
+		if err != nil {
+			paymentsLog.Errorf("watchHTLCEvents - failed to list the pending HTLCs: %v", err)
+			continue
+		}
+		if pending != nil {
+			diffHTLCs(pending, current)
+		}
+		pending = current
+	}
+}
+
+func fetchPendingHTLCs() (map[pendingHTLC]*lnrpc.HTLC, error) {
+	channels, err := lightningClient.ListChannels(context.Background(), &lnrpc.ListChannelsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	htlcs := make(map[pendingHTLC]*lnrpc.HTLC)
+	for _, c := range channels.Channels {
+		for _, htlc := range c.PendingHtlcs {
+			htlcs[pendingHTLC{chanID: c.ChanId, paymentHash: hex.EncodeToString(htlc.HashLock), incoming: htlc.Incoming}] = htlc
+		}
+	}
+	return htlcs, nil
+}
+
+// diffHTLCs publishes the HTLCs added since the previous poll and the outcome
+// of the ones which are not pending anymore. An HTLC pending in both directions
+// is a forward.
+func diffHTLCs(previous, current map[pendingHTLC]*lnrpc.HTLC) {
+	now := trustedNow().Unix()
+	isForward := func(htlcs map[pendingHTLC]*lnrpc.HTLC, key pendingHTLC) bool {
+		for k := range htlcs {
+			if k.paymentHash == key.paymentHash && k.incoming != key.incoming {
+				return true
+			}
+		}
+		return false
+	}
+	for key, htlc := range current {
+		if _, ok := previous[key]; ok {
+			continue
+		}
+		publishHTLCEvent(newHTLCEvent(key, htlc, data.HTLCEvent_ADDED, isForward(current, key), now))
+	}
+	for key, htlc := range previous {
+		if _, ok := current[key]; ok {
+			continue
+		}
+		eventType := data.HTLCEvent_FAILED
+		settled, err := htlcSettled(key)
+		if err != nil {
+			paymentsLog.Errorf("diffHTLCs - failed to resolve HTLC %v: %v", key.paymentHash, err)
+			eventType = data.HTLCEvent_RESOLVED
+		} else if settled {
+			eventType = data.HTLCEvent_SETTLED
+		}
+		publishHTLCEvent(newHTLCEvent(key, htlc, eventType, isForward(previous, key), now))
+	}
+}
+
+func newHTLCEvent(key pendingHTLC, htlc *lnrpc.HTLC, eventType data.HTLCEvent_EventType, forward bool, timestamp int64) *htlcEvent {
+	return &htlcEvent{
+		Timestamp:        timestamp,
+		Type:             eventType,
+		Incoming:         key.incoming,
+		Forward:          forward,
+		Amount:           htlc.Amount,
+		PaymentHash:      key.paymentHash,
+		ChanID:           key.chanID,
+		ExpirationHeight: htlc.ExpirationHeight,
+	}
+}
+
+// htlcSettled returns true if the HTLC which is not pending anymore was settled:
+// its invoice was paid for an incoming HTLC or the payment completed for an
+// outgoing one.
+func htlcSettled(key pendingHTLC) (bool, error) {
+	hash, err := hex.DecodeString(key.paymentHash)
+	if err != nil {
+		return false, err
+	}
+	if key.incoming {
+		invoice, err := lightningClient.LookupInvoice(context.Background(), &lnrpc.PaymentHash{RHash: hash})
+		if err != nil {
+			return false, fmt.Errorf("failed to lookup invoice: %v", err)
+		}
+		return invoice.Settled, nil
+	}
+	payments, err := lightningClient.ListPayments(context.Background(), &lnrpc.ListPaymentsRequest{})
+	if err != nil {
+		return false, err
+	}
+	for _, p := range payments.Payments {
+		if p.PaymentHash == key.paymentHash {
+			return true, nil
+		}
+	}
+	return false, nil
+}
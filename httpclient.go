@@ -0,0 +1,165 @@
+package breez
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultHTTPTimeout = 30 * time.Second
+)
+
+var (
+	httpClientMu  sync.Mutex
+	httpClient    *http.Client
+	httpClientCfg *Config
+)
+
+// headerTransport enforces the hosts allowlist and routes the Breez services
+// through the pinned transport, adding the configured headers to their
+// requests only so they don't leak to other hosts.
+type headerTransport struct {
+	base         http.RoundTripper
+	pinnedHosts  map[string]http.RoundTripper
+	headers      map[string]string
+	allowedHosts []string
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	host := req.URL.Hostname()
+	pinned, isPinned := t.pinnedHosts[host]
+	if !isPinned && !isHostAllowed(host, t.allowedHosts) {
+		return nil, fmt.Errorf("http: host %v is not allowed", host)
+	}
+	if isPinned && len(t.headers) > 0 {
+		r := new(http.Request)
+		*r = *req
+		r.Header = make(http.Header, len(req.Header))
+		for k, v := range req.Header {
+			r.Header[k] = v
+		}
+		for k, v := range t.headers {
+			if r.Header.Get(k) == "" {
+				r.Header.Set(k, v)
+			}
+		}
+		req = r
+	}
+	if isPinned {
+		return pinned.RoundTrip(req)
+	}
+	return t.base.RoundTrip(req)
+}
+
+func isHostAllowed(host string, allowedHosts []string) bool {
+	if len(allowedHosts) == 0 {
+		return true
+	}
+	for _, h := range allowedHosts {
+		if host == h || strings.HasSuffix(host, "."+h) {
+			return true
+		}
+	}
+	return false
+}
+
+// breezServiceHosts returns the hosts of the Breez services, which are pinned.
+func breezServiceHosts() []string {
+	if cfg == nil {
+		return nil
+	}
+	var hosts []string
+	for _, h := range append([]string{cfg.BreezServer}, cfg.PinnedHosts...) {
+		if h == "" {
+			continue
+		}
+		if host, _, err := net.SplitHostPort(h); err == nil {
+			h = host
+		}
+		hosts = append(hosts, h)
+	}
+	return hosts
+}
+
+func newTransport(proxy func(*http.Request) (*url.URL, error), tlsConfig *tls.Config) *http.Transport {
+	return &http.Transport{
+		Proxy:               proxy,
+		TLSClientConfig:     tlsConfig,
+		TLSHandshakeTimeout: 10 * time.Second,
+		IdleConnTimeout:     90 * time.Second,
+	}
+}
+
+func newHTTPClient() (*http.Client, error) {
+	proxy := http.ProxyFromEnvironment
+	timeout := defaultHTTPTimeout
+	var headers map[string]string
+	var allowedHosts []string
+	if cfg != nil {
+		if cfg.HTTPProxy != "" {
+			proxyURL, err := url.Parse(cfg.HTTPProxy)
+			if err != nil {
+				return nil, fmt.Errorf("http: invalid proxy %v: %v", cfg.HTTPProxy, err)
+			}
+			proxy = http.ProxyURL(proxyURL)
+		}
+		if cfg.HTTPTimeout > 0 {
+			timeout = cfg.HTTPTimeout
+		}
+		headers = cfg.HTTPHeaders
+		allowedHosts = cfg.HTTPAllowedHosts
+	}
+	pinnedHosts := make(map[string]http.RoundTripper)
+	for _, h := range breezServiceHosts() {
+		tlsConfig, err := pinnedTLSConfig(h)
+		if err != nil {
+			return nil, err
+		}
+		pinnedHosts[h] = newTransport(proxy, tlsConfig)
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &headerTransport{
+			base:         newTransport(proxy, nil),
+			pinnedHosts:  pinnedHosts,
+			headers:      headers,
+			allowedHosts: allowedHosts,
+		},
+	}, nil
+}
+
+/*
+getHTTPClient returns the shared client that every outbound web call (LNURL, avatars,
+rate providers) should use so proxy, pinning, timeouts and the allowlist apply everywhere.
+The client is built again when the configuration is loaded again.
+*/
+func getHTTPClient() (*http.Client, error) {
+	httpClientMu.Lock()
+	defer httpClientMu.Unlock()
+	if httpClient == nil || httpClientCfg != cfg {
+		client, err := newHTTPClient()
+		if err != nil {
+			return nil, err
+		}
+		httpClient, httpClientCfg = client, cfg
+	}
+	return httpClient, nil
+}
+
+// getWebhookHTTPClient returns the client of the merchant webhooks, the shared
+// client without the configured headers, which are meant for the Breez services.
+func getWebhookHTTPClient() (*http.Client, error) {
+	client, err := getHTTPClient()
+	if err != nil {
+		return nil, err
+	}
+	transport := *client.Transport.(*headerTransport)
+	transport.headers = nil
+	return &http.Client{Timeout: client.Timeout, Transport: &transport}, nil
+}
@@ -0,0 +1,57 @@
+package breez
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHeaderTransportScopesHeaders(t *testing.T) {
+	var received []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Get("Authorization"))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &headerTransport{
+		base:        http.DefaultTransport,
+		pinnedHosts: map[string]http.RoundTripper{"localhost": http.DefaultTransport},
+		headers:     map[string]string{"Authorization": "secret"},
+	}}
+	for _, url := range []string{server.URL, strings.Replace(server.URL, "127.0.0.1", "localhost", 1)} {
+		resp, err := client.Get(url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	if len(received) != 2 || received[0] != "" || received[1] != "secret" {
+		t.Errorf("expected the headers to be sent to the Breez services only, got %q", received)
+	}
+}
+
+func TestGetHTTPClientFollowsConfig(t *testing.T) {
+	previousCfg := cfg
+	defer func() { cfg = previousCfg }()
+
+	cfg = &Config{}
+	first, err := getHTTPClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if same, _ := getHTTPClient(); same != first {
+		t.Error("expected the client to be shared while the configuration is the same")
+	}
+	cfg = &Config{HTTPHeaders: map[string]string{"Authorization": "secret"}}
+	second, err := getHTTPClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second == first {
+		t.Error("expected a new client for the new configuration")
+	}
+	if headers := second.Transport.(*headerTransport).headers; headers["Authorization"] != "secret" {
+		t.Errorf("expected the new client to have the configured headers, got %v", headers)
+	}
+}
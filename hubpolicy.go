@@ -0,0 +1,165 @@
+package breez
+
+import (
+	"context"
+	"time"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
+)
+
+const (
+	hubPolicyInitialBackoff = 5 * time.Second
+	hubPolicyMaxBackoff     = 10 * time.Minute
+
+	//hubFeeReferenceAmount is the payment amount, in satoshi, the fees are compared at
+	hubFeeReferenceAmount = 10000
+
+	//hubFeeAlertRatio is the increase of the fee at the reference amount that is alerted
+	hubFeeAlertRatio = 1.2
+
+	hubPolicyDisabled      = "disabled"
+	hubPolicyMinHTLCRaised = "min_htlc_raised"
+	hubPolicyFeesRaised    = "fees_raised"
+)
+
+// hubFeeMsat returns the fee the hub charges to forward amount satoshi to us.
+func hubFeeMsat(policy *data.HubPolicy, amount int64) int64 {
+	return policy.FeeBaseMsat + amount*1000*policy.FeeRateMilliMsat/1000000
+}
+
+// hubPolicyAlerts returns the reasons the new policy affects receiving
+// compared to the previous one.
+func hubPolicyAlerts(previous, current *data.HubPolicy) []string {
+	var alerts []string
+	if current.Disabled && !previous.Disabled {
+		alerts = append(alerts, hubPolicyDisabled)
+	}
+	if current.MinHtlc > previous.MinHtlc {
+		alerts = append(alerts, hubPolicyMinHTLCRaised)
+	}
+	previousFee := hubFeeMsat(previous, hubFeeReferenceAmount)
+	currentFee := hubFeeMsat(current, hubFeeReferenceAmount)
+	if currentFee > previousFee && float64(currentFee) >= float64(previousFee)*hubFeeAlertRatio {
+		alerts = append(alerts, hubPolicyFeesRaised)
+	}
+	return alerts
+}
+
+/*
+GetHubPolicy returns the last known forwarding policy of the routing node toward this node: the fees
+it charges to forward the payments we receive, the minimum HTLC and whether the channel is disabled.
+*/
+func GetHubPolicy() (*data.HubPolicy, error) {
+	policy, err := fetchHubPolicy()
+	if err != nil || policy == nil {
+		return &data.HubPolicy{}, err
+	}
+	return policy, nil
+}
+
+// currentHubPolicy reads the policy of the routing node in the channel with it.
+func currentHubPolicy() (*data.HubPolicy, error) {
+	chanIDs, err := getBreezOpenChannelsPoints()
+	if err != nil || len(chanIDs) == 0 {
+		return nil, err
+	}
+	edge, err := lightningClient.GetChanInfo(context.Background(), &lnrpc.ChanInfoRequest{ChanId: chanIDs[0]})
+	if err != nil {
+		return nil, err
+	}
+	routingPolicy := edge.Node1Policy
+	if edge.Node2Pub == cfg.RoutingNodePubKey {
+		routingPolicy = edge.Node2Policy
+	}
+	if routingPolicy == nil {
+		return nil, nil
+	}
+	return &data.HubPolicy{
+		ChanId:           chanIDs[0],
+		FeeBaseMsat:      routingPolicy.FeeBaseMsat,
+		FeeRateMilliMsat: routingPolicy.FeeRateMilliMsat,
+		MinHtlc:          routingPolicy.MinHtlc,
+		TimeLockDelta:    routingPolicy.TimeLockDelta,
+		Disabled:         routingPolicy.Disabled,
+		Timestamp:        trustedNow().Unix(),
+	}, nil
+}
+
+// checkHubPolicy saves the current policy of the routing node and alerts the
+// app when the change affects receiving.
+func checkHubPolicy() {
+	current, err := currentHubPolicy()
+	if err != nil {
+		log.Errorf("checkHubPolicy - failed to read the routing node policy: %v", err)
+		return
+	}
+	if current == nil {
+		return
+	}
+	previous, err := fetchHubPolicy()
+	if err != nil {
+		log.Errorf("checkHubPolicy - failed to fetch the previous policy: %v", err)
+		return
+	}
+	if err := saveHubPolicy(current); err != nil {
+		log.Errorf("checkHubPolicy - failed to save the policy: %v", err)
+	}
+	if previous == nil || previous.ChanId != current.ChanId {
+		return
+	}
+	if alerts := hubPolicyAlerts(previous, current); len(alerts) > 0 {
+		log.Warnf("checkHubPolicy - routing node policy changed: %v", alerts)
+		notify(data.NotificationEvent{Type: data.NotificationEvent_HUB_POLICY_CHANGED, Data: alerts})
+	}
+}
+
+// watchHubPolicy checks the routing node policy on startup and on every
+// update of a channel advertised by the routing node.
+func watchHubPolicy() {
+	checkHubPolicy()
+	policy := &retryPolicy{initialBackoff: hubPolicyInitialBackoff, maxBackoff: hubPolicyMaxBackoff}
+	for retry := 1; ; retry++ {
+		updates, err := receiveHubPolicyUpdates()
+		if updates > 0 {
+			retry = 1
+		}
+		delay := policy.backoff(retry)
+		log.Errorf("watchHubPolicy - graph subscription ended: %v, resubscribing in %v", err, delay)
+		select {
+		case <-time.After(delay):
+		case <-quitChan:
+			return
+		}
+	}
+}
+
+func receiveHubPolicyUpdates() (int, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-quitChan:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	stream, err := lightningClient.SubscribeChannelGraph(ctx, &lnrpc.GraphTopologySubscription{})
+	if err != nil {
+		return 0, err
+	}
+	var updates int
+	for {
+		update, err := stream.Recv()
+		if err != nil {
+			return updates, err
+		}
+		for _, u := range update.ChannelUpdates {
+			if u.AdvertisingNode == cfg.RoutingNodePubKey {
+				updates++
+				checkHubPolicy()
+				break
+			}
+		}
+	}
+}
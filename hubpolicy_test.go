@@ -0,0 +1,27 @@
+package breez
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/breez/breez/data"
+)
+
+func TestHubPolicyAlerts(t *testing.T) {
+	previous := &data.HubPolicy{FeeBaseMsat: 1000, FeeRateMilliMsat: 1, MinHtlc: 1000}
+	tests := []struct {
+		current *data.HubPolicy
+		alerts  []string
+	}{
+		{&data.HubPolicy{FeeBaseMsat: 1000, FeeRateMilliMsat: 1, MinHtlc: 1000}, nil},
+		{&data.HubPolicy{FeeBaseMsat: 1100, FeeRateMilliMsat: 1, MinHtlc: 1000}, nil},
+		{&data.HubPolicy{FeeBaseMsat: 1000, FeeRateMilliMsat: 100, MinHtlc: 1000}, []string{hubPolicyFeesRaised}},
+		{&data.HubPolicy{FeeBaseMsat: 0, FeeRateMilliMsat: 1, MinHtlc: 1000, Disabled: true}, []string{hubPolicyDisabled}},
+		{&data.HubPolicy{FeeBaseMsat: 1000, FeeRateMilliMsat: 1, MinHtlc: 5000}, []string{hubPolicyMinHTLCRaised}},
+	}
+	for i, test := range tests {
+		if alerts := hubPolicyAlerts(previous, test.current); !reflect.DeepEqual(alerts, test.alerts) {
+			t.Errorf("%v: expected %v got %v", i, test.alerts, alerts)
+		}
+	}
+}
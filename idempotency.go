@@ -0,0 +1,156 @@
+package breez
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
+)
+
+var (
+	// ErrAlreadyPaid is returned when sending a payment request that was already paid.
+	ErrAlreadyPaid = errors.New("payment request was already paid")
+
+	// ErrPaymentInFlight is returned when sending a payment request that is being paid.
+	ErrPaymentInFlight = errors.New("payment is already in flight")
+
+	sendingMu       sync.Mutex
+	sendingPayments = make(map[string]bool)
+)
+
+type idempotentPayment struct {
+	PaymentHash string
+	Status      data.PaymentStatus_Status
+	Error       string
+}
+
+// beginSend reserves the payment hash for a send, failing if it is being sent
+// by another call, has an outgoing HTLC in the channels or was already paid.
+func beginSend(ctx context.Context, paymentHash string) error {
+	sendingMu.Lock()
+	if sendingPayments[paymentHash] {
+		sendingMu.Unlock()
+		return ErrPaymentInFlight
+	}
+	sendingPayments[paymentHash] = true
+	sendingMu.Unlock()
+
+	err := checkDuplicatePayment(ctx, paymentHash)
+	if err != nil {
+		endSend(paymentHash)
+	}
+	return err
+}
+
+func endSend(paymentHash string) {
+	sendingMu.Lock()
+	defer sendingMu.Unlock()
+	delete(sendingPayments, paymentHash)
+}
+
+// checkDuplicatePayment returns ErrAlreadyPaid if the payment is in the history and
+// ErrPaymentInFlight if an outgoing HTLC for it is pending, for instance after the
+// app was restarted in the middle of the payment.
+func checkDuplicatePayment(ctx context.Context, paymentHash string) error {
+	paid, err := hasPayment(paymentHash)
+	if err != nil {
+		return err
+	}
+	if paid {
+		return ErrAlreadyPaid
+	}
+	if !DaemonReady() {
+		return nil
+	}
+	hash, err := hex.DecodeString(paymentHash)
+	if err != nil {
+		return err
+	}
+	channels, err := lightningClient.ListChannels(ctx, &lnrpc.ListChannelsRequest{})
+	if err != nil {
+		return err
+	}
+	for _, c := range channels.Channels {
+		for _, htlc := range c.PendingHtlcs {
+			if !htlc.Incoming && bytes.Equal(htlc.HashLock, hash) {
+				return ErrPaymentInFlight
+			}
+		}
+	}
+	return nil
+}
+
+/*
+SendPaymentWithIdempotencyKey is SendPaymentForRequest for callers that may retry the same payment, for
+instance after the app was restarted. The first call with a key sends the payment and records its outcome,
+the next calls with the same key return that outcome without paying again. Only a failed payment is sent
+again. A key can't be reused for another payment request.
+*/
+func SendPaymentWithIdempotencyKey(paymentRequest string, amountSatoshi int64, idempotencyKey string) error {
+	if idempotencyKey == "" {
+		return errors.New("idempotency key is required")
+	}
+	decodedReq, err := decodePayReqLocally(paymentRequest)
+	if err != nil {
+		return err
+	}
+	previous, err := fetchIdempotentPayment(idempotencyKey)
+	if err != nil {
+		return err
+	}
+	if previous != nil {
+		if previous.PaymentHash != decodedReq.PaymentHash {
+			return fmt.Errorf("idempotency key %v was used for another payment", idempotencyKey)
+		}
+		switch previous.Status {
+		case data.PaymentStatus_SUCCEEDED:
+			return nil
+		case data.PaymentStatus_IN_FLIGHT:
+			//the app may have been killed while sending, the send checks tell if it went through
+			paymentsLog.Infof("SendPaymentWithIdempotencyKey - payment %v was left in flight", previous.PaymentHash)
+		}
+	}
+
+	record := &idempotentPayment{PaymentHash: decodedReq.PaymentHash, Status: data.PaymentStatus_IN_FLIGHT}
+	if err := saveIdempotentPayment(idempotencyKey, record); err != nil {
+		return err
+	}
+	err = sendPaymentForRequest(context.Background(), paymentRequest, amountSatoshi, 0)
+	switch err {
+	case nil, ErrAlreadyPaid:
+		record.Status = data.PaymentStatus_SUCCEEDED
+		err = nil
+	case ErrPaymentInFlight:
+	default:
+		record.Status = data.PaymentStatus_FAILED
+		record.Error = err.Error()
+	}
+	if saveErr := saveIdempotentPayment(idempotencyKey, record); saveErr != nil {
+		paymentsLog.Errorf("SendPaymentWithIdempotencyKey - failed to save the outcome of %v: %v", record.PaymentHash, saveErr)
+	}
+	return err
+}
+
+func serializeIdempotentPayment(p *idempotentPayment) ([]byte, error) {
+	paymentBytes, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return sealDBValue(paymentBytes)
+}
+
+func deserializeIdempotentPayment(paymentBytes []byte) (*idempotentPayment, error) {
+	var p idempotentPayment
+	paymentBytes, err := openDBValue(paymentBytes)
+	if err != nil {
+		return &p, err
+	}
+	err = json.Unmarshal(paymentBytes, &p)
+	return &p, err
+}
@@ -4,11 +4,9 @@ package breez
 
 import (
 	"context"
-	"crypto/x509"
 	"errors"
 	"fmt"
 	"path"
-	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -73,6 +71,8 @@ var (
 	isReady                      int32
 	started                      int32
 	quitChan                     chan struct{}
+	//stoppedChan is closed once breez stopped and closed the DB, after quitChan
+	stoppedChan chan struct{}
 )
 
 type Config struct {
@@ -82,6 +82,75 @@ type Config struct {
 	Network           string `long:"network"`
 	GrpcKeepAlive     bool   `long:"grpckeepalive"`
 	BootstrapURL      string `long:"bootstrap"`
+
+	//SavingsCoolingOff is the delay between requesting to unlock savings and the funds being spendable
+	SavingsCoolingOff time.Duration `long:"savingscoolingoff"`
+
+	//HTTP client settings used for all outbound web calls, the headers are only sent to the Breez services
+	HTTPProxy        string            `long:"httpproxy"`
+	HTTPTimeout      time.Duration     `long:"httptimeout"`
+	HTTPAllowedHosts []string          `long:"httpallowedhost"`
+	HTTPHeaders      map[string]string `long:"httpheader"`
+
+	//PinnedHosts are additional Breez endpoints (backup, rates) validated against the pin set.
+	//ServicePins are the base64 SHA256 hashes of the leaf or intermediate public keys of the Breez
+	//services, used until a signed pin set replaces them. Without pins the keys each service
+	//presents on its first connection are pinned. DisableServicePinning turns pinning off, for
+	//test networks only.
+	PinnedHosts           []string `long:"pinnedhost"`
+	ServicePins           []string `long:"servicepin"`
+	PinSigningKey         string   `long:"pinsigningkey"`
+	DisableServicePinning bool     `long:"disableservicepinning"`
+
+	//health check thresholds
+	HealthMaxBlocksBehind int64         `long:"healthmaxblocksbehind"`
+	HealthMaxBackupAge    time.Duration `long:"healthmaxbackupage"`
+
+	//fiat rates providers, returning the rates by currency code, aggregated by median
+	RatesProviders []string      `long:"ratesprovider"`
+	RatesInterval  time.Duration `long:"ratesinterval"`
+
+	//DeveloperMode enables the testing tools such as the payments fault injection
+	DeveloperMode bool `long:"developermode"`
+
+	//PersistHTLCEvents keeps the recent HTLC events for diagnosing failed payments
+	PersistHTLCEvents bool `long:"persisthtlcevents"`
+
+	//default retry policy of SendPaymentWithRetry
+	RetryMaxAttempts    int           `long:"retrymaxattempts"`
+	RetryInitialBackoff time.Duration `long:"retryinitialbackoff"`
+	RetryMaxBackoff     time.Duration `long:"retrymaxbackoff"`
+
+	//MinChannelSize is the capacity in satoshi below which routing node channels are consolidated
+	MinChannelSize int64 `long:"minchannelsize"`
+
+	//PairingMaxFailures is the number of failed or denied requests after which a pairing session is suspended
+	PairingMaxFailures int32 `long:"pairingmaxfailures"`
+
+	//GRPCListen is the address of the optional gRPC server exposing the breez API, disabled when empty.
+	//Calls need GRPCToken, or the token generated when it is empty. Without a TLS certificate it may
+	//only listen on a loopback address.
+	GRPCListen      string `long:"grpclisten"`
+	GRPCToken       string `long:"grpctoken"`
+	GRPCTLSCertPath string `long:"grpctlscert"`
+	GRPCTLSKeyPath  string `long:"grpctlskey"`
+
+	//HTTPAPIListen is the loopback address of the optional HTTP API for apps on the same device, disabled when empty
+	HTTPAPIListen string `long:"httpapilisten"`
+
+	//AvatarCacheDir is the directory where the app caches the avatars, the maintenance deletes the ones
+	//unused for 30 days and then the oldest ones above AvatarCacheMaxSize bytes, 20MB by default
+	AvatarCacheDir     string `long:"avatarcachedir"`
+	AvatarCacheMaxSize int64  `long:"avatarcachemaxsize"`
+
+	//PaymentRequestRetention is how long the saved payment requests are kept after their creation, 30 days by default
+	PaymentRequestRetention time.Duration `long:"paymentrequestretention"`
+
+	//LogLevels are the initial log levels by subsystem, e.g. loglevel=payments:debug
+	LogLevels map[string]string `long:"loglevel"`
+
+	//MetricsListen is the address serving the prometheus metrics on /metrics, disabled when empty
+	MetricsListen string `long:"metricslisten"`
 }
 
 func getBreezClientConnection() *grpc.ClientConn {
@@ -102,11 +171,11 @@ func getBreezClientConnection() *grpc.ClientConn {
 }
 
 func dial() (err error) {
-	cp := x509.NewCertPool()
-	if !cp.AppendCertsFromPEM([]byte(letsencryptCert)) {
-		return fmt.Errorf("credentials: failed to append certificates")
+	tlsConfig, err := pinnedTLSConfig(cfg.BreezServer)
+	if err != nil {
+		return err
 	}
-	creds := credentials.NewClientTLSFromCert(cp, "")
+	creds := credentials.NewTLS(tlsConfig)
 	dialOptions := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
 	breezClientConnection, err = grpc.Dial(cfg.BreezServer, dialOptions...)
 
@@ -133,15 +202,34 @@ func Start(workingDir string, syncJobMode bool) (chan data.NotificationEvent, er
 		fmt.Println("Warning initConfig", err)
 		return nil, err
 	}
+	applyConfigLogLevels()
 
-	if err := openDB(path.Join(appWorkingDir, "breez.db")); err != nil {
+	dbPath := path.Join(appWorkingDir, "breez.db")
+	compactDBIfScheduled(dbPath)
+	if err := openDB(dbPath); err != nil {
+		if isDBCorrupted(err) {
+			atomic.StoreInt32(&started, 0)
+			return nil, ErrDatabaseCorrupted
+		}
 		return nil, err
 	}
 	if err := doubleratchet.Start(path.Join(appWorkingDir, "sessions_encryption.db")); err != nil {
 		return nil, err
 	}
+	var macaroonsRotated bool
+	if !syncJobMode {
+		var err error
+		if macaroonsRotated, err = rotateMacaroons(); err != nil {
+			return nil, err
+		}
+	}
+	startOutbox()
+	stopped := make(chan struct{})
+	stoppedChan = stopped
 	go func() {
+		defer close(stopped)
 		defer closeDB()
+		defer stopOutbox()
 		defer doubleratchet.Stop()
 		defer atomic.StoreInt32(&started, 0)
 		defer atomic.StoreInt32(&isReady, 0)
@@ -153,7 +241,12 @@ func Start(workingDir string, syncJobMode bool) (chan data.NotificationEvent, er
 		if syncJobMode {
 			startLightningDaemon(syncAndStop)
 		} else {
-			startLightningDaemon(startBreez)
+			startLightningDaemon(func() {
+				startBreez()
+				if macaroonsRotated {
+					go onMacaroonsRotated()
+				}
+			})
 		}
 	}()
 
@@ -216,7 +309,7 @@ func startLightningDaemon(onReady func()) error {
 
 	if err != nil {
 		fmt.Println("Error starting breez", err)
-		notificationsChan <- data.NotificationEvent{Type: data.NotificationEvent_LIGHTNING_SERVICE_DOWN}
+		notify(data.NotificationEvent{Type: data.NotificationEvent_LIGHTNING_SERVICE_DOWN})
 		return err
 	}
 	close(quitChan)
@@ -235,6 +328,7 @@ func stopLightningDaemon() {
 //It first waits a pre-defined interval for the best block to retrieve
 //After that it just poll untill syncTocChain=true and then stop the daemon.
 func syncAndStop() {
+	defer chainSyncSeconds.since("job", time.Now())
 	//give it some time to get the best block and then sync.
 	timeToWait := waitBestBlockDuration
 	for {
@@ -260,11 +354,24 @@ func syncAndStop() {
 
 func startBreez() {
 	//start the go routings
-	notificationsChan <- data.NotificationEvent{Type: data.NotificationEvent_READY}
+	notify(data.NotificationEvent{Type: data.NotificationEvent_READY})
 
 	go trackOpenedChannel()
 	go watchRoutingNodeConnection()
 	go watchPayments()
+	go watchInvoiceReminders()
+	go watchRates()
+	go watchPaymentQueue()
+	go watchHTLCEvents()
+	go watchPendingExpiry()
+	go watchInvoiceExpiry()
+	go watchPaymentCodes()
+	go watchWebhooks()
+	go watchHubPolicy()
+	go watchMaintenance()
+	go startGRPCServer()
+	go startHTTPAPIServer()
+	go startMetricsServer()
 	watchFundTransfers()
 	go func() {
 		onAccountChanged()
@@ -272,7 +379,16 @@ func startBreez() {
 		if err != nil {
 			log.Errorf("Failed to sync chain %v", err)
 		}
+		go registerWrappedInvoices()
+		go func() {
+			if err := syncClosedChannels(); err != nil {
+				log.Errorf("Failed to sync closed channels %v", err)
+			}
+			resumeChannelConsolidations()
+		}()
 		go connectOnStartup()
+		go registerPushTokenOnStartup()
+		go verifyRestoredCredentials()
 		go watchOnChainState()
 	}()
 }
@@ -304,13 +420,13 @@ func GetConfig(workingDir string) (*Config, error) {
 
 func initLightningClient() error {
 	var clientError error
-	macaroonDir := strings.Join([]string{appWorkingDir, "data", "chain", "bitcoin", cfg.Network}, "/")
-	lightningClient, clientError = lightningclient.NewLightningClient(appWorkingDir, macaroonDir)
+	lightningClient, clientError = lightningclient.NewLightningClient(appWorkingDir, networkDir(appWorkingDir, cfg.Network))
 	if clientError != nil {
 		log.Errorf("Error in creating client", clientError)
-		notificationsChan <- data.NotificationEvent{Type: data.NotificationEvent_INITIALIZATION_FAILED}
+		notify(data.NotificationEvent{Type: data.NotificationEvent_INITIALIZATION_FAILED})
 		return clientError
 	}
+	paymentsClient = lightningClient
 	return nil
 }
 
@@ -0,0 +1,122 @@
+package breez
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/breez/breez/data"
+)
+
+const (
+	paymentIntentsCheckInterval = time.Minute
+)
+
+type paymentIntent struct {
+	PaymentHash       string
+	PaymentRequest    string
+	Amount            int64
+	CreationTimestamp int64
+}
+
+func serializePaymentIntent(i *paymentIntent) ([]byte, error) {
+	intentBytes, err := json.Marshal(i)
+	if err != nil {
+		return nil, err
+	}
+	return sealDBValue(intentBytes)
+}
+
+func deserializePaymentIntent(intentBytes []byte) (*paymentIntent, error) {
+	var i paymentIntent
+	intentBytes, err := openDBValue(intentBytes)
+	if err != nil {
+		return &i, err
+	}
+	err = json.Unmarshal(intentBytes, &i)
+	return &i, err
+}
+
+func (i *paymentIntent) toProto() *data.PaymentIntent {
+	return &data.PaymentIntent{
+		PaymentHash:       i.PaymentHash,
+		PaymentRequest:    i.PaymentRequest,
+		Amount:            i.Amount,
+		CreationTimestamp: i.CreationTimestamp,
+	}
+}
+
+/*
+GetPaymentIntents returns the payments whose sending was interrupted, for instance by the app being killed,
+and that are still in flight. The app should show them as pending instead of letting the user pay again.
+Once they complete a PAYMENT_INTENT_RESOLVED notification is sent with the payment hash and the payment
+status, SUCCEEDED when the payment went through and FAILED when it didn't.
+*/
+func GetPaymentIntents() (*data.PaymentIntents, error) {
+	intents, err := fetchPaymentIntents()
+	if err != nil {
+		return nil, err
+	}
+	result := &data.PaymentIntents{}
+	for _, i := range intents {
+		if !isSending(i.PaymentHash) {
+			result.Intents = append(result.Intents, i.toProto())
+		}
+	}
+	return result, nil
+}
+
+func isSending(paymentHash string) bool {
+	sendingMu.Lock()
+	defer sendingMu.Unlock()
+	return sendingPayments[paymentHash]
+}
+
+// watchPaymentIntents resolves the intents left by interrupted sends, checking
+// again periodically while some of them are still in flight.
+func watchPaymentIntents() {
+	for reconcilePaymentIntents() > 0 {
+		select {
+		case <-time.After(paymentIntentsCheckInterval):
+		case <-quitChan:
+			return
+		}
+		//payments completed since the app restarted are only in the daemon list
+		syncSentPayments()
+	}
+}
+
+// reconcilePaymentIntents resolves the intents of the payments not being sent
+// against the history and the pending HTLCs and returns how many are unresolved.
+func reconcilePaymentIntents() int {
+	intents, err := fetchPaymentIntents()
+	if err != nil {
+		paymentsLog.Errorf("reconcilePaymentIntents - failed to fetch payment intents: %v", err)
+		return 0
+	}
+	var unresolved int
+	for _, i := range intents {
+		if isSending(i.PaymentHash) {
+			continue
+		}
+		var status data.PaymentStatus_Status
+		switch err := checkDuplicatePayment(context.Background(), i.PaymentHash); err {
+		case ErrAlreadyPaid:
+			status = data.PaymentStatus_SUCCEEDED
+		case nil:
+			status = data.PaymentStatus_FAILED
+		default:
+			if err != ErrPaymentInFlight {
+				paymentsLog.Errorf("reconcilePaymentIntents - failed to check payment %v: %v", i.PaymentHash, err)
+			}
+			unresolved++
+			continue
+		}
+		paymentsLog.Infof("reconcilePaymentIntents - interrupted payment %v %v", i.PaymentHash, status)
+		if err := deletePaymentIntent(i.PaymentHash); err != nil {
+			paymentsLog.Errorf("reconcilePaymentIntents - failed to delete payment intent %v: %v", i.PaymentHash, err)
+		}
+		notify(data.NotificationEvent{Type: data.NotificationEvent_PAYMENT_INTENT_RESOLVED, Data: []string{i.PaymentHash, status.String()}})
+	}
+	return unresolved
+}
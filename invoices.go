@@ -0,0 +1,179 @@
+package breez
+
+import (
+	"context"
+	"encoding/hex"
+	"sort"
+	"time"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
+)
+
+const (
+	//invoicesPageSize is the number of invoices read from the daemon at a time
+	invoicesPageSize = 100
+
+	invoiceExpiryScanInterval = time.Minute
+)
+
+// listAllInvoices returns all the invoices of the daemon, paging through them
+// since the daemon returns at most a page at a time.
+func listAllInvoices() ([]*lnrpc.Invoice, error) {
+	var invoices []*lnrpc.Invoice
+	var offset uint64
+	for {
+		page, err := paymentsClient.ListInvoices(context.Background(), &lnrpc.ListInvoiceRequest{
+			IndexOffset:    offset,
+			NumMaxInvoices: invoicesPageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		invoices = append(invoices, page.Invoices...)
+		if len(page.Invoices) < invoicesPageSize {
+			return invoices, nil
+		}
+		offset = page.LastIndexOffset
+	}
+}
+
+// issuedInvoiceState returns the state of the invoice, a hidden invoice that
+// was paid anyway is settled.
+func issuedInvoiceState(settled, hidden bool, expiry, now int64) data.IssuedInvoice_State {
+	switch {
+	case settled:
+		return data.IssuedInvoice_SETTLED
+	case hidden:
+		return data.IssuedInvoice_HIDDEN
+	case expiry <= now:
+		return data.IssuedInvoice_EXPIRED
+	}
+	return data.IssuedInvoice_OPEN
+}
+
+/*
+GetIssuedInvoices returns the invoices created by this node, newest first, with their state
+(open, settled, expired or hidden), the amount paid and the settle date. Invoices the routing
+node issued on our behalf while syncing are included with the payment request it issued.
+*/
+func GetIssuedInvoices() (*data.IssuedInvoices, error) {
+	result, err := issuedInvoices()
+	if err != nil {
+		return nil, err
+	}
+	for _, issued := range result.Invoices {
+		if memo, err := DecodePaymentRequest(issued.PaymentRequest); err == nil {
+			issued.Memo = memo
+		} else {
+			paymentsLog.Errorf("GetIssuedInvoices - failed to decode invoice %v: %v", issued.PaymentHash, err)
+		}
+	}
+	return result, nil
+}
+
+// issuedInvoices returns the issued invoices without decoding their memo.
+func issuedInvoices() (*data.IssuedInvoices, error) {
+	invoices, err := listAllInvoices()
+	if err != nil {
+		return nil, err
+	}
+	hidden, err := fetchHiddenInvoices()
+	if err != nil {
+		return nil, err
+	}
+	wrappedInvoices, err := fetchWrappedInvoices()
+	if err != nil {
+		return nil, err
+	}
+	wrapped := make(map[string]*wrappedInvoiceInfo)
+	for _, i := range wrappedInvoices {
+		wrapped[i.PaymentHash] = i
+	}
+
+	now := trustedNow().Unix()
+	result := &data.IssuedInvoices{}
+	for _, i := range invoices {
+		paymentHash := hex.EncodeToString(i.RHash)
+		issued := &data.IssuedInvoice{
+			PaymentHash:       paymentHash,
+			PaymentRequest:    i.PaymentRequest,
+			Amount:            i.Value,
+			AmountPaid:        i.AmtPaidSat,
+			CreationTimestamp: i.CreationDate,
+			ExpiryTimestamp:   i.CreationDate + i.Expiry,
+			SettleTimestamp:   i.SettleDate,
+		}
+		if w, ok := wrapped[paymentHash]; ok {
+			issued.PaymentRequest = w.PaymentRequest
+			issued.Amount = w.Amount
+			delete(wrapped, paymentHash)
+		}
+		_, isHidden := hidden[paymentHash]
+		issued.State = issuedInvoiceState(i.Settled, isHidden, issued.ExpiryTimestamp, now)
+		result.Invoices = append(result.Invoices, issued)
+	}
+
+	//wrapped invoices which were not registered in the daemon yet
+	for _, w := range wrapped {
+		_, isHidden := hidden[w.PaymentHash]
+		result.Invoices = append(result.Invoices, &data.IssuedInvoice{
+			PaymentHash:       w.PaymentHash,
+			PaymentRequest:    w.PaymentRequest,
+			Amount:            w.Amount,
+			CreationTimestamp: w.CreationTimestamp,
+			ExpiryTimestamp:   w.CreationTimestamp + w.Expiry,
+			State:             issuedInvoiceState(false, isHidden, w.CreationTimestamp+w.Expiry, now),
+		})
+	}
+
+	sort.Slice(result.Invoices, func(i, j int) bool {
+		return result.Invoices[i].CreationTimestamp > result.Invoices[j].CreationTimestamp
+	})
+	return result, nil
+}
+
+// watchInvoiceExpiry periodically sends an INVOICE_EXPIRED notification with the
+// payment hash and the payment request of every open invoice that expired unpaid
+// since the previous scan.
+func watchInvoiceExpiry() {
+	ticker := time.NewTicker(invoiceExpiryScanInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-quitChan:
+			return
+		}
+		if DaemonReady() {
+			notifyExpiredInvoices()
+		}
+	}
+}
+
+func notifyExpiredInvoices() {
+	now := trustedNow().Unix()
+	lastScan, err := fetchInvoiceExpiryScan()
+	if err != nil {
+		paymentsLog.Errorf("notifyExpiredInvoices - failed to fetch the last scan time: %v", err)
+		return
+	}
+	//the first scan only starts tracking, older invoices expired long ago
+	if lastScan > 0 {
+		invoices, err := issuedInvoices()
+		if err != nil {
+			paymentsLog.Errorf("notifyExpiredInvoices - failed to list the invoices: %v", err)
+			return
+		}
+		for _, i := range invoices.Invoices {
+			if i.State != data.IssuedInvoice_EXPIRED || i.ExpiryTimestamp <= lastScan || i.ExpiryTimestamp > now {
+				continue
+			}
+			paymentsLog.Infof("notifyExpiredInvoices - invoice %v expired unpaid", i.PaymentHash)
+			notify(data.NotificationEvent{Type: data.NotificationEvent_INVOICE_EXPIRED, Data: []string{i.PaymentHash, i.PaymentRequest}})
+		}
+	}
+	if err := saveInvoiceExpiryScan(now); err != nil {
+		paymentsLog.Errorf("notifyExpiredInvoices - failed to save the scan time: %v", err)
+	}
+}
@@ -0,0 +1,156 @@
+package breez
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/breez/breez/data"
+)
+
+const (
+	defaultPairingMaxFailures = 5
+
+	securityAlertPairing  = "pairing"
+	securityAlertWebhook  = "webhook"
+	securityAlertAPIToken = "api_token"
+)
+
+var (
+	apiTokenFailuresMu sync.Mutex
+	apiTokenFailures   = make(map[string]int32)
+)
+
+func pairingMaxFailures() int32 {
+	if cfg != nil && cfg.PairingMaxFailures > 0 {
+		return cfg.PairingMaxFailures
+	}
+	return defaultPairingMaxFailures
+}
+
+// securityAlert sends a SECURITY_ALERT notification with the kind of credential,
+// its id (empty when all were affected) and the reason.
+func securityAlert(kind, id, reason string) {
+	log.Warnf("securityAlert - %v %v: %v", kind, id, reason)
+	notify(data.NotificationEvent{Type: data.NotificationEvent_SECURITY_ALERT, Data: []string{kind, id, reason}})
+}
+
+/*
+RevokeAllTokens revokes every paired companion session at once, e.g. when a device is lost or a
+companion is suspected to be compromised, and rotates the HTTP API and gRPC tokens. It returns the
+number of sessions revoked.
+*/
+func RevokeAllTokens() (int, error) {
+	sessions, err := fetchPairingSessions()
+	if err != nil {
+		return 0, err
+	}
+	revoked := 0
+	for _, s := range sessions {
+		if s.Revoked {
+			continue
+		}
+		s.Revoked = true
+		if err := savePairingSession(s); err != nil {
+			return revoked, err
+		}
+		revoked++
+	}
+	if _, err := RotateHTTPAPIToken(); err != nil {
+		return revoked, err
+	}
+	if _, err := RotateGRPCToken(); err != nil {
+		return revoked, err
+	}
+	securityAlert(securityAlertPairing, "", fmt.Sprintf("%v sessions revoked", revoked))
+	return revoked, nil
+}
+
+/*
+RevokeWebhooks disables every settlement rule that notifies an external service and removes the
+registered webhooks, so no payment details are posted anywhere until they are reviewed. It returns
+the number of rules disabled and webhooks removed.
+*/
+func RevokeWebhooks() (int, error) {
+	rules, err := fetchSettlementRules()
+	if err != nil {
+		return 0, err
+	}
+	disabled := 0
+	for _, r := range rules {
+		if r.Action != data.SettlementRule_NOTIFY_SERVICE || !r.Enabled {
+			continue
+		}
+		r.Enabled = false
+		if err := saveSettlementRule(r); err != nil {
+			return disabled, err
+		}
+		disabled++
+	}
+	webhooks, err := fetchWebhooks()
+	if err != nil {
+		return disabled, err
+	}
+	for _, w := range webhooks {
+		if err := deleteWebhook(w.Id); err != nil {
+			return disabled, err
+		}
+		disabled++
+	}
+	securityAlert(securityAlertWebhook, "", fmt.Sprintf("%v webhooks disabled", disabled))
+	return disabled, nil
+}
+
+// onPairingFailure counts a failed or denied request of the session and
+// suspends it once it reaches the maximum failures.
+func onPairingFailure(session *data.PairingSession, reason string) {
+	session.FailedAttempts++
+	suspend := session.FailedAttempts >= pairingMaxFailures()
+	if suspend {
+		session.Suspended = true
+	}
+	if err := savePairingSession(session); err != nil {
+		log.Errorf("onPairingFailure - failed to save session %v: %v", session.SessionID, err)
+		return
+	}
+	if suspend {
+		securityAlert(securityAlertPairing, session.SessionID, fmt.Sprintf("suspended after %v failed requests, last: %v", session.FailedAttempts, reason))
+	}
+}
+
+// onPairingSuccess resets the failures count of the session.
+func onPairingSuccess(session *data.PairingSession) {
+	if session.FailedAttempts == 0 {
+		return
+	}
+	session.FailedAttempts = 0
+	if err := savePairingSession(session); err != nil {
+		log.Errorf("onPairingSuccess - failed to save session %v: %v", session.SessionID, err)
+	}
+}
+
+// onAPITokenFailure counts a failed authentication with the API token of the
+// given id and rotates the token once it reaches the maximum failures, so a
+// client guessing it has to start over.
+func onAPITokenFailure(id string, rotate func() (string, error)) {
+	apiTokenFailuresMu.Lock()
+	apiTokenFailures[id]++
+	failures := apiTokenFailures[id]
+	if failures >= pairingMaxFailures() {
+		delete(apiTokenFailures, id)
+	}
+	apiTokenFailuresMu.Unlock()
+	if failures < pairingMaxFailures() {
+		return
+	}
+	if _, err := rotate(); err != nil {
+		log.Errorf("onAPITokenFailure - failed to rotate the %v token: %v", id, err)
+	}
+	securityAlert(securityAlertAPIToken, id, fmt.Sprintf("rotated after %v failed authentications", failures))
+}
+
+// onAPITokenSuccess resets the failures count of the API token.
+func onAPITokenSuccess(id string) {
+	apiTokenFailuresMu.Lock()
+	delete(apiTokenFailures, id)
+	apiTokenFailuresMu.Unlock()
+}
@@ -0,0 +1,321 @@
+package breez
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/breez/lightninglib/lnrpc"
+	"github.com/breez/lightninglib/lnwire"
+	"github.com/breez/lightninglib/zpay32"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"google.golang.org/grpc"
+)
+
+const memoryDaemonFee = 1
+
+var errMemoryDaemonUnsupported = errors.New("not supported by the in-memory daemon")
+
+// memoryDaemon is an in-memory lightning daemon for the payment flow tests.
+// It pays the invoices issued by remoteInvoice and its own invoices are paid
+// with settle. The calls outside the payment flows panic through the nil
+// embedded client, except the account and backup refreshes that follow a
+// payment, which fail.
+type memoryDaemon struct {
+	lnrpc.LightningClient
+
+	mu          sync.Mutex
+	key, remote *btcec.PrivateKey
+	balance     int64
+	invoices    map[string]*lnrpc.Invoice
+	preimages   map[string][]byte
+	payments    []*lnrpc.Payment
+	htlcs       []*lnrpc.HTLC
+	settleIndex uint64
+	settled     chan *lnrpc.Invoice
+}
+
+// installMemoryDaemon makes the payment flows run against a new in-memory
+// daemon with the given channel balance and returns the function restoring the
+// configuration. The daemon stays installed since the refreshes following a
+// payment run in the background.
+func installMemoryDaemon(t testing.TB, balance int64) (*memoryDaemon, func()) {
+	key, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	remote, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &memoryDaemon{
+		key:       key,
+		remote:    remote,
+		balance:   balance,
+		invoices:  make(map[string]*lnrpc.Invoice),
+		preimages: make(map[string][]byte),
+		settled:   make(chan *lnrpc.Invoice, 10),
+	}
+	previousCfg := cfg
+	cfg = &Config{Network: "testnet"}
+	paymentsClient = d
+	lightningClient = d
+	atomic.StoreInt32(&isReady, 1)
+	resetSyncedCache()
+	return d, func() {
+		atomic.StoreInt32(&isReady, 0)
+		cfg = previousCfg
+	}
+}
+
+func (d *memoryDaemon) encodeInvoice(key *btcec.PrivateKey, hash [32]byte, memo string, amount, expiry int64) (string, error) {
+	options := []func(*zpay32.Invoice){zpay32.Description(memo)}
+	if amount > 0 {
+		options = append(options, zpay32.Amount(lnwire.MilliSatoshi(amount*1000)))
+	}
+	if expiry > 0 {
+		options = append(options, zpay32.Expiry(time.Duration(expiry)*time.Second))
+	}
+	invoice, err := zpay32.NewInvoice(&chaincfg.TestNet3Params, hash, time.Now(), options...)
+	if err != nil {
+		return "", err
+	}
+	return invoice.Encode(zpay32.MessageSigner{
+		SignCompact: func(hash []byte) ([]byte, error) {
+			return btcec.SignCompact(btcec.S256(), key, hash, true)
+		},
+	})
+}
+
+func newPreimage() ([]byte, [32]byte, error) {
+	preimage := make([]byte, 32)
+	if _, err := rand.Read(preimage); err != nil {
+		return nil, [32]byte{}, err
+	}
+	return preimage, sha256.Sum256(preimage), nil
+}
+
+// remoteInvoice returns a payment request of a remote node the daemon can pay.
+func (d *memoryDaemon) remoteInvoice(t testing.TB, amount int64, memo string) string {
+	preimage, hash, err := newPreimage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	paymentRequest, err := d.encodeInvoice(d.remote, hash, memo, amount, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.preimages[hex.EncodeToString(hash[:])] = preimage
+	return paymentRequest
+}
+
+// settle pays the daemon invoice of paymentHash and publishes it to the
+// invoices subscription.
+func (d *memoryDaemon) settle(t testing.TB, paymentHash string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	invoice, ok := d.invoices[paymentHash]
+	if !ok {
+		t.Fatalf("no invoice %v", paymentHash)
+	}
+	d.settleIndex++
+	invoice.Settled = true
+	invoice.AmtPaidSat = invoice.Value
+	invoice.SettleDate = time.Now().Unix()
+	invoice.SettleIndex = d.settleIndex
+	d.balance += invoice.Value
+	d.settled <- invoice
+}
+
+func (d *memoryDaemon) GetInfo(ctx context.Context, in *lnrpc.GetInfoRequest, opts ...grpc.CallOption) (*lnrpc.GetInfoResponse, error) {
+	return &lnrpc.GetInfoResponse{
+		IdentityPubkey: hex.EncodeToString(d.key.PubKey().SerializeCompressed()),
+		SyncedToChain:  true,
+		BlockHeight:    1,
+	}, nil
+}
+
+// ListChannels returns a single channel holding the HTLCs in flight, if any.
+func (d *memoryDaemon) ListChannels(ctx context.Context, in *lnrpc.ListChannelsRequest, opts ...grpc.CallOption) (*lnrpc.ListChannelsResponse, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if len(d.htlcs) == 0 {
+		return &lnrpc.ListChannelsResponse{}, nil
+	}
+	channel := &lnrpc.Channel{Active: true, PendingHtlcs: append([]*lnrpc.HTLC(nil), d.htlcs...)}
+	return &lnrpc.ListChannelsResponse{Channels: []*lnrpc.Channel{channel}}, nil
+}
+
+func (d *memoryDaemon) AddInvoice(ctx context.Context, in *lnrpc.Invoice, opts ...grpc.CallOption) (*lnrpc.AddInvoiceResponse, error) {
+	preimage, hash, err := newPreimage()
+	if err != nil {
+		return nil, err
+	}
+	if in.RPreimage != nil {
+		preimage, hash = in.RPreimage, sha256.Sum256(in.RPreimage)
+	}
+	paymentRequest, err := d.encodeInvoice(d.key, hash, in.Memo, in.Value, in.Expiry)
+	if err != nil {
+		return nil, err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.invoices[hex.EncodeToString(hash[:])] = &lnrpc.Invoice{
+		Memo:           in.Memo,
+		RPreimage:      preimage,
+		RHash:          hash[:],
+		Value:          in.Value,
+		CreationDate:   time.Now().Unix(),
+		PaymentRequest: paymentRequest,
+		Expiry:         in.Expiry,
+		Private:        in.Private,
+		AddIndex:       uint64(len(d.invoices) + 1),
+	}
+	return &lnrpc.AddInvoiceResponse{RHash: hash[:], PaymentRequest: paymentRequest}, nil
+}
+
+func (d *memoryDaemon) LookupInvoice(ctx context.Context, in *lnrpc.PaymentHash, opts ...grpc.CallOption) (*lnrpc.Invoice, error) {
+	paymentHash := in.RHashStr
+	if paymentHash == "" {
+		paymentHash = hex.EncodeToString(in.RHash)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	invoice, ok := d.invoices[paymentHash]
+	if !ok {
+		return nil, errors.New("unable to locate invoice")
+	}
+	return invoice, nil
+}
+
+// ListInvoices returns a page of the invoices added after the index offset.
+func (d *memoryDaemon) ListInvoices(ctx context.Context, in *lnrpc.ListInvoiceRequest, opts ...grpc.CallOption) (*lnrpc.ListInvoiceResponse, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var invoices []*lnrpc.Invoice
+	for _, invoice := range d.invoices {
+		if invoice.AddIndex > in.IndexOffset {
+			invoices = append(invoices, invoice)
+		}
+	}
+	sort.Slice(invoices, func(i, j int) bool { return invoices[i].AddIndex < invoices[j].AddIndex })
+	if in.NumMaxInvoices > 0 && uint64(len(invoices)) > in.NumMaxInvoices {
+		invoices = invoices[:in.NumMaxInvoices]
+	}
+	response := &lnrpc.ListInvoiceResponse{Invoices: invoices, LastIndexOffset: in.IndexOffset}
+	if len(invoices) > 0 {
+		response.LastIndexOffset = invoices[len(invoices)-1].AddIndex
+	}
+	return response, nil
+}
+
+// memoryInvoiceStream delivers the settled invoices until they are closed.
+type memoryInvoiceStream struct {
+	grpc.ClientStream
+	ctx     context.Context
+	settled chan *lnrpc.Invoice
+}
+
+func (s *memoryInvoiceStream) Recv() (*lnrpc.Invoice, error) {
+	select {
+	case invoice, ok := <-s.settled:
+		if !ok {
+			return nil, io.EOF
+		}
+		return invoice, nil
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+func (d *memoryDaemon) SubscribeInvoices(ctx context.Context, in *lnrpc.InvoiceSubscription, opts ...grpc.CallOption) (lnrpc.Lightning_SubscribeInvoicesClient, error) {
+	return &memoryInvoiceStream{ctx: ctx, settled: d.settled}, nil
+}
+
+func (d *memoryDaemon) SendPaymentSync(ctx context.Context, in *lnrpc.SendRequest, opts ...grpc.CallOption) (*lnrpc.SendResponse, error) {
+	payReq, err := zpay32.Decode(in.PaymentRequest, &chaincfg.TestNet3Params)
+	if err != nil {
+		return nil, err
+	}
+	amount := in.Amt
+	if payReq.MilliSat != nil {
+		amount = int64(payReq.MilliSat.ToSatoshis())
+	}
+	paymentHash := hex.EncodeToString(payReq.PaymentHash[:])
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	preimage, ok := d.preimages[paymentHash]
+	if !ok {
+		return &lnrpc.SendResponse{PaymentError: "unable to find a path to destination"}, nil
+	}
+	if amount+memoryDaemonFee > d.balance {
+		return &lnrpc.SendResponse{PaymentError: "unable to find a path to destination"}, nil
+	}
+	d.balance -= amount + memoryDaemonFee
+	delete(d.preimages, paymentHash)
+	d.payments = append(d.payments, &lnrpc.Payment{
+		PaymentHash:     paymentHash,
+		Value:           amount,
+		CreationDate:    time.Now().Unix(),
+		Fee:             memoryDaemonFee,
+		PaymentPreimage: hex.EncodeToString(preimage),
+	})
+	return &lnrpc.SendResponse{
+		PaymentPreimage: preimage,
+		PaymentRoute: &lnrpc.Route{
+			TotalAmt:      amount + memoryDaemonFee,
+			TotalFees:     memoryDaemonFee,
+			TotalAmtMsat:  (amount + memoryDaemonFee) * 1000,
+			TotalFeesMsat: memoryDaemonFee * 1000,
+		},
+	}, nil
+}
+
+// QueryRoutes finds no route, so the payments are sent with SendPaymentSync
+// which finds its own.
+func (d *memoryDaemon) QueryRoutes(ctx context.Context, in *lnrpc.QueryRoutesRequest, opts ...grpc.CallOption) (*lnrpc.QueryRoutesResponse, error) {
+	return &lnrpc.QueryRoutesResponse{}, nil
+}
+
+func (d *memoryDaemon) SendToRouteSync(ctx context.Context, in *lnrpc.SendToRouteRequest, opts ...grpc.CallOption) (*lnrpc.SendResponse, error) {
+	return nil, errMemoryDaemonUnsupported
+}
+
+func (d *memoryDaemon) ListPayments(ctx context.Context, in *lnrpc.ListPaymentsRequest, opts ...grpc.CallOption) (*lnrpc.ListPaymentsResponse, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return &lnrpc.ListPaymentsResponse{Payments: append([]*lnrpc.Payment(nil), d.payments...)}, nil
+}
+
+func (d *memoryDaemon) NewAddress(ctx context.Context, in *lnrpc.NewAddressRequest, opts ...grpc.CallOption) (*lnrpc.NewAddressResponse, error) {
+	return nil, errMemoryDaemonUnsupported
+}
+
+func (d *memoryDaemon) SignMessage(ctx context.Context, in *lnrpc.SignMessageRequest, opts ...grpc.CallOption) (*lnrpc.SignMessageResponse, error) {
+	return nil, errMemoryDaemonUnsupported
+}
+
+func (d *memoryDaemon) ChannelBalance(ctx context.Context, in *lnrpc.ChannelBalanceRequest, opts ...grpc.CallOption) (*lnrpc.ChannelBalanceResponse, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return &lnrpc.ChannelBalanceResponse{Balance: d.balance}, nil
+}
+
+func (d *memoryDaemon) WalletBalance(ctx context.Context, in *lnrpc.WalletBalanceRequest, opts ...grpc.CallOption) (*lnrpc.WalletBalanceResponse, error) {
+	return nil, errMemoryDaemonUnsupported
+}
+
+func (d *memoryDaemon) GetBackup(ctx context.Context, in *lnrpc.GetBackupRequest, opts ...grpc.CallOption) (*lnrpc.GetBackupResponse, error) {
+	return nil, errMemoryDaemonUnsupported
+}
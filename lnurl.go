@@ -0,0 +1,295 @@
+package breez
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
+)
+
+const (
+	bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+	lnurlHRP      = "lnurl"
+)
+
+// lnurlPayResponse is the first step reply of an LNURL-pay service.
+type lnurlPayResponse struct {
+	Tag         string `json:"tag"`
+	Callback    string `json:"callback"`
+	MinSendable int64  `json:"minSendable"`
+	MaxSendable int64  `json:"maxSendable"`
+	Metadata    string `json:"metadata"`
+	Status      string `json:"status"`
+	Reason      string `json:"reason"`
+
+	//CommentAllowed is the maximum length of a payer comment, 0 if comments are not accepted
+	CommentAllowed int64 `json:"commentAllowed"`
+}
+
+// lnurlInvoiceResponse is the callback reply of an LNURL-pay service.
+type lnurlInvoiceResponse struct {
+	PR     string `json:"pr"`
+	Status string `json:"status"`
+	Reason string `json:"reason"`
+}
+
+func bech32Polymod(values []byte) uint32 {
+	generator := []uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	for _, v := range values {
+		b := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (b>>uint(i))&1 == 1 {
+				chk ^= generator[i]
+			}
+		}
+	}
+	return chk
+}
+
+// decodeLNURL decodes a bech32 encoded LNURL into its url.
+// LNURLs are longer than the 90 characters limit of bech32 so the limit is not enforced.
+func decodeLNURL(lnurl string) (string, error) {
+	lnurl = strings.ToLower(strings.TrimPrefix(strings.TrimPrefix(lnurl, "lightning:"), "LIGHTNING:"))
+	sep := strings.LastIndex(lnurl, "1")
+	if sep < 1 || sep+7 > len(lnurl) {
+		return "", errors.New("invalid lnurl")
+	}
+	hrp := lnurl[:sep]
+	if hrp != lnurlHRP {
+		return "", fmt.Errorf("invalid lnurl prefix %v", hrp)
+	}
+	var values []byte
+	for _, c := range lnurl[sep+1:] {
+		v := strings.IndexRune(bech32Charset, c)
+		if v < 0 {
+			return "", fmt.Errorf("invalid lnurl character %v", string(c))
+		}
+		values = append(values, byte(v))
+	}
+
+	expanded := make([]byte, 0, len(hrp)*2+1+len(values))
+	for _, c := range hrp {
+		expanded = append(expanded, byte(c>>5))
+	}
+	expanded = append(expanded, 0)
+	for _, c := range hrp {
+		expanded = append(expanded, byte(c&31))
+	}
+	if bech32Polymod(append(expanded, values...)) != 1 {
+		return "", errors.New("invalid lnurl checksum")
+	}
+
+	//convert the 5 bits groups, without the checksum, to bytes
+	var decoded []byte
+	acc, bits := uint32(0), uint(0)
+	for _, v := range values[:len(values)-6] {
+		acc = acc<<5 | uint32(v)
+		bits += 5
+		for bits >= 8 {
+			bits -= 8
+			decoded = append(decoded, byte(acc>>bits))
+		}
+	}
+	return string(decoded), nil
+}
+
+func lnurlGet(u string, reply interface{}) error {
+	client, err := getHTTPClient()
+	if err != nil {
+		return err
+	}
+	resp, err := client.Get(u)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return json.NewDecoder(resp.Body).Decode(reply)
+}
+
+// lnurlMetadataMemo builds the invoice memo shown in the payments list from the
+// LNURL metadata, like the Breez encoded memos do.
+func lnurlMetadataMemo(metadata, host string) (*data.InvoiceMemo, error) {
+	var entries [][]string
+	if err := json.Unmarshal([]byte(metadata), &entries); err != nil {
+		return nil, fmt.Errorf("invalid lnurl metadata: %v", err)
+	}
+	memo := &data.InvoiceMemo{PayeeName: host}
+	for _, e := range entries {
+		if len(e) != 2 {
+			continue
+		}
+		switch {
+		case e[0] == "text/plain":
+			memo.Description = e[1]
+		case strings.HasPrefix(e[0], "image/"):
+			memo.PayeeImageURL = "data:" + e[0] + "," + e[1]
+		}
+	}
+	return memo, nil
+}
+
+/*
+DecodeLNURLPay resolves an LNURL-pay endpoint and returns its payment parameters.
+*/
+func DecodeLNURLPay(lnurl string) (*data.LNURLPayParams, error) {
+	u, err := decodeLNURL(lnurl)
+	if err != nil {
+		return nil, err
+	}
+	return fetchLNURLPayParams(u)
+}
+
+func fetchLNURLPayParams(u string) (*data.LNURLPayParams, error) {
+	parsedURL, err := url.Parse(u)
+	if err != nil {
+		return nil, err
+	}
+	var reply lnurlPayResponse
+	if err := lnurlGet(u, &reply); err != nil {
+		return nil, err
+	}
+	if reply.Status == "ERROR" {
+		return nil, errors.New(reply.Reason)
+	}
+	if reply.Tag != "payRequest" {
+		return nil, fmt.Errorf("unsupported lnurl tag %v", reply.Tag)
+	}
+	memo, err := lnurlMetadataMemo(reply.Metadata, parsedURL.Hostname())
+	if err != nil {
+		return nil, err
+	}
+	return &data.LNURLPayParams{
+		Callback:       reply.Callback,
+		MinSendable:    reply.MinSendable,
+		MaxSendable:    reply.MaxSendable,
+		Metadata:       reply.Metadata,
+		Domain:         parsedURL.Hostname(),
+		InvoiceMemo:    memo,
+		CommentAllowed: reply.CommentAllowed,
+	}, nil
+}
+
+// lightningAddressURL returns the well-known LNURL-pay url of a user@domain lightning address.
+func lightningAddressURL(address string) (string, error) {
+	parts := strings.Split(strings.TrimPrefix(strings.TrimSpace(address), "lightning:"), "@")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", fmt.Errorf("invalid lightning address %v", address)
+	}
+	user, domain := strings.ToLower(parts[0]), strings.ToLower(parts[1])
+	scheme := "https"
+	if strings.HasSuffix(domain, ".onion") {
+		scheme = "http"
+	}
+	u := url.URL{Scheme: scheme, Host: domain, Path: "/.well-known/lnurlp/" + user}
+	if u.Hostname() == "" {
+		return "", fmt.Errorf("invalid lightning address %v", address)
+	}
+	return u.String(), nil
+}
+
+// fetchLNURLPayInvoice fetches an invoice from the callback and validates it against the
+// amount and the metadata hash. The comment is sent to the service if it is not empty.
+func fetchLNURLPayInvoice(params *data.LNURLPayParams, amountMsat int64, comment string) (string, *lnrpc.PayReq, error) {
+	callback, err := url.Parse(params.Callback)
+	if err != nil {
+		return "", nil, err
+	}
+	q := callback.Query()
+	q.Set("amount", fmt.Sprintf("%v", amountMsat))
+	if comment != "" {
+		if int64(len([]rune(comment))) > params.CommentAllowed {
+			return "", nil, fmt.Errorf("comment is longer than the %v characters allowed by the service", params.CommentAllowed)
+		}
+		q.Set("comment", comment)
+	}
+	callback.RawQuery = q.Encode()
+
+	var reply lnurlInvoiceResponse
+	if err := lnurlGet(callback.String(), &reply); err != nil {
+		return "", nil, err
+	}
+	if reply.Status == "ERROR" {
+		return "", nil, errors.New(reply.Reason)
+	}
+	decodedReq, err := decodePayReqLocally(reply.PR)
+	if err != nil {
+		return "", nil, err
+	}
+	metadataHash := sha256.Sum256([]byte(params.Metadata))
+	if decodedReq.DescriptionHash != hex.EncodeToString(metadataHash[:]) {
+		return "", nil, errors.New("invoice description hash doesn't match the lnurl metadata")
+	}
+	if decodedReq.NumSatoshis*1000 != amountMsat {
+		return "", nil, errors.New("invoice amount doesn't match the requested amount")
+	}
+	return reply.PR, decodedReq, nil
+}
+
+/*
+PayLNURL pays amount satoshi to an LNURL-pay service using the parameters returned by DecodeLNURLPay.
+The LNURL metadata is kept with the payment so it shows the payee like Breez encoded memos.
+The optional comment is passed to the payee when the service accepts comments and kept with the payment.
+*/
+func PayLNURL(params *data.LNURLPayParams, amount int64, comment string) error {
+	amountMsat := amount * 1000
+	if amountMsat < params.MinSendable || amountMsat > params.MaxSendable {
+		return fmt.Errorf("amount must be between %v and %v satoshi", params.MinSendable/1000, params.MaxSendable/1000)
+	}
+	paymentRequest, decodedReq, err := fetchLNURLPayInvoice(params, amountMsat, comment)
+	if err != nil {
+		return err
+	}
+	memo, err := lnurlMetadataMemo(params.Metadata, params.Domain)
+	if err != nil {
+		return err
+	}
+	memo.Amount = amount
+	memo.PayerComment = comment
+	if err := saveLNURLPayMemo(decodedReq.PaymentHash, memo); err != nil {
+		return err
+	}
+	return SendPaymentForRequest(paymentRequest, 0)
+}
+
+/*
+ResolveLightningAddress resolves a user@domain lightning address and fetches an invoice of
+amount satoshi from it. The invoice amount and description hash are verified against the
+address metadata and the returned memo is kept with the payment, so the payment request
+can be paid directly using SendPaymentForRequest.
+*/
+func ResolveLightningAddress(address string, amount int64) (*data.LightningAddressInvoice, error) {
+	u, err := lightningAddressURL(address)
+	if err != nil {
+		return nil, err
+	}
+	params, err := fetchLNURLPayParams(u)
+	if err != nil {
+		return nil, err
+	}
+	amountMsat := amount * 1000
+	if amountMsat < params.MinSendable || amountMsat > params.MaxSendable {
+		return nil, fmt.Errorf("amount must be between %v and %v satoshi", params.MinSendable/1000, params.MaxSendable/1000)
+	}
+	paymentRequest, decodedReq, err := fetchLNURLPayInvoice(params, amountMsat, "")
+	if err != nil {
+		return nil, err
+	}
+	memo, err := lnurlMetadataMemo(params.Metadata, address)
+	if err != nil {
+		return nil, err
+	}
+	memo.Amount = amount
+	memo.Expiry = decodedReq.Expiry
+	if err := saveLNURLPayMemo(decodedReq.PaymentHash, memo); err != nil {
+		return nil, err
+	}
+	return &data.LightningAddressInvoice{PaymentRequest: paymentRequest, InvoiceMemo: memo}, nil
+}
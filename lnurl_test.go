@@ -0,0 +1,45 @@
+package breez
+
+import (
+	"testing"
+)
+
+func TestDecodeLNURL(t *testing.T) {
+	lnurl := "LNURL1DP68GURN8GHJ7UM9WFMXJCM99E3K7MF0V9CXJ0M385EKVCENXC6R2C35XVUKXEFCV5MKVV34X5EKZD3EV56NYD3HXQURZEPEXEJXXEPNXSCRVWFNV9NXZCN9XQ6XYEFHVGCXXCMYXYMNSERXFQ5FNS"
+	u, err := decodeLNURL(lnurl)
+	if err != nil {
+		t.Fatal("failed to decode lnurl", err)
+	}
+	if u != "https://service.com/api?q=3fc3645b439ce8e7f2553a69e5267081d96dcd340693afabe04be7b0ccd178df" {
+		t.Error("unexpected url ", u)
+	}
+
+	if _, err := decodeLNURL(lnurl[:len(lnurl)-1] + "T"); err == nil {
+		t.Error("lnurl with a bad checksum should fail")
+	}
+}
+
+func TestLNURLMetadataMemo(t *testing.T) {
+	memo, err := lnurlMetadataMemo(`[["text/plain","Coffee"],["image/png;base64","iVBORw0KGgo="]]`, "service.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if memo.Description != "Coffee" || memo.PayeeName != "service.com" || memo.PayeeImageURL != "data:image/png;base64,iVBORw0KGgo=" {
+		t.Errorf("unexpected memo %v", memo)
+	}
+}
+
+func TestLightningAddressURL(t *testing.T) {
+	u, err := lightningAddressURL("Alice@Example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u != "https://example.com/.well-known/lnurlp/alice" {
+		t.Error("unexpected url ", u)
+	}
+	for _, address := range []string{"alice", "@example.com", "alice@", "a@b@c"} {
+		if _, err := lightningAddressURL(address); err == nil {
+			t.Errorf("%v should be an invalid lightning address", address)
+		}
+	}
+}
@@ -1,10 +1,39 @@
 package breez
 
 import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
 	"github.com/breez/lightninglib/daemon"
+	"github.com/btcsuite/btclog"
+)
+
+// subsystemLoggers are the breez loggers by subsystem name. They write to the
+// daemon log backend, so they go to the daemon log file which is rotated by
+// the daemon according to the maxlogfilesize and maxlogfiles options.
+var subsystemLoggers = make(map[string]btclog.Logger)
+
+var (
+	log         = newSubsystemLogger("breez", "BRUI")
+	paymentsLog = newSubsystemLogger("payments", "PAYM")
+	backupLog   = newSubsystemLogger("backup", "BCKP")
+	chainLog    = newSubsystemLogger("chain", "CHAN")
 )
 
-var log = daemon.BackendLog().Logger("BRUI")
+func newSubsystemLogger(subsystem, tag string) btclog.Logger {
+	logger := daemon.BackendLog().Logger(tag)
+	subsystemLoggers[subsystem] = logger
+	return logger
+}
 
 /*
 Log a message to lightninglib's logging system
@@ -33,3 +62,133 @@ func Log(msg string, lvl string) {
 func GetLogPath() string {
 	return appWorkingDir + "/logs/bitcoin/" + cfg.Network + "/lnd.log"
 }
+
+/*
+SetLogLevel sets the log level (trace, debug, info, warn, error, critical or off) of a subsystem:
+breez, payments, backup or chain. The initial levels are set by the loglevel options of the
+config, e.g. loglevel=payments:debug.
+*/
+func SetLogLevel(subsystem, level string) error {
+	logger, ok := subsystemLoggers[subsystem]
+	if !ok {
+		return fmt.Errorf("unknown log subsystem %v", subsystem)
+	}
+	logLevel, ok := btclog.LevelFromString(level)
+	if !ok {
+		return fmt.Errorf("unknown log level %v", level)
+	}
+	logger.SetLevel(logLevel)
+	return nil
+}
+
+// logLevelNames are the names of the levels accepted by SetLogLevel.
+var logLevelNames = map[btclog.Level]string{
+	btclog.LevelTrace:    "trace",
+	btclog.LevelDebug:    "debug",
+	btclog.LevelInfo:     "info",
+	btclog.LevelWarn:     "warn",
+	btclog.LevelError:    "error",
+	btclog.LevelCritical: "critical",
+	btclog.LevelOff:      "off",
+}
+
+/*
+GetLogLevels returns the log level of every subsystem.
+*/
+func GetLogLevels() map[string]string {
+	levels := make(map[string]string)
+	for subsystem, logger := range subsystemLoggers {
+		levels[subsystem] = logLevelNames[logger.Level()]
+	}
+	return levels
+}
+
+// applyConfigLogLevels sets the subsystem levels of the config.
+func applyConfigLogLevels() {
+	for subsystem, level := range cfg.LogLevels {
+		if err := SetLogLevel(subsystem, level); err != nil {
+			fmt.Println("Warning loglevel", err)
+		}
+	}
+}
+
+// logFiles returns the daemon log file and its rolls, oldest first.
+func logFiles() ([]string, error) {
+	files, err := filepath.Glob(GetLogPath() + "*")
+	if err != nil {
+		return nil, err
+	}
+	modified := make(map[string]time.Time)
+	for _, f := range files {
+		info, err := os.Stat(f)
+		if err != nil {
+			return nil, err
+		}
+		modified[f] = info.ModTime()
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return modified[files[i]].Before(modified[files[j]])
+	})
+	return files, nil
+}
+
+// readLogFile returns the content of a log file, decompressing the compressed
+// rolls.
+func readLogFile(path string) ([]byte, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil || filepath.Ext(path) != ".gz" {
+		return content, err
+	}
+	r, err := gzip.NewReader(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
+
+// addLogFiles adds the log files to the archive with the payment requests redacted.
+func addLogFiles(w *zip.Writer) error {
+	files, err := logFiles()
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		content, err := readLogFile(f)
+		if err != nil {
+			return err
+		}
+		if err := addBundleFile(w, strings.TrimSuffix(filepath.Base(f), ".gz"), redactLog(content)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+ExportLogs writes the current log file and its rotated rolls, with the payment requests redacted,
+to a zip file to attach to a support ticket and returns its path.
+*/
+func ExportLogs() (string, error) {
+	if cfg == nil {
+		return "", errors.New("breez is not initialized")
+	}
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	if err := addLogFiles(w); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+	dir, err := ioutil.TempDir("", "logs")
+	if err != nil {
+		return "", err
+	}
+	bundlePath := filepath.Join(dir, "breez-logs.zip")
+	if err := ioutil.WriteFile(bundlePath, buf.Bytes(), 0600); err != nil {
+		return "", err
+	}
+	log.Infof("ExportLogs - exported the logs to %v", bundlePath)
+	return bundlePath, nil
+}
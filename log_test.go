@@ -0,0 +1,19 @@
+package breez
+
+import "testing"
+
+func TestSetLogLevel(t *testing.T) {
+	defer SetLogLevel("payments", GetLogLevels()["payments"])
+	if err := SetLogLevel("payments", "debug"); err != nil {
+		t.Fatal(err)
+	}
+	if level := GetLogLevels()["payments"]; level != "debug" {
+		t.Errorf("expected debug got %v", level)
+	}
+	if err := SetLogLevel("unknown", "debug"); err == nil {
+		t.Error("unknown subsystem was accepted")
+	}
+	if err := SetLogLevel("payments", "verbose"); err == nil {
+		t.Error("unknown level was accepted")
+	}
+}
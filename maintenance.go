@@ -0,0 +1,324 @@
+package breez
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	maintenanceInterval      = 24 * time.Hour
+	maintenanceCheckInterval = time.Hour
+
+	//maintenanceCompactRatio is the ratio of free pages in the database that
+	//triggers a compaction on the next start
+	maintenanceCompactRatio = 0.25
+
+	compactMarkerSuffix = ".compact"
+
+	defaultPaymentRequestRetention = 30 * 24 * time.Hour
+
+	avatarCacheMaxAge         = 30 * 24 * time.Hour
+	defaultAvatarCacheMaxSize = 20 << 20
+)
+
+var (
+	deviceCharging  int32
+	deviceUnmetered int32
+	maintenanceWake = make(chan struct{}, 1)
+	maintenanceMu   sync.Mutex
+)
+
+// maintenanceTask is a housekeeping job that is not urgent and runs in the
+// maintenance window. It returns a short description of what it did.
+type maintenanceTask struct {
+	name string
+	run  func() (string, error)
+}
+
+var maintenanceTasks = []maintenanceTask{
+	{"payment_request_pruning", prunePaymentRequests},
+	{"db_compaction", scheduleDBCompaction},
+	{"backup_verification", verifyBackup},
+	{"ledger_invariants", checkLedgerInvariants},
+	{"avatar_cache_eviction", evictAvatarCache},
+}
+
+/*
+SetDeviceConditions is called by the app whenever the device starts or stops charging and whenever
+it moves between an unmetered (Wi-Fi) and a metered network. The maintenance window is open only
+while the device is charging on an unmetered network.
+*/
+func SetDeviceConditions(charging, unmetered bool) {
+	atomic.StoreInt32(&deviceCharging, boolToInt32(charging))
+	atomic.StoreInt32(&deviceUnmetered, boolToInt32(unmetered))
+	select {
+	case maintenanceWake <- struct{}{}:
+	default:
+	}
+}
+
+func boolToInt32(b bool) int32 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func maintenanceWindowOpen() bool {
+	return atomic.LoadInt32(&deviceCharging) == 1 && atomic.LoadInt32(&deviceUnmetered) == 1
+}
+
+/*
+ForceMaintenanceNow runs all the maintenance tasks immediately, regardless of the device conditions
+and of the last run, and returns the report of the run.
+*/
+func ForceMaintenanceNow() (*data.MaintenanceReport, error) {
+	if !DaemonReady() {
+		return nil, ErrDaemonNotReady
+	}
+	return runMaintenance(true)
+}
+
+/*
+GetMaintenanceReport returns the report of the last maintenance run.
+*/
+func GetMaintenanceReport() (*data.MaintenanceReport, error) {
+	report, err := fetchMaintenanceReport()
+	if err != nil || report == nil {
+		return &data.MaintenanceReport{}, err
+	}
+	return report, nil
+}
+
+func runMaintenance(forced bool) (*data.MaintenanceReport, error) {
+	maintenanceMu.Lock()
+	defer maintenanceMu.Unlock()
+	report := &data.MaintenanceReport{StartTime: time.Now().Unix(), Forced: forced}
+	for _, task := range maintenanceTasks {
+		start := time.Now()
+		result, err := task.run()
+		taskResult := &data.MaintenanceTaskResult{
+			Name:       task.name,
+			Ok:         err == nil,
+			Result:     result,
+			DurationMs: int64(time.Since(start) / time.Millisecond),
+		}
+		if err != nil {
+			log.Errorf("maintenance task %v failed: %v", task.name, err)
+			taskResult.Error = err.Error()
+		}
+		report.Tasks = append(report.Tasks, taskResult)
+	}
+	report.EndTime = time.Now().Unix()
+	if err := saveMaintenanceReport(report); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// maintenanceDue returns true when the last maintenance run is older than
+// the maintenance interval.
+func maintenanceDue() bool {
+	report, err := fetchMaintenanceReport()
+	if err != nil {
+		log.Errorf("maintenanceDue - failed to fetch the last report: %v", err)
+		return false
+	}
+	return report == nil || time.Since(time.Unix(report.StartTime, 0)) >= maintenanceInterval
+}
+
+// watchMaintenance runs the maintenance tasks when they are due and the
+// device is charging on an unmetered network.
+func watchMaintenance() {
+	ticker := time.NewTicker(maintenanceCheckInterval)
+	defer ticker.Stop()
+	for {
+		if maintenanceWindowOpen() && maintenanceDue() {
+			if _, err := runMaintenance(false); err != nil {
+				log.Errorf("watchMaintenance - failed to save the report: %v", err)
+			}
+		}
+		select {
+		case <-ticker.C:
+		case <-maintenanceWake:
+		case <-quitChan:
+			return
+		}
+	}
+}
+
+// scheduleDBCompaction requests a compaction of the database on the next
+// start when enough of it is free pages. The database can't be replaced while
+// it is open.
+func scheduleDBCompaction() (string, error) {
+	var size int64
+	err := db.View(func(tx *bolt.Tx) error {
+		size = tx.Size()
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	stats := db.Stats()
+	freePages := stats.FreePageN + stats.PendingPageN
+	pages := size / int64(db.Info().PageSize)
+	if pages == 0 || float64(freePages)/float64(pages) < maintenanceCompactRatio {
+		return fmt.Sprintf("%v of %v pages are free, no compaction needed", freePages, pages), nil
+	}
+	f, err := os.Create(db.Path() + compactMarkerSuffix)
+	if err != nil {
+		return "", err
+	}
+	f.Close()
+	return fmt.Sprintf("%v of %v pages are free, compaction scheduled for the next start", freePages, pages), nil
+}
+
+// prunePaymentRequests deletes the payment requests of the recorded payments
+// created before the retention horizon, the payments keep what was decoded
+// from them. The requests of the HTLCs in flight are kept for their pending
+// payments.
+func prunePaymentRequests() (string, error) {
+	retention := defaultPaymentRequestRetention
+	if cfg != nil && cfg.PaymentRequestRetention > 0 {
+		retention = cfg.PaymentRequestRetention
+	}
+	channels, err := paymentsClient.ListChannels(context.Background(), &lnrpc.ListChannelsRequest{})
+	if err != nil {
+		return "", err
+	}
+	inFlight := make(map[string]bool)
+	for _, c := range channels.Channels {
+		for _, htlc := range c.PendingHtlcs {
+			inFlight[hex.EncodeToString(htlc.HashLock)] = true
+		}
+	}
+	pruned, err := deletePaymentRequestsBefore(time.Now().Add(-retention).Unix(), inFlight)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("deleted %v payment requests older than %v", pruned, retention), nil
+}
+
+// compactDBIfScheduled compacts the database before it is opened when the
+// last maintenance run scheduled it.
+func compactDBIfScheduled(dbPath string) {
+	marker := dbPath + compactMarkerSuffix
+	if _, err := os.Stat(marker); err != nil {
+		return
+	}
+	//a corrupted database may make bbolt panic, openDB then reports it
+	defer func() {
+		if r := recover(); r != nil {
+			log.Errorf("Database compaction panicked: %v", r)
+		}
+	}()
+	os.Remove(marker)
+	if err := compactDB(dbPath); err != nil {
+		log.Errorf("Failed to compact the database: %v", err)
+	}
+}
+
+// verifyBackup checks the last backup is recent and that a copy of the
+// database, as it is backed up, opens and holds all the payments.
+func verifyBackup() (string, error) {
+	if err := checkBackup(); err != nil {
+		return "", err
+	}
+	dbCopy, err := breezdbCopy()
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(filepath.Dir(dbCopy))
+	copied, err := countBackupPayments(dbCopy)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the database backup: %v", err)
+	}
+	payments, err := fetchAllAccountPayments()
+	if err != nil {
+		return "", err
+	}
+	if copied != len(payments) {
+		return "", fmt.Errorf("database backup has %v payments instead of %v", copied, len(payments))
+	}
+	return fmt.Sprintf("database backup holds all %v payments", copied), nil
+}
+
+// checkLedgerInvariants checks the balance of the whole payments ledger never
+// goes negative and matches the daemon channel balance.
+func checkLedgerInvariants() (string, error) {
+	payments, err := fetchAllAccountPayments()
+	if err != nil {
+		return "", err
+	}
+	var balance int64
+	statement := &data.Statement{}
+	sort.SliceStable(payments, func(i, j int) bool {
+		return payments[i].CreationTimestamp < payments[j].CreationTimestamp
+	})
+	for _, payment := range payments {
+		balance += balanceChange(payment)
+		if balance < 0 {
+			statement.Warnings = append(statement.Warnings, fmt.Sprintf("balance is negative after payment %v", payment.PaymentHash))
+		}
+	}
+	reconcileStatement(statement, balance)
+	if len(statement.Warnings) > 0 {
+		return "", fmt.Errorf("%v", statement.Warnings)
+	}
+	return fmt.Sprintf("ledger balance %v matches the channel balance", balance), nil
+}
+
+// evictAvatarCache deletes the cached avatars unused for avatarCacheMaxAge and
+// then the oldest ones until the cache fits its maximum size.
+func evictAvatarCache() (string, error) {
+	if cfg == nil || cfg.AvatarCacheDir == "" {
+		return "no avatar cache", nil
+	}
+	maxSize := int64(defaultAvatarCacheMaxSize)
+	if cfg.AvatarCacheMaxSize > 0 {
+		maxSize = cfg.AvatarCacheMaxSize
+	}
+	entries, err := ioutil.ReadDir(cfg.AvatarCacheDir)
+	if os.IsNotExist(err) {
+		return "no avatar cache", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	var files []os.FileInfo
+	var size int64
+	for _, f := range entries {
+		if f.Mode().IsRegular() {
+			files = append(files, f)
+			size += f.Size()
+		}
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].ModTime().Before(files[j].ModTime())
+	})
+	horizon := time.Now().Add(-avatarCacheMaxAge)
+	evicted := 0
+	for _, f := range files {
+		if size <= maxSize && f.ModTime().After(horizon) {
+			break
+		}
+		if err := os.Remove(filepath.Join(cfg.AvatarCacheDir, f.Name())); err != nil {
+			return "", err
+		}
+		size -= f.Size()
+		evicted++
+	}
+	return fmt.Sprintf("evicted %v avatars, %v bytes left", evicted, size), nil
+}
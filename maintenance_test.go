@@ -0,0 +1,93 @@
+package breez
+
+import (
+	"context"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/breez/lightninglib/lnrpc"
+)
+
+func TestEvictAvatarCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "avatars")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	previousCfg := cfg
+	cfg = &Config{AvatarCacheDir: dir, AvatarCacheMaxSize: 20}
+	defer func() { cfg = previousCfg }()
+
+	now := time.Now()
+	avatars := []struct {
+		name string
+		age  time.Duration
+	}{
+		{"unused", avatarCacheMaxAge + time.Hour},
+		{"old", 3 * time.Hour},
+		{"recent", 2 * time.Hour},
+		{"newest", time.Hour},
+	}
+	for _, a := range avatars {
+		path := filepath.Join(dir, a.name)
+		if err := ioutil.WriteFile(path, make([]byte, 10), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, now.Add(-a.age), now.Add(-a.age)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := evictAvatarCache(); err != nil {
+		t.Fatal(err)
+	}
+	for _, a := range avatars {
+		_, err := os.Stat(filepath.Join(dir, a.name))
+		kept := a.name == "recent" || a.name == "newest"
+		if kept != (err == nil) {
+			t.Errorf("%v: expected kept %v, got %v", a.name, kept, err)
+		}
+	}
+}
+
+func TestPrunePaymentRequestsKeepsInFlight(t *testing.T) {
+	defer openTestDB(t)()
+	daemon, restore := installMemoryDaemon(t, 0)
+	defer restore()
+
+	hash, payReq := testPaymentRequest(t, time.Unix(10, 0))
+	if err := savePaymentRequest(hash, []byte(payReq)); err != nil {
+		t.Fatal(err)
+	}
+	if err := addAccountPayment(&paymentInfo{Type: sentPayment, PaymentHash: hash}, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	hashLock, err := hex.DecodeString(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	daemon.htlcs = []*lnrpc.HTLC{{HashLock: hashLock, Amount: 1000, ExpirationHeight: 100}}
+
+	if _, err := prunePaymentRequests(); err != nil {
+		t.Fatal(err)
+	}
+	pending, err := getPendingPayments(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 1 || pending[0].PaymentHash != hash {
+		t.Fatalf("expected the pending payment to keep its payment request, got %+v", pending)
+	}
+
+	daemon.htlcs = nil
+	if _, err := prunePaymentRequests(); err != nil {
+		t.Fatal(err)
+	}
+	if payReq, _ := fetchPaymentRequest(hash); payReq != nil {
+		t.Error("expected the payment request to be pruned once the HTLC resolved")
+	}
+}
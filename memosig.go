@@ -0,0 +1,65 @@
+package breez
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/tv42/zbase32"
+)
+
+var (
+	// signedMsgPrefix is the prefix lnd adds to every message it signs.
+	signedMsgPrefix = []byte("Lightning Signed Message:")
+)
+
+// payeeMetadata is the part of the memo the payee signs: the name and image
+// shown to the payer.
+func payeeMetadata(memo *data.InvoiceMemo) ([]byte, error) {
+	return json.Marshal([]string{memo.PayeeName, memo.PayeeImageURL})
+}
+
+// signPayeeMetadata signs the payee metadata of the memo with the node key.
+func signPayeeMetadata(memo *data.InvoiceMemo) error {
+	metadata, err := payeeMetadata(memo)
+	if err != nil {
+		return err
+	}
+	signed, err := paymentsClient.SignMessage(context.Background(), &lnrpc.SignMessageRequest{Msg: metadata})
+	if err != nil {
+		return err
+	}
+	memo.PayeeSignature = signed.Signature
+	return nil
+}
+
+// verifyPayeeMetadata returns true if the memo payee metadata is signed by the
+// invoice destination node.
+func verifyPayeeMetadata(memo *data.InvoiceMemo, destination string) bool {
+	if memo.PayeeSignature == "" {
+		return false
+	}
+	sig, err := zbase32.DecodeString(memo.PayeeSignature)
+	if err != nil {
+		return false
+	}
+	metadata, err := payeeMetadata(memo)
+	if err != nil {
+		return false
+	}
+	digest := chainhash.DoubleHashB(append(signedMsgPrefix, metadata...))
+	pubKey, _, err := btcec.RecoverCompact(btcec.S256(), sig, digest)
+	if err != nil {
+		return false
+	}
+	destinationKey, err := hex.DecodeString(destination)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(pubKey.SerializeCompressed(), destinationKey)
+}
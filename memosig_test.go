@@ -0,0 +1,41 @@
+package breez
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/breez/breez/data"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/tv42/zbase32"
+)
+
+func TestVerifyPayeeMetadata(t *testing.T) {
+	key, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	memo := &data.InvoiceMemo{PayeeName: "Coffee Shop", PayeeImageURL: "https://shop.com/logo.png"}
+	metadata, err := payeeMetadata(memo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := btcec.SignCompact(btcec.S256(), key, chainhash.DoubleHashB(append(signedMsgPrefix, metadata...)), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	memo.PayeeSignature = zbase32.EncodeToString(sig)
+	destination := hex.EncodeToString(key.PubKey().SerializeCompressed())
+
+	if !verifyPayeeMetadata(memo, destination) {
+		t.Error("memo signed by the destination should be verified")
+	}
+	otherKey, _ := btcec.NewPrivateKey(btcec.S256())
+	if verifyPayeeMetadata(memo, hex.EncodeToString(otherKey.PubKey().SerializeCompressed())) {
+		t.Error("memo signed by another node should not be verified")
+	}
+	memo.PayeeName = "Spoofed Shop"
+	if verifyPayeeMetadata(memo, destination) {
+		t.Error("memo with a modified payee name should not be verified")
+	}
+}
@@ -0,0 +1,205 @@
+package breez
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// latencyBuckets are the upper bounds in seconds of the latency histograms.
+var latencyBuckets = []float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30, 60, 300}
+
+var (
+	paymentsSentTotal = newMetricsCounter("breez_payments_sent_total",
+		"Lightning payments sent successfully.", "")
+	paymentsFailedTotal = newMetricsCounter("breez_payments_failed_total",
+		"Lightning payments that failed, by failure reason.", "reason")
+	paymentSendSeconds = newMetricsHistogram("breez_payment_send_duration_seconds",
+		"Time to send a lightning payment, by result.", "result")
+	invoicesSettledTotal = newMetricsCounter("breez_invoices_settled_total",
+		"Invoices settled and recorded as received payments.", "")
+	chainSyncSeconds = newMetricsHistogram("breez_chain_sync_duration_seconds",
+		"Time for the daemon to sync to the chain, by mode.", "mode")
+	dbOperationSeconds = newMetricsHistogram("breez_db_operation_duration_seconds",
+		"Time of the database operations, by operation.", "operation")
+
+	registeredMetrics = []metricsWriter{
+		paymentsSentTotal,
+		paymentsFailedTotal,
+		paymentSendSeconds,
+		invoicesSettledTotal,
+		chainSyncSeconds,
+		dbOperationSeconds,
+	}
+)
+
+// metricsWriter writes a metric in the prometheus text exposition format.
+type metricsWriter interface {
+	writeMetric(w io.Writer)
+}
+
+// metricsCounter is a counter with an optional label.
+type metricsCounter struct {
+	name, help, label string
+
+	mu     sync.Mutex
+	values map[string]uint64
+}
+
+func newMetricsCounter(name, help, label string) *metricsCounter {
+	return &metricsCounter{name: name, help: help, label: label, values: make(map[string]uint64)}
+}
+
+// inc increments the counter of the label value, empty for unlabeled counters.
+func (c *metricsCounter) inc(labelValue string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.values[labelValue]++
+}
+
+func (c *metricsCounter) writeMetric(w io.Writer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fmt.Fprintf(w, "# HELP %v %v\n# TYPE %v counter\n", c.name, c.help, c.name)
+	if c.label == "" {
+		fmt.Fprintf(w, "%v %v\n", c.name, c.values[""])
+		return
+	}
+	for _, labelValue := range sortedLabelValues(c.values) {
+		fmt.Fprintf(w, "%v{%v=%q} %v\n", c.name, c.label, labelValue, c.values[labelValue])
+	}
+}
+
+// histogramSeries holds the observations of a label value, counts has a
+// cumulative count per bucket.
+type histogramSeries struct {
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+// metricsHistogram is a histogram of latencyBuckets with an optional label.
+type metricsHistogram struct {
+	name, help, label string
+
+	mu     sync.Mutex
+	series map[string]*histogramSeries
+}
+
+func newMetricsHistogram(name, help, label string) *metricsHistogram {
+	return &metricsHistogram{name: name, help: help, label: label, series: make(map[string]*histogramSeries)}
+}
+
+// observe records a value in seconds for the label value.
+func (h *metricsHistogram) observe(labelValue string, seconds float64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	s, ok := h.series[labelValue]
+	if !ok {
+		s = &histogramSeries{counts: make([]uint64, len(latencyBuckets))}
+		h.series[labelValue] = s
+	}
+	for i, bound := range latencyBuckets {
+		if seconds <= bound {
+			s.counts[i]++
+		}
+	}
+	s.sum += seconds
+	s.count++
+}
+
+// since records the time elapsed from start, for deferred calls.
+func (h *metricsHistogram) since(labelValue string, start time.Time) {
+	h.observe(labelValue, time.Since(start).Seconds())
+}
+
+func (h *metricsHistogram) writeMetric(w io.Writer) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	fmt.Fprintf(w, "# HELP %v %v\n# TYPE %v histogram\n", h.name, h.help, h.name)
+	labelValues := make([]string, 0, len(h.series))
+	for labelValue := range h.series {
+		labelValues = append(labelValues, labelValue)
+	}
+	sort.Strings(labelValues)
+	for _, labelValue := range labelValues {
+		s := h.series[labelValue]
+		bucketLabels, labels := "", ""
+		if h.label != "" {
+			bucketLabels = fmt.Sprintf("%v=%q,", h.label, labelValue)
+			labels = fmt.Sprintf("{%v=%q}", h.label, labelValue)
+		}
+		for i, bound := range latencyBuckets {
+			fmt.Fprintf(w, "%v_bucket{%vle=%q} %v\n", h.name, bucketLabels, strconv.FormatFloat(bound, 'g', -1, 64), s.counts[i])
+		}
+		fmt.Fprintf(w, "%v_bucket{%vle=\"+Inf\"} %v\n", h.name, bucketLabels, s.count)
+		fmt.Fprintf(w, "%v_sum%v %v\n", h.name, labels, strconv.FormatFloat(s.sum, 'g', -1, 64))
+		fmt.Fprintf(w, "%v_count%v %v\n", h.name, labels, s.count)
+	}
+}
+
+func sortedLabelValues(values map[string]uint64) []string {
+	labelValues := make([]string, 0, len(values))
+	for labelValue := range values {
+		labelValues = append(labelValues, labelValue)
+	}
+	sort.Strings(labelValues)
+	return labelValues
+}
+
+// observeDBOperation records the time of a database operation started at start.
+func observeDBOperation(operation string, start time.Time) {
+	dbOperationSeconds.since(operation, start)
+}
+
+/*
+GetMetrics returns the wallet metrics in the prometheus text exposition format: the payments sent and
+failed, the send latency, the invoices settled, the chain sync duration and the database operations latency.
+*/
+func GetMetrics() string {
+	var b bytes.Buffer
+	for _, m := range registeredMetrics {
+		m.writeMetric(&b)
+	}
+	return b.String()
+}
+
+func metricsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	io.WriteString(w, GetMetrics())
+}
+
+// startMetricsServer serves the metrics on /metrics of the configured address
+// until breez is stopped.
+func startMetricsServer() {
+	if cfg.MetricsListen == "" {
+		return
+	}
+	listener, err := net.Listen("tcp", cfg.MetricsListen)
+	if err != nil {
+		log.Errorf("metrics - failed to listen on %v: %v", cfg.MetricsListen, err)
+		return
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", metricsHandler)
+	server := &http.Server{Handler: mux}
+	go func() {
+		<-quitChan
+		server.Shutdown(context.Background())
+	}()
+	log.Infof("metrics listening on %v", cfg.MetricsListen)
+	if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+		log.Errorf("metrics server stopped: %v", err)
+	}
+}
@@ -0,0 +1,34 @@
+package breez
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMetricsExposition(t *testing.T) {
+	counter := newMetricsCounter("test_total", "Test counter.", "reason")
+	counter.inc("NO_ROUTE")
+	counter.inc("NO_ROUTE")
+	histogram := newMetricsHistogram("test_seconds", "Test histogram.", "")
+	histogram.observe("", 0.25)
+	histogram.observe("", 2)
+
+	var b strings.Builder
+	counter.writeMetric(&b)
+	histogram.writeMetric(&b)
+	for _, line := range []string{
+		"# TYPE test_total counter",
+		`test_total{reason="NO_ROUTE"} 2`,
+		"# TYPE test_seconds histogram",
+		`test_seconds_bucket{le="0.1"} 0`,
+		`test_seconds_bucket{le="0.5"} 1`,
+		`test_seconds_bucket{le="5"} 2`,
+		`test_seconds_bucket{le="+Inf"} 2`,
+		"test_seconds_sum 2.25",
+		"test_seconds_count 2",
+	} {
+		if !strings.Contains(b.String(), line+"\n") {
+			t.Errorf("missing %q in:\n%v", line, b.String())
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package breez
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"sync/atomic"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	// migrationBackupSuffix is the suffix of the copy of the database taken
+	// before migrating it, used to recover from a database corrupted by the
+	// migration. It is deleted once the migration succeeds.
+	migrationBackupSuffix = ".premigration"
+
+	// corruptedDBSuffix is the suffix the corrupted databases are moved aside with.
+	corruptedDBSuffix = ".corrupted"
+)
+
+// dbVersionKey is the key of the schema version in the version bucket.
+var dbVersionKey = []byte("schemaVersion")
+
+// ErrDatabaseCorrupted is returned by Start when the database file is
+// corrupted, the app may then call RecoverCorruptedDatabase.
+var ErrDatabaseCorrupted = errors.New("the database is corrupted")
+
+// dbMigration is a forward migration of the database schema.
+type dbMigration struct {
+	description string
+	migrate     func(tx *bolt.Tx) error
+}
+
+// dbMigrations bring a database to the current schema, the schema version is
+// the number of migrations applied. New migrations are appended, the existing
+// ones must never change.
+var dbMigrations = []dbMigration{
+	{"set the millisatoshi fee of the sent payments", migrateSentPaymentsFeeMsat},
+}
+
+// migrateSentPaymentsFeeMsat sets the millisatoshi fee of the sent payments
+// saved before it was recorded.
+func migrateSentPaymentsFeeMsat(tx *bolt.Tx) error {
+	b := tx.Bucket([]byte(paymentsBucket))
+	updated := make(map[string][]byte)
+	err := b.ForEach(func(k, v []byte) error {
+		if v == nil {
+			//nested bucket
+			return nil
+		}
+		payment, err := deserializePaymentInfo(v)
+		if err != nil {
+			return err
+		}
+		if payment.Type != sentPayment || payment.Fee == 0 || payment.FeeMsat != 0 {
+			return nil
+		}
+		payment.FeeMsat = payment.Fee * 1000
+		paymentBuf, err := serializePaymentInfo(payment)
+		if err != nil {
+			return err
+		}
+		updated[string(k)] = paymentBuf
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for k, v := range updated {
+		if err := b.Put([]byte(k), v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func fetchDBVersion(tx *bolt.Tx) uint64 {
+	versionBuf := tx.Bucket([]byte(versionBucket)).Get(dbVersionKey)
+	if versionBuf == nil {
+		return 0
+	}
+	return btoi(versionBuf)
+}
+
+func saveDBVersion(tx *bolt.Tx, version uint64) error {
+	return tx.Bucket([]byte(versionBucket)).Put(dbVersionKey, itob(version))
+}
+
+// migrateDB applies the pending migrations, each in its own transaction so a
+// failed migration leaves the database at the previous version. A new
+// database is at the current version. Before migrating an existing database
+// it is copied for RecoverCorruptedDatabase, the copy is deleted once all the
+// migrations are applied.
+func migrateDB(dbPath string, newDB bool) error {
+	var version uint64
+	err := db.Update(func(tx *bolt.Tx) error {
+		if newDB {
+			return saveDBVersion(tx, uint64(len(dbMigrations)))
+		}
+		version = fetchDBVersion(tx)
+		return nil
+	})
+	if err != nil || newDB {
+		return err
+	}
+	if version > uint64(len(dbMigrations)) {
+		return fmt.Errorf("database schema version %v is newer than the supported version %v", version, len(dbMigrations))
+	}
+	if version == uint64(len(dbMigrations)) {
+		return nil
+	}
+	err = db.View(func(tx *bolt.Tx) error {
+		return tx.CopyFile(dbPath+migrationBackupSuffix, 0600)
+	})
+	if err != nil {
+		return err
+	}
+	for ; version < uint64(len(dbMigrations)); version++ {
+		m := dbMigrations[version]
+		log.Infof("migrateDB - migrating to version %v: %v", version+1, m.description)
+		err := db.Update(func(tx *bolt.Tx) error {
+			if err := m.migrate(tx); err != nil {
+				return err
+			}
+			return saveDBVersion(tx, version+1)
+		})
+		if err != nil {
+			return fmt.Errorf("database migration to version %v failed: %v", version+1, err)
+		}
+	}
+	return os.Remove(dbPath + migrationBackupSuffix)
+}
+
+// dbPanicError is returned by openDB when bbolt panicked, which it does on
+// some corrupted pages instead of returning an error.
+type dbPanicError struct {
+	value interface{}
+}
+
+func (e *dbPanicError) Error() string {
+	return fmt.Sprintf("database panicked: %v", e.value)
+}
+
+// isDBCorrupted returns true if the database failed to open because its file
+// is corrupted.
+func isDBCorrupted(err error) bool {
+	if _, ok := err.(*dbPanicError); ok {
+		return true
+	}
+	return err == bolt.ErrInvalid || err == bolt.ErrChecksum || err == bolt.ErrVersionMismatch
+}
+
+/*
+RecoverCorruptedDatabase is called by the app after Start failed with a DATABASE_CORRUPTED error and the
+user chose to recover. It moves the corrupted database aside and returns its path. The copy taken before
+an interrupted migration is restored when there is one: it is only kept until the migration succeeds, so
+nothing was recorded after it. Otherwise the next Start begins with a new database, which lacks the swap
+addresses and the other data that can't be synced again from the daemon, so the app should offer
+restoring the last backup instead.
+*/
+func RecoverCorruptedDatabase(workingDir string) (string, error) {
+	if atomic.LoadInt32(&started) == 1 {
+		return "", errors.New("the database can't be recovered while breez is started")
+	}
+	dbPath := path.Join(workingDir, "breez.db")
+	corruptedPath := fmt.Sprintf("%v%v-%v", dbPath, corruptedDBSuffix, time.Now().Unix())
+	if err := os.Rename(dbPath, corruptedPath); err != nil {
+		return "", err
+	}
+	backupPath := dbPath + migrationBackupSuffix
+	if backup, err := bolt.Open(backupPath, 0600, &bolt.Options{ReadOnly: true, Timeout: time.Second}); err == nil {
+		backup.Close()
+		if err := os.Rename(backupPath, dbPath); err != nil {
+			return "", err
+		}
+		log.Criticalf("RecoverCorruptedDatabase - moved the corrupted database to %v and restored the copy taken before the interrupted migration", corruptedPath)
+	} else {
+		log.Criticalf("RecoverCorruptedDatabase - moved the corrupted database to %v, starting with a new database", corruptedPath)
+	}
+	return corruptedPath, nil
+}
@@ -0,0 +1,120 @@
+package breez
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+func setTestDBVersion(t *testing.T, version uint64) {
+	err := db.Update(func(tx *bolt.Tx) error {
+		return saveDBVersion(tx, version)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMigrateDB(t *testing.T) {
+	defer openTestDB(t)()
+	if err := addAccountPayment(&paymentInfo{Type: sentPayment, PaymentHash: "h1", Amount: 100, Fee: 2}, 0, 1); err != nil {
+		t.Fatal(err)
+	}
+	setTestDBVersion(t, 0)
+	dbPath := db.Path()
+	if err := migrateDB(dbPath, false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(dbPath + migrationBackupSuffix); !os.IsNotExist(err) {
+		t.Errorf("the copy taken before the migration wasn't deleted: %v", err)
+	}
+	payments, err := fetchAllAccountPayments()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(payments) != 1 || payments[0].FeeMsat != 2000 {
+		t.Errorf("fee wasn't migrated: %+v", payments)
+	}
+	db.View(func(tx *bolt.Tx) error {
+		if version := fetchDBVersion(tx); version != uint64(len(dbMigrations)) {
+			t.Errorf("expected version %v got %v", len(dbMigrations), version)
+		}
+		return nil
+	})
+
+	setTestDBVersion(t, uint64(len(dbMigrations)+1))
+	if err := migrateDB(dbPath, false); err == nil {
+		t.Error("newer schema version was accepted")
+	}
+}
+
+func TestRecoverCorruptedDatabase(t *testing.T) {
+	dir, err := ioutil.TempDir("", "breez-recover")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	dbPath := path.Join(dir, "breez.db")
+	if err := ioutil.WriteFile(dbPath, bytes.Repeat([]byte("corrupted"), 4096), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := openDB(dbPath); !isDBCorrupted(err) {
+		t.Fatalf("expected a corrupted database error, got %v", err)
+	}
+	corruptedPath, err := RecoverCorruptedDatabase(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(corruptedPath); err != nil {
+		t.Errorf("the corrupted database wasn't kept: %v", err)
+	}
+	if _, err := os.Stat(dbPath); !os.IsNotExist(err) {
+		t.Errorf("the corrupted database wasn't moved aside: %v", err)
+	}
+}
+
+// corruptRootPages marks the root pages of both meta pages of a bbolt file as
+// freelist pages, which makes bbolt panic when it reads them.
+func corruptRootPages(t *testing.T, dbPath string) {
+	f, err := os.OpenFile(dbPath, os.O_RDWR, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	meta := make([]byte, 40)
+	if _, err := f.ReadAt(meta, 0); err != nil {
+		t.Fatal(err)
+	}
+	pageSize := int64(binary.LittleEndian.Uint32(meta[24:]))
+	for i := int64(0); i < 2; i++ {
+		if _, err := f.ReadAt(meta, i*pageSize); err != nil {
+			t.Fatal(err)
+		}
+		root := int64(binary.LittleEndian.Uint64(meta[32:]))
+		if _, err := f.WriteAt([]byte{0x10, 0}, root*pageSize+8); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestOpenDBRecoversPanic(t *testing.T) {
+	dir, err := ioutil.TempDir("", "breez-panic")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	dbPath := path.Join(dir, "breez.db")
+	if err := openDB(dbPath); err != nil {
+		t.Fatal(err)
+	}
+	closeDB()
+	corruptRootPages(t, dbPath)
+	if err := openDB(dbPath); !isDBCorrupted(err) {
+		t.Fatalf("expected a corrupted database error, got %v", err)
+	}
+}
@@ -0,0 +1,319 @@
+package breez
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	swapInMechanism       = "swap-in"
+	swapOutMechanism      = "swap-out"
+	channelOpenMechanism  = "channel-open"
+	channelCloseMechanism = "channel-close"
+)
+
+// moveFundsMechanism is a way of moving funds between the balances. Splicing
+// isn't one of them since the daemon doesn't support it.
+type moveFundsMechanism struct {
+	name string
+	// quote returns the fee of moving the operation amount and the function
+	// starting the move, or an error if the mechanism can't move it now.
+	quote func(ctx context.Context, operation *data.MoveFundsOperation) (int64, func() error, error)
+}
+
+var moveFundsMechanisms = map[data.MoveFundsOperation_Direction][]moveFundsMechanism{
+	data.MoveFundsOperation_TO_LIGHTNING: {
+		{swapInMechanism, quoteSwapIn},
+		{channelOpenMechanism, quoteChannelOpen},
+	},
+	data.MoveFundsOperation_TO_ONCHAIN: {
+		{swapOutMechanism, quoteSwapOut},
+		{channelCloseMechanism, quoteChannelClose},
+	},
+}
+
+func serializeMoveFundsOperation(o *data.MoveFundsOperation) ([]byte, error) {
+	operationBytes, err := proto.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return sealDBValue(operationBytes)
+}
+
+func deserializeMoveFundsOperation(operationBytes []byte) (*data.MoveFundsOperation, error) {
+	var operation data.MoveFundsOperation
+	operationBytes, err := openDBValue(operationBytes)
+	if err != nil {
+		return &operation, err
+	}
+	err = proto.Unmarshal(operationBytes, &operation)
+	return &operation, err
+}
+
+/*
+MoveFunds moves the given amount between the lightning and the on-chain balances with a single call.
+It picks the available mechanism with the lowest fee for the direction and amount and tracks the whole
+flow as one operation, which stays PENDING until the funds arrive. AddFundsInit, SendWalletCoins,
+RemoveFund and CloseChannel are still available for power users.
+*/
+func MoveFunds(direction data.MoveFundsOperation_Direction, amount int64) (*data.MoveFundsOperation, error) {
+	return MoveFundsContext(context.Background(), direction, amount)
+}
+
+/*
+MoveFundsContext is MoveFunds with a context, which attributes the sends in the audit log.
+*/
+func MoveFundsContext(ctx context.Context, direction data.MoveFundsOperation_Direction, amount int64) (*data.MoveFundsOperation, error) {
+	mechanisms, ok := moveFundsMechanisms[direction]
+	if !ok {
+		return nil, errors.New("unknown direction")
+	}
+	if amount <= 0 {
+		return nil, errors.New("amount must be positive")
+	}
+	operation := &data.MoveFundsOperation{
+		Direction: direction,
+		Amount:    amount,
+		Timestamp: trustedNow().Unix(),
+	}
+
+	var start func() error
+	var reasons []string
+	for _, m := range mechanisms {
+		fee, mechanismStart, err := m.quote(ctx, operation)
+		if err != nil {
+			reasons = append(reasons, fmt.Sprintf("%v: %v", m.name, err))
+			continue
+		}
+		if start == nil || fee < operation.Fee {
+			operation.Mechanism = m.name
+			operation.Fee = fee
+			start = mechanismStart
+		}
+	}
+	if start == nil {
+		return nil, fmt.Errorf("no mechanism can move the amount now (%v)", strings.Join(reasons, "; "))
+	}
+
+	if err := addMoveFundsOperation(operation); err != nil {
+		return nil, err
+	}
+	log.Infof("MoveFunds - starting operation %v using %v, fee %v", operation.Id, operation.Mechanism, operation.Fee)
+	if err := start(); err != nil {
+		log.Errorf("MoveFunds - operation %v failed: %v", operation.Id, err)
+		operation.Status = data.MoveFundsOperation_FAILED
+		operation.ErrorMessage = err.Error()
+	}
+	if err := saveMoveFundsOperation(operation); err != nil {
+		return nil, err
+	}
+	go onAccountChanged()
+	return operation, nil
+}
+
+// quoteSwapIn funds a new swap address from the on-chain wallet. The swap
+// itself is completed by the regular swap address watchers.
+func quoteSwapIn(ctx context.Context, operation *data.MoveFundsOperation) (int64, func() error, error) {
+	limits, err := GetSwapLimits()
+	if err != nil {
+		return 0, nil, err
+	}
+	if operation.Amount < limits.MinSwapIn || operation.Amount > limits.MaxSwapIn {
+		return 0, nil, fmt.Errorf("amount must be between %v and %v", limits.MinSwapIn, limits.MaxSwapIn)
+	}
+	satPerByte := GetDefaultSatPerByteFee()
+	return swapTxVirtualSize * satPerByte, func() error {
+		reply, err := AddFundsInit("")
+		if err != nil {
+			return err
+		}
+		if reply.ErrorMessage != "" {
+			return errors.New(reply.ErrorMessage)
+		}
+		operation.Address = reply.Address
+		operation.Txid, err = SendWalletCoinsContext(ctx, reply.Address, operation.Amount, satPerByte)
+		return err
+	}, nil
+}
+
+// quoteChannelOpen opens a new channel to the routing node with the amount.
+func quoteChannelOpen(ctx context.Context, operation *data.MoveFundsOperation) (int64, func() error, error) {
+	if operation.Amount < minChannelSize() {
+		return 0, nil, fmt.Errorf("amount is below the minimum channel size %v", minChannelSize())
+	}
+	satPerByte := GetDefaultSatPerByteFee()
+	fee := fundingTxVirtualSize * satPerByte
+	walletBalance, err := lightningClient.WalletBalance(ctx, &lnrpc.WalletBalanceRequest{})
+	if err != nil {
+		return 0, nil, err
+	}
+	if walletBalance.ConfirmedBalance < operation.Amount+fee {
+		return 0, nil, errors.New("amount exceeds the confirmed wallet balance")
+	}
+	return fee, func() error {
+		channelPoint, err := lightningClient.OpenChannelSync(ctx, &lnrpc.OpenChannelRequest{
+			NodePubkeyString:   cfg.RoutingNodePubKey,
+			LocalFundingAmount: operation.Amount,
+			SatPerByte:         satPerByte,
+			Private:            true,
+		})
+		if err != nil {
+			return err
+		}
+		txid, err := chainhash.NewHash(channelPoint.GetFundingTxidBytes())
+		if err != nil {
+			return err
+		}
+		operation.Txid = txid.String()
+		return nil
+	}, nil
+}
+
+// quoteSwapOut swaps the amount out to a new address of the on-chain wallet.
+func quoteSwapOut(ctx context.Context, operation *data.MoveFundsOperation) (int64, func() error, error) {
+	limits, err := GetSwapLimits()
+	if err != nil {
+		return 0, nil, err
+	}
+	if operation.Amount < limits.MinSwapOut || operation.Amount > limits.MaxSwapOut {
+		return 0, nil, fmt.Errorf("amount must be between %v and %v", limits.MinSwapOut, limits.MaxSwapOut)
+	}
+	addr, err := lightningClient.NewAddress(ctx, &lnrpc.NewAddressRequest{Type: lnrpc.NewAddressRequest_WITNESS_PUBKEY_HASH})
+	if err != nil {
+		return 0, nil, err
+	}
+	quote, err := quoteRemoveFund(operation.Amount, addr.Address)
+	if err != nil {
+		return 0, nil, err
+	}
+	if quote.errorMessage != "" {
+		return 0, nil, errors.New(quote.errorMessage)
+	}
+	return quote.fee(), func() error {
+		operation.Address = addr.Address
+		reply, err := payRemoveFund(ctx, quote)
+		if err != nil {
+			return err
+		}
+		operation.Txid = reply.Txid
+		return nil
+	}, nil
+}
+
+// quoteChannelClose closes the routing node channel whose whole local balance
+// is the amount.
+func quoteChannelClose(ctx context.Context, operation *data.MoveFundsOperation) (int64, func() error, error) {
+	channels, err := routingNodeChannels()
+	if err != nil {
+		return 0, nil, err
+	}
+	for _, c := range channels {
+		if !c.Active || c.LocalBalance != operation.Amount {
+			continue
+		}
+		channelPoint := c.ChannelPoint
+		return closeTxVirtualSize * normalCloseSatPerByte, func() error {
+			txid, err := CloseChannel(channelPoint, data.CloseFeeStrategy_NORMAL, 0)
+			if err != nil {
+				return err
+			}
+			operation.Txid = txid
+			return nil
+		}, nil
+	}
+	return 0, nil, errors.New("no channel has exactly the amount as its balance")
+}
+
+// updateMoveFundsOperations completes the pending operations whose funds
+// arrived and fails the swaps which were refunded.
+func updateMoveFundsOperations() {
+	operations, err := fetchMoveFundsOperations()
+	if err != nil {
+		log.Errorf("updateMoveFundsOperations - failed to fetch operations: %v", err)
+		return
+	}
+	var confirmed map[string]bool
+	for _, operation := range operations {
+		if operation.Status != data.MoveFundsOperation_PENDING || operation.Txid == "" {
+			continue
+		}
+		status, errorMessage := data.MoveFundsOperation_PENDING, ""
+		switch operation.Mechanism {
+		case swapInMechanism:
+			address, err := fetchSwapAddress(operation.Address)
+			if err != nil || address == nil {
+				log.Errorf("updateMoveFundsOperations - failed to fetch swap address %v: %v", operation.Address, err)
+				continue
+			}
+			if address.PaidAmount > 0 {
+				status = data.MoveFundsOperation_COMPLETED
+			} else if address.LastRefundTxID != "" {
+				status, errorMessage = data.MoveFundsOperation_FAILED, "the swap was refunded"
+			}
+		case channelOpenMechanism:
+			channels, err := routingNodeChannels()
+			if err != nil {
+				log.Errorf("updateMoveFundsOperations - failed to list channels: %v", err)
+				continue
+			}
+			for _, c := range channels {
+				if strings.HasPrefix(c.ChannelPoint, operation.Txid+":") {
+					status = data.MoveFundsOperation_COMPLETED
+				}
+			}
+		default:
+			if confirmed == nil {
+				if confirmed, err = confirmedTransactions(); err != nil {
+					log.Errorf("updateMoveFundsOperations - failed to get transactions: %v", err)
+					return
+				}
+			}
+			if confirmed[operation.Txid] {
+				status = data.MoveFundsOperation_COMPLETED
+			}
+		}
+		if status == data.MoveFundsOperation_PENDING {
+			continue
+		}
+		log.Infof("updateMoveFundsOperations - operation %v is %v", operation.Id, status)
+		operation.Status = status
+		operation.ErrorMessage = errorMessage
+		if err := saveMoveFundsOperation(operation); err != nil {
+			log.Errorf("updateMoveFundsOperations - failed to save operation %v: %v", operation.Id, err)
+		}
+	}
+}
+
+// confirmedTransactions returns the ids of the wallet transactions with at
+// least one confirmation.
+func confirmedTransactions() (map[string]bool, error) {
+	txs, err := lightningClient.GetTransactions(context.Background(), &lnrpc.GetTransactionsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	confirmed := make(map[string]bool)
+	for _, tx := range txs.Transactions {
+		if tx.NumConfirmations > 0 {
+			confirmed[tx.TxHash] = true
+		}
+	}
+	return confirmed, nil
+}
+
+/*
+GetMoveFundsOperations returns all the operations started by MoveFunds.
+*/
+func GetMoveFundsOperations() (*data.MoveFundsOperationsList, error) {
+	operations, err := fetchMoveFundsOperations()
+	if err != nil {
+		return nil, err
+	}
+	return &data.MoveFundsOperationsList{Operations: operations}, nil
+}
@@ -0,0 +1,44 @@
+package breez
+
+import (
+	"testing"
+
+	"github.com/breez/breez/data"
+)
+
+func TestUpdateMoveFundsOperations(t *testing.T) {
+	defer openTestDB(t)()
+	for _, address := range []string{"paid", "refunded", "pending"} {
+		if err := saveSwapAddressInfo(&SwapAddressInfo{Address: address, PaymentHash: []byte(address)}); err != nil {
+			t.Fatal(err)
+		}
+		operation := &data.MoveFundsOperation{Mechanism: swapInMechanism, Address: address, Txid: address + "-tx"}
+		if err := addMoveFundsOperation(operation); err != nil {
+			t.Fatal(err)
+		}
+	}
+	updateSwapAddress("paid", func(a *SwapAddressInfo) error {
+		a.PaidAmount = 1000
+		return nil
+	})
+	updateSwapAddress("refunded", func(a *SwapAddressInfo) error {
+		a.LastRefundTxID = "refund-tx"
+		return nil
+	})
+
+	updateMoveFundsOperations()
+	operations, err := fetchMoveFundsOperations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]data.MoveFundsOperation_Status{
+		"paid":     data.MoveFundsOperation_COMPLETED,
+		"refunded": data.MoveFundsOperation_FAILED,
+		"pending":  data.MoveFundsOperation_PENDING,
+	}
+	for _, o := range operations {
+		if o.Status != expected[o.Address] {
+			t.Errorf("operation of %v: got %v, expected %v", o.Address, o.Status, expected[o.Address])
+		}
+	}
+}
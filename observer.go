@@ -0,0 +1,136 @@
+package breez
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"io"
+
+	"github.com/breez/breez/data"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	observerKeySize = 32
+)
+
+// observerKey returns the key shared with watch-only apps, creating it on first use.
+func observerKey() ([]byte, error) {
+	key, err := fetchObserverKey()
+	if err != nil {
+		return nil, err
+	}
+	if key != nil {
+		return key, nil
+	}
+	key = make([]byte, observerKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return key, saveObserverKey(key)
+}
+
+/*
+GetObserverCredential returns the credential a companion watch-only app needs in order to
+decrypt the snapshots created by ExportObserverSnapshot. It gives no spending ability.
+*/
+func GetObserverCredential() (*data.ObserverCredential, error) {
+	acc, err := GetAccountInfo()
+	if err != nil {
+		return nil, err
+	}
+	key, err := observerKey()
+	if err != nil {
+		return nil, err
+	}
+	return &data.ObserverCredential{NodeID: acc.Id, Key: key}, nil
+}
+
+/*
+ResetObserverCredential replaces the observer key so previously shared credentials can't
+decrypt new snapshots.
+*/
+func ResetObserverCredential() error {
+	key := make([]byte, observerKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return err
+	}
+	return saveObserverKey(key)
+}
+
+/*
+ExportObserverSnapshot returns the current balance and payments history encrypted with
+the observer key (AES-256-GCM, the nonce is prepended to the cipher text).
+*/
+func ExportObserverSnapshot() ([]byte, error) {
+	acc, err := GetAccountInfo()
+	if err != nil {
+		return nil, err
+	}
+	payments, err := GetPayments()
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := proto.Marshal(&data.ObserverSnapshot{
+		Account:   acc,
+		Payments:  payments,
+		Timestamp: trustedNow().Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	key, err := observerKey()
+	if err != nil {
+		return nil, err
+	}
+	return encryptWithKey(key, snapshot)
+}
+
+func encryptWithKey(key, plainText []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plainText, nil), nil
+}
+
+func decryptWithKey(key, encrypted []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	if len(encrypted) < gcm.NonceSize() {
+		return nil, errors.New("invalid cipher text")
+	}
+	nonce, cipherText := encrypted[:gcm.NonceSize()], encrypted[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, cipherText, nil)
+}
+
+/*
+ReadObserverSnapshot decrypts a snapshot using the observer credential key.
+It is used by the watch-only app.
+*/
+func ReadObserverSnapshot(key, encrypted []byte) (*data.ObserverSnapshot, error) {
+	snapshotBytes, err := decryptWithKey(key, encrypted)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &data.ObserverSnapshot{}
+	if err := proto.Unmarshal(snapshotBytes, snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
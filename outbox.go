@@ -0,0 +1,147 @@
+package breez
+
+import (
+	"sync"
+
+	"github.com/breez/breez/data"
+)
+
+const (
+	//outboxMaxEvents is the number of unacknowledged events kept, the oldest are dropped beyond it
+	//except the settlement events
+	outboxMaxEvents = 1000
+
+	//outboxFallbackSize is the number of events waiting for delivery when they couldn't be persisted
+	outboxFallbackSize = 100
+)
+
+var (
+	outboxSignal   = make(chan struct{}, 1)
+	outboxReplay   = make(chan struct{}, 1)
+	outboxFallback = make(chan data.NotificationEvent, outboxFallbackSize)
+	outboxQuit     chan struct{}
+	outboxWG       sync.WaitGroup
+)
+
+// notify queues the event in the notifications outbox and returns without waiting
+// for the app. Events stay in the outbox until acknowledged so they are delivered
+// again after a restart. The subscriptions get the event right away.
+func notify(event data.NotificationEvent) {
+	if err := addOutboxEvent(&event); err != nil {
+		log.Errorf("notify - failed to add %v to the outbox, delivering without persistence: %v", event.Type, err)
+		select {
+		case outboxFallback <- event:
+		default:
+			log.Errorf("notify - dropped %v, too many events are waiting for delivery", event.Type)
+		}
+		publishNotification(event)
+		return
+	}
+	publishNotification(event)
+	select {
+	case outboxSignal <- struct{}{}:
+	default:
+	}
+}
+
+/*
+AckNotification removes the notification with the given id from the outbox once the app handled it.
+Notifications which are not acknowledged are delivered again when breez is started.
+*/
+func AckNotification(id uint64) error {
+	if id == 0 {
+		return nil
+	}
+	return deleteOutboxEvent(id)
+}
+
+// transientNotifications are the notifications which only matter while breez
+// runs, a consumer without UI can acknowledge them.
+var transientNotifications = map[data.NotificationEvent_NotificationType]bool{
+	data.NotificationEvent_READY:                           true,
+	data.NotificationEvent_INITIALIZATION_FAILED:           true,
+	data.NotificationEvent_ACCOUNT_CHANGED:                 true,
+	data.NotificationEvent_ROUTING_NODE_CONNECTION_CHANGED: true,
+	data.NotificationEvent_LIGHTNING_SERVICE_DOWN:          true,
+	data.NotificationEvent_RATES_CHANGED:                   true,
+	data.NotificationEvent_CLOCK_SKEW:                      true,
+}
+
+// settlementNotifications are the notifications of received payments, they
+// are kept in the outbox until acknowledged even over outboxMaxEvents.
+var settlementNotifications = map[data.NotificationEvent_NotificationType]bool{
+	data.NotificationEvent_INVOICE_PAID:      true,
+	data.NotificationEvent_DONATION_RECEIVED: true,
+}
+
+/*
+AckTransientNotification acknowledges the notification if it only matters while breez runs, e.g. READY
+or ACCOUNT_CHANGED. It is called by the consumers without UI, like a background sync, so the others, e.g.
+INVOICE_PAID, are kept for the app.
+*/
+func AckTransientNotification(event data.NotificationEvent) error {
+	if !transientNotifications[event.Type] {
+		return nil
+	}
+	return AckNotification(event.Id)
+}
+
+/*
+ReplayNotifications delivers again, in order, every notification which wasn't acknowledged. It is called
+when the app UI attaches again so the events delivered while it was detached, e.g. an INVOICE_PAID during
+a background sync, are not lost.
+*/
+func ReplayNotifications() {
+	select {
+	case outboxReplay <- struct{}{}:
+	default:
+	}
+}
+
+func startOutbox() {
+	outboxQuit = make(chan struct{})
+	outboxWG.Add(1)
+	go deliverOutbox(outboxQuit)
+}
+
+// stopOutbox stops the delivery and waits for it so the DB can be closed.
+func stopOutbox() {
+	close(outboxQuit)
+	outboxWG.Wait()
+}
+
+// deliverOutbox delivers the outbox events in order to the notifications channel.
+// Every pending event is delivered once per run, starting with the ones left
+// unacknowledged by the previous run, and again on every replay. The events
+// which couldn't be persisted are delivered once.
+func deliverOutbox(quit chan struct{}) {
+	defer outboxWG.Done()
+	var lastDelivered uint64
+	for {
+		events, err := fetchOutboxEvents(lastDelivered)
+		if err != nil {
+			log.Errorf("deliverOutbox - failed to fetch events: %v", err)
+		}
+		for _, event := range events {
+			select {
+			case notificationsChan <- *event:
+				lastDelivered = event.Id
+			case <-quit:
+				return
+			}
+		}
+		select {
+		case <-outboxSignal:
+		case <-outboxReplay:
+			lastDelivered = 0
+		case event := <-outboxFallback:
+			select {
+			case notificationsChan <- event:
+			case <-quit:
+				return
+			}
+		case <-quit:
+			return
+		}
+	}
+}
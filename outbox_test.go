@@ -0,0 +1,62 @@
+package breez
+
+import (
+	"testing"
+
+	"github.com/breez/breez/data"
+)
+
+func TestOutboxDropsOldestEvents(t *testing.T) {
+	defer openTestDB(t)()
+	eventTypes := []data.NotificationEvent_NotificationType{
+		data.NotificationEvent_INVOICE_PAID,
+		data.NotificationEvent_PAYMENT_FAILED,
+		data.NotificationEvent_READY,
+	}
+	for len(eventTypes) < outboxMaxEvents+3 {
+		eventTypes = append(eventTypes, data.NotificationEvent_INVOICE_PAID)
+	}
+	for _, eventType := range eventTypes {
+		if err := addOutboxEvent(&data.NotificationEvent{Type: eventType}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	events, err := fetchOutboxEvents(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The transient READY and then PAYMENT_FAILED are dropped, the
+	// INVOICE_PAID events are kept over the limit.
+	if len(events) != outboxMaxEvents+1 {
+		t.Fatalf("expected %v events, got %v", outboxMaxEvents+1, len(events))
+	}
+	if events[0].Id != 1 || events[1].Id != 4 {
+		t.Errorf("expected the oldest INVOICE_PAID to be kept and the other events dropped, got %v and %v", events[0].Id, events[1].Id)
+	}
+	for _, event := range events {
+		if event.Type != data.NotificationEvent_INVOICE_PAID {
+			t.Errorf("expected only INVOICE_PAID events, got %v", event.Type)
+		}
+	}
+}
+
+func TestAckTransientNotification(t *testing.T) {
+	defer openTestDB(t)()
+	ready := &data.NotificationEvent{Type: data.NotificationEvent_READY}
+	paid := &data.NotificationEvent{Type: data.NotificationEvent_INVOICE_PAID}
+	for _, event := range []*data.NotificationEvent{ready, paid} {
+		if err := addOutboxEvent(event); err != nil {
+			t.Fatal(err)
+		}
+		if err := AckTransientNotification(*event); err != nil {
+			t.Fatal(err)
+		}
+	}
+	events, err := fetchOutboxEvents(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].Id != paid.Id {
+		t.Errorf("expected only the INVOICE_PAID event to be kept, got %v", events)
+	}
+}
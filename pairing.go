@@ -0,0 +1,175 @@
+package breez
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/breez/doubleratchet"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	pairingSessionExpiry = 365 * 24 * time.Hour
+
+	errPairingPermissionDenied = "permission denied"
+	errPairingUnknownRequest   = "unknown request"
+)
+
+func serializePairingSession(s *data.PairingSession) ([]byte, error) {
+	return json.Marshal(s)
+}
+
+func deserializePairingSession(sessionBytes []byte) (*data.PairingSession, error) {
+	var session data.PairingSession
+	err := json.Unmarshal(sessionBytes, &session)
+	return &session, err
+}
+
+/*
+StartPairing creates an encrypted session for a desktop companion app with the given permissions.
+The reply is meant to be shown as a QR code and scanned by the companion, which then creates
+its side of the session. The messages themselves are relayed by the app over the Breez relay.
+*/
+func StartPairing(permissions *data.PairingPermissions) (*data.StartPairingReply, error) {
+	id := make([]byte, 32)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	sessionID := hex.EncodeToString(id)
+	expiry := trustedNow().Add(pairingSessionExpiry)
+	secret, pubKey, err := doubleratchet.NewSession(sessionID, uint64(expiry.Unix()))
+	if err != nil {
+		return nil, err
+	}
+	err = savePairingSession(&data.PairingSession{
+		SessionID:        sessionID,
+		Permissions:      permissions,
+		CreatedTimestamp: trustedNow().Unix(),
+		ExpiryTimestamp:  expiry.Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("StartPairing - created pairing session %v", sessionID)
+	return &data.StartPairingReply{SessionID: sessionID, Secret: secret, PubKey: pubKey}, nil
+}
+
+/*
+GetPairingSessions returns all the paired companion sessions.
+*/
+func GetPairingSessions() (*data.PairingSessionsList, error) {
+	sessions, err := fetchPairingSessions()
+	if err != nil {
+		return nil, err
+	}
+	return &data.PairingSessionsList{Sessions: sessions}, nil
+}
+
+/*
+RevokePairing revokes a companion session so its requests are rejected from now on.
+*/
+func RevokePairing(sessionID string) error {
+	session, err := fetchPairingSession(sessionID)
+	if err != nil {
+		return err
+	}
+	if session == nil {
+		return fmt.Errorf("pairing session %v not found", sessionID)
+	}
+	session.Revoked = true
+	log.Infof("RevokePairing - revoked pairing session %v", sessionID)
+	return savePairingSession(session)
+}
+
+/*
+HandlePairingMessage decrypts a request relayed from a paired companion, executes it if the session
+permissions allow it and returns the encrypted reply to relay back.
+*/
+func HandlePairingMessage(sessionID, encryptedMessage string) (string, error) {
+	session, err := fetchPairingSession(sessionID)
+	if err != nil {
+		return "", err
+	}
+	if session == nil {
+		return "", fmt.Errorf("pairing session %v not found", sessionID)
+	}
+	if session.Revoked {
+		return "", errors.New("pairing session was revoked")
+	}
+	if session.Suspended {
+		return "", errors.New("pairing session was suspended")
+	}
+	if session.ExpiryTimestamp < trustedNow().Unix() {
+		return "", errors.New("pairing session expired")
+	}
+
+	message, err := doubleratchet.RatchetDecrypt(sessionID, encryptedMessage)
+	if err != nil {
+		onPairingFailure(session, "message decryption failed")
+		return "", err
+	}
+	requestBytes, err := base64.StdEncoding.DecodeString(message)
+	if err != nil {
+		return "", err
+	}
+	request := &data.PairingRequest{}
+	if err := proto.Unmarshal(requestBytes, request); err != nil {
+		return "", err
+	}
+
+	reply := handlePairingRequest(session, request)
+	if reply.ErrorMessage == errPairingPermissionDenied || reply.ErrorMessage == errPairingUnknownRequest {
+		onPairingFailure(session, reply.ErrorMessage)
+	} else {
+		onPairingSuccess(session)
+	}
+	replyBytes, err := proto.Marshal(reply)
+	if err != nil {
+		return "", err
+	}
+	return doubleratchet.RatchetEncrypt(sessionID, base64.StdEncoding.EncodeToString(replyBytes))
+}
+
+func handlePairingRequest(session *data.PairingSession, request *data.PairingRequest) *data.PairingReply {
+	permissions := session.Permissions
+	if permissions == nil {
+		permissions = &data.PairingPermissions{}
+	}
+	log.Infof("handlePairingRequest - session %v requested %v", session.SessionID, request.Type)
+	var err error
+	reply := &data.PairingReply{}
+	switch request.Type {
+	case data.PairingRequest_GET_ACCOUNT:
+		if !permissions.Read {
+			return &data.PairingReply{ErrorMessage: errPairingPermissionDenied}
+		}
+		reply.Account, err = GetAccountInfo()
+	case data.PairingRequest_GET_PAYMENTS:
+		if !permissions.Read {
+			return &data.PairingReply{ErrorMessage: errPairingPermissionDenied}
+		}
+		reply.Payments, err = GetPayments()
+	case data.PairingRequest_CREATE_INVOICE:
+		if !permissions.CreateInvoice {
+			return &data.PairingReply{ErrorMessage: errPairingPermissionDenied}
+		}
+		reply.PaymentRequest, err = AddStandardInvoice(&data.InvoiceMemo{Amount: request.Amount, Description: request.Memo})
+	case data.PairingRequest_BACKUP_ACK:
+		if !permissions.Backup {
+			return &data.PairingReply{ErrorMessage: errPairingPermissionDenied}
+		}
+		err = onDeviceBackupAck(session.SessionID, request.BackupID, request.ChunkIndex)
+	default:
+		return &data.PairingReply{ErrorMessage: errPairingUnknownRequest}
+	}
+	if err != nil {
+		return &data.PairingReply{ErrorMessage: err.Error()}
+	}
+	return reply
+}
@@ -0,0 +1,254 @@
+package breez
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/breez/breez/data"
+)
+
+const (
+	defaultPaymentCodePoolSize      = 5
+	maxPaymentCodePoolSize          = 50
+	defaultPaymentCodeInvoiceExpiry = 7 * 24 * 3600
+	paymentCodesTopUpInterval       = 10 * time.Minute
+
+	//pooledInvoiceMinLifetime is the time left for the payer to pay a served invoice
+	pooledInvoiceMinLifetime = 10 * 60
+)
+
+var (
+	paymentCodesSignal = make(chan struct{}, 1)
+	paymentCodesMu     sync.Mutex
+)
+
+type pooledInvoice struct {
+	PaymentRequest  string
+	PaymentHash     string
+	ExpiryTimestamp int64
+}
+
+type paymentCode struct {
+	ID                string
+	Description       string
+	Amount            int64
+	PoolSize          int32
+	InvoiceExpiry     int64
+	CreationTimestamp int64
+	Invoices          []*pooledInvoice
+}
+
+func serializePaymentCode(c *paymentCode) ([]byte, error) {
+	codeBytes, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	return sealDBValue(codeBytes)
+}
+
+func deserializePaymentCode(codeBytes []byte) (*paymentCode, error) {
+	var c paymentCode
+	codeBytes, err := openDBValue(codeBytes)
+	if err != nil {
+		return &c, err
+	}
+	err = json.Unmarshal(codeBytes, &c)
+	return &c, err
+}
+
+func (c *paymentCode) toProto() *data.PaymentCode {
+	return &data.PaymentCode{
+		Id:                c.ID,
+		Description:       c.Description,
+		Amount:            c.Amount,
+		PoolSize:          c.PoolSize,
+		InvoiceExpiry:     c.InvoiceExpiry,
+		CreationTimestamp: c.CreationTimestamp,
+		AvailableInvoices: int32(len(c.Invoices)),
+	}
+}
+
+func signalPaymentCodes() {
+	select {
+	case paymentCodesSignal <- struct{}{}:
+	default:
+	}
+}
+
+/*
+CreatePaymentCode creates a long-lived payment code that can be printed as a static QR. The code is backed
+by a pool of invoices with the request description and amount, generated ahead of time and topped up as
+they are served or expire, so every payer gets a fresh invoice from NextPaymentCodeInvoice.
+*/
+func CreatePaymentCode(request *data.CreatePaymentCodeRequest) (*data.PaymentCode, error) {
+	if request.Amount < 0 {
+		return nil, errors.New("amount can't be negative")
+	}
+	if request.PoolSize < 0 || request.PoolSize > maxPaymentCodePoolSize {
+		return nil, fmt.Errorf("pool size must be between 1 and %v", maxPaymentCodePoolSize)
+	}
+	if request.InvoiceExpiry < 0 || (request.InvoiceExpiry > 0 && request.InvoiceExpiry <= 2*pooledInvoiceMinLifetime) {
+		return nil, fmt.Errorf("invoice expiry must be longer than %v seconds", 2*pooledInvoiceMinLifetime)
+	}
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	c := &paymentCode{
+		ID:                hex.EncodeToString(id),
+		Description:       request.Description,
+		Amount:            request.Amount,
+		PoolSize:          request.PoolSize,
+		InvoiceExpiry:     request.InvoiceExpiry,
+		CreationTimestamp: trustedNow().Unix(),
+	}
+	if c.PoolSize == 0 {
+		c.PoolSize = defaultPaymentCodePoolSize
+	}
+	if c.InvoiceExpiry == 0 {
+		c.InvoiceExpiry = defaultPaymentCodeInvoiceExpiry
+	}
+	if err := savePaymentCode(c); err != nil {
+		return nil, err
+	}
+	log.Infof("CreatePaymentCode - created payment code %v", c.ID)
+	signalPaymentCodes()
+	return c.toProto(), nil
+}
+
+/*
+GetPaymentCodes returns the payment codes with the number of invoices ready in their pools.
+*/
+func GetPaymentCodes() (*data.PaymentCodes, error) {
+	codes, err := fetchPaymentCodes()
+	if err != nil {
+		return nil, err
+	}
+	result := &data.PaymentCodes{}
+	for _, c := range codes {
+		result.Codes = append(result.Codes, c.toProto())
+	}
+	return result, nil
+}
+
+/*
+DeletePaymentCode deletes the payment code and its pool. The invoices already served can still be paid.
+*/
+func DeletePaymentCode(id string) error {
+	paymentCodesMu.Lock()
+	defer paymentCodesMu.Unlock()
+	return deletePaymentCode(id)
+}
+
+/*
+NextPaymentCodeInvoice serves the next unexpired invoice of the payment code pool. Served invoices leave
+the pool so no two payers get the same invoice, and the pool is topped up in the background.
+*/
+func NextPaymentCodeInvoice(id string) (string, error) {
+	paymentCodesMu.Lock()
+	defer paymentCodesMu.Unlock()
+	c, err := fetchPaymentCode(id)
+	if err != nil {
+		return "", err
+	}
+	if c == nil {
+		return "", fmt.Errorf("payment code %v not found", id)
+	}
+	defer signalPaymentCodes()
+	now := trustedNow().Unix()
+	for len(c.Invoices) > 0 {
+		invoice := c.Invoices[0]
+		c.Invoices = c.Invoices[1:]
+		if invoice.ExpiryTimestamp-now < pooledInvoiceMinLifetime {
+			continue
+		}
+		if err := savePaymentCode(c); err != nil {
+			return "", err
+		}
+		return invoice.PaymentRequest, nil
+	}
+	if err := savePaymentCode(c); err != nil {
+		return "", err
+	}
+	//an empty pool is filled on demand so the payer doesn't have to wait for the top up
+	invoice, err := addPooledInvoice(c)
+	if err != nil {
+		return "", err
+	}
+	return invoice.PaymentRequest, nil
+}
+
+// watchPaymentCodes tops up the payment code pools when a code is created or
+// served and periodically, which also replaces the invoices about to expire.
+func watchPaymentCodes() {
+	ticker := time.NewTicker(paymentCodesTopUpInterval)
+	defer ticker.Stop()
+	for {
+		topUpPaymentCodes()
+		select {
+		case <-paymentCodesSignal:
+		case <-ticker.C:
+		case <-quitChan:
+			return
+		}
+	}
+}
+
+func topUpPaymentCodes() {
+	if !DaemonReady() {
+		return
+	}
+	paymentCodesMu.Lock()
+	defer paymentCodesMu.Unlock()
+	codes, err := fetchPaymentCodes()
+	if err != nil {
+		log.Errorf("topUpPaymentCodes - failed to fetch payment codes: %v", err)
+		return
+	}
+	now := trustedNow().Unix()
+	for _, c := range codes {
+		var invoices []*pooledInvoice
+		for _, invoice := range c.Invoices {
+			//invoices regenerated for closed channels were hidden
+			hidden, err := isInvoiceHidden(invoice.PaymentHash)
+			if err == nil && !hidden && invoice.ExpiryTimestamp-now >= pooledInvoiceMinLifetime {
+				invoices = append(invoices, invoice)
+			}
+		}
+		c.Invoices = invoices
+		for len(c.Invoices) < int(c.PoolSize) {
+			invoice, err := addPooledInvoice(c)
+			if err != nil {
+				log.Errorf("topUpPaymentCodes - failed to add an invoice to payment code %v: %v", c.ID, err)
+				break
+			}
+			c.Invoices = append(c.Invoices, invoice)
+		}
+		if err := savePaymentCode(c); err != nil {
+			log.Errorf("topUpPaymentCodes - failed to save payment code %v: %v", c.ID, err)
+		}
+	}
+}
+
+func addPooledInvoice(c *paymentCode) (*pooledInvoice, error) {
+	invoice := &data.InvoiceMemo{Description: c.Description, Amount: c.Amount, Expiry: c.InvoiceExpiry}
+	paymentRequest, err := addMemoInvoice(context.Background(), invoice, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	decodedReq, err := decodePayReqLocally(paymentRequest)
+	if err != nil {
+		return nil, err
+	}
+	return &pooledInvoice{
+		PaymentRequest:  paymentRequest,
+		PaymentHash:     decodedReq.PaymentHash,
+		ExpiryTimestamp: decodedReq.Timestamp + decodedReq.Expiry,
+	}, nil
+}
@@ -2,13 +2,18 @@ package breez
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"sort"
 	"strings"
-
 	"time"
+	"unicode"
 
 	"github.com/breez/breez/data"
 	"github.com/breez/lightninglib/lnrpc"
@@ -18,12 +23,23 @@ import (
 
 type paymentType byte
 
+const (
+	breezFeeRecipient = "Breez"
+
+	//backoff of the invoices resubscription, reset once invoices are received again
+	invoicesInitialBackoff = time.Second
+	invoicesMaxBackoff     = 5 * time.Minute
+)
+
 const (
 	defaultInvoiceExpiry int64 = 3600
 	sentPayment                = paymentType(0)
 	receivedPayment            = paymentType(1)
 	depositPayment             = paymentType(2)
 	withdrawalPayment          = paymentType(3)
+	refundPayment              = paymentType(4)
+	serviceFeePayment          = paymentType(5)
+	channelClosePayment        = paymentType(6)
 )
 
 type paymentInfo struct {
@@ -35,115 +51,703 @@ type paymentInfo struct {
 	PayeeImageURL              string
 	PayerName                  string
 	PayerImageURL              string
+	PayerComment               string
 	TransferRequest            bool
 	PaymentHash                string
 	RedeemTxID                 string
 	Destination                string
 	PendingExpirationHeight    uint32
 	PendingExpirationTimestamp int64
+
+	//service fee line items
+	ParentPaymentHash string
+	FeeRecipient      string
+
+	//channel close entries
+	CloseReason closeReason
+	ClosingTxID string
+	CloseFee    int64
+
+	//fiat value when the payment settled
+	FiatAmount   float64
+	FiatCurrency string
+
+	//routing fee of sent payments, the daemon payments list reports it in satoshi only
+	Fee     int64
+	FeeMsat int64
+
+	//tax metadata of received payments
+	VatRate       float64
+	TaxAmount     int64
+	MerchantTaxID string
+
+	//device that requested a transfer between the user devices
+	DeviceName string
+
+	//funding source of deposits
+	FundingProvider     string
+	FundingOrderID      string
+	FundingCounterparty string
+	FundingTxIDs        []string
 }
 
 func serializePaymentInfo(s *paymentInfo) ([]byte, error) {
-	return json.Marshal(s)
+	paymentBytes, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return sealDBValue(paymentBytes)
 }
 
 func deserializePaymentInfo(paymentBytes []byte) (*paymentInfo, error) {
 	var payment paymentInfo
-	err := json.Unmarshal(paymentBytes, &payment)
+	paymentBytes, err := openDBValue(paymentBytes)
+	if err != nil {
+		return &payment, err
+	}
+	err = json.Unmarshal(paymentBytes, &payment)
 	return &payment, err
 }
 
 var blankInvoiceGroup singleflight.Group
 
+//ErrNoRouteWithinFeeLimit is returned when a payment with a fee limit found no route charging less.
+var ErrNoRouteWithinFeeLimit = errors.New("no route within the fee limit")
+
 /*
 GetPayments is responsible for retrieving the payment were made in this account
 */
 func GetPayments() (*data.PaymentsList, error) {
+	return GetPaymentsContext(context.Background())
+}
+
+/*
+GetPaymentsContext is GetPayments with a context canceling the daemon calls for the pending payments.
+*/
+func GetPaymentsContext(ctx context.Context) (*data.PaymentsList, error) {
+	rawPayments, err := fetchSortedPayments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	paymentsList := make([]*data.Payment, 0, len(rawPayments))
+	for _, payment := range rawPayments {
+		paymentsList = append(paymentsList, paymentInfoToProto(payment))
+	}
+
+	resultPayments := &data.PaymentsList{PaymentsList: paymentsList}
+	return resultPayments, nil
+}
+
+/*
+GetPaymentsPage returns up to limit payments, newest first, starting after the given cursor.
+Only payments of the requested types created within the requested time range are returned,
+empty types and zero timestamps match all payments.
+An empty cursor returns the first page which also includes the pending payments.
+The returned NextCursor is passed to get the following page and is empty on the last page.
+*/
+func GetPaymentsPage(request *data.PaymentsPageRequest) (*data.PaymentsPage, error) {
+	return GetPaymentsPageContext(context.Background(), request)
+}
+
+/*
+GetPaymentsPageContext is GetPaymentsPage with a context canceling the daemon calls for the pending payments.
+*/
+func GetPaymentsPageContext(ctx context.Context, request *data.PaymentsPageRequest) (*data.PaymentsPage, error) {
+	if request.Limit <= 0 {
+		return nil, errors.New("limit must be positive")
+	}
+	before, err := hex.DecodeString(request.Cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %v", err)
+	}
+	if request.Cursor == "" {
+		before = nil
+	}
+	filter := &paymentsFilter{FromTimestamp: request.FromTimestamp, ToTimestamp: request.ToTimestamp}
+	for _, t := range request.Types {
+		filter.Types = append(filter.Types, paymentTypeFromProto(t))
+	}
+
+	page := &data.PaymentsPage{}
+	if before == nil {
+		pendingPayments, err := getPendingPayments(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, payment := range pendingPayments {
+			if filter.match(payment) {
+				page.PaymentsList = append(page.PaymentsList, paymentInfoToProto(payment))
+			}
+		}
+	}
+	rawPayments, next, err := fetchPaymentsPage(before, int(request.Limit), filter)
+	if err != nil {
+		return nil, err
+	}
+	for _, payment := range rawPayments {
+		page.PaymentsList = append(page.PaymentsList, paymentInfoToProto(payment))
+	}
+	page.NextCursor = hex.EncodeToString(next)
+	return page, nil
+}
+
+// searchWords splits text into the lower case words used by the payments search.
+func searchWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+/*
+SearchPayments returns the payments, newest first, matching every word of the query.
+A word matches the beginning of a word in the description, the payee or payer name or the destination.
+*/
+func SearchPayments(query string) (*data.PaymentsList, error) {
+	words := searchWords(query)
+	if len(words) == 0 {
+		return &data.PaymentsList{}, nil
+	}
+	ids, err := searchPaymentIDs(words)
+	if err != nil {
+		return nil, err
+	}
+	rawPayments, err := fetchPaymentsByIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(rawPayments, func(i, j int) bool {
+		return rawPayments[i].CreationTimestamp > rawPayments[j].CreationTimestamp
+	})
+	paymentsList := make([]*data.Payment, 0, len(rawPayments))
+	for _, payment := range rawPayments {
+		paymentsList = append(paymentsList, paymentInfoToProto(payment))
+	}
+	return &data.PaymentsList{PaymentsList: paymentsList}, nil
+}
+
+/*
+GetSpendingByPayee aggregates the payments sent in the last window seconds by payee, largest amount first.
+Payments are grouped by payee name when the invoice has one, otherwise by destination node.
+A zero window aggregates all the sent payments.
+*/
+func GetSpendingByPayee(window int64) (*data.SpendingByPayee, error) {
+	filter := &paymentsFilter{Types: []paymentType{sentPayment}}
+	if window > 0 {
+		filter.FromTimestamp = time.Now().Unix() - window
+	}
+	spending := make(map[string]*data.PayeeSpending)
+	var before []byte
+	for {
+		payments, next, err := fetchPaymentsPage(before, paymentsScanPageSize, filter)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range payments {
+			key := "destination:" + p.Destination
+			if p.PayeeName != "" {
+				key = "name:" + p.PayeeName
+			}
+			payee, ok := spending[key]
+			if !ok {
+				//payments are newest first so the first one is the last payment
+				payee = &data.PayeeSpending{PayeeName: p.PayeeName, Destination: p.Destination, LastPaymentTimestamp: p.CreationTimestamp}
+				spending[key] = payee
+			}
+			payee.Amount += p.Amount
+			payee.Count++
+		}
+		if next == nil {
+			break
+		}
+		before = next
+	}
+
+	result := &data.SpendingByPayee{}
+	for _, payee := range spending {
+		result.Payees = append(result.Payees, payee)
+	}
+	sort.Slice(result.Payees, func(i, j int) bool {
+		if result.Payees[i].Amount != result.Payees[j].Amount {
+			return result.Payees[i].Amount > result.Payees[j].Amount
+		}
+		return result.Payees[i].LastPaymentTimestamp > result.Payees[j].LastPaymentTimestamp
+	})
+	return result, nil
+}
+
+/*
+StreamPayments writes the payments, newest first, to w in chunks of at most chunkSize
+payments so the whole list is never built in memory. Every chunk is a serialized
+data.PaymentsList prefixed by its length as a 4 bytes big endian integer.
+*/
+func StreamPayments(w io.Writer, chunkSize int) error {
+	if chunkSize <= 0 {
+		return errors.New("chunk size must be positive")
+	}
+	pendingPayments, err := getPendingPayments(context.Background())
+	if err != nil {
+		return err
+	}
+
+	chunk := &data.PaymentsList{PaymentsList: make([]*data.Payment, 0, chunkSize)}
+	buf := proto.NewBuffer(nil)
+	lengthBuf := make([]byte, 4)
+	writeChunk := func() error {
+		buf.Reset()
+		if err := buf.Marshal(chunk); err != nil {
+			return err
+		}
+		binary.BigEndian.PutUint32(lengthBuf, uint32(len(buf.Bytes())))
+		if _, err := w.Write(lengthBuf); err != nil {
+			return err
+		}
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return err
+		}
+		chunk.PaymentsList = chunk.PaymentsList[:0]
+		return nil
+	}
+	for _, payment := range pendingPayments {
+		chunk.PaymentsList = append(chunk.PaymentsList, paymentInfoToProto(payment))
+		if len(chunk.PaymentsList) == chunkSize {
+			if err := writeChunk(); err != nil {
+				return err
+			}
+		}
+	}
+
+	var before []byte
+	for {
+		rawPayments, next, err := fetchPaymentsPage(before, chunkSize-len(chunk.PaymentsList), nil)
+		if err != nil {
+			return err
+		}
+		for _, payment := range rawPayments {
+			chunk.PaymentsList = append(chunk.PaymentsList, paymentInfoToProto(payment))
+		}
+		if next == nil {
+			break
+		}
+		if err := writeChunk(); err != nil {
+			return err
+		}
+		before = next
+	}
+	if len(chunk.PaymentsList) > 0 {
+		return writeChunk()
+	}
+	return nil
+}
+
+//fetchSortedPayments returns the stored and pending payments, newest first.
+func fetchSortedPayments(ctx context.Context) ([]*paymentInfo, error) {
 	rawPayments, err := fetchAllAccountPayments()
 	if err != nil {
 		return nil, err
 	}
 
-	pendingPayments, err := getPendingPayments()
+	pendingPayments, err := getPendingPayments(ctx)
 	if err != nil {
 		return nil, err
 	}
 	rawPayments = append(rawPayments, pendingPayments...)
 
-	var paymentsList []*data.Payment
-	for _, payment := range rawPayments {
-		paymentItem := &data.Payment{
-			Amount:            payment.Amount,
-			CreationTimestamp: payment.CreationTimestamp,
-			RedeemTxID:        payment.RedeemTxID,
-			PaymentHash:       payment.PaymentHash,
-			Destination:       payment.Destination,
-			InvoiceMemo: &data.InvoiceMemo{
-				Description:     payment.Description,
-				Amount:          payment.Amount,
-				PayeeImageURL:   payment.PayeeImageURL,
-				PayeeName:       payment.PayeeName,
-				PayerImageURL:   payment.PayerImageURL,
-				PayerName:       payment.PayerName,
-				TransferRequest: payment.TransferRequest,
-			},
-			PendingExpirationHeight:    payment.PendingExpirationHeight,
-			PendingExpirationTimestamp: payment.PendingExpirationTimestamp,
-		}
-		switch payment.Type {
-		case sentPayment:
-			paymentItem.Type = data.Payment_SENT
-		case receivedPayment:
-			paymentItem.Type = data.Payment_RECEIVED
-		case depositPayment:
-			paymentItem.Type = data.Payment_DEPOSIT
-		case withdrawalPayment:
-			paymentItem.Type = data.Payment_WITHDRAWAL
-		}
-
-		paymentsList = append(paymentsList, paymentItem)
-	}
-
-	sort.Slice(paymentsList, func(i, j int) bool {
-		return paymentsList[i].CreationTimestamp > paymentsList[j].CreationTimestamp
+	sort.Slice(rawPayments, func(i, j int) bool {
+		return rawPayments[i].CreationTimestamp > rawPayments[j].CreationTimestamp
 	})
+	return rawPayments, nil
+}
 
-	resultPayments := &data.PaymentsList{PaymentsList: paymentsList}
-	return resultPayments, nil
+func paymentTypeFromProto(t data.Payment_PaymentType) paymentType {
+	switch t {
+	case data.Payment_RECEIVED:
+		return receivedPayment
+	case data.Payment_DEPOSIT:
+		return depositPayment
+	case data.Payment_WITHDRAWAL:
+		return withdrawalPayment
+	case data.Payment_REFUND:
+		return refundPayment
+	case data.Payment_SERVICE_FEE:
+		return serviceFeePayment
+	case data.Payment_CHANNEL_CLOSED:
+		return channelClosePayment
+	}
+	return sentPayment
+}
+
+func paymentInfoToProto(payment *paymentInfo) *data.Payment {
+	paymentItem := &data.Payment{
+		Amount:            payment.Amount,
+		CreationTimestamp: payment.CreationTimestamp,
+		RedeemTxID:        payment.RedeemTxID,
+		PaymentHash:       payment.PaymentHash,
+		Destination:       payment.Destination,
+		InvoiceMemo: &data.InvoiceMemo{
+			Description:     payment.Description,
+			Amount:          payment.Amount,
+			PayeeImageURL:   payment.PayeeImageURL,
+			PayeeName:       payment.PayeeName,
+			PayerImageURL:   payment.PayerImageURL,
+			PayerName:       payment.PayerName,
+			PayerComment:    payment.PayerComment,
+			TransferRequest: payment.TransferRequest,
+			DeviceName:      payment.DeviceName,
+		},
+		PendingExpirationHeight:    payment.PendingExpirationHeight,
+		PendingExpirationTimestamp: payment.PendingExpirationTimestamp,
+		ParentPaymentHash:          payment.ParentPaymentHash,
+		FeeRecipient:               payment.FeeRecipient,
+		FiatAmount:                 payment.FiatAmount,
+		FiatCurrency:               payment.FiatCurrency,
+		Fee:                        payment.Fee,
+		FeeMsat:                    payment.FeeMsat,
+	}
+	if payment.FundingProvider != "" {
+		paymentItem.FundingSource = &data.FundingSource{
+			Provider:     payment.FundingProvider,
+			OrderID:      payment.FundingOrderID,
+			Counterparty: payment.FundingCounterparty,
+			TxIDs:        payment.FundingTxIDs,
+		}
+	}
+	if payment.VatRate > 0 || payment.TaxAmount > 0 || payment.MerchantTaxID != "" {
+		paymentItem.InvoiceMemo.Tax = &data.TaxInfo{
+			VatRate:       payment.VatRate,
+			TaxAmount:     payment.TaxAmount,
+			MerchantTaxID: payment.MerchantTaxID,
+		}
+	}
+	if payment.Type == channelClosePayment {
+		paymentItem.CloseReason = payment.CloseReason.toProto()
+		paymentItem.ClosingTxID = payment.ClosingTxID
+		paymentItem.CloseFee = payment.CloseFee
+	}
+	switch payment.Type {
+	case sentPayment:
+		paymentItem.Type = data.Payment_SENT
+	case receivedPayment:
+		paymentItem.Type = data.Payment_RECEIVED
+	case depositPayment:
+		paymentItem.Type = data.Payment_DEPOSIT
+	case withdrawalPayment:
+		paymentItem.Type = data.Payment_WITHDRAWAL
+	case refundPayment:
+		paymentItem.Type = data.Payment_REFUND
+	case serviceFeePayment:
+		paymentItem.Type = data.Payment_SERVICE_FEE
+	case channelClosePayment:
+		paymentItem.Type = data.Payment_CHANNEL_CLOSED
+	}
+	return paymentItem
 }
 
 /*
 SendPaymentForRequest send the payment according to the details specified in the bolt 11 payment request.
-If the payment was failed an error is returned
+If the payment was failed an error is returned.
+It blocks until the payment completes, SendPaymentAsync returns immediately instead.
 */
 func SendPaymentForRequest(paymentRequest string, amountSatoshi int64) error {
-	log.Infof("sendPaymentForRequest: amount = %v", amountSatoshi)
-	decodedReq, err := lightningClient.DecodePayReq(context.Background(), &lnrpc.PayReqString{PayReq: paymentRequest})
+	return SendPaymentForRequestContext(context.Background(), paymentRequest, amountSatoshi)
+}
+
+/*
+SendPaymentForRequestContext is SendPaymentForRequest with a context, canceling it stops waiting for
+the daemon and fails the payment attempt.
+*/
+func SendPaymentForRequestContext(ctx context.Context, paymentRequest string, amountSatoshi int64) error {
+	return sendPaymentForRequest(ctx, paymentRequest, amountSatoshi, 0)
+}
+
+/*
+SendPaymentWithFeeLimit is SendPaymentForRequest paying at most the given fee, in satoshi and/or in percent
+of the amount. When both are set the lower one applies. ErrNoRouteWithinFeeLimit is returned if every
+route charges more.
+*/
+func SendPaymentWithFeeLimit(paymentRequest string, amountSatoshi int64, feeLimit *data.FeeLimit) error {
+	return SendPaymentWithFeeLimitContext(context.Background(), paymentRequest, amountSatoshi, feeLimit)
+}
+
+/*
+SendPaymentWithFeeLimitContext is SendPaymentWithFeeLimit with a context, canceling it stops waiting for
+the daemon and fails the payment attempt.
+*/
+func SendPaymentWithFeeLimitContext(ctx context.Context, paymentRequest string, amountSatoshi int64, feeLimit *data.FeeLimit) error {
+	decodedReq, err := decodePayReqLocally(paymentRequest)
 	if err != nil {
 		return err
 	}
-	if err := savePaymentRequest(decodedReq.PaymentHash, []byte(paymentRequest)); err != nil {
+	amount := amountSatoshi
+	if amount == 0 {
+		amount = decodedReq.NumSatoshis
+	}
+	limit, err := effectiveFeeLimit(feeLimit, amount)
+	if err != nil {
+		return err
+	}
+	return sendPaymentForRequest(ctx, paymentRequest, amountSatoshi, limit)
+}
+
+// effectiveFeeLimit returns the fee limit in satoshi, 0 for no limit. Since 0
+// means no limit a percent limit is rounded up to at least 1 satoshi.
+func effectiveFeeLimit(feeLimit *data.FeeLimit, amount int64) (int64, error) {
+	if feeLimit == nil {
+		return 0, nil
+	}
+	if feeLimit.Sat < 0 || feeLimit.Percent < 0 {
+		return 0, errors.New("fee limit can't be negative")
+	}
+	limit := feeLimit.Sat
+	if feeLimit.Percent > 0 {
+		percentLimit := int64(float64(amount) * feeLimit.Percent / 100)
+		if percentLimit < 1 {
+			percentLimit = 1
+		}
+		if limit == 0 || percentLimit < limit {
+			limit = percentLimit
+		}
+	}
+	return limit, nil
+}
+
+//sendPaymentForRequest sends the payment paying at most feeLimit satoshi in fees, no limit if it is 0.
+func sendPaymentForRequest(ctx context.Context, paymentRequest string, amountSatoshi int64, feeLimit int64) error {
+	return sendPaymentUsing(ctx, paymentRequest, amountSatoshi, feeLimit, sendDecodedPayment)
+}
+
+// paymentSender makes the payment attempts of a decoded payment request.
+type paymentSender func(ctx context.Context, paymentRequest string, decodedReq *lnrpc.PayReq, amountSatoshi, amount, feeLimit int64) error
+
+// sendPaymentUsing sends the payment with the given sender, recording it when
+// it succeeds and the failure when it doesn't.
+func sendPaymentUsing(ctx context.Context, paymentRequest string, amountSatoshi int64, feeLimit int64, send paymentSender) (err error) {
+	paymentsLog.Infof("sendPaymentForRequest: amount = %v, fee limit = %v", amountSatoshi, feeLimit)
+	audit := newSpendAudit(ctx, data.SpendAuditEntry_LIGHTNING)
+	audit.Amount = amountSatoshi
+	audit.FeeLimit = feeLimit
+	defer func() { finishSpendAudit(audit, err) }()
+	ctx = withSpendAudit(ctx, audit)
+
+	decodedReq, err := decodePayReqLocally(paymentRequest)
+	if err != nil {
+		return err
+	}
+	amount := amountSatoshi
+	if amount == 0 {
+		amount = decodedReq.NumSatoshis
+	}
+	audit.Amount = amount
+	audit.Destination = decodedReq.Destination
+	audit.PaymentHash = decodedReq.PaymentHash
+	if err := beginSend(ctx, decodedReq.PaymentHash); err != nil {
 		return err
 	}
-	log.Infof("sendPaymentForRequest: before sending payment...")
-	response, err := lightningClient.SendPaymentSync(context.Background(), &lnrpc.SendRequest{PaymentRequest: paymentRequest, Amt: amountSatoshi})
+	defer endSend(decodedReq.PaymentHash)
+	err = checkSpendingPolicy(ctx, decodedReq, amount)
+	recordSpendCheck(ctx, "spending_policy", err)
 	if err != nil {
-		log.Infof("sendPaymentForRequest: error sending payment %v", err)
 		return err
 	}
-	log.Infof("sendPaymentForRequest finished successfully")
-	if len(response.PaymentError) > 0 {
-		return errors.New(response.PaymentError)
+	//the intent outlives the app being killed while sending, see reconcilePaymentIntents
+	intent := &paymentIntent{
+		PaymentHash:       decodedReq.PaymentHash,
+		PaymentRequest:    paymentRequest,
+		Amount:            amount,
+		CreationTimestamp: trustedNow().Unix(),
+	}
+	if err := savePaymentIntent(intent); err != nil {
+		return err
 	}
+	defer deletePaymentIntent(intent.PaymentHash)
+	if err := savePaymentRequest(decodedReq.PaymentHash, []byte(paymentRequest)); err != nil {
+		return err
+	}
+	sendStart := time.Now()
+	if err := send(ctx, paymentRequest, decodedReq, amountSatoshi, amount, feeLimit); err != nil {
+		paymentSendSeconds.since("failed", sendStart)
+		paymentsFailedTotal.inc(classifyPaymentFailure(err).reason.String())
+		recordFailedPayment(paymentRequest, decodedReq, amount, trustedNow().Unix(), err)
+		return newPaymentError(err)
+	}
+	paymentSendSeconds.since("succeeded", sendStart)
+	paymentsSentTotal.inc("")
 
 	syncSentPayments()
 	return nil
 }
 
+// feeLimitError returns ErrNoRouteWithinFeeLimit for a no route failure of a
+// payment with a fee limit, since the daemon skips the routes over the limit
+// and reports that no route was found.
+func feeLimitError(err error, feeLimit int64) error {
+	if feeLimit > 0 && paymentFailureReason(err) == data.FailedPayment_NO_ROUTE {
+		return ErrNoRouteWithinFeeLimit
+	}
+	return err
+}
+
+// paymentChecks are the policy checks applied before every payment attempt.
+var paymentChecks = []struct {
+	name  string
+	check func(decodedReq *lnrpc.PayReq, amount int64) error
+}{
+	{"invoice_expiry", func(decodedReq *lnrpc.PayReq, amount int64) error {
+		if decodedReq.Timestamp+decodedReq.Expiry <= trustedNow().Unix() {
+			return errors.New("invoice expired")
+		}
+		return nil
+	}},
+	{"spendable_balance", func(decodedReq *lnrpc.PayReq, amount int64) error {
+		return checkSpendable(amount)
+	}},
+	{"fault_injection", func(decodedReq *lnrpc.PayReq, amount int64) error {
+		return injectedSendFailure(decodedReq)
+	}},
+}
+
+// checkPayment returns the reason a payment attempt can't be made and records
+// the checks in the spending audit entry.
+func checkPayment(ctx context.Context, decodedReq *lnrpc.PayReq, amount int64) error {
+	for _, c := range paymentChecks {
+		err := c.check(decodedReq, amount)
+		recordSpendCheck(ctx, c.name, err)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sendDecodedPayment checks and sends the payment, every error returned is a
+// failed payment attempt.
+func sendDecodedPayment(ctx context.Context, paymentRequest string, decodedReq *lnrpc.PayReq, amountSatoshi, amount, feeLimit int64) error {
+	if err := checkPayment(ctx, decodedReq, amount); err != nil {
+		return err
+	}
+	paymentsLog.Infof("sendPaymentForRequest: before sending payment...")
+	sendRequest := &lnrpc.SendRequest{PaymentRequest: paymentRequest, Amt: amountSatoshi}
+	if feeLimit > 0 {
+		sendRequest.FeeLimit = &lnrpc.FeeLimit{Limit: &lnrpc.FeeLimit_Fixed{Fixed: feeLimit}}
+	}
+	response, err := paymentsClient.SendPaymentSync(ctx, sendRequest)
+	if err != nil {
+		paymentsLog.Infof("sendPaymentForRequest: error sending payment %v", err)
+		return feeLimitError(err, feeLimit)
+	}
+	paymentsLog.Infof("sendPaymentForRequest finished successfully")
+	return onSendResponse(decodedReq, response, feeLimit)
+}
+
+// onSendResponse returns the payment error of the response or saves the route
+// of the successful payment.
+func onSendResponse(decodedReq *lnrpc.PayReq, response *lnrpc.SendResponse, feeLimit int64) error {
+	if len(response.PaymentError) > 0 {
+		return feeLimitError(errors.New(response.PaymentError), feeLimit)
+	}
+	if response.PaymentRoute != nil {
+		if err := savePaymentRoute(decodedReq.PaymentHash, paymentRouteFromRPC(response.PaymentRoute)); err != nil {
+			paymentsLog.Errorf("sendPaymentForRequest: failed to save the payment route: %v", err)
+		}
+	}
+	return nil
+}
+
 /*
 AddInvoice encapsulate a given invoice information in a payment request
 */
 func AddInvoice(invoice *data.InvoiceMemo) (paymentRequest string, err error) {
+	return AddInvoiceContext(context.Background(), invoice)
+}
+
+/*
+AddInvoiceContext is AddInvoice with a context canceling the daemon or the routing node call.
+*/
+func AddInvoiceContext(ctx context.Context, invoice *data.InvoiceMemo) (paymentRequest string, err error) {
+	return addMemoInvoice(ctx, invoice, nil, "")
+}
+
+/*
+AddInvoiceWithPreimage is AddInvoice with the preimage chosen by the caller, so it can be used as a proof
+of payment token outside the app. When the request has no preimage a random one is generated. The reply
+has the payment request, its payment hash and the preimage. Invoices added this way are not regenerated
+when their route hints become unusable since a new invoice can't reuse the preimage.
+When the request asks for a fallback address, a new wallet address is embedded in the invoice so payers
+without channels can pay on-chain, and a confirmed payment to it is recorded as a deposit. Wrapped invoices
+are issued by the routing node and can't embed it, the reply fallback address is then empty.
+*/
+func AddInvoiceWithPreimage(request *data.AddInvoiceRequest) (*data.AddInvoiceReply, error) {
+	var preimage []byte
+	if request.Preimage != "" {
+		if err := validateHash(request.Preimage, "preimage"); err != nil {
+			return nil, err
+		}
+		preimage, _ = hex.DecodeString(request.Preimage)
+	} else {
+		preimage = make([]byte, 32)
+		if _, err := rand.Read(preimage); err != nil {
+			return nil, err
+		}
+	}
+	hash := sha256.Sum256(preimage)
+	paymentHash := hex.EncodeToString(hash[:])
+	used, err := hasPayment(paymentHash)
+	if err != nil {
+		return nil, err
+	}
+	if used {
+		return nil, errors.New("preimage was already used by a payment")
+	}
+	invoice := request.InvoiceMemo
+	if invoice == nil {
+		invoice = &data.InvoiceMemo{}
+	}
+	var fallbackAddr string
+	if request.FallbackAddress && canReceiveLocally() {
+		newAddress, err := paymentsClient.NewAddress(context.Background(), &lnrpc.NewAddressRequest{Type: lnrpc.NewAddressRequest_WITNESS_PUBKEY_HASH})
+		if err != nil {
+			return nil, err
+		}
+		fallbackAddr = newAddress.Address
+	}
+	paymentRequest, err := addMemoInvoice(context.Background(), invoice, preimage, fallbackAddr)
+	if err != nil {
+		return nil, err
+	}
+	if fallbackAddr != "" {
+		if err := trackFallbackAddress(paymentRequest, fallbackAddr); err != nil {
+			return nil, err
+		}
+	}
+	return &data.AddInvoiceReply{
+		PaymentRequest:  paymentRequest,
+		PaymentHash:     paymentHash,
+		Preimage:        hex.EncodeToString(preimage),
+		FallbackAddress: fallbackAddr,
+	}, nil
+}
+
+// addMemoInvoice adds the invoice locally or wrapped by the routing node. A nil
+// preimage lets the daemon or addWrappedInvoice generate one. The fallback
+// address is only embedded in local invoices.
+func addMemoInvoice(ctx context.Context, invoice *data.InvoiceMemo, preimage []byte, fallbackAddr string) (paymentRequest string, err error) {
+	invoice.PayeeSignature = ""
+	invoice.Verified = false
+	if !invoice.TransferRequest {
+		if err := applyTaxTemplate(invoice); err != nil {
+			return "", err
+		}
+	}
+	if err := signTransferRequest(invoice); err != nil {
+		paymentsLog.Errorf("AddInvoice - failed to sign transfer request: %v", err)
+	}
+	local := canReceiveLocally()
+	//wrapped invoices are paid to the routing node so the payer can't verify our signature
+	if invoice.PayeeName != "" && local {
+		if err := signPayeeMetadata(invoice); err != nil {
+			paymentsLog.Errorf("AddInvoice - failed to sign payee metadata: %v", err)
+		}
+	}
 	memo, err := proto.Marshal(invoice)
 	if err != nil {
 		return "", err
@@ -156,58 +760,61 @@ func AddInvoice(invoice *data.InvoiceMemo) (paymentRequest string, err error) {
 		invoiceExpiry = invoice.Expiry
 	}
 
-	response, err := lightningClient.AddInvoice(context.Background(), &lnrpc.Invoice{Memo: string(memo), Private: true, Value: invoice.Amount, Expiry: invoiceExpiry})
-	if err != nil {
-		return "", err
-	}
-	log.Infof("Generated Invoice: %v", response.PaymentRequest)
-	return response.PaymentRequest, nil
+	return addLocalOrWrappedInvoice(ctx, local, string(memo), invoice.Amount, invoiceExpiry, preimage, fallbackAddr)
 }
 
 /*
 AddStandardInvoice encapsulate a given amount and description in a payment request
 */
 func AddStandardInvoice(invoice *data.InvoiceMemo) (paymentRequest string, err error) {
+	return AddStandardInvoiceContext(context.Background(), invoice)
+}
+
+/*
+AddStandardInvoiceContext is AddStandardInvoice with a context canceling the daemon or the routing node call.
+*/
+func AddStandardInvoiceContext(ctx context.Context, invoice *data.InvoiceMemo) (paymentRequest string, err error) {
 	// Format the standard invoice memo
-	memo := invoice.Description + " | " + invoice.PayeeName + " | " + invoice.PayeeImageURL
+	memo := encodeStandardMemo(invoice)
 
 	if invoice.Expiry <= 0 {
 		invoice.Expiry = defaultInvoiceExpiry
 	}
 
-	response, err := lightningClient.AddInvoice(context.Background(), &lnrpc.Invoice{Memo: memo, Private: true, Value: invoice.Amount, Expiry: invoice.Expiry})
-	if err != nil {
-		return "", err
-	}
-	log.Infof("Generated Invoice: %v", response.PaymentRequest)
-	return response.PaymentRequest, nil
+	return addLocalOrWrappedInvoice(ctx, canReceiveLocally(), memo, invoice.Amount, invoice.Expiry, nil, "")
 }
 
 /*
 DecodeInvoice is used by the payer to decode the payment request and read the invoice details.
 */
 func DecodePaymentRequest(paymentRequest string) (*data.InvoiceMemo, error) {
-	log.Infof("DecodePaymentRequest %v", paymentRequest)
-	decodedPayReq, err := lightningClient.DecodePayReq(context.Background(), &lnrpc.PayReqString{PayReq: paymentRequest})
+	paymentsLog.Infof("DecodePaymentRequest %v", paymentRequest)
+	decodedPayReq, err := decodePayReqLocally(paymentRequest)
 	if err != nil {
-		log.Errorf("DecodePaymentRequest error: %v", err)
+		paymentsLog.Errorf("DecodePaymentRequest error: %v", err)
 		return nil, err
 	}
+	if decodedPayReq.Description == "" && decodedPayReq.DescriptionHash != "" {
+		//invoices of LNURL-pay services commit to the metadata we kept when paying
+		lnurlMemo, err := fetchLNURLPayMemo(decodedPayReq.PaymentHash)
+		if err != nil {
+			return nil, err
+		}
+		if lnurlMemo != nil {
+			return lnurlMemo, nil
+		}
+	}
 	invoiceMemo := &data.InvoiceMemo{}
 	if err := proto.Unmarshal([]byte(decodedPayReq.Description), invoiceMemo); err != nil {
 		// In case we cannot unmarshal the description we are probably dealing with a standard invoice
-		if strings.Count(decodedPayReq.Description, " | ") == 2 {
-			// There is also the 'description | payee | logo' encoding
-			// meant to encode breez metadata in a way that's human readable
-			invoiceData := strings.Split(decodedPayReq.Description, " | ")
-			invoiceMemo.Description = invoiceData[0]
-			invoiceMemo.PayeeName = invoiceData[1]
-			invoiceMemo.PayeeImageURL = invoiceData[2]
-		} else {
-			invoiceMemo.Description = decodedPayReq.Description
-		}
+		// that may carry the breez metadata in a human readable encoding
+		invoiceMemo.Reset()
+		decodeStandardMemo(decodedPayReq.Description, invoiceMemo)
 		invoiceMemo.Amount = decodedPayReq.NumSatoshis
+		invoiceMemo.PayeeSignature = ""
 	}
+	invoiceMemo.Verified = verifyPayeeMetadata(invoiceMemo, decodedPayReq.Destination)
+	invoiceMemo.OwnDevice = verifyDeviceProof(invoiceMemo, decodedPayReq.Destination, decodedPayReq.NumSatoshis)
 
 	return invoiceMemo, nil
 }
@@ -216,7 +823,7 @@ func DecodePaymentRequest(paymentRequest string) (*data.InvoiceMemo, error) {
 GetRelatedInvoice is used by the payee to fetch the related invoice of its sent payment request so he can see if it is settled.
 */
 func GetRelatedInvoice(paymentRequest string) (*data.Invoice, error) {
-	decodedPayReq, err := lightningClient.DecodePayReq(context.Background(), &lnrpc.PayReqString{PayReq: paymentRequest})
+	decodedPayReq, err := decodePayReqLocally(paymentRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -226,7 +833,7 @@ func GetRelatedInvoice(paymentRequest string) (*data.Invoice, error) {
 		return nil, err
 	}
 
-	lookup, err := lightningClient.LookupInvoice(context.Background(), &lnrpc.PaymentHash{RHashStr: decodedPayReq.PaymentHash})
+	lookup, err := paymentsClient.LookupInvoice(context.Background(), &lnrpc.PaymentHash{RHashStr: decodedPayReq.PaymentHash})
 	if err != nil {
 		return nil, err
 	}
@@ -240,72 +847,123 @@ func GetRelatedInvoice(paymentRequest string) (*data.Invoice, error) {
 	return invoice, nil
 }
 
+type invoiceStream interface {
+	Recv() (*lnrpc.Invoice, error)
+}
+
 func watchPayments() {
 	syncSentPayments()
-	_, lastInvoiceSettledIndex := fetchPaymentsSyncInfo()
-	log.Infof("last invoice settled index ", lastInvoiceSettledIndex)
-	stream, err := lightningClient.SubscribeInvoices(context.Background(), &lnrpc.InvoiceSubscription{SettleIndex: lastInvoiceSettledIndex})
-	if err != nil {
-		log.Criticalf("Failed to call SubscribeInvoices %v, %v", stream, err)
+	go watchPaymentIntents()
+	subscribe := func(ctx context.Context, settleIndex uint64) (invoiceStream, error) {
+		return paymentsClient.SubscribeInvoices(ctx, &lnrpc.InvoiceSubscription{SettleIndex: settleIndex})
 	}
-
+	policy := &retryPolicy{initialBackoff: invoicesInitialBackoff, maxBackoff: invoicesMaxBackoff}
 	go func() {
-		for {
-			invoice, err := stream.Recv()
-			log.Infof("watchPayments - Invoice received by subscription")
-			if err != nil {
-				log.Criticalf("Failed to receive an invoice : %v", err)
-				return
+		for retry := 1; ; retry++ {
+			received, err := receiveInvoices(subscribe, onNewReceivedPayment)
+			if received > 0 {
+				retry = 1
 			}
-			if invoice.Settled {
-				log.Infof("watchPayments adding a received payment")
-				if err = onNewReceivedPayment(invoice); err != nil {
-					log.Criticalf("Failed to update received payment : %v", err)
-					return
-				}
+			delay := policy.backoff(retry)
+			paymentsLog.Errorf("watchPayments - invoices subscription ended: %v, resubscribing in %v", err, delay)
+			select {
+			case <-time.After(delay):
+			case <-quitChan:
+				return
 			}
 		}
 	}()
 }
 
-func syncSentPayments() error {
-	log.Infof("syncSentPayments")
-	lightningPayments, err := lightningClient.ListPayments(context.Background(), &lnrpc.ListPaymentsRequest{})
+// receiveInvoices subscribes to the invoices settled after the last recorded
+// settle index and adds them as received payments. The index is updated in the
+// same transaction as the payment, so resubscribing never skips or repeats one.
+// It returns the number of invoices received and the error ending the subscription.
+func receiveInvoices(subscribe func(context.Context, uint64) (invoiceStream, error), onSettled func(*lnrpc.Invoice) error) (int, error) {
+	_, lastInvoiceSettledIndex := fetchPaymentsSyncInfo()
+	paymentsLog.Infof("last invoice settled index ", lastInvoiceSettledIndex)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := subscribe(ctx, lastInvoiceSettledIndex)
 	if err != nil {
-		return err
+		paymentsLog.Criticalf("Failed to call SubscribeInvoices %v, %v", stream, err)
+		return 0, err
 	}
+
+	var received int
+	for {
+		if chaosFires("invoices subscription") {
+			return received, errChaos
+		}
+		invoice, err := stream.Recv()
+		paymentsLog.Infof("watchPayments - Invoice received by subscription")
+		if err != nil {
+			paymentsLog.Criticalf("Failed to receive an invoice : %v", err)
+			return received, err
+		}
+		received++
+		if invoice.Settled {
+			paymentsLog.Infof("watchPayments adding a received payment")
+			if err = onSettled(invoice); err != nil {
+				paymentsLog.Criticalf("Failed to update received payment : %v", err)
+				return received, err
+			}
+		}
+	}
+}
+
+// newSentPayments returns the daemon payments not recorded yet. Payments made in
+// the same second as the last recorded one are checked by hash since only some
+// of them may have been recorded.
+func newSentPayments(payments []*lnrpc.Payment) []*lnrpc.Payment {
 	lastPaymentTime, _ := fetchPaymentsSyncInfo()
-	for _, paymentItem := range lightningPayments.Payments {
-		if paymentItem.CreationDate <= lastPaymentTime {
+	var newPayments []*lnrpc.Payment
+	for _, paymentItem := range payments {
+		if paymentItem.CreationDate < lastPaymentTime {
 			continue
 		}
-		log.Infof("syncSentPayments adding an outgoing payment")
+		if paymentItem.CreationDate == lastPaymentTime {
+			if recorded, err := hasPayment(paymentItem.PaymentHash); err != nil || recorded {
+				continue
+			}
+		}
+		newPayments = append(newPayments, paymentItem)
+	}
+	return newPayments
+}
+
+func syncSentPayments() error {
+	paymentsLog.Infof("syncSentPayments")
+	lightningPayments, err := paymentsClient.ListPayments(context.Background(), &lnrpc.ListPaymentsRequest{})
+	if err != nil {
+		return err
+	}
+	for _, paymentItem := range newSentPayments(lightningPayments.Payments) {
+		paymentsLog.Infof("syncSentPayments adding an outgoing payment")
 		onNewSentPayment(paymentItem)
 	}
 
 	return nil
-
-	//TODO delete history of payment requests after the new payments API stablized.
 }
 
-func getPendingPayments() ([]*paymentInfo, error) {
+func getPendingPayments(ctx context.Context) ([]*paymentInfo, error) {
 	var payments []*paymentInfo
 
 	if DaemonReady() {
-		channelsRes, err := lightningClient.ListChannels(context.Background(), &lnrpc.ListChannelsRequest{})
+		channelsRes, err := paymentsClient.ListChannels(ctx, &lnrpc.ListChannelsRequest{})
 		if err != nil {
 			return nil, err
 		}
 
-		chainInfo, chainErr := lightningClient.GetInfo(context.Background(), &lnrpc.GetInfoRequest{})
+		chainInfo, chainErr := paymentsClient.GetInfo(ctx, &lnrpc.GetInfoRequest{})
 		if chainErr != nil {
-			log.Errorf("Failed get chain info", chainErr)
+			paymentsLog.Errorf("Failed get chain info", chainErr)
 			return nil, chainErr
 		}
 
 		for _, ch := range channelsRes.Channels {
 			for _, htlc := range ch.PendingHtlcs {
-				pendingItem, err := createPendingPayment(htlc, chainInfo.BlockHeight)
+				pendingItem, err := createPendingPayment(ctx, htlc, chainInfo.BlockHeight)
 				if err != nil {
 					return nil, err
 				}
@@ -317,7 +975,7 @@ func getPendingPayments() ([]*paymentInfo, error) {
 	return payments, nil
 }
 
-func createPendingPayment(htlc *lnrpc.HTLC, currentBlockHeight uint32) (*paymentInfo, error) {
+func createPendingPayment(ctx context.Context, htlc *lnrpc.HTLC, currentBlockHeight uint32) (*paymentInfo, error) {
 	paymentType := sentPayment
 	if htlc.Incoming {
 		paymentType = receivedPayment
@@ -325,32 +983,31 @@ func createPendingPayment(htlc *lnrpc.HTLC, currentBlockHeight uint32) (*payment
 
 	var paymentRequest string
 	if htlc.Incoming {
-		invoice, err := lightningClient.LookupInvoice(context.Background(), &lnrpc.PaymentHash{RHash: htlc.HashLock})
+		invoice, err := paymentsClient.LookupInvoice(ctx, &lnrpc.PaymentHash{RHash: htlc.HashLock})
 		if err != nil {
-			log.Errorf("createPendingPayment - failed to call LookupInvoice %v", err)
+			paymentsLog.Errorf("createPendingPayment - failed to call LookupInvoice %v", err)
 			return nil, err
 		}
 		paymentRequest = invoice.PaymentRequest
 	} else {
-		payReqBytes, err := fetchPaymentRequest(string(htlc.HashLock))
+		payReqBytes, err := fetchPaymentRequest(hex.EncodeToString(htlc.HashLock))
 		if err != nil {
-			log.Errorf("createPendingPayment - failed to call fetchPaymentRequest %v", err)
+			paymentsLog.Errorf("createPendingPayment - failed to call fetchPaymentRequest %v", err)
 			return nil, err
 		}
 		paymentRequest = string(payReqBytes)
 	}
 
-	minutesToExpire := time.Duration((htlc.ExpirationHeight - currentBlockHeight) * 10)
 	paymentData := &paymentInfo{
 		Type:                       paymentType,
 		Amount:                     htlc.Amount,
-		CreationTimestamp:          time.Now().Unix(),
+		CreationTimestamp:          trustedNow().Unix(),
 		PendingExpirationHeight:    htlc.ExpirationHeight,
-		PendingExpirationTimestamp: time.Now().Add(minutesToExpire * time.Minute).Unix(),
+		PendingExpirationTimestamp: pendingExpirationTimestamp(htlc.ExpirationHeight, currentBlockHeight),
 	}
 
 	if paymentRequest != "" {
-		decodedReq, err := lightningClient.DecodePayReq(context.Background(), &lnrpc.PayReqString{PayReq: paymentRequest})
+		decodedReq, err := decodePayReqLocally(paymentRequest)
 		if err != nil {
 			return nil, err
 		}
@@ -387,7 +1044,7 @@ func onNewSentPayment(paymentItem *lnrpc.Payment) error {
 	}
 
 	paymentType := sentPayment
-	decodedReq, err := lightningClient.DecodePayReq(context.Background(), &lnrpc.PayReqString{PayReq: string(paymentRequest)})
+	decodedReq, err := decodePayReqLocally(string(paymentRequest))
 	if err != nil {
 		return err
 	}
@@ -404,11 +1061,23 @@ func onNewSentPayment(paymentItem *lnrpc.Payment) error {
 		PayeeName:         invoiceMemo.PayeeName,
 		PayerImageURL:     invoiceMemo.PayerImageURL,
 		PayerName:         invoiceMemo.PayerName,
+		PayerComment:      invoiceMemo.PayerComment,
 		TransferRequest:   invoiceMemo.TransferRequest,
+		DeviceName:        invoiceMemo.DeviceName,
 		PaymentHash:       decodedReq.PaymentHash,
 		Destination:       decodedReq.Destination,
+		Fee:               paymentItem.Fee,
+		FeeMsat:           paymentItem.Fee * 1000,
+	}
+	if parentHash, err := fetchSplitParent(decodedReq.PaymentHash); err == nil {
+		paymentData.ParentPaymentHash = parentHash
+	}
+	//the route keeps the fee in millisatoshi precision
+	if route, err := fetchPaymentRoute(decodedReq.PaymentHash); err == nil && route != nil {
+		paymentData.FeeMsat = route.TotalFeesMsat
 	}
 
+	setSettlementFiatValue(paymentData)
 	err = addAccountPayment(paymentData, 0, uint64(paymentItem.CreationDate))
 	go func() {
 		time.Sleep(2 * time.Second)
@@ -441,16 +1110,39 @@ func onNewReceivedPayment(invoice *lnrpc.Invoice) error {
 		PayeeName:         invoiceMemo.PayeeName,
 		PayerImageURL:     invoiceMemo.PayerImageURL,
 		PayerName:         invoiceMemo.PayerName,
+		PayerComment:      invoiceMemo.PayerComment,
 		TransferRequest:   invoiceMemo.TransferRequest,
+		DeviceName:        invoiceMemo.DeviceName,
 		PaymentHash:       hex.EncodeToString(invoice.RHash),
 	}
+	if paymentType == depositPayment {
+		setDepositFundingSource(paymentData, invoice.RHash)
+	}
+	if invoiceMemo.Tax != nil {
+		paymentData.VatRate = invoiceMemo.Tax.VatRate
+		paymentData.TaxAmount = invoiceMemo.Tax.TaxAmount
+		paymentData.MerchantTaxID = invoiceMemo.Tax.MerchantTaxID
+	}
+
+	if hidden, err := isInvoiceHidden(paymentData.PaymentHash); err == nil && hidden {
+		paymentsLog.Warnf("onNewReceivedPayment - hidden invoice %v was paid", paymentData.PaymentHash)
+	}
 
+	setSettlementFiatValue(paymentData)
 	err = addAccountPayment(paymentData, invoice.SettleIndex, 0)
 	if err != nil {
-		log.Criticalf("Unable to add reveived payment : %v", err)
+		paymentsLog.Criticalf("Unable to add reveived payment : %v", err)
 		return err
 	}
-	notificationsChan <- data.NotificationEvent{Type: data.NotificationEvent_INVOICE_PAID}
+	invoicesSettledTotal.inc("")
+	onWrappedInvoiceSettled(paymentData.PaymentHash)
+	deleteInvoiceHints(paymentData.PaymentHash)
+	deleteFallbackAddress(paymentData.PaymentHash)
+	onDonationSettled(paymentData.PaymentHash, paymentData.Amount, paymentData.CreationTimestamp)
+	go forwardSplitShares(paymentData.PaymentHash, paymentData.Amount)
+	go runSettlementHooks(paymentData)
+	queueWebhookDeliveries(paymentData)
+	notify(data.NotificationEvent{Type: data.NotificationEvent_INVOICE_PAID, Data: []string{paymentData.PaymentHash, paymentData.PayerComment}})
 	go func() {
 		time.Sleep(2 * time.Second)
 		extractBackupPaths()
@@ -458,3 +1150,59 @@ func onNewReceivedPayment(invoice *lnrpc.Invoice) error {
 	onAccountChanged()
 	return nil
 }
+
+/*
+addServiceFeePayment records a fee paid to a service (LSP channel opening, swaps) as its
+own line item linked to the payment it was charged for.
+*/
+func addServiceFeePayment(parentPaymentHash, recipient string, fee int64, description string) error {
+	return addAccountPayment(&paymentInfo{
+		Type:              serviceFeePayment,
+		Amount:            fee,
+		CreationTimestamp: trustedNow().Unix(),
+		Description:       description,
+		PayeeName:         recipient,
+		PaymentHash:       parentPaymentHash + ":fee",
+		ParentPaymentHash: parentPaymentHash,
+		FeeRecipient:      recipient,
+	}, 0, 0)
+}
+
+/*
+GetQuarantinedPayments returns the payments that were not added to the payments list
+because their hash was empty or already used.
+*/
+func GetQuarantinedPayments() (*data.QuarantinedPaymentsList, error) {
+	quarantined, err := fetchQuarantinedPayments()
+	if err != nil {
+		return nil, err
+	}
+	list := &data.QuarantinedPaymentsList{}
+	for _, q := range quarantined {
+		list.Payments = append(list.Payments, &data.QuarantinedPayment{
+			Id:      q.ID,
+			Payment: paymentInfoToProto(q.Payment),
+			Reason:  q.Reason,
+		})
+	}
+	return list, nil
+}
+
+/*
+ResolveQuarantinedPayment repairs a quarantined payment using the given resolution.
+*/
+func ResolveQuarantinedPayment(id uint64, resolution data.QuarantineResolution) error {
+	if err := resolveQuarantinedPayment(id, resolution); err != nil {
+		return err
+	}
+	onAccountChanged()
+	return nil
+}
+
+// validateHash checks that value is a hex encoded payment hash or preimage.
+func validateHash(value, name string) error {
+	if b, err := hex.DecodeString(value); err != nil || len(b) != 32 {
+		return fmt.Errorf("invalid %v", name)
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package breez
+
+import (
+	"context"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/breez/lightninglib/lnrpc"
+)
+
+var perfThresholds = flag.Bool("perf", false, "check the payments hot path benchmarks against their thresholds")
+
+// perfThreshold is the maximum allowed cost per operation of a benchmark.
+// The values leave a generous margin so only real regressions fail.
+type perfThreshold struct {
+	name        string
+	bench       func(b *testing.B)
+	maxNsPerOp  int64
+	maxAllocsOp int64
+}
+
+func syntheticPayment(i int) *paymentInfo {
+	return &paymentInfo{
+		Type:              paymentType(i % 5),
+		Amount:            int64(1000 + i),
+		CreationTimestamp: int64(1546300800 + i),
+		Description:       fmt.Sprintf("Synthetic payment %v", i),
+		PayeeName:         "Payee",
+		PayeeImageURL:     "https://example.com/payee.png",
+		PaymentHash:       fmt.Sprintf("%064x", i),
+		Destination:       "02a1b2c3d4e5f6",
+	}
+}
+
+// seedPayments records count payments of real payment requests through
+// addAccountPayment, like the payment flows do.
+func seedPayments(b *testing.B, count int) {
+	//syncing every payment would make seeding the large lists take minutes
+	db.NoSync = true
+	defer func() { db.NoSync = false }()
+	for i := 0; i < count; i++ {
+		hash, payReq := testPaymentRequest(b, time.Unix(1546300800+int64(i), 0))
+		if err := savePaymentRequest(hash, []byte(payReq)); err != nil {
+			b.Fatal("failed to save payment request", err)
+		}
+		decoded, err := decodePayReqLocally(payReq)
+		if err != nil {
+			b.Fatal("failed to decode payment request", err)
+		}
+		payment := syntheticPayment(i)
+		payment.PaymentHash = decoded.PaymentHash
+		payment.Destination = decoded.Destination
+		payment.CreationTimestamp = decoded.Timestamp
+		if err := addAccountPayment(payment, 0, uint64(decoded.Timestamp)); err != nil {
+			b.Fatal("failed to add payment", err)
+		}
+	}
+}
+
+// openBenchDB opens a test database on testnet, the returned function closes
+// and deletes it.
+func openBenchDB(b *testing.B) func() {
+	previousCfg := cfg
+	cfg = &Config{Network: "testnet"}
+	closeTestDB := openTestDB(b)
+	return func() {
+		closeTestDB()
+		cfg = previousCfg
+	}
+}
+
+func benchmarkGetPayments(b *testing.B, count int) {
+	defer openBenchDB(b)()
+	seedPayments(b, count)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		list, err := GetPayments()
+		if err != nil {
+			b.Fatal("failed to get payments", err)
+		}
+		if len(list.PaymentsList) != count {
+			b.Fatalf("expected %v payments, got %v", count, len(list.PaymentsList))
+		}
+	}
+}
+
+func BenchmarkGetPayments10k(b *testing.B) {
+	benchmarkGetPayments(b, 10000)
+}
+
+func BenchmarkGetPayments100k(b *testing.B) {
+	benchmarkGetPayments(b, 100000)
+}
+
+func BenchmarkSerializePaymentInfo(b *testing.B) {
+	payment := syntheticPayment(1)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := serializePaymentInfo(payment); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCreatePendingPayment(b *testing.B) {
+	defer openBenchDB(b)()
+	hash, payReq := testPaymentRequest(b, time.Now())
+	if err := savePaymentRequest(hash, []byte(payReq)); err != nil {
+		b.Fatal(err)
+	}
+	hashLock, err := hex.DecodeString(hash)
+	if err != nil {
+		b.Fatal(err)
+	}
+	htlc := &lnrpc.HTLC{Incoming: false, Amount: 1000, HashLock: hashLock, ExpirationHeight: 600}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		payment, err := createPendingPayment(context.Background(), htlc, 500)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if payment.PaymentHash != hash {
+			b.Fatalf("expected the payment request of %v to be used, got %q", hash, payment.PaymentHash)
+		}
+	}
+}
+
+// TestPaymentsPerformance runs the hot path benchmarks and fails if any of them
+// exceeds its threshold. It only runs with -perf so it can be enabled in CI:
+// go test -run TestPaymentsPerformance -perf
+func TestPaymentsPerformance(t *testing.T) {
+	if !*perfThresholds {
+		t.Skip("run with -perf to check the performance thresholds")
+	}
+	thresholds := []perfThreshold{
+		{name: "GetPayments10k", bench: BenchmarkGetPayments10k, maxNsPerOp: 500e6, maxAllocsOp: 500000},
+		{name: "SerializePaymentInfo", bench: BenchmarkSerializePaymentInfo, maxNsPerOp: 20e3, maxAllocsOp: 10},
+		{name: "CreatePendingPayment", bench: BenchmarkCreatePendingPayment, maxNsPerOp: 2e6, maxAllocsOp: 2000},
+	}
+	for _, th := range thresholds {
+		r := testing.Benchmark(th.bench)
+		t.Logf("%v: %v, %v", th.name, r.String(), r.MemString())
+		if r.NsPerOp() > th.maxNsPerOp {
+			t.Errorf("%v: %v ns/op exceeds threshold %v", th.name, r.NsPerOp(), th.maxNsPerOp)
+		}
+		if r.AllocsPerOp() > th.maxAllocsOp {
+			t.Errorf("%v: %v allocs/op exceeds threshold %v", th.name, r.AllocsPerOp(), th.maxAllocsOp)
+		}
+	}
+}
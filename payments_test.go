@@ -1,11 +1,19 @@
 package breez
 
 import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
 	"io"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
 	"github.com/btcsuite/btclog"
+	"github.com/golang/protobuf/proto"
 )
 
 const (
@@ -37,8 +45,7 @@ func copyFile(src, dest string) (err error) {
 
 func TestGetPayments(t *testing.T) {
 	var err error
-	openDB("testDB")
-	defer deleteDB()
+	defer openTestDB(t)()
 	payment1 := &paymentInfo{
 		Type:              receivedPayment,
 		Description:       "Received Payment1",
@@ -77,6 +84,258 @@ func TestGetPayments(t *testing.T) {
 	}
 }
 
+func TestStreamPayments(t *testing.T) {
+	defer openTestDB(t)()
+	for i := 0; i < 5; i++ {
+		err := addAccountPayment(&paymentInfo{
+			Type:              receivedPayment,
+			Amount:            10,
+			CreationTimestamp: int64(i),
+			PaymentHash:       fmt.Sprintf("h%v", i),
+		}, uint64(i), 0)
+		if err != nil {
+			t.Error("failed to add payment", err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := StreamPayments(&buf, 2); err != nil {
+		t.Fatal("failed to stream payments", err)
+	}
+	var chunks []*data.PaymentsList
+	for buf.Len() > 0 {
+		length := binary.BigEndian.Uint32(buf.Next(4))
+		chunk := &data.PaymentsList{}
+		if err := proto.Unmarshal(buf.Next(int(length)), chunk); err != nil {
+			t.Fatal("failed to unmarshal chunk", err)
+		}
+		chunks = append(chunks, chunk)
+	}
+	if len(chunks) != 3 {
+		t.Fatal("expected 3 chunks, got ", len(chunks))
+	}
+	if len(chunks[2].PaymentsList) != 1 {
+		t.Error("last chunk should have 1 payment, got ", len(chunks[2].PaymentsList))
+	}
+	if chunks[0].PaymentsList[0].CreationTimestamp != 4 {
+		t.Error("first payment should have timestamp 4, got ", chunks[0].PaymentsList[0].CreationTimestamp)
+	}
+}
+
+func TestGetPaymentsPage(t *testing.T) {
+	defer openTestDB(t)()
+	for _, ts := range []int64{30, 10, 50, 20, 40} {
+		err := addAccountPayment(&paymentInfo{
+			Type:              sentPayment,
+			Amount:            10,
+			CreationTimestamp: ts,
+			PaymentHash:       fmt.Sprintf("h%v", ts),
+		}, 0, uint64(ts))
+		if err != nil {
+			t.Error("failed to add payment", err)
+		}
+	}
+
+	var timestamps []int64
+	cursor := ""
+	for pages := 0; pages < 3; pages++ {
+		page, err := GetPaymentsPage(&data.PaymentsPageRequest{Cursor: cursor, Limit: 2})
+		if err != nil {
+			t.Fatal("failed to get payments page", err)
+		}
+		for _, p := range page.PaymentsList {
+			timestamps = append(timestamps, p.CreationTimestamp)
+		}
+		cursor = page.NextCursor
+		if cursor == "" {
+			break
+		}
+	}
+	if cursor != "" {
+		t.Error("last page should not have a next cursor")
+	}
+	if fmt.Sprint(timestamps) != "[50 40 30 20 10]" {
+		t.Error("unexpected payments order ", timestamps)
+	}
+
+	if err := addAccountPayment(&paymentInfo{Type: receivedPayment, Amount: 10, CreationTimestamp: 35, PaymentHash: "r35"}, 1, 0); err != nil {
+		t.Fatal("failed to add payment", err)
+	}
+	page, err := GetPaymentsPage(&data.PaymentsPageRequest{
+		Limit:         10,
+		Types:         []data.Payment_PaymentType{data.Payment_SENT},
+		FromTimestamp: 20,
+		ToTimestamp:   40,
+	})
+	if err != nil {
+		t.Fatal("failed to get filtered payments", err)
+	}
+	timestamps = nil
+	for _, p := range page.PaymentsList {
+		timestamps = append(timestamps, p.CreationTimestamp)
+	}
+	if fmt.Sprint(timestamps) != "[40 30 20]" || page.NextCursor != "" {
+		t.Error("unexpected filtered payments ", timestamps, page.NextCursor)
+	}
+}
+
+func TestSearchPayments(t *testing.T) {
+	defer openTestDB(t)()
+	payments := []*paymentInfo{
+		{Type: sentPayment, CreationTimestamp: 1, PaymentHash: "h1", Description: "Morning coffee", PayeeName: "Blue Bottle"},
+		{Type: sentPayment, CreationTimestamp: 2, PaymentHash: "h2", Description: "Coffee beans", PayeeName: "Roastery"},
+		{Type: receivedPayment, CreationTimestamp: 3, PaymentHash: "h3", Description: "Lunch", PayerName: "Alice"},
+	}
+	for i, p := range payments {
+		if err := addAccountPayment(p, uint64(i), 0); err != nil {
+			t.Fatal("failed to add payment", err)
+		}
+	}
+	for query, expected := range map[string]string{
+		"coffee":       "[h2 h1]",
+		"COF blue":     "[h1]",
+		"alice":        "[h3]",
+		"coffee lunch": "[]",
+		"   ":          "[]",
+	} {
+		result, err := SearchPayments(query)
+		if err != nil {
+			t.Fatal("failed to search payments", err)
+		}
+		var hashes []string
+		for _, p := range result.PaymentsList {
+			hashes = append(hashes, p.PaymentHash)
+		}
+		if fmt.Sprint(hashes) != expected {
+			t.Errorf("search %q returned %v instead of %v", query, hashes, expected)
+		}
+	}
+}
+
+func TestGetSpendingByPayee(t *testing.T) {
+	defer openTestDB(t)()
+	now := time.Now().Unix()
+	payments := []*paymentInfo{
+		{Type: sentPayment, Amount: 100, CreationTimestamp: now - 10, PaymentHash: "h1", PayeeName: "Coffee", Destination: "d1"},
+		{Type: sentPayment, Amount: 200, CreationTimestamp: now - 5, PaymentHash: "h2", PayeeName: "Coffee", Destination: "d2"},
+		{Type: sentPayment, Amount: 250, CreationTimestamp: now - 3, PaymentHash: "h3", Destination: "d3"},
+		{Type: sentPayment, Amount: 1000, CreationTimestamp: now - 1000, PaymentHash: "h4", Destination: "d3"},
+		{Type: receivedPayment, Amount: 5000, CreationTimestamp: now - 1, PaymentHash: "h5", PayerName: "Coffee"},
+	}
+	for i, p := range payments {
+		if err := addAccountPayment(p, uint64(i), 0); err != nil {
+			t.Fatal("failed to add payment", err)
+		}
+	}
+	spending, err := GetSpendingByPayee(100)
+	if err != nil {
+		t.Fatal("failed to get spending", err)
+	}
+	if len(spending.Payees) != 2 {
+		t.Fatal("expected 2 payees, got ", len(spending.Payees))
+	}
+	coffee, other := spending.Payees[0], spending.Payees[1]
+	if coffee.PayeeName != "Coffee" || coffee.Amount != 300 || coffee.Count != 2 || coffee.LastPaymentTimestamp != now-5 {
+		t.Error("unexpected coffee spending ", coffee)
+	}
+	if other.Destination != "d3" || other.Amount != 250 || other.Count != 1 {
+		t.Error("unexpected destination spending ", other)
+	}
+}
+
+func TestEffectiveFeeLimit(t *testing.T) {
+	tests := []struct {
+		limit    *data.FeeLimit
+		expected int64
+	}{
+		{nil, 0},
+		{&data.FeeLimit{Sat: 50}, 50},
+		{&data.FeeLimit{Percent: 1}, 100},
+		{&data.FeeLimit{Sat: 50, Percent: 1}, 50},
+		{&data.FeeLimit{Sat: 500, Percent: 1}, 100},
+		{&data.FeeLimit{Percent: 0.001}, 1},
+	}
+	for i, test := range tests {
+		limit, err := effectiveFeeLimit(test.limit, 10000)
+		if err != nil || limit != test.expected {
+			t.Errorf("test %v: expected %v got %v, %v", i, test.expected, limit, err)
+		}
+	}
+	if _, err := effectiveFeeLimit(&data.FeeLimit{Sat: -1}, 10000); err == nil {
+		t.Error("negative fee limit should fail")
+	}
+}
+
+func TestPaymentFlows(t *testing.T) {
+	defer openTestDB(t)()
+	daemon, restore := installMemoryDaemon(t, 10000)
+	defer restore()
+
+	paymentRequest, err := AddInvoice(&data.InvoiceMemo{Description: "coffee", Amount: 1000})
+	if err != nil {
+		t.Fatal("failed to add an invoice", err)
+	}
+	decodedReq, err := decodePayReqLocally(paymentRequest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	daemon.settle(t, decodedReq.PaymentHash)
+	close(daemon.settled)
+	subscribe := func(ctx context.Context, settleIndex uint64) (invoiceStream, error) {
+		return paymentsClient.SubscribeInvoices(ctx, &lnrpc.InvoiceSubscription{SettleIndex: settleIndex})
+	}
+	if received, err := receiveInvoices(subscribe, onNewReceivedPayment); received != 1 || err != io.EOF {
+		t.Errorf("receiveInvoices returned %v, %v", received, err)
+	}
+	issued, err := GetIssuedInvoices()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issued.Invoices) != 1 || issued.Invoices[0].State != data.IssuedInvoice_SETTLED {
+		t.Errorf("expected the settled invoice to be issued, got %v", issued.Invoices)
+	}
+
+	memo, err := proto.Marshal(&data.InvoiceMemo{Description: "pizza", Amount: 500})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := SendPaymentForRequest(daemon.remoteInvoice(t, 500, string(memo)), 0); err != nil {
+		t.Fatal("failed to send a payment", err)
+	}
+	if err := SendPaymentForRequest(daemon.remoteInvoice(t, 100000, string(memo)), 0); err == nil {
+		t.Error("payment above the balance should fail")
+	}
+
+	paymentsList, err := GetPayments()
+	if err != nil {
+		t.Fatal(err)
+	}
+	payments := make(map[data.Payment_PaymentType]*data.Payment)
+	for _, p := range paymentsList.PaymentsList {
+		payments[p.Type] = p
+	}
+	if len(paymentsList.PaymentsList) != 2 {
+		t.Fatalf("expected 2 payments, got %v", len(paymentsList.PaymentsList))
+	}
+	if p := payments[data.Payment_RECEIVED]; p == nil || p.Amount != 1000 || p.InvoiceMemo.Description != "coffee" {
+		t.Errorf("unexpected received payment %v", p)
+	}
+	if p := payments[data.Payment_SENT]; p == nil || p.Amount != 500 || p.Fee != memoryDaemonFee || p.InvoiceMemo.Description != "pizza" {
+		t.Errorf("unexpected sent payment %v", p)
+	}
+	if daemon.balance != 10000+1000-500-memoryDaemonFee {
+		t.Errorf("unexpected daemon balance %v", daemon.balance)
+	}
+	failed, err := GetFailedPayments()
+	if err != nil || len(failed.Payments) != 1 {
+		t.Errorf("expected the failed payment to be recorded: %v, %v", failed, err)
+	}
+	intents, err := GetPaymentIntents()
+	if err != nil || len(intents.Intents) != 0 {
+		t.Errorf("payment intents should be resolved: %v, %v", intents, err)
+	}
+}
+
 func TestMain(m *testing.M) {
 	log = btclog.Disabled
 	os.Exit(m.Run())
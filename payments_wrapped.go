@@ -0,0 +1,247 @@
+package breez
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/breez/lightninglib/lnrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	breezservice "github.com/breez/breez/breez"
+)
+
+// syncedCacheDuration is how long the daemon sync state is reused before
+// GetInfo is called again.
+const syncedCacheDuration = 30 * time.Second
+
+var (
+	syncedMu        sync.Mutex
+	synced          bool
+	syncedCheckTime time.Time
+
+	// wrappedInvoicesUnsupported is set once the server answered it doesn't
+	// issue wrapped invoices.
+	wrappedInvoicesUnsupported int32
+
+	// wrappedInvoicesClient returns the client of the server issuing the
+	// wrapped invoices.
+	wrappedInvoicesClient = func() breezservice.FundManagerClient {
+		return breezservice.NewFundManagerClient(getBreezClientConnection())
+	}
+)
+
+// wrappedInvoiceInfo holds the data needed to register the local invoice that
+// matches an invoice the routing node issued on our behalf.
+type wrappedInvoiceInfo struct {
+	PaymentHash       string
+	Preimage          []byte
+	Amount            int64
+	Memo              string
+	Expiry            int64
+	CreationTimestamp int64
+	PaymentRequest    string
+
+	//ServiceFee is deducted by the routing node for opening the channel
+	ServiceFee int64
+	Registered bool
+}
+
+func serializeWrappedInvoiceInfo(s *wrappedInvoiceInfo) ([]byte, error) {
+	invoiceBytes, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return sealDBValue(invoiceBytes)
+}
+
+func deserializeWrappedInvoiceInfo(invoiceBytes []byte) (*wrappedInvoiceInfo, error) {
+	var invoice wrappedInvoiceInfo
+	invoiceBytes, err := openDBValue(invoiceBytes)
+	if err != nil {
+		return &invoice, err
+	}
+	err = json.Unmarshal(invoiceBytes, &invoice)
+	return &invoice, err
+}
+
+// canReceiveLocally returns true if the local node is ready and synced so it can
+// issue invoices by itself. The sync state is cached for syncedCacheDuration.
+func canReceiveLocally() bool {
+	if !DaemonReady() {
+		return false
+	}
+	syncedMu.Lock()
+	cached, checkTime := synced, syncedCheckTime
+	syncedMu.Unlock()
+	if time.Since(checkTime) < syncedCacheDuration {
+		return cached
+	}
+
+	//not holding the lock during the call so a slow daemon doesn't stall the other callers
+	chainInfo, err := paymentsClient.GetInfo(context.Background(), &lnrpc.GetInfoRequest{})
+	if err != nil {
+		paymentsLog.Errorf("canReceiveLocally - failed to call GetInfo %v", err)
+		return false
+	}
+	syncedMu.Lock()
+	synced, syncedCheckTime = chainInfo.SyncedToChain, time.Now()
+	syncedMu.Unlock()
+	return chainInfo.SyncedToChain
+}
+
+// addLocalOrWrappedInvoice adds the invoice locally when the node can receive
+// by itself, otherwise wrapped by the routing node. When the server doesn't
+// issue wrapped invoices it is added locally as soon as the daemon is ready,
+// the invoice route hints may then be stale until the node is synced.
+func addLocalOrWrappedInvoice(ctx context.Context, local bool, memo string, amount, expiry int64, preimage []byte, fallbackAddr string) (string, error) {
+	if !local {
+		paymentRequest, err := addWrappedInvoice(ctx, memo, amount, expiry, preimage)
+		if status.Code(err) != codes.Unimplemented || !DaemonReady() {
+			return paymentRequest, err
+		}
+		paymentsLog.Infof("AddInvoice - the server doesn't issue wrapped invoices, adding a local one")
+	}
+	paymentRequest, err := addLocalInvoice(ctx, memo, amount, expiry, preimage, fallbackAddr)
+	if err != nil {
+		return "", err
+	}
+	paymentsLog.Infof("Generated Invoice: %v", paymentRequest)
+	return paymentRequest, nil
+}
+
+/*
+addWrappedInvoice asks the routing node to issue an invoice on our behalf while the local
+node is still syncing. The preimage is generated here unless given and kept in the db, the routing node only
+learns the hash. It holds the incoming payment and forwards it once the matching local invoice
+is registered (see registerWrappedInvoices) so the settlement ends up in our payments as usual.
+It needs the AddWrappedInvoice call of the server, an Unimplemented status is returned without calling
+it again once the server answered it doesn't support it.
+*/
+func addWrappedInvoice(ctx context.Context, memo string, amount, expiry int64, preimage []byte) (string, error) {
+	if atomic.LoadInt32(&wrappedInvoicesUnsupported) == 1 {
+		return "", status.Error(codes.Unimplemented, "the server doesn't issue wrapped invoices")
+	}
+	acc, err := GetAccountInfo()
+	if err != nil {
+		return "", err
+	}
+	if acc.Id == "" {
+		return "", errors.New("node identity is not known yet")
+	}
+
+	if preimage == nil {
+		preimage = make([]byte, 32)
+		if _, err := rand.Read(preimage); err != nil {
+			return "", err
+		}
+	}
+	hash := sha256.Sum256(preimage)
+
+	ctx, cancel := context.WithTimeout(ctx, endpointTimeout*time.Second)
+	defer cancel()
+	reply, err := wrappedInvoicesClient().AddWrappedInvoice(ctx, &breezservice.AddWrappedInvoiceRequest{
+		NodeID:      acc.Id,
+		PaymentHash: hash[:],
+		Amount:      amount,
+		Memo:        memo,
+		Expiry:      expiry,
+	})
+	if err != nil {
+		paymentsLog.Errorf("AddWrappedInvoice: server endpoint call failed: %v", err)
+		if status.Code(err) == codes.Unimplemented {
+			atomic.StoreInt32(&wrappedInvoicesUnsupported, 1)
+		}
+		return "", err
+	}
+	if reply.ErrorMessage != "" {
+		return "", errors.New(reply.ErrorMessage)
+	}
+
+	err = saveWrappedInvoice(&wrappedInvoiceInfo{
+		PaymentHash:       hex.EncodeToString(hash[:]),
+		Preimage:          preimage,
+		Amount:            amount,
+		Memo:              memo,
+		Expiry:            expiry,
+		CreationTimestamp: trustedNow().Unix(),
+		PaymentRequest:    reply.PaymentRequest,
+		ServiceFee:        reply.ServiceFee,
+	})
+	if err != nil {
+		return "", err
+	}
+	paymentsLog.Infof("Generated wrapped Invoice: %v", reply.PaymentRequest)
+
+	if DaemonReady() {
+		go registerWrappedInvoices()
+	}
+	return reply.PaymentRequest, nil
+}
+
+// registerWrappedInvoices adds a local invoice for every wrapped invoice the routing node
+// issued for us so it can forward the held payment. Expired ones are just removed.
+func registerWrappedInvoices() {
+	invoices, err := fetchWrappedInvoices()
+	if err != nil {
+		paymentsLog.Errorf("registerWrappedInvoices - failed to fetch wrapped invoices %v", err)
+		return
+	}
+	for _, i := range invoices {
+		if i.CreationTimestamp+i.Expiry < trustedNow().Unix() {
+			paymentsLog.Infof("registerWrappedInvoices - removing expired wrapped invoice %v", i.PaymentHash)
+			deleteWrappedInvoice(i.PaymentHash)
+			continue
+		}
+		if i.Registered {
+			continue
+		}
+		_, err := paymentsClient.AddInvoice(context.Background(), &lnrpc.Invoice{RPreimage: i.Preimage, Memo: i.Memo, Private: true, Value: i.Amount - i.ServiceFee, Expiry: i.Expiry})
+		if err != nil {
+			paymentsLog.Errorf("registerWrappedInvoices - failed to add invoice for hash %v: %v", i.PaymentHash, err)
+			continue
+		}
+		paymentsLog.Infof("registerWrappedInvoices - registered local invoice for hash %v", i.PaymentHash)
+
+		//keep the ones with a fee until settled so the fee can be recorded
+		if i.ServiceFee > 0 {
+			i.Registered = true
+			err = saveWrappedInvoice(i)
+		} else {
+			err = deleteWrappedInvoice(i.PaymentHash)
+		}
+		if err != nil {
+			paymentsLog.Errorf("registerWrappedInvoices - failed to update wrapped invoice %v", err)
+		}
+	}
+}
+
+// onWrappedInvoiceSettled records the routing node fee of a settled wrapped invoice.
+func onWrappedInvoiceSettled(paymentHash string) {
+	invoices, err := fetchWrappedInvoices()
+	if err != nil {
+		paymentsLog.Errorf("onWrappedInvoiceSettled - failed to fetch wrapped invoices %v", err)
+		return
+	}
+	for _, i := range invoices {
+		if i.PaymentHash != paymentHash {
+			continue
+		}
+		if i.ServiceFee > 0 {
+			if err := addServiceFeePayment(paymentHash, breezFeeRecipient, i.ServiceFee, "Channel opening fee"); err != nil {
+				paymentsLog.Errorf("onWrappedInvoiceSettled - failed to add service fee %v", err)
+				return
+			}
+		}
+		if err := deleteWrappedInvoice(paymentHash); err != nil {
+			paymentsLog.Errorf("onWrappedInvoiceSettled - failed to delete wrapped invoice %v", err)
+		}
+	}
+}
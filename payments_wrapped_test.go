@@ -0,0 +1,180 @@
+package breez
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	breezservice "github.com/breez/breez/breez"
+)
+
+// resetSyncedCache makes canReceiveLocally ask the daemon again.
+func resetSyncedCache() {
+	syncedMu.Lock()
+	defer syncedMu.Unlock()
+	syncedCheckTime = time.Time{}
+}
+
+// syncingDaemon is a memory daemon reporting the given sync state and counting
+// the GetInfo calls.
+type syncingDaemon struct {
+	*memoryDaemon
+	synced   bool
+	getInfos int
+}
+
+func (d *syncingDaemon) GetInfo(ctx context.Context, in *lnrpc.GetInfoRequest, opts ...grpc.CallOption) (*lnrpc.GetInfoResponse, error) {
+	d.getInfos++
+	info, err := d.memoryDaemon.GetInfo(ctx, in, opts...)
+	if err != nil {
+		return nil, err
+	}
+	info.SyncedToChain = d.synced
+	return info, nil
+}
+
+// wrappingServer issues wrapped invoices, or answers it doesn't support them.
+type wrappingServer struct {
+	breezservice.FundManagerClient
+	unimplemented bool
+	calls         int
+}
+
+func (s *wrappingServer) AddWrappedInvoice(ctx context.Context, in *breezservice.AddWrappedInvoiceRequest, opts ...grpc.CallOption) (*breezservice.AddWrappedInvoiceReply, error) {
+	s.calls++
+	if s.unimplemented {
+		return nil, status.Error(codes.Unimplemented, "unknown method AddWrappedInvoice")
+	}
+	return &breezservice.AddWrappedInvoiceReply{PaymentRequest: "lntbwrapped"}, nil
+}
+
+func TestAddLocalOrWrappedInvoice(t *testing.T) {
+	defer openTestDB(t)()
+	memory, restore := installMemoryDaemon(t, 0)
+	defer restore()
+	defer resetSyncedCache()
+	daemon := &syncingDaemon{memoryDaemon: memory}
+	paymentsClient = daemon
+	lightningClient = daemon
+	previousClient := wrappedInvoicesClient
+	defer func() {
+		wrappedInvoicesClient = previousClient
+		atomic.StoreInt32(&wrappedInvoicesUnsupported, 0)
+	}()
+	accBuf, err := proto.Marshal(&data.Account{Id: "node"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := saveAccount(accBuf); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		ready, synced bool
+		unimplemented bool
+		wrapped       bool
+		fails         bool
+	}{
+		{name: "synced", ready: true, synced: true},
+		{name: "daemon starting", wrapped: true},
+		{name: "daemon starting, no server support", unimplemented: true, fails: true},
+		{name: "syncing, no server support", ready: true, unimplemented: true},
+	}
+	for _, test := range tests {
+		resetSyncedCache()
+		atomic.StoreInt32(&wrappedInvoicesUnsupported, 0)
+		var ready int32
+		if test.ready {
+			ready = 1
+		}
+		atomic.StoreInt32(&isReady, ready)
+		daemon.synced = test.synced
+		server := &wrappingServer{unimplemented: test.unimplemented}
+		wrappedInvoicesClient = func() breezservice.FundManagerClient { return server }
+
+		paymentRequest, err := AddStandardInvoice(&data.InvoiceMemo{Description: "coffee", Amount: 1000})
+		if test.fails {
+			if err == nil {
+				t.Errorf("%v: expected the invoice to fail", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: %v", test.name, err)
+			continue
+		}
+		if wrapped := paymentRequest == "lntbwrapped"; wrapped != test.wrapped {
+			t.Errorf("%v: expected wrapped = %v, got %v", test.name, test.wrapped, paymentRequest)
+		}
+		if test.synced && server.calls != 0 {
+			t.Errorf("%v: expected the server not to be asked for a wrapped invoice", test.name)
+		}
+	}
+
+	//the server said it doesn't support wrapped invoices, it isn't asked again
+	server := &wrappingServer{unimplemented: true}
+	wrappedInvoicesClient = func() breezservice.FundManagerClient { return server }
+	if _, err := AddStandardInvoice(&data.InvoiceMemo{Description: "coffee", Amount: 1000}); err != nil {
+		t.Fatal(err)
+	}
+	if server.calls != 0 {
+		t.Errorf("expected the unsupported server not to be called again, got %v calls", server.calls)
+	}
+
+	//the sync state is cached
+	daemon.getInfos = 0
+	for i := 0; i < 3; i++ {
+		canReceiveLocally()
+	}
+	if daemon.getInfos > 1 {
+		t.Errorf("expected the sync state to be cached, GetInfo was called %v times", daemon.getInfos)
+	}
+}
+
+// stalledDaemon blocks GetInfo until released.
+type stalledDaemon struct {
+	*memoryDaemon
+	called  chan struct{}
+	release chan struct{}
+}
+
+func (d *stalledDaemon) GetInfo(ctx context.Context, in *lnrpc.GetInfoRequest, opts ...grpc.CallOption) (*lnrpc.GetInfoResponse, error) {
+	d.called <- struct{}{}
+	<-d.release
+	return d.memoryDaemon.GetInfo(ctx, in, opts...)
+}
+
+func TestCanReceiveLocallyUnlocksDuringGetInfo(t *testing.T) {
+	memory, restore := installMemoryDaemon(t, 0)
+	defer restore()
+	defer resetSyncedCache()
+	daemon := &stalledDaemon{memoryDaemon: memory, called: make(chan struct{}), release: make(chan struct{})}
+	paymentsClient = daemon
+
+	done := make(chan bool)
+	go func() { done <- canReceiveLocally() }()
+	<-daemon.called
+
+	cacheRead := make(chan struct{})
+	go func() {
+		syncedMu.Lock()
+		syncedMu.Unlock()
+		close(cacheRead)
+	}()
+	select {
+	case <-cacheRead:
+	case <-time.After(time.Second):
+		t.Error("expected the sync state cache to be free while GetInfo is running")
+	}
+	close(daemon.release)
+	<-done
+}
@@ -0,0 +1,88 @@
+package breez
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
+)
+
+const (
+	//blockPollInterval is how often the chain height is checked for new blocks
+	blockPollInterval = 30 * time.Second
+
+	//minExpiryDelta is the smallest expiry change worth notifying
+	minExpiryDelta = 60
+)
+
+// pendingExpirationTimestamp estimates when an HTLC expiring at expirationHeight
+// times out, assuming a block every ten minutes from now.
+func pendingExpirationTimestamp(expirationHeight, currentHeight uint32) int64 {
+	var blocksLeft uint32
+	if expirationHeight > currentHeight {
+		blocksLeft = expirationHeight - currentHeight
+	}
+	return trustedNow().Add(time.Duration(blocksLeft) * blockInterval).Unix()
+}
+
+// watchPendingExpiry recomputes the expiration timestamp of the pending HTLCs whenever
+// a new block arrives and sends a PENDING_EXPIRY_CHANGED notification with the
+// block height followed by the payment hash and new timestamp of every HTLC whose
+// expiry moved. The daemon has no block subscription so the height is polled.
+func watchPendingExpiry() {
+	ticker := time.NewTicker(blockPollInterval)
+	defer ticker.Stop()
+	var height uint32
+	expiries := make(map[string]int64)
+	for {
+		select {
+		case <-ticker.C:
+		case <-quitChan:
+			return
+		}
+		if !DaemonReady() {
+			continue
+		}
+		info, err := lightningClient.GetInfo(context.Background(), &lnrpc.GetInfoRequest{})
+		if err != nil {
+			log.Errorf("watchPendingExpiry - failed to get the chain height: %v", err)
+			continue
+		}
+		if info.BlockHeight == height {
+			continue
+		}
+		height = info.BlockHeight
+		channels, err := lightningClient.ListChannels(context.Background(), &lnrpc.ListChannelsRequest{})
+		if err != nil {
+			log.Errorf("watchPendingExpiry - failed to list the pending HTLCs: %v", err)
+			continue
+		}
+		expiries = pendingExpiryDelta(channels.Channels, height, expiries)
+	}
+}
+
+// pendingExpiryDelta notifies the expiry changes since the previous block and
+// returns the current expiries.
+func pendingExpiryDelta(channels []*lnrpc.Channel, height uint32, previous map[string]int64) map[string]int64 {
+	current := make(map[string]int64)
+	delta := []string{strconv.FormatUint(uint64(height), 10)}
+	for _, c := range channels {
+		for _, htlc := range c.PendingHtlcs {
+			paymentHash := hex.EncodeToString(htlc.HashLock)
+			expiry := pendingExpirationTimestamp(htlc.ExpirationHeight, height)
+			current[paymentHash] = expiry
+			if old, ok := previous[paymentHash]; ok && old-expiry < minExpiryDelta && expiry-old < minExpiryDelta {
+				current[paymentHash] = old
+				continue
+			}
+			delta = append(delta, paymentHash, strconv.FormatInt(expiry, 10))
+		}
+	}
+	if len(delta) > 1 {
+		notify(data.NotificationEvent{Type: data.NotificationEvent_PENDING_EXPIRY_CHANGED, Data: delta})
+	}
+	return current
+}
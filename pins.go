@@ -0,0 +1,202 @@
+package breez
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/breez/breez/data"
+	"github.com/btcsuite/btcd/btcec"
+)
+
+var (
+	pinsMu sync.RWMutex
+
+	//ErrPinValidation is returned when a Breez service presents a certificate that doesn't match the pin set
+	ErrPinValidation = errors.New("security: certificate doesn't match the pinned keys")
+)
+
+// pinSet is the list of base64 encoded SHA256 hashes of the SubjectPublicKeyInfo
+// accepted for the Breez services. Updates are signed by Breez and carry an
+// increasing sequence number so an older set can't be replayed, and an expiry
+// so a leaked key pinned by a set isn't trusted forever.
+type pinSet struct {
+	Pins     []string
+	Expiry   int64
+	Sequence uint64
+}
+
+func serializePinSet(p *pinSet) ([]byte, error) {
+	return json.Marshal(p)
+}
+
+func deserializePinSet(pinSetBytes []byte) (*pinSet, error) {
+	var p pinSet
+	err := json.Unmarshal(pinSetBytes, &p)
+	return &p, err
+}
+
+func spkiHash(cert *x509.Certificate) string {
+	h := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return base64.StdEncoding.EncodeToString(h[:])
+}
+
+// chainPins returns the pins of the keys of a verified chain, the last
+// certificate is the bundled trust anchor, which every chain contains, so
+// only the keys below it are pinned.
+func chainPins(chain []*x509.Certificate) []string {
+	var pins []string
+	for _, cert := range chain[:len(chain)-1] {
+		pins = append(pins, spkiHash(cert))
+	}
+	return pins
+}
+
+// currentPins returns the pins of the last signed update when it didn't
+// expire, the configured pins otherwise.
+func currentPins() map[string]bool {
+	pins := make(map[string]bool)
+	pinsMu.RLock()
+	defer pinsMu.RUnlock()
+	p, err := fetchPinSet()
+	if err != nil {
+		log.Errorf("currentPins - failed to fetch pin set: %v", err)
+	}
+	if p != nil && p.Expiry > trustedNow().Unix() {
+		for _, pin := range p.Pins {
+			pins[pin] = true
+		}
+		return pins
+	}
+	for _, pin := range cfg.ServicePins {
+		pins[pin] = true
+	}
+	return pins
+}
+
+func verifyPins(host string) func([][]byte, [][]*x509.Certificate) error {
+	return func(_ [][]byte, verifiedChains [][]*x509.Certificate) error {
+		pins := currentPins()
+		if len(pins) == 0 {
+			var err error
+			if pins, err = firstUsePins(host, verifiedChains); err != nil {
+				return err
+			}
+		}
+		for _, chain := range verifiedChains {
+			for _, pin := range chainPins(chain) {
+				if pins[pin] {
+					return nil
+				}
+			}
+		}
+		log.Errorf("verifyPins - pin validation failed for %v", host)
+		go func() {
+			notify(data.NotificationEvent{Type: data.NotificationEvent_SECURITY_PIN_FAILED, Data: []string{host}})
+		}()
+		return ErrPinValidation
+	}
+}
+
+// firstUsePins returns the pins of the host recorded on its first connection,
+// which are used when neither the configuration nor a signed pin set has pins,
+// so the installs without pins keep connecting and a later certificate has to
+// match the first one. The first connection of a host records the keys of its
+// verified chains.
+func firstUsePins(host string, verifiedChains [][]*x509.Certificate) (map[string]bool, error) {
+	pinsMu.Lock()
+	defer pinsMu.Unlock()
+	p, err := fetchFirstUsePins(host)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		p = &pinSet{}
+		for _, chain := range verifiedChains {
+			p.Pins = append(p.Pins, chainPins(chain)...)
+		}
+		log.Warnf("firstUsePins - no pins configured, pinning the %v keys of %v", len(p.Pins), host)
+		if err := saveFirstUsePins(host, p); err != nil {
+			return nil, err
+		}
+	}
+	pins := make(map[string]bool)
+	for _, pin := range p.Pins {
+		pins[pin] = true
+	}
+	return pins, nil
+}
+
+// pinnedTLSConfig returns a tls config for the Breez services that requires a
+// chain to the bundled CA with a pinned leaf or intermediate public key. The
+// keys of a host are pinned on its first connection when there are no pins,
+// unless the configuration disables pinning.
+func pinnedTLSConfig(host string) (*tls.Config, error) {
+	cp := x509.NewCertPool()
+	if !cp.AppendCertsFromPEM([]byte(letsencryptCert)) {
+		return nil, fmt.Errorf("credentials: failed to append certificates")
+	}
+	if cfg.DisableServicePinning {
+		log.Warnf("pinnedTLSConfig - pinning is disabled for %v", host)
+		return &tls.Config{RootCAs: cp}, nil
+	}
+	return &tls.Config{
+		RootCAs:               cp,
+		VerifyPeerCertificate: verifyPins(host),
+	}, nil
+}
+
+/*
+UpdatePinSet replaces the pins of the Breez services with a new set, which is used instead of the
+configured pins until it expires. The set must be signed by the Breez pin signing key so pins can be
+rotated safely, its sequence number must be higher than the one of the current set and it must have
+an expiry in the future.
+*/
+func UpdatePinSet(pinSetBytes, signature []byte) error {
+	if cfg.PinSigningKey == "" {
+		return errors.New("pin signing key is not configured")
+	}
+	keyBytes, err := hex.DecodeString(cfg.PinSigningKey)
+	if err != nil {
+		return err
+	}
+	pubKey, err := btcec.ParsePubKey(keyBytes, btcec.S256())
+	if err != nil {
+		return err
+	}
+	sig, err := btcec.ParseDERSignature(signature, btcec.S256())
+	if err != nil {
+		return err
+	}
+	hash := sha256.Sum256(pinSetBytes)
+	if !sig.Verify(hash[:], pubKey) {
+		return errors.New("invalid pin set signature")
+	}
+	p, err := deserializePinSet(pinSetBytes)
+	if err != nil {
+		return err
+	}
+	if len(p.Pins) == 0 {
+		return errors.New("empty pin set")
+	}
+	if p.Expiry <= trustedNow().Unix() {
+		return errors.New("the pin set has no expiry or is expired")
+	}
+	pinsMu.Lock()
+	defer pinsMu.Unlock()
+	current, err := fetchPinSet()
+	if err != nil {
+		return err
+	}
+	if current != nil && p.Sequence <= current.Sequence {
+		return fmt.Errorf("pin set sequence %v is not higher than the current %v", p.Sequence, current.Sequence)
+	}
+	log.Infof("UpdatePinSet - updating to %v pins, sequence %v", len(p.Pins), p.Sequence)
+	return savePinSet(p)
+}
@@ -0,0 +1,120 @@
+package breez
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+)
+
+func TestUpdatePinSet(t *testing.T) {
+	defer openTestDB(t)()
+	key, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	previousCfg := cfg
+	cfg = &Config{
+		ServicePins:   []string{"configured"},
+		PinSigningKey: hex.EncodeToString(key.PubKey().SerializeCompressed()),
+	}
+	defer func() { cfg = previousCfg }()
+
+	update := func(p *pinSet) error {
+		pinSetBytes, _ := serializePinSet(p)
+		hash := sha256.Sum256(pinSetBytes)
+		sig, err := key.Sign(hash[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		return UpdatePinSet(pinSetBytes, sig.Serialize())
+	}
+
+	if pins := currentPins(); !pins["configured"] {
+		t.Errorf("expected the configured pins, got %v", pins)
+	}
+	expiry := time.Now().Add(time.Hour).Unix()
+	if err := update(&pinSet{Pins: []string{"rotated"}, Sequence: 2}); err == nil {
+		t.Error("a pin set without expiry should be rejected")
+	}
+	if err := update(&pinSet{Pins: []string{"rotated"}, Sequence: 2, Expiry: time.Now().Add(-time.Hour).Unix()}); err == nil {
+		t.Error("an expired pin set should be rejected")
+	}
+	if err := update(&pinSet{Pins: []string{"rotated"}, Sequence: 2, Expiry: expiry}); err != nil {
+		t.Fatal(err)
+	}
+	if pins := currentPins(); !pins["rotated"] || pins["configured"] {
+		t.Errorf("expected the signed set to replace the configured pins, got %v", pins)
+	}
+	if err := update(&pinSet{Pins: []string{"old"}, Sequence: 1, Expiry: expiry}); err == nil {
+		t.Error("an older pin set should be rejected")
+	}
+	if err := update(&pinSet{Pins: []string{"old"}, Sequence: 2, Expiry: expiry}); err == nil {
+		t.Error("a pin set with the same sequence should be rejected")
+	}
+}
+
+// testChain returns a verified chain of a new leaf certificate signed by the
+// bundled CA, the leaf is self-signed since only its key is pinned.
+func testChain(t *testing.T) []*x509.Certificate {
+	block, _ := pem.Decode([]byte(letsencryptCert))
+	anchor, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{SerialNumber: big.NewInt(1), NotAfter: time.Now().Add(time.Hour)}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return []*x509.Certificate{leaf, anchor}
+}
+
+func TestVerifyPinsFirstUse(t *testing.T) {
+	defer openTestDB(t)()
+	previousCfg := cfg
+	cfg = &Config{}
+	defer func() { cfg = previousCfg }()
+
+	first, second := testChain(t), testChain(t)
+	if err := verifyPins("breez")(nil, [][]*x509.Certificate{first}); err != nil {
+		t.Fatal("the first connection without pins should succeed ", err)
+	}
+	if err := verifyPins("breez")(nil, [][]*x509.Certificate{first}); err != nil {
+		t.Error("the keys of the first connection should be pinned ", err)
+	}
+	if err := verifyPins("backup")(nil, [][]*x509.Certificate{second}); err != nil {
+		t.Error("the keys should be pinned by host ", err)
+	}
+	if err := verifyPins("breez")(nil, [][]*x509.Certificate{second}); err != ErrPinValidation {
+		t.Errorf("expected ErrPinValidation for other keys, got %v", err)
+	}
+	if p, _ := fetchFirstUsePins("breez"); p == nil || len(p.Pins) != 1 || p.Pins[0] != spkiHash(first[0]) {
+		t.Errorf("expected only the leaf key to be pinned, got %+v", p)
+	}
+
+	cfg.DisableServicePinning = true
+	tlsConfig, err := pinnedTLSConfig("breez")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tlsConfig.VerifyPeerCertificate != nil {
+		t.Error("pins shouldn't be verified when pinning is disabled")
+	}
+}
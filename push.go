@@ -0,0 +1,126 @@
+package breez
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	breezservice "github.com/breez/breez/breez"
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
+)
+
+const (
+	wakeupCheckInterval = 2 * time.Second
+)
+
+/*
+RegisterPaymentNotification registers the device push token with the routing node, which sends a push
+notification when a payment to this node arrives while the daemon is down. The app is expected to call
+WakeupAndSettle when it receives it. The token is saved and registered again on every startup.
+*/
+func RegisterPaymentNotification(token string) error {
+	if token == "" {
+		return errors.New("missing notification token")
+	}
+	if err := savePushToken(token); err != nil {
+		return err
+	}
+	return registerPushToken(token)
+}
+
+func registerPushToken(token string) error {
+	acc, err := GetAccountInfo()
+	if err != nil {
+		return err
+	}
+	if acc.Id == "" {
+		return errors.New("node identity is not known yet")
+	}
+	c, ctx, cancel := getFundManager()
+	defer cancel()
+	_, err = c.RegisterPaymentNotification(ctx, &breezservice.RegisterPaymentNotificationRequest{
+		NodeID:            acc.Id,
+		NotificationToken: token,
+	})
+	return err
+}
+
+// registerPushTokenOnStartup registers the saved token again in case the
+// routing node lost it.
+func registerPushTokenOnStartup() {
+	token, err := fetchPushToken()
+	if err != nil || token == "" {
+		return
+	}
+	if err := registerPushToken(token); err != nil {
+		log.Errorf("registerPushTokenOnStartup - failed to register notification token: %v", err)
+	}
+}
+
+/*
+WakeupAndSettle is called by the app on a payment push notification while breez is not running. It starts
+breez, lets the routing node forward the pending payments, settles them and stops once there are no incoming
+payments left in flight or the timeout is reached. It returns the payment hashes of the settled payments.
+The settlement notifications are delivered again on the next start so the app handles them as usual.
+*/
+func WakeupAndSettle(workingDir string, timeout time.Duration) (*data.WakeupResult, error) {
+	events, err := Start(workingDir, false)
+	if err != nil {
+		return nil, err
+	}
+	quit := quitChan
+	defer func() {
+		Stop()
+		WaitDaemonShutdown()
+	}()
+
+	result := &data.WakeupResult{}
+	deadline := time.After(timeout)
+	ticker := time.NewTicker(wakeupCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case event := <-events:
+			if event.Type == data.NotificationEvent_INVOICE_PAID && len(event.Data) > 0 {
+				log.Infof("WakeupAndSettle - settled %v", event.Data[0])
+				result.SettledPaymentHashes = append(result.SettledPaymentHashes, event.Data[0])
+			}
+			if err := AckTransientNotification(event); err != nil {
+				log.Errorf("WakeupAndSettle - failed to acknowledge %v: %v", event.Id, err)
+			}
+		case <-ticker.C:
+			if len(result.SettledPaymentHashes) == 0 || !DaemonReady() {
+				continue
+			}
+			pending, err := hasPendingIncomingHTLCs()
+			if err != nil {
+				log.Errorf("WakeupAndSettle - failed to check the pending payments: %v", err)
+				continue
+			}
+			if !pending {
+				return result, nil
+			}
+		case <-deadline:
+			result.TimedOut = true
+			return result, nil
+		case <-quit:
+			return result, errors.New("breez stopped before settling")
+		}
+	}
+}
+
+func hasPendingIncomingHTLCs() (bool, error) {
+	channels, err := lightningClient.ListChannels(context.Background(), &lnrpc.ListChannelsRequest{})
+	if err != nil {
+		return false, err
+	}
+	for _, c := range channels.Channels {
+		for _, htlc := range c.PendingHtlcs {
+			if htlc.Incoming {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
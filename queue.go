@@ -0,0 +1,221 @@
+package breez
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/breez/breez/data"
+)
+
+const (
+	paymentQueueInterval = time.Minute
+)
+
+var (
+	paymentQueueSignal = make(chan struct{}, 1)
+	paymentQueueMu     sync.Mutex
+)
+
+type queuedPayment struct {
+	PaymentHash       string
+	PaymentRequest    string
+	Amount            int64
+	FeeLimit          int64
+	CreationTimestamp int64
+	ExpiryTimestamp   int64
+	Status            data.QueuedPayment_Status
+	Error             string
+}
+
+func serializeQueuedPayment(p *queuedPayment) ([]byte, error) {
+	paymentBytes, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return sealDBValue(paymentBytes)
+}
+
+func deserializeQueuedPayment(paymentBytes []byte) (*queuedPayment, error) {
+	var p queuedPayment
+	paymentBytes, err := openDBValue(paymentBytes)
+	if err != nil {
+		return &p, err
+	}
+	err = json.Unmarshal(paymentBytes, &p)
+	return &p, err
+}
+
+func (p *queuedPayment) toProto() *data.QueuedPayment {
+	return &data.QueuedPayment{
+		PaymentHash:       p.PaymentHash,
+		PaymentRequest:    p.PaymentRequest,
+		Amount:            p.Amount,
+		FeeLimit:          p.FeeLimit,
+		CreationTimestamp: p.CreationTimestamp,
+		ExpiryTimestamp:   p.ExpiryTimestamp,
+		Status:            p.Status,
+		Error:             p.Error,
+	}
+}
+
+func signalPaymentQueue() {
+	select {
+	case paymentQueueSignal <- struct{}{}:
+	default:
+	}
+}
+
+/*
+QueuePayment validates the payment request and keeps it to be sent as soon as the daemon is ready and
+connected to the routing node, so the payment can be made while offline. feeLimit is the maximum fee in
+satoshi, 0 for no limit. Payments whose invoice expires before they are sent are marked as expired.
+*/
+func QueuePayment(paymentRequest string, amount, feeLimit int64) (*data.QueuedPayment, error) {
+	decodedReq, err := decodePayReqLocally(paymentRequest)
+	if err != nil {
+		return nil, err
+	}
+	if decodedReq.NumSatoshis == 0 && amount <= 0 {
+		return nil, errors.New("amount is required for a payment request without an amount")
+	}
+	if feeLimit < 0 {
+		return nil, errors.New("fee limit can't be negative")
+	}
+	expiry := decodedReq.Timestamp + decodedReq.Expiry
+	if expiry <= trustedNow().Unix() {
+		return nil, errors.New("payment request is expired")
+	}
+	existing, err := fetchQueuedPayment(decodedReq.PaymentHash)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("payment %v is already queued", decodedReq.PaymentHash)
+	}
+	p := &queuedPayment{
+		PaymentHash:       decodedReq.PaymentHash,
+		PaymentRequest:    paymentRequest,
+		Amount:            amount,
+		FeeLimit:          feeLimit,
+		CreationTimestamp: trustedNow().Unix(),
+		ExpiryTimestamp:   expiry,
+		Status:            data.QueuedPayment_QUEUED,
+	}
+	if err := saveQueuedPayment(p); err != nil {
+		return nil, err
+	}
+	paymentsLog.Infof("QueuePayment - queued payment %v", p.PaymentHash)
+	signalPaymentQueue()
+	return p.toProto(), nil
+}
+
+/*
+GetQueuedPayments returns the queued payments which were not sent yet, including the failed and
+expired ones, oldest first.
+*/
+func GetQueuedPayments() (*data.QueuedPayments, error) {
+	payments, err := fetchQueuedPayments()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(payments, func(i, j int) bool {
+		return payments[i].CreationTimestamp < payments[j].CreationTimestamp
+	})
+	result := &data.QueuedPayments{}
+	for _, p := range payments {
+		result.Payments = append(result.Payments, p.toProto())
+	}
+	return result, nil
+}
+
+/*
+CancelQueuedPayment removes a queued payment. A payment that is being sent can't be canceled.
+*/
+func CancelQueuedPayment(paymentHash string) error {
+	paymentQueueMu.Lock()
+	defer paymentQueueMu.Unlock()
+	p, err := fetchQueuedPayment(paymentHash)
+	if err != nil {
+		return err
+	}
+	if p == nil {
+		return fmt.Errorf("payment %v is not queued", paymentHash)
+	}
+	if p.Status == data.QueuedPayment_SENDING {
+		return errors.New("payment is being sent")
+	}
+	return deleteQueuedPayment(paymentHash)
+}
+
+// watchPaymentQueue sends the queued payments when a payment is queued, when the
+// routing node gets connected and periodically.
+func watchPaymentQueue() {
+	ticker := time.NewTicker(paymentQueueInterval)
+	defer ticker.Stop()
+	for {
+		processPaymentQueue()
+		select {
+		case <-paymentQueueSignal:
+		case <-ticker.C:
+		case <-quitChan:
+			return
+		}
+	}
+}
+
+func processPaymentQueue() {
+	if !DaemonReady() || !isConnectedToRoutingNode() {
+		return
+	}
+	paymentQueueMu.Lock()
+	defer paymentQueueMu.Unlock()
+	payments, err := fetchQueuedPayments()
+	if err != nil {
+		paymentsLog.Errorf("processPaymentQueue - failed to fetch queued payments: %v", err)
+		return
+	}
+	for _, p := range payments {
+		//a payment left sending was interrupted, sending it again is safe since
+		//a payment already paid or in flight is refused.
+		if p.Status != data.QueuedPayment_QUEUED && p.Status != data.QueuedPayment_SENDING {
+			continue
+		}
+		if p.ExpiryTimestamp <= trustedNow().Unix() {
+			p.Status = data.QueuedPayment_EXPIRED
+			updateQueuedPayment(p)
+			continue
+		}
+		p.Status = data.QueuedPayment_SENDING
+		if !updateQueuedPayment(p) {
+			continue
+		}
+		paymentsLog.Infof("processPaymentQueue - sending queued payment %v", p.PaymentHash)
+		ctx := withSpendInitiator(context.Background(), data.SpendAuditEntry_SCHEDULER, "")
+		if err := sendPaymentForRequest(ctx, p.PaymentRequest, p.Amount, p.FeeLimit); err != nil && err != ErrAlreadyPaid {
+			paymentsLog.Errorf("processPaymentQueue - payment %v failed: %v", p.PaymentHash, err)
+			p.Status = data.QueuedPayment_FAILED
+			p.Error = err.Error()
+			updateQueuedPayment(p)
+			continue
+		}
+		if err := deleteQueuedPayment(p.PaymentHash); err != nil {
+			paymentsLog.Errorf("processPaymentQueue - failed to remove sent payment %v: %v", p.PaymentHash, err)
+		}
+		notify(data.NotificationEvent{Type: data.NotificationEvent_QUEUED_PAYMENT_CHANGED, Data: []string{p.PaymentHash, data.QueuedPayment_SENT.String()}})
+	}
+}
+
+// updateQueuedPayment saves the payment and notifies about its new status.
+func updateQueuedPayment(p *queuedPayment) bool {
+	if err := saveQueuedPayment(p); err != nil {
+		paymentsLog.Errorf("updateQueuedPayment - failed to save payment %v: %v", p.PaymentHash, err)
+		return false
+	}
+	notify(data.NotificationEvent{Type: data.NotificationEvent_QUEUED_PAYMENT_CHANGED, Data: []string{p.PaymentHash, p.Status.String()}})
+	return true
+}
@@ -0,0 +1,433 @@
+package breez
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/breez/breez/data"
+)
+
+const (
+	defaultRatesProvider = "https://blockchain.info/ticker"
+	defaultRatesInterval = 10 * time.Minute
+	defaultFiatCurrency  = "USD"
+
+	//ratesMaxDeviation is how far from the median a provider rate can be before
+	//it is rejected as an outlier
+	ratesMaxDeviation = 0.1
+
+	//settlementRateMaxAge is how far the rate time can be from the settlement
+	//time for the rate to be recorded as the payment fiat value
+	settlementRateMaxAge = time.Hour
+
+	satoshisPerBitcoin = 100000000
+)
+
+// fiatRate is the price of one bitcoin in a fiat currency.
+type fiatRate struct {
+	Currency  string
+	Value     float64
+	Timestamp int64
+}
+
+// tickerRate is the rate format of the provider, a map from the currency code
+// to the rate object.
+type tickerRate struct {
+	Last float64 `json:"last"`
+}
+
+func serializeFiatRate(r *fiatRate) ([]byte, error) {
+	return json.Marshal(r)
+}
+
+func deserializeFiatRate(rateBytes []byte) (*fiatRate, error) {
+	var r fiatRate
+	err := json.Unmarshal(rateBytes, &r)
+	return &r, err
+}
+
+/*
+FiatRatesProvider is a source of the price of one bitcoin by fiat currency code.
+Providers other than the configured ticker URLs are added with RegisterFiatRatesProvider.
+*/
+type FiatRatesProvider interface {
+	Name() string
+	FetchRates() (map[string]float64, error)
+}
+
+// ratesProviderHealth tracks how a provider has been doing so a bad feed is
+// visible to the user before pinning another source.
+type ratesProviderHealth struct {
+	LastSuccess         int64
+	LastError           string
+	ConsecutiveFailures int64
+	Outliers            int64
+}
+
+var (
+	ratesProvidersMu     sync.Mutex
+	customRatesProviders []FiatRatesProvider
+	ratesProvidersHealth = make(map[string]*ratesProviderHealth)
+)
+
+// tickerRatesProvider fetches the rates from a URL in the blockchain.info ticker format.
+type tickerRatesProvider struct {
+	url string
+}
+
+func (p *tickerRatesProvider) Name() string {
+	u, err := url.Parse(p.url)
+	if err != nil || u.Host == "" {
+		return p.url
+	}
+	return u.Host
+}
+
+func ratesInterval() time.Duration {
+	if cfg == nil || cfg.RatesInterval <= 0 {
+		return defaultRatesInterval
+	}
+	return cfg.RatesInterval
+}
+
+func (p *tickerRatesProvider) FetchRates() (map[string]float64, error) {
+	client, err := getHTTPClient()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Get(p.url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("rates provider returned status %v", resp.StatusCode)
+	}
+	var ticker map[string]tickerRate
+	if err := json.NewDecoder(resp.Body).Decode(&ticker); err != nil {
+		return nil, err
+	}
+	rates := make(map[string]float64)
+	for currency, r := range ticker {
+		if r.Last > 0 {
+			rates[strings.ToUpper(currency)] = r.Last
+		}
+	}
+	return rates, nil
+}
+
+/*
+RegisterFiatRatesProvider adds a rates provider queried along with the configured ones.
+*/
+func RegisterFiatRatesProvider(p FiatRatesProvider) {
+	ratesProvidersMu.Lock()
+	defer ratesProvidersMu.Unlock()
+	customRatesProviders = append(customRatesProviders, p)
+}
+
+func ratesProviders() []FiatRatesProvider {
+	urls := []string{defaultRatesProvider}
+	if cfg != nil && len(cfg.RatesProviders) > 0 {
+		urls = cfg.RatesProviders
+	}
+	var providers []FiatRatesProvider
+	for _, u := range urls {
+		providers = append(providers, &tickerRatesProvider{url: u})
+	}
+	ratesProvidersMu.Lock()
+	defer ratesProvidersMu.Unlock()
+	return append(providers, customRatesProviders...)
+}
+
+// activeRatesProviders returns the providers to query, only the pinned one if
+// the user pinned a source.
+func activeRatesProviders() ([]FiatRatesProvider, error) {
+	providers := ratesProviders()
+	pinned, err := fetchRatesSource()
+	if err != nil || pinned == "" {
+		return providers, err
+	}
+	for _, p := range providers {
+		if p.Name() == pinned {
+			return []FiatRatesProvider{p}, nil
+		}
+	}
+	return nil, fmt.Errorf("pinned rates source %v is not configured", pinned)
+}
+
+func providerHealth(name string) *ratesProviderHealth {
+	h, ok := ratesProvidersHealth[name]
+	if !ok {
+		h = &ratesProviderHealth{}
+		ratesProvidersHealth[name] = h
+	}
+	return h
+}
+
+// aggregateRates returns the median of the values after rejecting the ones too
+// far from the median of all of them, and which values were rejected.
+func aggregateRates(values []float64) (float64, []bool) {
+	m := median(values)
+	rejected := make([]bool, len(values))
+	var accepted []float64
+	for i, v := range values {
+		if m <= 0 || v < m*(1-ratesMaxDeviation) || v > m*(1+ratesMaxDeviation) {
+			rejected[i] = true
+			continue
+		}
+		accepted = append(accepted, v)
+	}
+	if len(accepted) == 0 {
+		return 0, rejected
+	}
+	return median(accepted), rejected
+}
+
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]float64{}, values...)
+	sort.Float64s(sorted)
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[middle-1] + sorted[middle]) / 2
+	}
+	return sorted[middle]
+}
+
+// fetchAggregatedRates queries the providers concurrently and aggregates their
+// rates by currency, updating the providers health.
+func fetchAggregatedRates() (map[string]float64, error) {
+	providers, err := activeRatesProviders()
+	if err != nil {
+		return nil, err
+	}
+	results := make([]map[string]float64, len(providers))
+	errs := make([]error, len(providers))
+	var wg sync.WaitGroup
+	for i, p := range providers {
+		wg.Add(1)
+		go func(i int, p FiatRatesProvider) {
+			defer wg.Done()
+			results[i], errs[i] = p.FetchRates()
+		}(i, p)
+	}
+	wg.Wait()
+
+	ratesProvidersMu.Lock()
+	defer ratesProvidersMu.Unlock()
+	valuesByCurrency := make(map[string][]float64)
+	providersByCurrency := make(map[string][]string)
+	for i, p := range providers {
+		h := providerHealth(p.Name())
+		if errs[i] != nil {
+			log.Errorf("fetchAggregatedRates - provider %v failed: %v", p.Name(), errs[i])
+			h.LastError = errs[i].Error()
+			h.ConsecutiveFailures++
+			continue
+		}
+		h.LastSuccess = time.Now().Unix()
+		h.ConsecutiveFailures = 0
+		for currency, value := range results[i] {
+			currency = strings.ToUpper(currency)
+			valuesByCurrency[currency] = append(valuesByCurrency[currency], value)
+			providersByCurrency[currency] = append(providersByCurrency[currency], p.Name())
+		}
+	}
+	if len(valuesByCurrency) == 0 {
+		return nil, errors.New("no rates provider is available")
+	}
+
+	rates := make(map[string]float64)
+	outliers := make(map[string]bool)
+	for currency, values := range valuesByCurrency {
+		rate, rejected := aggregateRates(values)
+		for i, r := range rejected {
+			if r {
+				outliers[providersByCurrency[currency][i]] = true
+			}
+		}
+		if rate > 0 {
+			rates[currency] = rate
+		}
+	}
+	for name := range outliers {
+		log.Infof("fetchAggregatedRates - provider %v returned outlier rates", name)
+		providerHealth(name).Outliers++
+	}
+	return rates, nil
+}
+
+// updateRates stores the aggregated rates and notifies if any of them changed.
+func updateRates() error {
+	rates, err := fetchAggregatedRates()
+	if err != nil {
+		return err
+	}
+	now := time.Now().Unix()
+	changed := false
+	for currency, value := range rates {
+		current, err := fetchFiatRate(currency)
+		if err != nil {
+			return err
+		}
+		if current == nil || current.Value != value {
+			changed = true
+		}
+		if err := saveFiatRate(&fiatRate{Currency: currency, Value: value, Timestamp: now}); err != nil {
+			return err
+		}
+	}
+	if changed {
+		notify(data.NotificationEvent{Type: data.NotificationEvent_RATES_CHANGED})
+	}
+	return nil
+}
+
+// watchRates periodically refreshes the cached fiat rates.
+func watchRates() {
+	ticker := time.NewTicker(ratesInterval())
+	defer ticker.Stop()
+	for {
+		if err := updateRates(); err != nil {
+			log.Errorf("watchRates - failed to update rates: %v", err)
+		}
+		select {
+		case <-ticker.C:
+		case <-quitChan:
+			return
+		}
+	}
+}
+
+func fiatRateToProto(r *fiatRate) *data.Rate {
+	return &data.Rate{Currency: r.Currency, Value: r.Value, Timestamp: r.Timestamp}
+}
+
+/*
+GetRate returns the last fetched price of one bitcoin in the given currency.
+*/
+func GetRate(currency string) (*data.Rate, error) {
+	r, err := fetchFiatRate(strings.ToUpper(currency))
+	if err != nil {
+		return nil, err
+	}
+	if r == nil {
+		return nil, fmt.Errorf("no rate for currency %v", currency)
+	}
+	return fiatRateToProto(r), nil
+}
+
+/*
+GetRates returns the last fetched prices of one bitcoin in all the available currencies.
+*/
+func GetRates() (*data.Rates, error) {
+	rates, err := fetchFiatRates()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(rates, func(i, j int) bool {
+		return rates[i].Currency < rates[j].Currency
+	})
+	result := &data.Rates{}
+	for _, r := range rates {
+		result.Rates = append(result.Rates, fiatRateToProto(r))
+	}
+	return result, nil
+}
+
+/*
+GetRatesProviders returns the rates providers with their health and whether the user pinned them.
+*/
+func GetRatesProviders() (*data.RatesProviders, error) {
+	pinned, err := fetchRatesSource()
+	if err != nil {
+		return nil, err
+	}
+	providers := ratesProviders()
+	ratesProvidersMu.Lock()
+	defer ratesProvidersMu.Unlock()
+	result := &data.RatesProviders{}
+	for _, p := range providers {
+		h := providerHealth(p.Name())
+		result.Providers = append(result.Providers, &data.RatesProvider{
+			Name:                p.Name(),
+			Pinned:              p.Name() == pinned,
+			LastSuccess:         h.LastSuccess,
+			LastError:           h.LastError,
+			ConsecutiveFailures: h.ConsecutiveFailures,
+			Outliers:            h.Outliers,
+		})
+	}
+	return result, nil
+}
+
+/*
+SetRatesSource pins the rates to a single provider by name, an empty name aggregates all the providers.
+*/
+func SetRatesSource(name string) error {
+	if name != "" {
+		found := false
+		for _, p := range ratesProviders() {
+			found = found || p.Name() == name
+		}
+		if !found {
+			return fmt.Errorf("unknown rates provider %v", name)
+		}
+	}
+	if err := saveRatesSource(name); err != nil {
+		return err
+	}
+	go func() {
+		if err := updateRates(); err != nil {
+			log.Errorf("SetRatesSource - failed to update rates: %v", err)
+		}
+	}()
+	return nil
+}
+
+/*
+SetFiatCurrency sets the currency in which the fiat value of new payments is recorded.
+*/
+func SetFiatCurrency(currency string) error {
+	return saveFiatCurrency(strings.ToUpper(currency))
+}
+
+/*
+GetFiatCurrency returns the currency in which the fiat value of new payments is recorded.
+*/
+func GetFiatCurrency() (string, error) {
+	currency, err := fetchFiatCurrency()
+	if err != nil || currency == "" {
+		return defaultFiatCurrency, err
+	}
+	return currency, nil
+}
+
+// setSettlementFiatValue records the payment value in the fiat currency using the
+// cached rate, only if the rate is from around the time the payment settled so
+// payments synced long after they settled don't get today's value.
+func setSettlementFiatValue(payment *paymentInfo) {
+	currency, err := GetFiatCurrency()
+	if err != nil {
+		log.Errorf("setSettlementFiatValue - failed to get the fiat currency: %v", err)
+		return
+	}
+	rate, err := fetchFiatRate(currency)
+	if err != nil || rate == nil {
+		return
+	}
+	rateTime, settleTime := time.Unix(rate.Timestamp, 0), time.Unix(payment.CreationTimestamp, 0)
+	if rateTime.Sub(settleTime) > settlementRateMaxAge || settleTime.Sub(rateTime) > settlementRateMaxAge {
+		return
+	}
+	payment.FiatAmount = float64(payment.Amount) * rate.Value / satoshisPerBitcoin
+	payment.FiatCurrency = currency
+}
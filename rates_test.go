@@ -0,0 +1,18 @@
+package breez
+
+import (
+	"testing"
+)
+
+func TestAggregateRates(t *testing.T) {
+	rate, rejected := aggregateRates([]float64{100, 102, 98, 150})
+	if rate != 100 {
+		t.Errorf("expected 100 got %v", rate)
+	}
+	if !rejected[3] || rejected[0] || rejected[1] || rejected[2] {
+		t.Errorf("expected only the last value rejected, got %v", rejected)
+	}
+	if rate, _ := aggregateRates([]float64{100}); rate != 100 {
+		t.Errorf("expected a single provider rate, got %v", rate)
+	}
+}
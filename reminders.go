@@ -0,0 +1,185 @@
+package breez
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
+)
+
+const (
+	reminderCheckInterval = time.Minute
+	defaultReminderLocale = "en"
+)
+
+// reminderTemplates are the reminder messages by language, formatted with
+// the amount and the time left until the invoice expires.
+var reminderTemplates = map[string]string{
+	"en": "Your request for %v sats expires in %v",
+	"es": "Tu solicitud de %v sats vence en %v",
+	"de": "Deine Anfrage über %v Sats läuft in %v ab",
+	"fr": "Votre demande de %v sats expire dans %v",
+	"pt": "O seu pedido de %v sats expira em %v",
+}
+
+// thousandsSeparators are the digit group separators by language.
+var thousandsSeparators = map[string]string{
+	"en": ",",
+	"es": ".",
+	"de": ".",
+	"fr": " ",
+	"pt": ".",
+}
+
+type invoiceReminder struct {
+	PaymentHash     string
+	Amount          int64
+	ExpiryTimestamp int64
+	RemindAt        int64
+	Locale          string
+}
+
+func serializeInvoiceReminder(r *invoiceReminder) ([]byte, error) {
+	reminderBytes, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	return sealDBValue(reminderBytes)
+}
+
+func deserializeInvoiceReminder(reminderBytes []byte) (*invoiceReminder, error) {
+	var r invoiceReminder
+	reminderBytes, err := openDBValue(reminderBytes)
+	if err != nil {
+		return &r, err
+	}
+	err = json.Unmarshal(reminderBytes, &r)
+	return &r, err
+}
+
+func reminderLanguage(locale string) string {
+	lang := strings.ToLower(strings.Split(strings.Replace(locale, "_", "-", -1), "-")[0])
+	if _, ok := reminderTemplates[lang]; !ok {
+		return defaultReminderLocale
+	}
+	return lang
+}
+
+func formatReminderAmount(amount int64, lang string) string {
+	digits := strconv.FormatInt(amount, 10)
+	var groups []string
+	for len(digits) > 3 {
+		groups = append([]string{digits[len(digits)-3:]}, groups...)
+		digits = digits[:len(digits)-3]
+	}
+	groups = append([]string{digits}, groups...)
+	return strings.Join(groups, thousandsSeparators[lang])
+}
+
+func formatReminderDuration(d time.Duration) string {
+	if d >= time.Hour {
+		return fmt.Sprintf("%vh", int64(d.Hours()))
+	}
+	minutes := int64(d.Minutes())
+	if minutes < 1 {
+		minutes = 1
+	}
+	return fmt.Sprintf("%vm", minutes)
+}
+
+// reminderMessage returns the reminder text in the reminder locale.
+func reminderMessage(r *invoiceReminder, now time.Time) string {
+	lang := reminderLanguage(r.Locale)
+	left := time.Unix(r.ExpiryTimestamp, 0).Sub(now)
+	return fmt.Sprintf(reminderTemplates[lang], formatReminderAmount(r.Amount, lang), formatReminderDuration(left))
+}
+
+/*
+AddInvoiceReminder schedules a reminder remindBefore seconds before the invoice expires.
+If the invoice is still unpaid by then an INVOICE_REMINDER notification is sent with the
+payment hash, the expiry and a message in the given locale.
+*/
+func AddInvoiceReminder(paymentRequest string, remindBefore int64, locale string) error {
+	decodedReq, err := decodePayReqLocally(paymentRequest)
+	if err != nil {
+		return err
+	}
+	expiry := decodedReq.Timestamp + decodedReq.Expiry
+	remindAt := expiry - remindBefore
+	if remindAt <= trustedNow().Unix() {
+		return errors.New("the reminder time already passed")
+	}
+	return saveInvoiceReminder(&invoiceReminder{
+		PaymentHash:     decodedReq.PaymentHash,
+		Amount:          decodedReq.NumSatoshis,
+		ExpiryTimestamp: expiry,
+		RemindAt:        remindAt,
+		Locale:          locale,
+	})
+}
+
+/*
+CancelInvoiceReminder removes the reminder of the invoice with the given payment hash.
+*/
+func CancelInvoiceReminder(paymentHash string) error {
+	return deleteInvoiceReminder(paymentHash)
+}
+
+// watchInvoiceReminders periodically sends the due reminders of unpaid invoices.
+func watchInvoiceReminders() {
+	sendDueInvoiceReminders()
+	ticker := time.NewTicker(reminderCheckInterval)
+	for {
+		select {
+		case <-ticker.C:
+			sendDueInvoiceReminders()
+		case <-quitChan:
+			ticker.Stop()
+			return
+		}
+	}
+}
+
+func sendDueInvoiceReminders() {
+	reminders, err := fetchInvoiceReminders()
+	if err != nil {
+		log.Errorf("sendDueInvoiceReminders - failed to fetch reminders %v", err)
+		return
+	}
+	now := trustedNow()
+	for _, r := range reminders {
+		if r.RemindAt > now.Unix() {
+			continue
+		}
+		if err := deleteInvoiceReminder(r.PaymentHash); err != nil {
+			log.Errorf("sendDueInvoiceReminders - failed to delete reminder %v", err)
+			continue
+		}
+		if r.ExpiryTimestamp <= now.Unix() {
+			continue
+		}
+		hash, err := hex.DecodeString(r.PaymentHash)
+		if err != nil {
+			continue
+		}
+		invoice, err := lightningClient.LookupInvoice(context.Background(), &lnrpc.PaymentHash{RHash: hash})
+		if err != nil {
+			log.Errorf("sendDueInvoiceReminders - failed to lookup invoice %v: %v", r.PaymentHash, err)
+			continue
+		}
+		if invoice.Settled {
+			continue
+		}
+		notify(data.NotificationEvent{
+			Type: data.NotificationEvent_INVOICE_REMINDER,
+			Data: []string{r.PaymentHash, strconv.FormatInt(r.ExpiryTimestamp, 10), reminderMessage(r, now)},
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package breez
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReminderMessage(t *testing.T) {
+	now := time.Unix(1000000, 0)
+	tests := []struct {
+		locale   string
+		left     time.Duration
+		expected string
+	}{
+		{"en_US", time.Hour, "Your request for 50,000 sats expires in 1h"},
+		{"de-DE", 30 * time.Minute, "Deine Anfrage über 50.000 Sats läuft in 30m ab"},
+		{"xx", 10 * time.Second, "Your request for 50,000 sats expires in 1m"},
+	}
+	for _, test := range tests {
+		r := &invoiceReminder{Amount: 50000, ExpiryTimestamp: now.Add(test.left).Unix(), Locale: test.locale}
+		if msg := reminderMessage(r, now); msg != test.expected {
+			t.Errorf("%v: expected %q, got %q", test.locale, test.expected, msg)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package breez
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/breez/breez/data"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	httpAPITokenSize    = 32
+	httpAPIMaxBodyBytes = 1 << 20
+
+	// httpAPITokenID identifies the HTTP API token in the audit log and the
+	// security alerts.
+	httpAPITokenID = "http"
+)
+
+var errHTTPAPIMethod = errors.New("method not allowed")
+
+/*
+GetHTTPAPIToken returns the token local companion apps must send as a bearer authorization header to
+call the HTTP API. It is generated on first use.
+*/
+func GetHTTPAPIToken() (string, error) {
+	token, err := fetchHTTPAPIToken()
+	if err != nil || token != "" {
+		return token, err
+	}
+	return RotateHTTPAPIToken()
+}
+
+/*
+RotateHTTPAPIToken replaces the HTTP API token, so apps holding the previous one can't call the API
+anymore, and returns the new token.
+*/
+func RotateHTTPAPIToken() (string, error) {
+	token := make([]byte, httpAPITokenSize)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	encoded := hex.EncodeToString(token)
+	if err := saveHTTPAPIToken(encoded); err != nil {
+		return "", err
+	}
+	return encoded, nil
+}
+
+// authorizeHTTPAPICall checks the request carries the HTTP API token as a
+// bearer authorization header. Failures are reported to the kill switch.
+func authorizeHTTPAPICall(r *http.Request) bool {
+	token, err := fetchHTTPAPIToken()
+	if err != nil || token == "" {
+		return false
+	}
+	expected := []byte("Bearer " + token)
+	if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), expected) != 1 {
+		onAPITokenFailure(httpAPITokenID, RotateHTTPAPIToken)
+		return false
+	}
+	onAPITokenSuccess(httpAPITokenID)
+	return true
+}
+
+func writeHTTPAPIError(w http.ResponseWriter, code int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
+
+func writeHTTPAPIResponse(w http.ResponseWriter, response proto.Message, err error) {
+	if err != nil {
+		writeHTTPAPIError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	m := jsonpb.Marshaler{EmitDefaults: true}
+	if err := m.Marshal(w, response); err != nil {
+		log.Errorf("HTTP API - failed to write the response: %v", err)
+	}
+}
+
+// httpAPIHandler wraps an API handler with the method check, the
+// authorization and the request body decoding. request is nil for handlers
+// without a body. The spending done with the request context is attributed to
+// the API token in the audit log.
+func httpAPIHandler(method string, newRequest func() proto.Message, handle func(r *http.Request, request proto.Message) (proto.Message, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method {
+			writeHTTPAPIError(w, http.StatusMethodNotAllowed, errHTTPAPIMethod)
+			return
+		}
+		if !authorizeHTTPAPICall(r) {
+			writeHTTPAPIError(w, http.StatusUnauthorized, errors.New("invalid or missing token"))
+			return
+		}
+		var request proto.Message
+		if newRequest != nil {
+			request = newRequest()
+			body := http.MaxBytesReader(w, r.Body, httpAPIMaxBodyBytes)
+			if err := jsonpb.Unmarshal(body, request); err != nil {
+				writeHTTPAPIError(w, http.StatusBadRequest, fmt.Errorf("invalid request: %v", err))
+				return
+			}
+		}
+		r = r.WithContext(withSpendInitiator(r.Context(), data.SpendAuditEntry_API_TOKEN, httpAPITokenID))
+		response, err := handle(r, request)
+		writeHTTPAPIResponse(w, response, err)
+	}
+}
+
+func httpAPIMux() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/health", HealthHandler)
+	mux.Handle("/v1/account", httpAPIHandler(http.MethodGet, nil, func(r *http.Request, _ proto.Message) (proto.Message, error) {
+		return GetAccountInfo()
+	}))
+	mux.Handle("/v1/payments", httpAPIHandler(http.MethodGet, nil, func(r *http.Request, _ proto.Message) (proto.Message, error) {
+		return GetPaymentsContext(r.Context())
+	}))
+	mux.Handle("/v1/payments/page", httpAPIHandler(http.MethodPost, func() proto.Message { return &data.PaymentsPageRequest{} },
+		func(r *http.Request, request proto.Message) (proto.Message, error) {
+			return GetPaymentsPageContext(r.Context(), request.(*data.PaymentsPageRequest))
+		}))
+	mux.Handle("/v1/payments/send", httpAPIHandler(http.MethodPost, func() proto.Message { return &data.PayInvoiceRequest{} },
+		func(r *http.Request, request proto.Message) (proto.Message, error) {
+			return (&apiServer{}).SendPaymentForRequest(r.Context(), request.(*data.PayInvoiceRequest))
+		}))
+	mux.Handle("/v1/invoices", httpAPIHandler(http.MethodPost, func() proto.Message { return &data.AddInvoiceRequest{} },
+		func(r *http.Request, request proto.Message) (proto.Message, error) {
+			return AddInvoiceWithPreimage(request.(*data.AddInvoiceRequest))
+		}))
+	mux.Handle("/v1/invoices/decode", httpAPIHandler(http.MethodPost, func() proto.Message { return &data.PaymentRequest{} },
+		func(r *http.Request, request proto.Message) (proto.Message, error) {
+			return DecodePaymentRequest(request.(*data.PaymentRequest).PaymentRequest)
+		}))
+	mux.Handle("/v1/payments/status", httpAPIHandler(http.MethodGet, nil, func(r *http.Request, _ proto.Message) (proto.Message, error) {
+		return GetPaymentStatus(r.URL.Query().Get("paymentHash"))
+	}))
+	return mux
+}
+
+// startHTTPAPIServer serves the HTTP API on the configured address until the
+// daemon stops. It only listens on a loopback address since it is meant for
+// apps on the same device.
+func startHTTPAPIServer() {
+	if cfg.HTTPAPIListen == "" {
+		return
+	}
+	if !isLoopbackAddress(cfg.HTTPAPIListen) {
+		log.Errorf("HTTP API - refusing to listen on the non loopback address %v", cfg.HTTPAPIListen)
+		return
+	}
+	listener, err := net.Listen("tcp", cfg.HTTPAPIListen)
+	if err != nil {
+		log.Errorf("HTTP API - failed to listen on %v: %v", cfg.HTTPAPIListen, err)
+		return
+	}
+	server := &http.Server{Handler: httpAPIMux()}
+	go func() {
+		<-quitChan
+		server.Shutdown(context.Background())
+	}()
+	log.Infof("HTTP API listening on %v", cfg.HTTPAPIListen)
+	if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+		log.Errorf("HTTP API stopped: %v", err)
+	}
+}
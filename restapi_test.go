@@ -0,0 +1,73 @@
+package breez
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHTTPAPIAuthorization(t *testing.T) {
+	defer openTestDB(t)()
+	token, err := GetHTTPAPIToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mux := httpAPIMux()
+	tests := []struct {
+		method        string
+		authorization string
+		code          int
+	}{
+		{http.MethodGet, "", http.StatusUnauthorized},
+		{http.MethodGet, "Bearer wrong", http.StatusUnauthorized},
+		{http.MethodPost, "Bearer " + token, http.StatusMethodNotAllowed},
+	}
+	for _, test := range tests {
+		r := httptest.NewRequest(test.method, "/v1/payments/status?paymentHash=h1", nil)
+		if test.authorization != "" {
+			r.Header.Set("Authorization", test.authorization)
+		}
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		if w.Code != test.code {
+			t.Errorf("%v %q: got %v, expected %v", test.method, test.authorization, w.Code, test.code)
+		}
+	}
+
+	rotated, err := RotateHTTPAPIToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rotated == token {
+		t.Error("token wasn't rotated")
+	}
+	r := httptest.NewRequest(http.MethodGet, "/v1/payments/status?paymentHash=h1", nil)
+	r.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("previous token still authorized: %v", w.Code)
+	}
+}
+
+func TestHTTPAPIRotatesTokenAfterFailures(t *testing.T) {
+	defer openTestDB(t)()
+	token, err := GetHTTPAPIToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	onAPITokenSuccess(httpAPITokenID)
+	mux := httpAPIMux()
+	for i := int32(0); i < pairingMaxFailures(); i++ {
+		r := httptest.NewRequest(http.MethodGet, "/v1/payments/status?paymentHash=h1", nil)
+		r.Header.Set("Authorization", "Bearer wrong")
+		mux.ServeHTTP(httptest.NewRecorder(), r)
+	}
+	rotated, err := GetHTTPAPIToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rotated == token {
+		t.Error("token wasn't rotated after the failed authentications")
+	}
+}
@@ -0,0 +1,168 @@
+package breez
+
+import (
+	"context"
+	"time"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
+)
+
+const (
+	defaultRetryMaxAttempts    = 3
+	defaultRetryInitialBackoff = 2 * time.Second
+	defaultRetryMaxBackoff     = 30 * time.Second
+
+	//retryRoutes is the number of routes queried to find one avoiding the failed hops
+	retryRoutes = 10
+)
+
+type retryPolicy struct {
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+}
+
+// newRetryPolicy returns the configured policy overridden by the non zero
+// fields of the request policy.
+func newRetryPolicy(p *data.RetryPolicy) *retryPolicy {
+	policy := &retryPolicy{
+		maxAttempts:    defaultRetryMaxAttempts,
+		initialBackoff: defaultRetryInitialBackoff,
+		maxBackoff:     defaultRetryMaxBackoff,
+	}
+	if cfg != nil && cfg.RetryMaxAttempts > 0 {
+		policy.maxAttempts = cfg.RetryMaxAttempts
+	}
+	if cfg != nil && cfg.RetryInitialBackoff > 0 {
+		policy.initialBackoff = cfg.RetryInitialBackoff
+	}
+	if cfg != nil && cfg.RetryMaxBackoff > 0 {
+		policy.maxBackoff = cfg.RetryMaxBackoff
+	}
+	if p == nil {
+		return policy
+	}
+	if p.MaxAttempts > 0 {
+		policy.maxAttempts = int(p.MaxAttempts)
+	}
+	if p.InitialBackoffMs > 0 {
+		policy.initialBackoff = time.Duration(p.InitialBackoffMs) * time.Millisecond
+	}
+	if p.MaxBackoffMs > 0 {
+		policy.maxBackoff = time.Duration(p.MaxBackoffMs) * time.Millisecond
+	}
+	return policy
+}
+
+// backoff returns the delay before the given retry, doubling from the initial
+// backoff up to the maximum.
+func (p *retryPolicy) backoff(retry int) time.Duration {
+	d := p.initialBackoff
+	for i := 1; i < retry && d < p.maxBackoff; i++ {
+		d *= 2
+	}
+	if d > p.maxBackoff {
+		return p.maxBackoff
+	}
+	return d
+}
+
+// retryable returns false for the failures another attempt can't fix.
+func retryable(err error) bool {
+	switch paymentFailureReason(err) {
+	case data.FailedPayment_INVOICE_EXPIRED, data.FailedPayment_INSUFFICIENT_BALANCE, data.FailedPayment_INCORRECT_PAYMENT_DETAILS:
+		return false
+	}
+	return err != context.Canceled && err != context.DeadlineExceeded
+}
+
+/*
+SendPaymentWithRetry starts paying the payment request like SendPaymentAsync, retrying failed attempts
+according to the policy, fields left zero use the configured policy. Every retry waits for the backoff and
+takes a route avoiding the channels of the routes which failed before. The attempts are reported as
+IN_FLIGHT statuses of the payment.
+*/
+func SendPaymentWithRetry(paymentRequest string, amountSatoshi int64, policy *data.RetryPolicy) (string, error) {
+	return startAsyncPayment(paymentRequest, func() error {
+		return sendPaymentUsing(context.Background(), paymentRequest, amountSatoshi, 0, retryingSender(newRetryPolicy(policy)))
+	})
+}
+
+func retryingSender(policy *retryPolicy) paymentSender {
+	return func(ctx context.Context, paymentRequest string, decodedReq *lnrpc.PayReq, amountSatoshi, amount, feeLimit int64) error {
+		excluded := make(map[uint64]bool)
+		var err error
+		for attempt := 1; attempt <= policy.maxAttempts; attempt++ {
+			status := &data.PaymentStatus{PaymentHash: decodedReq.PaymentHash, Status: data.PaymentStatus_IN_FLIGHT, Attempt: int32(attempt)}
+			if attempt > 1 {
+				select {
+				case <-time.After(policy.backoff(attempt - 1)):
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+				status.Error = err.Error()
+				publishPaymentStatus(status)
+			}
+			paymentsLog.Infof("retryingSender: attempt %v of payment %v", attempt, decodedReq.PaymentHash)
+			if err = sendAttempt(ctx, paymentRequest, decodedReq, amountSatoshi, amount, feeLimit, excluded); err == nil || !retryable(err) {
+				return err
+			}
+		}
+		return err
+	}
+}
+
+// sendAttempt sends the payment over a route avoiding the excluded channels,
+// excluding the remote channels of the route if it fails. When no such route
+// is known it lets the daemon find one, which also uses the invoice hints.
+func sendAttempt(ctx context.Context, paymentRequest string, decodedReq *lnrpc.PayReq, amountSatoshi, amount, feeLimit int64, excluded map[uint64]bool) error {
+	route := routeExcluding(ctx, decodedReq, amount, feeLimit, excluded)
+	if route == nil {
+		return sendDecodedPayment(ctx, paymentRequest, decodedReq, amountSatoshi, amount, feeLimit)
+	}
+	if err := checkPayment(ctx, decodedReq, amount); err != nil {
+		return err
+	}
+	response, err := paymentsClient.SendToRouteSync(ctx, &lnrpc.SendToRouteRequest{
+		PaymentHashString: decodedReq.PaymentHash,
+		Routes:            []*lnrpc.Route{route},
+	})
+	if err == nil {
+		err = onSendResponse(decodedReq, response, feeLimit)
+	}
+	if err != nil {
+		for _, hop := range route.Hops[1:] {
+			excluded[hop.ChanId] = true
+		}
+	}
+	return err
+}
+
+// routeExcluding returns the cheapest route which doesn't use any of the
+// excluded channels, or nil if there is none.
+func routeExcluding(ctx context.Context, decodedReq *lnrpc.PayReq, amount, feeLimit int64, excluded map[uint64]bool) *lnrpc.Route {
+	request := &lnrpc.QueryRoutesRequest{
+		PubKey:         decodedReq.Destination,
+		Amt:            amount,
+		NumRoutes:      retryRoutes,
+		FinalCltvDelta: int32(decodedReq.CltvExpiry),
+	}
+	if feeLimit > 0 {
+		request.FeeLimit = &lnrpc.FeeLimit{Limit: &lnrpc.FeeLimit_Fixed{Fixed: feeLimit}}
+	}
+	routes, err := paymentsClient.QueryRoutes(ctx, request)
+	if err != nil {
+		return nil
+	}
+	for _, route := range routes.Routes {
+		usable := len(route.Hops) > 0
+		for _, hop := range route.Hops {
+			usable = usable && !excluded[hop.ChanId]
+		}
+		if usable {
+			return route
+		}
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package breez
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/breez/breez/data"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := newRetryPolicy(&data.RetryPolicy{InitialBackoffMs: 1000, MaxBackoffMs: 5000})
+	expected := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}
+	for i, e := range expected {
+		if d := policy.backoff(i + 1); d != e {
+			t.Errorf("retry %v: expected %v got %v", i+1, e, d)
+		}
+	}
+	if policy.maxAttempts != defaultRetryMaxAttempts {
+		t.Errorf("expected the default max attempts, got %v", policy.maxAttempts)
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	if !retryable(errors.New("unable to find a path to destination")) {
+		t.Error("no route should be retried")
+	}
+	if retryable(errors.New("invoice expired")) {
+		t.Error("expired invoice should not be retried")
+	}
+}
+
+func TestRetryingSender(t *testing.T) {
+	defer openTestDB(t)()
+	daemon, restore := installMemoryDaemon(t, 1000)
+	defer restore()
+
+	send := retryingSender(newRetryPolicy(&data.RetryPolicy{MaxAttempts: 2, InitialBackoffMs: 1, MaxBackoffMs: 1}))
+	if err := sendPaymentUsing(context.Background(), daemon.remoteInvoice(t, 500, "coffee"), 0, 0, send); err != nil {
+		t.Fatal("failed to send a payment ", err)
+	}
+	if err := sendPaymentUsing(context.Background(), daemon.remoteInvoice(t, 5000, "dinner"), 0, 0, send); err == nil {
+		t.Error("payment above the balance should fail")
+	}
+	if len(daemon.payments) != 1 || daemon.payments[0].Value != 500 {
+		t.Errorf("expected only the first payment to be sent, got %v", daemon.payments)
+	}
+}
@@ -0,0 +1,153 @@
+package breez
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
+)
+
+// errNoRouteHints is returned when the daemon couldn't add a route hint through
+// any of the routing node channels, usually because none can receive the amount.
+var errNoRouteHints = errors.New("no routing node channel can receive this amount")
+
+// invoiceHints are the routing node channels an open invoice is routed through
+// together with what is needed to issue it again if they all close.
+type invoiceHints struct {
+	PaymentHash     string
+	Memo            string
+	Amount          int64
+	ExpiryTimestamp int64
+	ChanIDs         []uint64
+}
+
+func serializeInvoiceHints(h *invoiceHints) ([]byte, error) {
+	return json.Marshal(h)
+}
+
+func deserializeInvoiceHints(hintsBytes []byte) (*invoiceHints, error) {
+	var h invoiceHints
+	err := json.Unmarshal(hintsBytes, &h)
+	return &h, err
+}
+
+// routingNodeHintChannels returns the routing node channels referenced by the
+// route hints of the payment request.
+func routingNodeHintChannels(decodedReq *lnrpc.PayReq) []uint64 {
+	var chanIDs []uint64
+	for _, hint := range decodedReq.RouteHints {
+		for _, hop := range hint.HopHints {
+			if hop.NodeId == cfg.RoutingNodePubKey {
+				chanIDs = append(chanIDs, hop.ChanId)
+			}
+		}
+	}
+	return chanIDs
+}
+
+// addLocalInvoice adds a private invoice to the daemon and makes sure it carries
+// a route hint through a routing node channel, the only way payers can reach us.
+// The daemon builds the hints itself, so they are verified after the fact.
+// Invoices with a caller preimage are not kept for regeneration.
+func addLocalInvoice(ctx context.Context, memo string, amount, expiry int64, preimage []byte, fallbackAddr string) (string, error) {
+	response, err := paymentsClient.AddInvoice(ctx, &lnrpc.Invoice{
+		Memo: memo, Private: true, Value: amount, Expiry: expiry, RPreimage: preimage, FallbackAddr: fallbackAddr,
+	})
+	if err != nil {
+		return "", err
+	}
+	decodedReq, err := decodePayReqLocally(response.PaymentRequest)
+	if err != nil {
+		return "", err
+	}
+	chanIDs := routingNodeHintChannels(decodedReq)
+	if len(chanIDs) == 0 {
+		channels, err := routingNodeChannels()
+		if err != nil {
+			return "", err
+		}
+		if len(channels) > 0 {
+			paymentsLog.Errorf("addLocalInvoice - invoice %v has no route hint to the routing node", decodedReq.PaymentHash)
+			return "", errNoRouteHints
+		}
+		return response.PaymentRequest, nil
+	}
+	if preimage != nil {
+		return response.PaymentRequest, nil
+	}
+	err = saveInvoiceHints(&invoiceHints{
+		PaymentHash:     decodedReq.PaymentHash,
+		Memo:            memo,
+		Amount:          amount,
+		ExpiryTimestamp: decodedReq.Timestamp + decodedReq.Expiry,
+		ChanIDs:         chanIDs,
+	})
+	if err != nil {
+		paymentsLog.Errorf("addLocalInvoice - failed to save the route hints of %v: %v", decodedReq.PaymentHash, err)
+	}
+	return response.PaymentRequest, nil
+}
+
+// regenerateInvoices issues again the open invoices whose route hints only reference
+// closed routing node channels. The old invoice is hidden and an INVOICE_REGENERATED
+// notification is sent with its payment hash and the new payment request.
+func regenerateInvoices() {
+	hints, err := fetchInvoiceHints()
+	if err != nil || len(hints) == 0 {
+		return
+	}
+	channels, err := routingNodeChannels()
+	if err != nil {
+		paymentsLog.Errorf("regenerateInvoices - failed to list the routing node channels: %v", err)
+		return
+	}
+	open := make(map[uint64]bool)
+	for _, c := range channels {
+		open[c.ChanId] = true
+	}
+	now := trustedNow().Unix()
+	for _, h := range hints {
+		if h.ExpiryTimestamp <= now {
+			deleteInvoiceHints(h.PaymentHash)
+			continue
+		}
+		reachable := false
+		for _, chanID := range h.ChanIDs {
+			reachable = reachable || open[chanID]
+		}
+		if reachable {
+			continue
+		}
+		invoice, err := lightningClient.LookupInvoice(context.Background(), &lnrpc.PaymentHash{RHashStr: h.PaymentHash})
+		if err != nil {
+			paymentsLog.Errorf("regenerateInvoices - failed to lookup invoice %v: %v", h.PaymentHash, err)
+			continue
+		}
+		hidden, err := isInvoiceHidden(h.PaymentHash)
+		if err != nil {
+			continue
+		}
+		if invoice.Settled || hidden {
+			deleteInvoiceHints(h.PaymentHash)
+			continue
+		}
+		paymentRequest, err := addLocalInvoice(context.Background(), h.Memo, h.Amount, h.ExpiryTimestamp-now, nil, "")
+		if err != nil {
+			paymentsLog.Errorf("regenerateInvoices - failed to regenerate invoice %v: %v", h.PaymentHash, err)
+			continue
+		}
+		//the daemon can't cancel the old invoice, it stays payable but is
+		//listed as hidden and its reminder is removed
+		if err := saveHiddenInvoice(h.PaymentHash, trustedNow().Unix()); err != nil {
+			paymentsLog.Errorf("regenerateInvoices - failed to hide invoice %v: %v", h.PaymentHash, err)
+		}
+		if err := deleteInvoiceReminder(h.PaymentHash); err != nil {
+			paymentsLog.Errorf("regenerateInvoices - failed to remove the reminder of %v: %v", h.PaymentHash, err)
+		}
+		deleteInvoiceHints(h.PaymentHash)
+		paymentsLog.Infof("regenerateInvoices - invoice %v regenerated after its channels closed", h.PaymentHash)
+		notify(data.NotificationEvent{Type: data.NotificationEvent_INVOICE_REGENERATED, Data: []string{h.PaymentHash, paymentRequest}})
+	}
+}
@@ -0,0 +1,91 @@
+package breez
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
+)
+
+// paymentRoute is the route a sent payment took, as returned by the daemon
+// when the payment succeeded.
+type paymentRoute struct {
+	TotalAmtMsat  int64
+	TotalFeesMsat int64
+	TotalTimeLock uint32
+	Hops          []paymentHop
+}
+
+type paymentHop struct {
+	PubKey           string
+	ChanID           uint64
+	AmtToForwardMsat int64
+	FeeMsat          int64
+	Expiry           uint32
+}
+
+func serializePaymentRoute(r *paymentRoute) ([]byte, error) {
+	routeBytes, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	return sealDBValue(routeBytes)
+}
+
+func deserializePaymentRoute(routeBytes []byte) (*paymentRoute, error) {
+	var r paymentRoute
+	routeBytes, err := openDBValue(routeBytes)
+	if err != nil {
+		return &r, err
+	}
+	err = json.Unmarshal(routeBytes, &r)
+	return &r, err
+}
+
+func paymentRouteFromRPC(route *lnrpc.Route) *paymentRoute {
+	r := &paymentRoute{
+		TotalAmtMsat:  route.TotalAmtMsat,
+		TotalFeesMsat: route.TotalFeesMsat,
+		TotalTimeLock: route.TotalTimeLock,
+	}
+	for _, h := range route.Hops {
+		r.Hops = append(r.Hops, paymentHop{
+			PubKey:           h.PubKey,
+			ChanID:           h.ChanId,
+			AmtToForwardMsat: h.AmtToForwardMsat,
+			FeeMsat:          h.FeeMsat,
+			Expiry:           h.Expiry,
+		})
+	}
+	return r
+}
+
+/*
+GetPaymentRoute returns the hops a sent payment was routed through and the fee taken by each of them.
+*/
+func GetPaymentRoute(paymentHash string) (*data.PaymentRoute, error) {
+	r, err := fetchPaymentRoute(paymentHash)
+	if err != nil {
+		return nil, err
+	}
+	if r == nil {
+		return nil, fmt.Errorf("no route for payment %v", paymentHash)
+	}
+	result := &data.PaymentRoute{
+		PaymentHash:   paymentHash,
+		TotalAmtMsat:  r.TotalAmtMsat,
+		TotalFeesMsat: r.TotalFeesMsat,
+		TotalTimeLock: r.TotalTimeLock,
+	}
+	for _, h := range r.Hops {
+		result.Hops = append(result.Hops, &data.RouteHop{
+			PubKey:           h.PubKey,
+			ChanId:           h.ChanID,
+			AmtToForwardMsat: h.AmtToForwardMsat,
+			FeeMsat:          h.FeeMsat,
+			Expiry:           h.Expiry,
+		})
+	}
+	return result, nil
+}
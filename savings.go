@@ -0,0 +1,231 @@
+package breez
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
+)
+
+const (
+	defaultSavingsCoolingOff = 24 * time.Hour
+)
+
+// ErrSavingsDecrease is returned by SetSavings for an amount lower than the
+// locked savings, which can only be released by RequestSavingsUnlock.
+var ErrSavingsDecrease = errors.New("savings can only be lowered after RequestSavingsUnlock")
+
+type savingsInfo struct {
+	Amount          int64
+	UnlockTimestamp int64
+	//UnlockAmount is the amount released when the cooling-off period ends, 0 for all the savings
+	UnlockAmount int64
+}
+
+func serializeSavingsInfo(s *savingsInfo) ([]byte, error) {
+	return json.Marshal(s)
+}
+
+func deserializeSavingsInfo(savingsBytes []byte) (*savingsInfo, error) {
+	var savings savingsInfo
+	err := json.Unmarshal(savingsBytes, &savings)
+	return &savings, err
+}
+
+func savingsCoolingOff() time.Duration {
+	if cfg == nil || cfg.SavingsCoolingOff <= 0 {
+		return defaultSavingsCoolingOff
+	}
+	return cfg.SavingsCoolingOff
+}
+
+// unlockEnded returns true if the cooling-off period of a requested unlock ended.
+func (s *savingsInfo) unlockEnded() bool {
+	return s.UnlockTimestamp > 0 && s.UnlockTimestamp <= trustedNow().Unix()
+}
+
+// releaseEndedUnlock releases the unlocked amount of the savings whose
+// cooling-off period ended.
+func releaseEndedUnlock(savings *savingsInfo) {
+	if !savings.unlockEnded() {
+		return
+	}
+	if savings.UnlockAmount == 0 || savings.UnlockAmount >= savings.Amount {
+		*savings = savingsInfo{}
+		return
+	}
+	savings.Amount -= savings.UnlockAmount
+	savings.UnlockAmount = 0
+	savings.UnlockTimestamp = 0
+}
+
+// addSavings adds an amount to the savings. The funds added are locked, so a
+// pending unlock is canceled.
+func addSavings(amount int64) error {
+	return updateSavings(func(savings *savingsInfo) error {
+		releaseEndedUnlock(savings)
+		savings.Amount += amount
+		savings.UnlockTimestamp = 0
+		savings.UnlockAmount = 0
+		return nil
+	})
+}
+
+// lockedSavings returns the amount currently flagged as savings.
+// A savings balance whose cooling-off period ended is released.
+func lockedSavings() (int64, error) {
+	savings, err := fetchSavings()
+	if err != nil || savings == nil {
+		return 0, err
+	}
+	if !savings.unlockEnded() {
+		return savings.Amount, nil
+	}
+	var amount int64
+	err = updateSavings(func(savings *savingsInfo) error {
+		if savings.unlockEnded() {
+			log.Infof("lockedSavings - cooling-off period ended, releasing %v of %v", savings.UnlockAmount, savings.Amount)
+		}
+		releaseEndedUnlock(savings)
+		amount = savings.Amount
+		return nil
+	})
+	return amount, err
+}
+
+/*
+GetSavings returns the savings sub-balance and, if an unlock was requested, the amount
+unlocked and the time it becomes spendable.
+*/
+func GetSavings() (*data.SavingsInfo, error) {
+	amount, err := lockedSavings()
+	if err != nil {
+		return nil, err
+	}
+	savings, err := fetchSavings()
+	if err != nil {
+		return nil, err
+	}
+	reply := &data.SavingsInfo{Amount: amount}
+	if savings != nil {
+		reply.UnlockTimestamp = savings.UnlockTimestamp
+		reply.UnlockAmount = savings.UnlockAmount
+		if reply.UnlockTimestamp > 0 && reply.UnlockAmount == 0 {
+			reply.UnlockAmount = savings.Amount
+		}
+	}
+	return reply, nil
+}
+
+/*
+SetSavings flags the given amount of the channel balance as savings.
+Savings are excluded from GetSpendableBalance and can't be sent until unlocked. The amount can only be
+raised, which cancels a pending unlock; lowering the savings requires RequestSavingsUnlock and its
+cooling-off period.
+*/
+func SetSavings(amount int64) error {
+	if amount < 0 {
+		return errors.New("savings amount can't be negative")
+	}
+	channelBalance, err := lightningClient.ChannelBalance(context.Background(), &lnrpc.ChannelBalanceRequest{})
+	if err != nil {
+		return err
+	}
+	if amount > channelBalance.Balance {
+		return errors.New("savings amount exceeds balance")
+	}
+	err = updateSavings(func(savings *savingsInfo) error {
+		releaseEndedUnlock(savings)
+		if amount < savings.Amount {
+			return ErrSavingsDecrease
+		}
+		if amount > savings.Amount {
+			savings.Amount = amount
+			savings.UnlockTimestamp = 0
+			savings.UnlockAmount = 0
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	onAccountChanged()
+	return nil
+}
+
+/*
+RequestSavingsUnlock starts the cooling-off period after which the given amount of the savings becomes
+spendable. The payment authorizer is asked first, without one the unlock is refused. Requesting a
+different amount while an unlock is pending starts the cooling-off period again.
+It returns the unix time the amount will be released.
+*/
+func RequestSavingsUnlock(amount int64) (int64, error) {
+	if amount <= 0 {
+		return 0, errors.New("unlock amount must be positive")
+	}
+	savings, err := lockedSavings()
+	if err != nil {
+		return 0, err
+	}
+	if amount > savings {
+		return 0, errors.New("unlock amount exceeds savings")
+	}
+	authorizer := currentPaymentAuthorizer()
+	if authorizer == nil || !authorizer.AuthorizePayment(&data.PaymentAuthorizationRequest{
+		Description: "savings unlock",
+		Amount:      amount,
+	}) {
+		return 0, ErrPaymentNotAuthorized
+	}
+	var unlockTimestamp int64
+	err = updateSavings(func(savings *savingsInfo) error {
+		releaseEndedUnlock(savings)
+		if amount > savings.Amount {
+			return errors.New("unlock amount exceeds savings")
+		}
+		if savings.UnlockTimestamp == 0 || savings.UnlockAmount != amount {
+			savings.UnlockTimestamp = trustedNow().Add(savingsCoolingOff()).Unix()
+			savings.UnlockAmount = amount
+		}
+		unlockTimestamp = savings.UnlockTimestamp
+		return nil
+	})
+	return unlockTimestamp, err
+}
+
+/*
+GetSpendableBalance returns the channel balance excluding the locked savings.
+*/
+func GetSpendableBalance() (int64, error) {
+	channelBalance, err := lightningClient.ChannelBalance(context.Background(), &lnrpc.ChannelBalanceRequest{})
+	if err != nil {
+		return 0, err
+	}
+	savings, err := lockedSavings()
+	if err != nil {
+		return 0, err
+	}
+	spendable := channelBalance.Balance - savings
+	if spendable < 0 {
+		spendable = 0
+	}
+	return spendable, nil
+}
+
+func checkSpendable(amount int64) error {
+	savings, err := lockedSavings()
+	if err != nil || savings == 0 {
+		return err
+	}
+	spendable, err := GetSpendableBalance()
+	if err != nil {
+		return err
+	}
+	if amount > spendable {
+		return errors.New("amount exceeds spendable balance, unlock savings first")
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package breez
+
+import (
+	"testing"
+
+	"github.com/breez/breez/data"
+)
+
+type testAuthorizer bool
+
+func (a testAuthorizer) AuthorizePayment(request *data.PaymentAuthorizationRequest) bool {
+	return bool(a)
+}
+
+func TestSetSavingsOnlyIncreases(t *testing.T) {
+	defer openTestDB(t)()
+	_, restore := installMemoryDaemon(t, 1000)
+	defer restore()
+
+	SetPaymentAuthorizer(testAuthorizer(true))
+	defer SetPaymentAuthorizer(nil)
+
+	if err := SetSavings(500); err != nil {
+		t.Fatal(err)
+	}
+	unlockTimestamp, err := RequestSavingsUnlock(500)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if unlockTimestamp == 0 {
+		t.Fatal("expected an unlock time")
+	}
+	if err := SetSavings(0); err != ErrSavingsDecrease {
+		t.Errorf("lowering the savings should fail with ErrSavingsDecrease, got %v", err)
+	}
+	savings, err := GetSavings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if savings.Amount != 500 || savings.UnlockTimestamp != unlockTimestamp {
+		t.Errorf("savings changed by a rejected decrease: %v", savings)
+	}
+
+	if err := SetSavings(600); err != nil {
+		t.Fatal(err)
+	}
+	savings, _ = GetSavings()
+	if savings.Amount != 600 || savings.UnlockTimestamp != 0 {
+		t.Errorf("raising the savings should lock them again: %v", savings)
+	}
+}
+
+func TestAddSavingsCancelsUnlock(t *testing.T) {
+	defer openTestDB(t)()
+	SetPaymentAuthorizer(testAuthorizer(true))
+	defer SetPaymentAuthorizer(nil)
+
+	if err := addSavings(100); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := RequestSavingsUnlock(100); err != nil {
+		t.Fatal(err)
+	}
+	if err := addSavings(50); err != nil {
+		t.Fatal(err)
+	}
+	savings, err := fetchSavings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if savings.Amount != 150 || savings.UnlockTimestamp != 0 {
+		t.Errorf("expected 150 locked savings, got %+v", savings)
+	}
+}
+
+func TestRequestSavingsUnlockAmount(t *testing.T) {
+	defer openTestDB(t)()
+	if err := addSavings(100); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := RequestSavingsUnlock(40); err != ErrPaymentNotAuthorized {
+		t.Fatalf("unlocking without an authorizer should fail, got %v", err)
+	}
+	SetPaymentAuthorizer(testAuthorizer(false))
+	defer SetPaymentAuthorizer(nil)
+	if _, err := RequestSavingsUnlock(40); err != ErrPaymentNotAuthorized {
+		t.Fatalf("a denied unlock should fail, got %v", err)
+	}
+	SetPaymentAuthorizer(testAuthorizer(true))
+	if _, err := RequestSavingsUnlock(200); err == nil {
+		t.Fatal("unlocking more than the savings should fail")
+	}
+	if _, err := RequestSavingsUnlock(40); err != nil {
+		t.Fatal(err)
+	}
+
+	err := updateSavings(func(savings *savingsInfo) error {
+		savings.UnlockTimestamp = trustedNow().Unix() - 1
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	locked, err := lockedSavings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if locked != 60 {
+		t.Errorf("expected 60 savings left locked, got %v", locked)
+	}
+}
@@ -0,0 +1,134 @@
+package scenario
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/breez/breez"
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
+	"github.com/breez/lightninglib/zpay32"
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+type breezNode struct {
+	network *chaincfg.Params
+	events  chan data.NotificationEvent
+}
+
+// NewBreezNode returns the Breez node running in this process on the given network. events is
+// the channel returned by breez.Start, which must not be read by anyone else during the scenario.
+func NewBreezNode(network *chaincfg.Params, events chan data.NotificationEvent) Node {
+	return &breezNode{network: network, events: events}
+}
+
+func (n *breezNode) CreateInvoice(amount int64, description string) (string, string, error) {
+	paymentRequest, err := breez.AddInvoice(&data.InvoiceMemo{Amount: amount, Description: description})
+	if err != nil {
+		return "", "", err
+	}
+	decoded, err := zpay32.Decode(paymentRequest, n.network)
+	if err != nil {
+		return "", "", err
+	}
+	return paymentRequest, hex.EncodeToString(decoded.PaymentHash[:]), nil
+}
+
+func (n *breezNode) PayInvoice(paymentRequest string, amount int64) error {
+	return breez.SendPaymentForRequest(paymentRequest, amount)
+}
+
+func (n *breezNode) Balance() (int64, error) {
+	account, err := breez.GetAccountInfo()
+	if err != nil {
+		return 0, err
+	}
+	return account.Balance, nil
+}
+
+func (n *breezNode) Payments() ([]*data.Payment, error) {
+	payments, err := breez.GetPayments()
+	if err != nil {
+		return nil, err
+	}
+	return payments.PaymentsList, nil
+}
+
+func (n *breezNode) Events() <-chan data.NotificationEvent {
+	return n.events
+}
+
+type lndNode struct {
+	client lnrpc.LightningClient
+}
+
+// NewLndNode returns a node backed by the RPC client of a lightning daemon,
+// typically the counterparty node of the regtest network.
+func NewLndNode(client lnrpc.LightningClient) Node {
+	return &lndNode{client: client}
+}
+
+func (n *lndNode) CreateInvoice(amount int64, description string) (string, string, error) {
+	response, err := n.client.AddInvoice(context.Background(), &lnrpc.Invoice{Value: amount, Memo: description})
+	if err != nil {
+		return "", "", err
+	}
+	return response.PaymentRequest, hex.EncodeToString(response.RHash), nil
+}
+
+func (n *lndNode) PayInvoice(paymentRequest string, amount int64) error {
+	response, err := n.client.SendPaymentSync(context.Background(), &lnrpc.SendRequest{PaymentRequest: paymentRequest, Amt: amount})
+	if err != nil {
+		return err
+	}
+	if response.PaymentError != "" {
+		return fmt.Errorf("payment failed: %v", response.PaymentError)
+	}
+	return nil
+}
+
+func (n *lndNode) Balance() (int64, error) {
+	balance, err := n.client.ChannelBalance(context.Background(), &lnrpc.ChannelBalanceRequest{})
+	if err != nil {
+		return 0, err
+	}
+	return balance.Balance, nil
+}
+
+// Payments returns the sent payments and the settled invoices of the node.
+func (n *lndNode) Payments() ([]*data.Payment, error) {
+	var result []*data.Payment
+	payments, err := n.client.ListPayments(context.Background(), &lnrpc.ListPaymentsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range payments.Payments {
+		result = append(result, &data.Payment{
+			Type:              data.Payment_SENT,
+			Amount:            p.Value,
+			CreationTimestamp: p.CreationDate,
+			PaymentHash:       p.PaymentHash,
+		})
+	}
+	invoices, err := n.client.ListInvoices(context.Background(), &lnrpc.ListInvoiceRequest{})
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range invoices.Invoices {
+		if !i.Settled {
+			continue
+		}
+		result = append(result, &data.Payment{
+			Type:              data.Payment_RECEIVED,
+			Amount:            i.AmtPaidSat,
+			CreationTimestamp: i.SettleDate,
+			PaymentHash:       hex.EncodeToString(i.RHash),
+		})
+	}
+	return result, nil
+}
+
+func (n *lndNode) Events() <-chan data.NotificationEvent {
+	return nil
+}
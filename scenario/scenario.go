@@ -0,0 +1,268 @@
+// Package scenario runs declarative end-to-end scripts against a set of nodes,
+// for example a Breez node and a second node of a regtest network. A scenario
+// is a JSON document of steps such as:
+//
+//	{"name": "receive", "steps": [
+//		{"action": "create_invoice", "node": "breez", "amount": 1000, "as": "inv"},
+//		{"action": "pay", "node": "payer", "invoice": "inv"},
+//		{"action": "wait_event", "node": "breez", "event": "INVOICE_PAID"},
+//		{"action": "assert_payment", "node": "breez", "invoice": "inv", "type": "RECEIVED", "amount": 1000}
+//	]}
+//
+// Running it produces a Report that can be saved as JSON for CI or attached to a bug.
+package scenario
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/breez/breez/data"
+)
+
+const (
+	actionCreateInvoice = "create_invoice"
+	actionPay           = "pay"
+	actionWaitEvent     = "wait_event"
+	actionAssertBalance = "assert_balance"
+	actionAssertPayment = "assert_payment"
+	actionSleep         = "sleep"
+
+	defaultStepTimeout = 30 * time.Second
+)
+
+// Node is a lightning node the scenario steps run against.
+type Node interface {
+	// CreateInvoice returns the payment request and the payment hash of a new invoice.
+	CreateInvoice(amount int64, description string) (string, string, error)
+	PayInvoice(paymentRequest string, amount int64) error
+	Balance() (int64, error)
+	Payments() ([]*data.Payment, error)
+	// Events returns the node notifications, nil if the node has none.
+	Events() <-chan data.NotificationEvent
+}
+
+// Step is a single scenario action. The fields used depend on the action.
+type Step struct {
+	Action      string `json:"action"`
+	Node        string `json:"node"`
+	Amount      int64  `json:"amount,omitempty"`
+	Description string `json:"description,omitempty"`
+	// As names the invoice created by create_invoice for the following steps.
+	As string `json:"as,omitempty"`
+	// Invoice is the name of an invoice created by a previous step.
+	Invoice string `json:"invoice,omitempty"`
+	Event   string `json:"event,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Balance *int64 `json:"balance,omitempty"`
+	// TimeoutMs bounds wait_event and the polling of the assertions, 30 seconds by default.
+	TimeoutMs int64 `json:"timeoutMs,omitempty"`
+}
+
+// Scenario is a named list of steps.
+type Scenario struct {
+	Name  string `json:"name"`
+	Steps []Step `json:"steps"`
+}
+
+// StepResult is the outcome of a step.
+type StepResult struct {
+	Index      int    `json:"index"`
+	Action     string `json:"action"`
+	Node       string `json:"node"`
+	Passed     bool   `json:"passed"`
+	Error      string `json:"error,omitempty"`
+	DurationMs int64  `json:"durationMs"`
+}
+
+// Report is the structured result of a scenario run.
+type Report struct {
+	Scenario   string       `json:"scenario"`
+	Passed     bool         `json:"passed"`
+	StartTime  time.Time    `json:"startTime"`
+	DurationMs int64        `json:"durationMs"`
+	Steps      []StepResult `json:"steps"`
+}
+
+// Load reads a scenario from a JSON file.
+func Load(path string) (*Scenario, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var s Scenario
+	if err := json.Unmarshal(b, &s); err != nil {
+		return nil, fmt.Errorf("invalid scenario %v: %v", path, err)
+	}
+	return &s, nil
+}
+
+// Save writes the report as JSON.
+func (r *Report) Save(path string) error {
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, b, 0644)
+}
+
+type invoice struct {
+	paymentRequest string
+	paymentHash    string
+}
+
+type runner struct {
+	nodes    map[string]Node
+	invoices map[string]invoice
+}
+
+// Run executes the steps in order and stops at the first failing one.
+// The steps that were not run are not part of the report.
+func Run(s *Scenario, nodes map[string]Node) *Report {
+	r := &runner{nodes: nodes, invoices: make(map[string]invoice)}
+	report := &Report{Scenario: s.Name, Passed: true, StartTime: time.Now()}
+	for i, step := range s.Steps {
+		start := time.Now()
+		err := r.runStep(step)
+		result := StepResult{
+			Index:      i,
+			Action:     step.Action,
+			Node:       step.Node,
+			Passed:     err == nil,
+			DurationMs: int64(time.Since(start) / time.Millisecond),
+		}
+		if err != nil {
+			result.Error = err.Error()
+			report.Passed = false
+		}
+		report.Steps = append(report.Steps, result)
+		if err != nil {
+			break
+		}
+	}
+	report.DurationMs = int64(time.Since(report.StartTime) / time.Millisecond)
+	return report
+}
+
+func (r *runner) runStep(step Step) error {
+	timeout := defaultStepTimeout
+	if step.TimeoutMs > 0 {
+		timeout = time.Duration(step.TimeoutMs) * time.Millisecond
+	}
+	if step.Action == actionSleep {
+		time.Sleep(timeout)
+		return nil
+	}
+	node, ok := r.nodes[step.Node]
+	if !ok {
+		return fmt.Errorf("unknown node %q", step.Node)
+	}
+
+	switch step.Action {
+	case actionCreateInvoice:
+		if step.As == "" {
+			return fmt.Errorf("%v needs a name for the invoice", step.Action)
+		}
+		paymentRequest, paymentHash, err := node.CreateInvoice(step.Amount, step.Description)
+		if err != nil {
+			return err
+		}
+		r.invoices[step.As] = invoice{paymentRequest: paymentRequest, paymentHash: paymentHash}
+		return nil
+	case actionPay:
+		inv, err := r.invoice(step.Invoice)
+		if err != nil {
+			return err
+		}
+		return node.PayInvoice(inv.paymentRequest, step.Amount)
+	case actionWaitEvent:
+		return waitEvent(node, step.Event, timeout)
+	case actionAssertBalance:
+		if step.Balance == nil {
+			return fmt.Errorf("%v needs the expected balance", step.Action)
+		}
+		return eventually(timeout, func() error { return assertBalance(node, *step.Balance) })
+	case actionAssertPayment:
+		inv, err := r.invoice(step.Invoice)
+		if err != nil {
+			return err
+		}
+		return eventually(timeout, func() error { return assertPayment(node, inv.paymentHash, step) })
+	}
+	return fmt.Errorf("unknown action %q", step.Action)
+}
+
+func (r *runner) invoice(name string) (invoice, error) {
+	inv, ok := r.invoices[name]
+	if !ok {
+		return inv, fmt.Errorf("unknown invoice %q", name)
+	}
+	return inv, nil
+}
+
+func waitEvent(node Node, event string, timeout time.Duration) error {
+	eventType, ok := data.NotificationEvent_NotificationType_value[event]
+	if !ok {
+		return fmt.Errorf("unknown event %q", event)
+	}
+	events := node.Events()
+	if events == nil {
+		return fmt.Errorf("node has no events")
+	}
+	deadline := time.After(timeout)
+	for {
+		select {
+		case e := <-events:
+			if int32(e.Type) == eventType {
+				return nil
+			}
+		case <-deadline:
+			return fmt.Errorf("%v not received within %v", event, timeout)
+		}
+	}
+}
+
+// eventually retries the assertion until it passes or the timeout elapses since
+// the balance and history are updated asynchronously.
+func eventually(timeout time.Duration, assert func() error) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		err := assert()
+		if err == nil || time.Now().After(deadline) {
+			return err
+		}
+		time.Sleep(200 * time.Millisecond)
+	}
+}
+
+func assertBalance(node Node, expected int64) error {
+	balance, err := node.Balance()
+	if err != nil {
+		return err
+	}
+	if balance != expected {
+		return fmt.Errorf("balance is %v, expected %v", balance, expected)
+	}
+	return nil
+}
+
+func assertPayment(node Node, paymentHash string, step Step) error {
+	payments, err := node.Payments()
+	if err != nil {
+		return err
+	}
+	for _, p := range payments {
+		if p.PaymentHash != paymentHash {
+			continue
+		}
+		if step.Type != "" && p.Type.String() != step.Type {
+			return fmt.Errorf("payment type is %v, expected %v", p.Type, step.Type)
+		}
+		if step.Amount != 0 && p.Amount != step.Amount {
+			return fmt.Errorf("payment amount is %v, expected %v", p.Amount, step.Amount)
+		}
+		return nil
+	}
+	return fmt.Errorf("payment of invoice %q not found", step.Invoice)
+}
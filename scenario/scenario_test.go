@@ -0,0 +1,113 @@
+package scenario
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/breez/breez/data"
+)
+
+type fakeNetwork struct {
+	invoices map[string]*fakeNode
+	amounts  map[string]int64
+}
+
+type fakeNode struct {
+	network  *fakeNetwork
+	balance  int64
+	payments []*data.Payment
+	events   chan data.NotificationEvent
+}
+
+func (n *fakeNode) CreateInvoice(amount int64, description string) (string, string, error) {
+	hash := fmt.Sprintf("hash%v", len(n.network.invoices))
+	n.network.invoices[hash] = n
+	n.network.amounts[hash] = amount
+	return "lnbc" + hash, hash, nil
+}
+
+func (n *fakeNode) PayInvoice(paymentRequest string, amount int64) error {
+	hash := paymentRequest[len("lnbc"):]
+	payee, ok := n.network.invoices[hash]
+	if !ok {
+		return errors.New("unknown invoice")
+	}
+	if amount == 0 {
+		amount = n.network.amounts[hash]
+	}
+	if n.balance < amount {
+		return errors.New("insufficient balance")
+	}
+	n.balance -= amount
+	payee.balance += amount
+	n.payments = append(n.payments, &data.Payment{Type: data.Payment_SENT, Amount: amount, PaymentHash: hash})
+	payee.payments = append(payee.payments, &data.Payment{Type: data.Payment_RECEIVED, Amount: amount, PaymentHash: hash})
+	if payee.events != nil {
+		payee.events <- data.NotificationEvent{Type: data.NotificationEvent_INVOICE_PAID}
+	}
+	return nil
+}
+
+func (n *fakeNode) Balance() (int64, error) {
+	return n.balance, nil
+}
+
+func (n *fakeNode) Payments() ([]*data.Payment, error) {
+	return n.payments, nil
+}
+
+func (n *fakeNode) Events() <-chan data.NotificationEvent {
+	return n.events
+}
+
+func newFakeNodes() map[string]Node {
+	network := &fakeNetwork{invoices: make(map[string]*fakeNode), amounts: make(map[string]int64)}
+	return map[string]Node{
+		"breez": &fakeNode{network: network, events: make(chan data.NotificationEvent, 10)},
+		"payer": &fakeNode{network: network, balance: 5000},
+	}
+}
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
+
+func TestRunScenario(t *testing.T) {
+	s := &Scenario{Name: "receive", Steps: []Step{
+		{Action: actionCreateInvoice, Node: "breez", Amount: 1000, As: "inv"},
+		{Action: actionPay, Node: "payer", Invoice: "inv"},
+		{Action: actionWaitEvent, Node: "breez", Event: "INVOICE_PAID", TimeoutMs: 100},
+		{Action: actionAssertPayment, Node: "breez", Invoice: "inv", Type: "RECEIVED", Amount: 1000},
+		{Action: actionAssertBalance, Node: "payer", Balance: int64Ptr(4000)},
+	}}
+	report := Run(s, newFakeNodes())
+	if !report.Passed || len(report.Steps) != len(s.Steps) {
+		t.Fatalf("expected the scenario to pass, got %+v", report)
+	}
+}
+
+func TestRunScenarioStopsAtFailure(t *testing.T) {
+	s := &Scenario{Name: "overpay", Steps: []Step{
+		{Action: actionCreateInvoice, Node: "breez", Amount: 10000, As: "inv"},
+		{Action: actionPay, Node: "payer", Invoice: "inv"},
+		{Action: actionAssertBalance, Node: "breez", Balance: int64Ptr(10000)},
+	}}
+	report := Run(s, newFakeNodes())
+	if report.Passed {
+		t.Fatal("expected the scenario to fail")
+	}
+	if len(report.Steps) != 2 || report.Steps[1].Error != "insufficient balance" {
+		t.Fatalf("expected the run to stop at the failed payment, got %+v", report.Steps)
+	}
+}
+
+func TestRunScenarioUnknownInvoice(t *testing.T) {
+	s := &Scenario{Name: "unknown", Steps: []Step{
+		{Action: actionPay, Node: "payer", Invoice: "missing"},
+	}}
+	report := Run(s, newFakeNodes())
+	if report.Passed || report.Steps[0].Error != `unknown invoice "missing"` {
+		t.Fatalf("unexpected report %+v", report.Steps)
+	}
+}
@@ -0,0 +1,97 @@
+package breez
+
+import (
+	"context"
+	"errors"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
+	"github.com/breez/lightninglib/lnwallet"
+)
+
+const (
+	//sendMaxFeeRounds is the number of times the amount is lowered to fit the route fees
+	sendMaxFeeRounds = 3
+)
+
+// channelReserve is the balance that must stay in the channel, the same
+// reserve used for the receive and pay limits.
+func channelReserve(c *lnrpc.Channel) int64 {
+	reserve := c.Capacity / 100
+	if reserve < int64(lnwallet.DefaultDustLimit()) {
+		reserve = int64(lnwallet.DefaultDustLimit())
+	}
+	return reserve
+}
+
+// maxChannelPayment returns the largest amount a single active channel can send.
+// The local balance of the daemon already excludes the in-flight outgoing HTLCs.
+func maxChannelPayment() (int64, error) {
+	channels, err := lightningClient.ListChannels(context.Background(), &lnrpc.ListChannelsRequest{ActiveOnly: true})
+	if err != nil {
+		return 0, err
+	}
+	var max int64
+	for _, c := range channels.Channels {
+		if canPay := c.LocalBalance - channelReserve(c); canPay > max {
+			max = canPay
+		}
+	}
+	return max, nil
+}
+
+// routeFee returns the fee of the cheapest route paying amount to destination.
+func routeFee(destination string, amount int64) (int64, error) {
+	routes, err := lightningClient.QueryRoutes(context.Background(), &lnrpc.QueryRoutesRequest{PubKey: destination, Amt: amount, NumRoutes: 1})
+	if err != nil {
+		return 0, err
+	}
+	if len(routes.Routes) == 0 {
+		return 0, errors.New("no route to destination")
+	}
+	return routes.Routes[0].TotalFees, nil
+}
+
+/*
+ComputeSendMax returns the largest amount that can be paid to the payment request destination: the
+spendable balance of the best channel after its reserve and the in-flight payments, minus the fees
+of the probable route. It is meant for payment requests without an amount.
+*/
+func ComputeSendMax(paymentRequest string) (*data.SendMax, error) {
+	decodedReq, err := decodePayReqLocally(paymentRequest)
+	if err != nil {
+		return nil, err
+	}
+	if decodedReq.NumSatoshis > 0 {
+		return nil, errors.New("payment request has a fixed amount")
+	}
+	max, err := maxChannelPayment()
+	if err != nil {
+		return nil, err
+	}
+	spendable, err := GetSpendableBalance()
+	if err != nil {
+		return nil, err
+	}
+	if spendable < max {
+		max = spendable
+	}
+	if max <= 0 {
+		return &data.SendMax{}, nil
+	}
+
+	amount, fee := max, int64(0)
+	for i := 0; i < sendMaxFeeRounds; i++ {
+		if fee, err = routeFee(decodedReq.Destination, amount); err != nil {
+			return nil, err
+		}
+		if amount+fee <= max {
+			break
+		}
+		amount = max - fee
+	}
+	if amount+fee > max || amount <= 0 {
+		return &data.SendMax{}, nil
+	}
+	return &data.SendMax{Amount: amount, EstimatedFee: fee}, nil
+}
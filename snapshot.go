@@ -0,0 +1,78 @@
+package breez
+
+import (
+	"encoding/json"
+
+	"github.com/breez/breez/data"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	//paymentsSnapshotSize is the number of latest payments kept in the snapshot
+	paymentsSnapshotSize = 20
+)
+
+var paymentsSnapshotKey = []byte("snapshot")
+
+// paymentTitle is the line the history shows for the payment: the counterparty
+// name when it is known, the description otherwise.
+func paymentTitle(payment *paymentInfo) string {
+	switch {
+	case payment.Type == sentPayment && payment.PayeeName != "":
+		return payment.PayeeName
+	case payment.Type == receivedPayment && payment.PayerName != "":
+		return payment.PayerName
+	}
+	return payment.Description
+}
+
+// refreshPaymentsSnapshot rewrites the snapshot of the latest payments in the
+// transaction that changed them, so it is always consistent with the history.
+func refreshPaymentsSnapshot(tx *bolt.Tx) error {
+	paymentsB := tx.Bucket([]byte(paymentsBucket))
+	snapshot := &data.PaymentsSnapshot{UpdatedTimestamp: trustedNow().Unix()}
+	c := tx.Bucket([]byte(paymentsByTimeBucket)).Cursor()
+	for k, v := c.Last(); k != nil && len(snapshot.Payments) < paymentsSnapshotSize; k, v = c.Prev() {
+		payment, err := deserializePaymentInfo(paymentsB.Get(v))
+		if err != nil {
+			return err
+		}
+		snapshot.Payments = append(snapshot.Payments, &data.PaymentSummary{
+			PaymentHash:       payment.PaymentHash,
+			Type:              paymentInfoToProto(payment).Type,
+			Amount:            payment.Amount,
+			Title:             paymentTitle(payment),
+			CreationTimestamp: payment.CreationTimestamp,
+		})
+	}
+	snapshotBuf, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	if snapshotBuf, err = sealDBValue(snapshotBuf); err != nil {
+		return err
+	}
+	return tx.Bucket([]byte(paymentsSnapshotBucket)).Put(paymentsSnapshotKey, snapshotBuf)
+}
+
+/*
+GetRecentPaymentsSnapshot returns a summary of the latest payments, newest first, read from a single
+record that is updated with every change of the history. It only needs the database to be open so the
+app can show the history right away, before the daemon is ready and GetPayments can be called.
+Pending payments are not part of the snapshot.
+*/
+func GetRecentPaymentsSnapshot() (*data.PaymentsSnapshot, error) {
+	if db == nil {
+		return &data.PaymentsSnapshot{}, nil
+	}
+	snapshotBuf, err := fetchItem([]byte(paymentsSnapshotBucket), paymentsSnapshotKey)
+	if err != nil || snapshotBuf == nil {
+		return &data.PaymentsSnapshot{}, err
+	}
+	if snapshotBuf, err = openDBValue(snapshotBuf); err != nil {
+		return nil, err
+	}
+	var snapshot data.PaymentsSnapshot
+	err = json.Unmarshal(snapshotBuf, &snapshot)
+	return &snapshot, err
+}
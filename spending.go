@@ -0,0 +1,168 @@
+package breez
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
+)
+
+const (
+	spendingBudgetWindow = 24 * time.Hour
+)
+
+var (
+	authorizerMu      sync.Mutex
+	paymentAuthorizer PaymentAuthorizer
+
+	//ErrPaymentLimitExceeded is returned when a payment is over the maximum amount of the spending policy
+	ErrPaymentLimitExceeded = errors.New("payment amount exceeds the spending limit")
+
+	//ErrDailyBudgetExceeded is returned when a payment would take the last 24 hours spending over the daily budget
+	ErrDailyBudgetExceeded = errors.New("payment exceeds the daily spending budget")
+
+	//ErrPaymentNotAuthorized is returned when a payment above the authorization threshold isn't authorized
+	ErrPaymentNotAuthorized = errors.New("payment was not authorized")
+)
+
+/*
+PaymentAuthorizer is implemented by the host app to authorize the payments above the authorization
+threshold of the spending policy, e.g. by asking the user for a PIN or biometrics. AuthorizePayment
+blocks until the user decides and returns true to let the payment go through.
+*/
+type PaymentAuthorizer interface {
+	AuthorizePayment(request *data.PaymentAuthorizationRequest) bool
+}
+
+/*
+SetPaymentAuthorizer sets the authorizer asked before sending the payments above the authorization
+threshold. Without one such payments are refused. A nil authorizer removes the current one.
+*/
+func SetPaymentAuthorizer(a PaymentAuthorizer) {
+	authorizerMu.Lock()
+	defer authorizerMu.Unlock()
+	paymentAuthorizer = a
+}
+
+func currentPaymentAuthorizer() PaymentAuthorizer {
+	authorizerMu.Lock()
+	defer authorizerMu.Unlock()
+	return paymentAuthorizer
+}
+
+/*
+SetSpendingPolicy saves the limits applied to every lightning payment: the maximum amount of a single
+payment, the budget of the payments sent in the last 24 hours and the amount above which the payment
+authorizer is asked. A zero value disables the limit, a nil policy disables them all.
+*/
+func SetSpendingPolicy(policy *data.SpendingPolicy) error {
+	if policy == nil {
+		policy = &data.SpendingPolicy{}
+	}
+	if policy.MaxPaymentAmount < 0 || policy.DailyBudget < 0 || policy.AuthorizationThreshold < 0 {
+		return errors.New("spending limits can't be negative")
+	}
+	//the amount spent is reported by GetSpendingPolicy, not saved
+	policyBuf, err := json.Marshal(&data.SpendingPolicy{
+		MaxPaymentAmount:       policy.MaxPaymentAmount,
+		DailyBudget:            policy.DailyBudget,
+		AuthorizationThreshold: policy.AuthorizationThreshold,
+	})
+	if err != nil {
+		return err
+	}
+	return saveSpendingPolicy(policyBuf)
+}
+
+/*
+GetSpendingPolicy returns the spending policy together with the amount spent in the last 24 hours.
+*/
+func GetSpendingPolicy() (*data.SpendingPolicy, error) {
+	policy, err := loadSpendingPolicy()
+	if err != nil {
+		return nil, err
+	}
+	policy.SpentToday, err = recentSpending()
+	return policy, err
+}
+
+func loadSpendingPolicy() (*data.SpendingPolicy, error) {
+	policyBuf, err := fetchSpendingPolicy()
+	if err != nil || policyBuf == nil {
+		return &data.SpendingPolicy{}, err
+	}
+	var policy data.SpendingPolicy
+	if err := json.Unmarshal(policyBuf, &policy); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// recentSpending returns the amount sent, fees included, in the budget window.
+func recentSpending() (int64, error) {
+	filter := &paymentsFilter{
+		Types:         []paymentType{sentPayment},
+		FromTimestamp: trustedNow().Add(-spendingBudgetWindow).Unix(),
+	}
+	var spent int64
+	var before []byte
+	for {
+		payments, next, err := fetchPaymentsPage(before, paymentsScanPageSize, filter)
+		if err != nil {
+			return 0, err
+		}
+		for _, p := range payments {
+			spent += p.Amount + p.Fee
+		}
+		if next == nil {
+			return spent, nil
+		}
+		before = next
+	}
+}
+
+// checkSpendingPolicy applies the spending policy to a payment of amount and
+// asks the payment authorizer when the amount is above the threshold.
+func checkSpendingPolicy(ctx context.Context, decodedReq *lnrpc.PayReq, amount int64) error {
+	policy, err := loadSpendingPolicy()
+	if err != nil {
+		return err
+	}
+	if policy.MaxPaymentAmount > 0 && amount > policy.MaxPaymentAmount {
+		return ErrPaymentLimitExceeded
+	}
+	var spent int64
+	if policy.DailyBudget > 0 {
+		if spent, err = recentSpending(); err != nil {
+			return err
+		}
+		if spent+amount > policy.DailyBudget {
+			return ErrDailyBudgetExceeded
+		}
+	}
+	if policy.AuthorizationThreshold == 0 || amount < policy.AuthorizationThreshold {
+		return nil
+	}
+	authorizer := currentPaymentAuthorizer()
+	if authorizer == nil {
+		return ErrPaymentNotAuthorized
+	}
+	request := &data.PaymentAuthorizationRequest{
+		PaymentHash: decodedReq.PaymentHash,
+		Destination: decodedReq.Destination,
+		Description: decodedReq.Description,
+		Amount:      amount,
+		SpentToday:  spent,
+	}
+	if i, ok := ctx.Value(spendInitiatorKey{}).(spendInitiator); ok {
+		request.Initiator = i.initiator
+	}
+	if !authorizer.AuthorizePayment(request) {
+		return ErrPaymentNotAuthorized
+	}
+	return nil
+}
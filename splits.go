@@ -0,0 +1,161 @@
+package breez
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/breez/breez/data"
+)
+
+func serializePaymentSplit(s *data.PaymentSplit) ([]byte, error) {
+	splitBytes, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return sealDBValue(splitBytes)
+}
+
+func deserializePaymentSplit(splitBytes []byte) (*data.PaymentSplit, error) {
+	var split data.PaymentSplit
+	splitBytes, err := openDBValue(splitBytes)
+	if err != nil {
+		return &split, err
+	}
+	err = json.Unmarshal(splitBytes, &split)
+	return &split, err
+}
+
+/*
+AddSplitInvoice creates an invoice whose incoming payment is split between recipients.
+When it is settled every recipient share is forwarded automatically and recorded as a
+child payment of the received one. The rest stays in the wallet.
+*/
+func AddSplitInvoice(invoice *data.InvoiceMemo, recipients []*data.SplitRecipient) (string, error) {
+	var total int32
+	for _, r := range recipients {
+		if r.Percent <= 0 {
+			return "", fmt.Errorf("invalid share for recipient %v", r.Name)
+		}
+		if _, err := url.Parse(r.CallbackURL); err != nil || r.CallbackURL == "" {
+			return "", fmt.Errorf("invalid callback for recipient %v", r.Name)
+		}
+		total += r.Percent
+	}
+	if total > 100 {
+		return "", errors.New("recipients shares exceed 100%")
+	}
+
+	paymentRequest, err := AddInvoice(invoice)
+	if err != nil {
+		return "", err
+	}
+	decodedReq, err := decodePayReqLocally(paymentRequest)
+	if err != nil {
+		return "", err
+	}
+	err = savePaymentSplit(&data.PaymentSplit{PaymentHash: decodedReq.PaymentHash, Recipients: recipients})
+	if err != nil {
+		return "", err
+	}
+	return paymentRequest, nil
+}
+
+/*
+GetPaymentSplit returns the split of a received payment and the forwarded shares.
+*/
+func GetPaymentSplit(paymentHash string) (*data.PaymentSplit, error) {
+	split, err := fetchPaymentSplit(paymentHash)
+	if err != nil {
+		return nil, err
+	}
+	if split == nil {
+		return nil, fmt.Errorf("no split for payment %v", paymentHash)
+	}
+	return split, nil
+}
+
+// forwardSplitShares pays the recipients shares of a settled split invoice.
+func forwardSplitShares(paymentHash string, amount int64) {
+	split, err := fetchPaymentSplit(paymentHash)
+	if err != nil {
+		log.Errorf("forwardSplitShares - failed to fetch split %v", err)
+		return
+	}
+	if split == nil || len(split.Forwards) > 0 {
+		return
+	}
+	for _, r := range split.Recipients {
+		share := amount * int64(r.Percent) / 100
+		forward := &data.SplitForward{Recipient: r.Name, Amount: share}
+		if err := forwardSplitShare(paymentHash, r, forward); err != nil {
+			log.Errorf("forwardSplitShares - failed to forward %v to %v: %v", share, r.Name, err)
+			forward.ErrorMessage = err.Error()
+		}
+		split.Forwards = append(split.Forwards, forward)
+		if err := savePaymentSplit(split); err != nil {
+			log.Errorf("forwardSplitShares - failed to save split %v", err)
+		}
+	}
+}
+
+func forwardSplitShare(parentHash string, recipient *data.SplitRecipient, forward *data.SplitForward) error {
+	if forward.Amount <= 0 {
+		return errors.New("share is too small")
+	}
+	paymentRequest, err := fetchRecipientInvoice(recipient.CallbackURL, forward.Amount)
+	if err != nil {
+		return err
+	}
+	decodedReq, err := decodePayReqLocally(paymentRequest)
+	if err != nil {
+		return err
+	}
+	if decodedReq.NumSatoshis != forward.Amount {
+		return fmt.Errorf("recipient invoice amount %v doesn't match the share %v", decodedReq.NumSatoshis, forward.Amount)
+	}
+	forward.ChildPaymentHash = decodedReq.PaymentHash
+	if err := saveSplitChild(decodedReq.PaymentHash, parentHash); err != nil {
+		return err
+	}
+	ctx := withSpendInitiator(context.Background(), data.SpendAuditEntry_PLUGIN, "split:"+parentHash)
+	return sendPaymentForRequest(ctx, paymentRequest, 0, 0)
+}
+
+// fetchRecipientInvoice asks the recipient callback for an invoice of the given amount.
+// It follows the LNURL-pay callback convention: amount in millisatoshi and a {"pr": ...} reply.
+func fetchRecipientInvoice(callbackURL string, amount int64) (string, error) {
+	client, err := getHTTPClient()
+	if err != nil {
+		return "", err
+	}
+	u, err := url.Parse(callbackURL)
+	if err != nil {
+		return "", err
+	}
+	q := u.Query()
+	q.Set("amount", fmt.Sprintf("%v", amount*1000))
+	u.RawQuery = q.Encode()
+	resp, err := client.Get(u.String())
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	var reply struct {
+		PR     string `json:"pr"`
+		Status string `json:"status"`
+		Reason string `json:"reason"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&reply); err != nil {
+		return "", err
+	}
+	if reply.Status == "ERROR" {
+		return "", errors.New(reply.Reason)
+	}
+	if reply.PR == "" {
+		return "", errors.New("recipient didn't return an invoice")
+	}
+	return reply.PR, nil
+}
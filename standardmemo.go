@@ -0,0 +1,80 @@
+package breez
+
+import (
+	"strings"
+
+	"github.com/breez/breez/data"
+)
+
+const (
+	standardMemoSeparator = " | "
+
+	//standardMemoVersion is the last field of the escaped encoding, legacy memos have three fields
+	standardMemoVersion = "v1"
+)
+
+var standardMemoEscaper = strings.NewReplacer(`\`, `\\`, `|`, `\|`)
+
+// encodeStandardMemo encodes the breez metadata of a standard invoice so it stays
+// readable in other wallets: the description, payee name and payee image URL
+// with their pipes and backslashes escaped, followed by the encoding version.
+func encodeStandardMemo(invoice *data.InvoiceMemo) string {
+	return strings.Join([]string{
+		standardMemoEscaper.Replace(invoice.Description),
+		standardMemoEscaper.Replace(invoice.PayeeName),
+		standardMemoEscaper.Replace(invoice.PayeeImageURL),
+		standardMemoVersion,
+	}, standardMemoSeparator)
+}
+
+// splitStandardMemo splits the memo at the unescaped pipes and unescapes the fields.
+// Since the fields are joined with a space around the pipe, exactly one space is
+// trimmed from each side of the separator.
+func splitStandardMemo(memo string) []string {
+	var fields []string
+	var field strings.Builder
+	for i := 0; i < len(memo); i++ {
+		switch {
+		case memo[i] == '\\' && i+1 < len(memo):
+			i++
+			field.WriteByte(memo[i])
+		case memo[i] == '|':
+			fields = append(fields, field.String())
+			field.Reset()
+		default:
+			field.WriteByte(memo[i])
+		}
+	}
+	fields = append(fields, field.String())
+	for i := range fields {
+		if i > 0 {
+			fields[i] = strings.TrimPrefix(fields[i], " ")
+		}
+		if i < len(fields)-1 {
+			fields[i] = strings.TrimSuffix(fields[i], " ")
+		}
+	}
+	return fields
+}
+
+// decodeStandardMemo fills the invoice memo from a standard invoice description.
+// Legacy memos split at the three field separators are still decoded and any
+// other description is taken as is.
+func decodeStandardMemo(description string, invoiceMemo *data.InvoiceMemo) {
+	if strings.HasSuffix(description, standardMemoSeparator+standardMemoVersion) {
+		if fields := splitStandardMemo(description); len(fields) == 4 && fields[3] == standardMemoVersion {
+			invoiceMemo.Description = fields[0]
+			invoiceMemo.PayeeName = fields[1]
+			invoiceMemo.PayeeImageURL = fields[2]
+			return
+		}
+	}
+	if strings.Count(description, standardMemoSeparator) == 2 {
+		invoiceData := strings.Split(description, standardMemoSeparator)
+		invoiceMemo.Description = invoiceData[0]
+		invoiceMemo.PayeeName = invoiceData[1]
+		invoiceMemo.PayeeImageURL = invoiceData[2]
+		return
+	}
+	invoiceMemo.Description = description
+}
@@ -0,0 +1,36 @@
+package breez
+
+import (
+	"testing"
+
+	"github.com/breez/breez/data"
+)
+
+func TestStandardMemoEncoding(t *testing.T) {
+	memos := []*data.InvoiceMemo{
+		{Description: "coffee | cake", PayeeName: "Shop", PayeeImageURL: "https://shop.com/logo.png"},
+		{Description: `back\slash |`, PayeeName: "| Shop |"},
+		{Description: "trailing space ", PayeeImageURL: " leading space"},
+		{},
+	}
+	for _, memo := range memos {
+		decoded := &data.InvoiceMemo{}
+		decodeStandardMemo(encodeStandardMemo(memo), decoded)
+		if decoded.Description != memo.Description || decoded.PayeeName != memo.PayeeName || decoded.PayeeImageURL != memo.PayeeImageURL {
+			t.Errorf("decoded memo %+v, want %+v", decoded, memo)
+		}
+	}
+}
+
+func TestDecodeLegacyStandardMemo(t *testing.T) {
+	decoded := &data.InvoiceMemo{}
+	decodeStandardMemo("coffee | Shop | https://shop.com/logo.png", decoded)
+	if decoded.Description != "coffee" || decoded.PayeeName != "Shop" || decoded.PayeeImageURL != "https://shop.com/logo.png" {
+		t.Errorf("unexpected legacy memo %+v", decoded)
+	}
+	decoded = &data.InvoiceMemo{}
+	decodeStandardMemo("just a description", decoded)
+	if decoded.Description != "just a description" || decoded.PayeeName != "" {
+		t.Errorf("unexpected plain memo %+v", decoded)
+	}
+}
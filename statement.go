@@ -0,0 +1,113 @@
+package breez
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
+)
+
+const (
+	statementMonthLayout = "2006-01"
+)
+
+// balanceChange returns how the payment changed the lightning balance. Service
+// fees are already part of the payment they were charged for and refunds never
+// entered the lightning balance, so both leave it unchanged.
+func balanceChange(payment *paymentInfo) int64 {
+	switch payment.Type {
+	case receivedPayment, depositPayment:
+		return payment.Amount
+	case sentPayment, withdrawalPayment:
+		return -(payment.Amount + payment.Fee)
+	case channelClosePayment:
+		return -payment.Amount
+	}
+	return 0
+}
+
+/*
+GenerateStatement returns the account statement of a month given as YYYY-MM in UTC: the opening balance,
+every payment with the running balance after it, the totals, including the tax of the received payments,
+and the closing balance.
+The ledger is cross-checked against the daemon channel balance and any inconsistency is reported
+in the statement warnings.
+*/
+func GenerateStatement(month string) (*data.Statement, error) {
+	start, err := time.Parse(statementMonthLayout, month)
+	if err != nil {
+		return nil, fmt.Errorf("invalid month %v, expected YYYY-MM", month)
+	}
+	end := start.AddDate(0, 1, 0)
+	payments, err := fetchAllAccountPayments()
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(payments, func(i, j int) bool {
+		return payments[i].CreationTimestamp < payments[j].CreationTimestamp
+	})
+
+	statement := &data.Statement{Month: month, FromTimestamp: start.Unix(), ToTimestamp: end.Unix()}
+	var balance int64
+	for _, payment := range payments {
+		change := balanceChange(payment)
+		balance += change
+		if payment.CreationTimestamp < statement.FromTimestamp {
+			statement.OpeningBalance = balance
+			continue
+		}
+		if payment.CreationTimestamp >= statement.ToTimestamp {
+			continue
+		}
+		if change > 0 {
+			statement.TotalCredits += change
+		} else {
+			statement.TotalDebits -= change
+		}
+		statement.TotalFees += paymentFee(payment)
+		if payment.Type == serviceFeePayment {
+			statement.TotalFees += payment.Amount
+		}
+		statement.TotalTax += payment.TaxAmount
+		statement.Items = append(statement.Items, &data.StatementItem{
+			Payment:        paymentInfoToProto(payment),
+			BalanceChange:  change,
+			RunningBalance: balance,
+		})
+		statement.ClosingBalance = balance
+		if balance < 0 {
+			statement.Warnings = append(statement.Warnings, fmt.Sprintf("balance is negative after payment %v", payment.PaymentHash))
+		}
+	}
+	if len(statement.Items) == 0 {
+		statement.ClosingBalance = statement.OpeningBalance
+	}
+	if statement.ClosingBalance != statement.OpeningBalance+statement.TotalCredits-statement.TotalDebits {
+		statement.Warnings = append(statement.Warnings, "closing balance doesn't match the opening balance and the totals")
+	}
+	reconcileStatement(statement, balance)
+	return statement, nil
+}
+
+// reconcileStatement compares the balance of the whole ledger with the daemon
+// channel balance.
+func reconcileStatement(statement *data.Statement, ledgerBalance int64) {
+	if !DaemonReady() {
+		statement.Warnings = append(statement.Warnings, "lightning daemon is not ready, the ledger was not reconciled")
+		return
+	}
+	channelBalance, err := lightningClient.ChannelBalance(context.Background(), &lnrpc.ChannelBalanceRequest{})
+	if err != nil {
+		statement.Warnings = append(statement.Warnings, fmt.Sprintf("failed to get the channel balance: %v", err))
+		return
+	}
+	statement.Discrepancy = channelBalance.Balance - ledgerBalance
+	statement.Reconciled = statement.Discrepancy == 0
+	if !statement.Reconciled {
+		statement.Warnings = append(statement.Warnings,
+			fmt.Sprintf("ledger balance %v differs from the channel balance %v, pending payments are not included", ledgerBalance, channelBalance.Balance))
+	}
+}
@@ -0,0 +1,97 @@
+package breez
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/breez/breez/data"
+)
+
+const (
+	subscriptionBufferSize = 32
+)
+
+var (
+	subscriptionsMu sync.Mutex
+	subscriptions   = make(map[*NotificationSubscription]bool)
+)
+
+/*
+NotificationSubscription receives the notifications of the types it subscribed to on C, independently of
+the notifications channel returned by Start and of the other subscriptions. C is buffered, when the
+subscriber doesn't keep up the oldest notification in the buffer is dropped to make room for the new one.
+*/
+type NotificationSubscription struct {
+	C <-chan data.NotificationEvent
+
+	ch      chan data.NotificationEvent
+	types   map[data.NotificationEvent_NotificationType]bool
+	dropped uint64
+}
+
+/*
+SubscribeNotifications returns a subscription to the notifications of the given types, of all types when
+none is given. Unsubscribe must be called once the notifications are not needed anymore.
+*/
+func SubscribeNotifications(types ...data.NotificationEvent_NotificationType) *NotificationSubscription {
+	ch := make(chan data.NotificationEvent, subscriptionBufferSize)
+	s := &NotificationSubscription{C: ch, ch: ch}
+	if len(types) > 0 {
+		s.types = make(map[data.NotificationEvent_NotificationType]bool)
+		for _, t := range types {
+			s.types[t] = true
+		}
+	}
+	subscriptionsMu.Lock()
+	defer subscriptionsMu.Unlock()
+	subscriptions[s] = true
+	return s
+}
+
+/*
+Unsubscribe stops the notifications and closes C.
+*/
+func (s *NotificationSubscription) Unsubscribe() {
+	subscriptionsMu.Lock()
+	defer subscriptionsMu.Unlock()
+	if subscriptions[s] {
+		delete(subscriptions, s)
+		close(s.ch)
+	}
+}
+
+/*
+Dropped returns the number of notifications dropped since the subscriber didn't keep up.
+*/
+func (s *NotificationSubscription) Dropped() uint64 {
+	return atomic.LoadUint64(&s.dropped)
+}
+
+// send delivers the event without blocking, dropping the oldest buffered one
+// when the buffer is full. It is called with subscriptionsMu held.
+func (s *NotificationSubscription) send(event data.NotificationEvent) {
+	if s.types != nil && !s.types[event.Type] {
+		return
+	}
+	for {
+		select {
+		case s.ch <- event:
+			return
+		default:
+		}
+		select {
+		case <-s.ch:
+			atomic.AddUint64(&s.dropped, 1)
+		default:
+		}
+	}
+}
+
+// publishNotification delivers the event to the subscriptions.
+func publishNotification(event data.NotificationEvent) {
+	subscriptionsMu.Lock()
+	defer subscriptionsMu.Unlock()
+	for s := range subscriptions {
+		s.send(event)
+	}
+}
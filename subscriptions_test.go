@@ -0,0 +1,45 @@
+package breez
+
+import (
+	"testing"
+
+	"github.com/breez/breez/data"
+)
+
+func TestSubscribeNotifications(t *testing.T) {
+	paid := SubscribeNotifications(data.NotificationEvent_INVOICE_PAID)
+	defer paid.Unsubscribe()
+	all := SubscribeNotifications()
+
+	publishNotification(data.NotificationEvent{Type: data.NotificationEvent_READY})
+	publishNotification(data.NotificationEvent{Type: data.NotificationEvent_INVOICE_PAID, Data: []string{"h1"}})
+	if e := <-paid.C; e.Type != data.NotificationEvent_INVOICE_PAID || e.Data[0] != "h1" {
+		t.Errorf("expected the paid invoice, got %v", e)
+	}
+	if len(paid.C) != 0 {
+		t.Errorf("expected only the subscribed types")
+	}
+	if len(all.C) != 2 {
+		t.Errorf("expected all the notifications, got %v", len(all.C))
+	}
+
+	all.Unsubscribe()
+	if _, ok := <-all.C; !ok {
+		t.Errorf("expected the buffered notifications after unsubscribing")
+	}
+	all.Unsubscribe()
+}
+
+func TestSlowSubscriber(t *testing.T) {
+	s := SubscribeNotifications()
+	defer s.Unsubscribe()
+	for i := 0; i < subscriptionBufferSize+3; i++ {
+		publishNotification(data.NotificationEvent{Type: data.NotificationEvent_ACCOUNT_CHANGED, Id: uint64(i)})
+	}
+	if s.Dropped() != 3 {
+		t.Errorf("expected 3 dropped notifications, got %v", s.Dropped())
+	}
+	if e := <-s.C; e.Id != 3 {
+		t.Errorf("expected the oldest notifications to be dropped, got %v", e.Id)
+	}
+}
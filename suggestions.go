@@ -0,0 +1,90 @@
+package breez
+
+import (
+	"sort"
+
+	"github.com/breez/breez/data"
+)
+
+const (
+	defaultSuggestedAmounts = 4
+
+	//suggestionsScanLimit is the number of latest payments the suggestions are computed from
+	suggestionsScanLimit = 500
+)
+
+// suggestAmounts returns the most frequent amounts of the payments, given newest
+// first. Amounts used as often are ordered by how recently they were used.
+func suggestAmounts(payments []*paymentInfo, limit int) []int64 {
+	counts := make(map[int64]int)
+	var amounts []int64
+	for _, p := range payments {
+		if p.Amount <= 0 {
+			continue
+		}
+		if counts[p.Amount] == 0 {
+			amounts = append(amounts, p.Amount)
+		}
+		counts[p.Amount]++
+	}
+	sort.SliceStable(amounts, func(i, j int) bool {
+		return counts[amounts[i]] > counts[amounts[j]]
+	})
+	if len(amounts) > limit {
+		amounts = amounts[:limit]
+	}
+	return amounts
+}
+
+// suggestionsCounterparty returns true if the payment was made with the counterparty:
+// the payer of received payments, the payee name or destination of sent ones.
+func suggestionsCounterparty(p *paymentInfo, counterparty string) bool {
+	if p.Type == receivedPayment {
+		return p.PayerName == counterparty
+	}
+	return p.PayeeName == counterparty || p.Destination == counterparty
+}
+
+/*
+GetSuggestedAmounts returns quick amounts for the send or request screen computed from the latest payments:
+the amounts used most often with the request counterparty, or with anyone if there are no payments with it,
+and the amount of the last of these payments.
+*/
+func GetSuggestedAmounts(request *data.SuggestedAmountsRequest) (*data.SuggestedAmounts, error) {
+	limit := int(request.Limit)
+	if limit <= 0 {
+		limit = defaultSuggestedAmounts
+	}
+	filter := &paymentsFilter{Types: []paymentType{sentPayment}}
+	if request.Screen == data.SuggestedAmountsRequest_REQUEST {
+		filter.Types = []paymentType{receivedPayment}
+	}
+
+	var all, matching []*paymentInfo
+	var before []byte
+	for len(all) < suggestionsScanLimit {
+		payments, next, err := fetchPaymentsPage(before, suggestionsScanLimit-len(all), filter)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range payments {
+			all = append(all, p)
+			if request.Counterparty != "" && suggestionsCounterparty(p, request.Counterparty) {
+				matching = append(matching, p)
+			}
+		}
+		if next == nil {
+			break
+		}
+		before = next
+	}
+
+	if len(matching) == 0 {
+		matching = all
+	}
+	result := &data.SuggestedAmounts{Amounts: suggestAmounts(matching, limit)}
+	if len(matching) > 0 {
+		result.LastAmount = matching[0].Amount
+	}
+	return result, nil
+}
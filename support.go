@@ -0,0 +1,209 @@
+package breez
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/breez/breez/data"
+	"github.com/breez/lightninglib/lnrpc"
+)
+
+const (
+	redacted = "[redacted]"
+)
+
+var (
+	paymentRequestPattern = regexp.MustCompile(`(?i)ln(bc|tb|bcrt|sb)[0-9a-z]{20,}`)
+)
+
+// supportDiagnostics holds the node state included in a support bundle.
+type supportDiagnostics struct {
+	Network          string
+	BlockHeight      uint32
+	SyncedToChain    bool
+	NumActiveChannel uint32
+	NumPeers         uint32
+	AccountStatus    string
+	Balance          int64
+	WalletBalance    int64
+	DaemonReady      bool
+	HubPolicy        *data.HubPolicy
+	Maintenance      *data.MaintenanceReport
+}
+
+// redactPayment returns a copy of the payment with the fields hidden by the redaction level.
+// Hashes and amounts are always kept so payments can still be matched.
+func redactPayment(payment *paymentInfo, level data.RedactionLevel) *paymentInfo {
+	p := *payment
+	if p.Description != "" {
+		p.Description = redacted
+	}
+	if level >= data.RedactionLevel_STANDARD {
+		for _, field := range []*string{&p.PayeeName, &p.PayeeImageURL, &p.PayerName, &p.PayerImageURL, &p.FundingCounterparty} {
+			if *field != "" {
+				*field = redacted
+			}
+		}
+	}
+	if level >= data.RedactionLevel_STRICT {
+		for _, field := range []*string{&p.Destination, &p.RedeemTxID, &p.FeeRecipient, &p.FundingOrderID} {
+			if *field != "" {
+				*field = redacted
+			}
+		}
+		if len(p.FundingTxIDs) > 0 {
+			p.FundingTxIDs = []string{redacted}
+		}
+	}
+	return &p
+}
+
+// redactLog hides payment requests, which embed the memo and payee, from log lines.
+func redactLog(logBytes []byte) []byte {
+	return paymentRequestPattern.ReplaceAll(logBytes, []byte(redacted))
+}
+
+// redactConfig returns the config without secrets: header values, proxy credentials and the gRPC token.
+func redactConfig(c *Config) *Config {
+	if c == nil {
+		return nil
+	}
+	redactedConfig := *c
+	if c.HTTPHeaders != nil {
+		redactedConfig.HTTPHeaders = make(map[string]string)
+		for k := range c.HTTPHeaders {
+			redactedConfig.HTTPHeaders[k] = redacted
+		}
+	}
+	if proxyURL, err := url.Parse(c.HTTPProxy); err == nil && proxyURL.User != nil {
+		proxyURL.User = url.User(redacted)
+		redactedConfig.HTTPProxy = proxyURL.String()
+	}
+	if c.GRPCToken != "" {
+		redactedConfig.GRPCToken = redacted
+	}
+	return &redactedConfig
+}
+
+func supportDiagnosticsInfo() *supportDiagnostics {
+	diagnostics := &supportDiagnostics{DaemonReady: DaemonReady()}
+	if policy, err := fetchHubPolicy(); err == nil {
+		diagnostics.HubPolicy = policy
+	}
+	if report, err := fetchMaintenanceReport(); err == nil {
+		diagnostics.Maintenance = report
+	}
+	if cfg != nil {
+		diagnostics.Network = cfg.Network
+	}
+	if !diagnostics.DaemonReady {
+		return diagnostics
+	}
+	if info, err := lightningClient.GetInfo(context.Background(), &lnrpc.GetInfoRequest{}); err == nil {
+		diagnostics.BlockHeight = info.BlockHeight
+		diagnostics.SyncedToChain = info.SyncedToChain
+		diagnostics.NumActiveChannel = info.NumActiveChannels
+		diagnostics.NumPeers = info.NumPeers
+	}
+	if acc, err := calculateAccount(); err == nil {
+		diagnostics.AccountStatus = acc.Status.String()
+		diagnostics.Balance = acc.Balance
+		diagnostics.WalletBalance = acc.WalletBalance
+	}
+	return diagnostics
+}
+
+// addBundleFile adds a file with a fixed modification time so the same content
+// always produces the same archive.
+func addBundleFile(w *zip.Writer, name string, content []byte) error {
+	f, err := w.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: time.Unix(0, 0).UTC()})
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(content)
+	return err
+}
+
+func buildSupportBundle(level data.RedactionLevel) ([]byte, error) {
+	payments, err := fetchAllAccountPayments()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(payments, func(i, j int) bool {
+		if payments[i].CreationTimestamp != payments[j].CreationTimestamp {
+			return payments[i].CreationTimestamp < payments[j].CreationTimestamp
+		}
+		return payments[i].PaymentHash < payments[j].PaymentHash
+	})
+	redactedPayments := make([]*paymentInfo, 0, len(payments))
+	for _, p := range payments {
+		redactedPayments = append(redactedPayments, redactPayment(p, level))
+	}
+
+	files := make(map[string]interface{})
+	files["payments.json"] = redactedPayments
+	files["diagnostics.json"] = supportDiagnosticsInfo()
+	files["config.json"] = redactConfig(cfg)
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, name := range []string{"config.json", "diagnostics.json", "payments.json"} {
+		content, err := json.MarshalIndent(files[name], "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		if err := addBundleFile(w, name, content); err != nil {
+			return nil, err
+		}
+	}
+	if level < data.RedactionLevel_STRICT && cfg != nil {
+		if err := addLogFiles(w); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+/*
+CreateSupportBundle writes a sanitized snapshot of the payments, logs, diagnostics and config
+to a single encrypted file that can be attached to a support ticket. The redaction level decides
+which payment details are hidden, memos are always redacted and hashes always kept.
+It returns the file path and the key to share with support separately.
+*/
+func CreateSupportBundle(level data.RedactionLevel) (*data.SupportBundle, error) {
+	bundle, err := buildSupportBundle(level)
+	if err != nil {
+		return nil, err
+	}
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	encrypted, err := encryptWithKey(key, bundle)
+	if err != nil {
+		return nil, err
+	}
+	dir, err := ioutil.TempDir("", "support")
+	if err != nil {
+		return nil, err
+	}
+	bundlePath := filepath.Join(dir, "breez-support.bin")
+	if err := ioutil.WriteFile(bundlePath, encrypted, 0600); err != nil {
+		return nil, err
+	}
+	log.Infof("CreateSupportBundle - created support bundle %v", bundlePath)
+	return &data.SupportBundle{Path: bundlePath, Key: hex.EncodeToString(key)}, nil
+}
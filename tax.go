@@ -0,0 +1,87 @@
+package breez
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+
+	"github.com/breez/breez/data"
+	"github.com/golang/protobuf/proto"
+)
+
+// validateTaxInfo checks the rate is a percentage and the tax fits in the amount.
+func validateTaxInfo(tax *data.TaxInfo, amount int64) error {
+	if tax.VatRate < 0 || tax.VatRate >= 100 {
+		return errors.New("VAT rate must be a percentage between 0 and 100")
+	}
+	if tax.TaxAmount < 0 || (amount > 0 && tax.TaxAmount > amount) {
+		return errors.New("tax amount must be between 0 and the invoice amount")
+	}
+	return nil
+}
+
+// includedTax returns the tax included in a gross amount at the given VAT rate.
+func includedTax(amount int64, vatRate float64) int64 {
+	return int64(math.Round(float64(amount) * vatRate / (100 + vatRate)))
+}
+
+// applyTaxTemplate fills the invoice tax with the saved template when the caller
+// didn't set one and computes the included tax when only the rate is known.
+func applyTaxTemplate(invoice *data.InvoiceMemo) error {
+	if invoice.Tax == nil {
+		template, err := loadTaxTemplate()
+		if err != nil {
+			return err
+		}
+		if template == nil {
+			return nil
+		}
+		invoice.Tax = proto.Clone(template).(*data.TaxInfo)
+		invoice.Tax.TaxAmount = 0
+	}
+	if invoice.Tax.TaxAmount == 0 && invoice.Tax.VatRate > 0 {
+		invoice.Tax.TaxAmount = includedTax(invoice.Amount, invoice.Tax.VatRate)
+	}
+	return validateTaxInfo(invoice.Tax, invoice.Amount)
+}
+
+/*
+SetTaxTemplate saves the tax metadata added to the invoices created without their own: the merchant tax ID
+and the VAT rate used to compute the tax included in the invoice amount. The tax is part of the invoice memo
+so the payer sees it on the receipt, and it is kept with the received payment for exports and statements.
+A nil or empty template stops adding tax metadata.
+*/
+func SetTaxTemplate(template *data.TaxInfo) error {
+	if template == nil || (template.VatRate == 0 && template.MerchantTaxID == "") {
+		return saveTaxTemplate(nil)
+	}
+	if err := validateTaxInfo(template, 0); err != nil {
+		return err
+	}
+	templateBuf, err := json.Marshal(template)
+	if err != nil {
+		return err
+	}
+	return saveTaxTemplate(templateBuf)
+}
+
+/*
+GetTaxTemplate returns the tax metadata added to new invoices, empty if none is set.
+*/
+func GetTaxTemplate() (*data.TaxInfo, error) {
+	template, err := loadTaxTemplate()
+	if err != nil || template == nil {
+		return &data.TaxInfo{}, err
+	}
+	return template, nil
+}
+
+func loadTaxTemplate() (*data.TaxInfo, error) {
+	templateBuf, err := fetchTaxTemplate()
+	if err != nil || templateBuf == nil {
+		return nil, err
+	}
+	var template data.TaxInfo
+	err = json.Unmarshal(templateBuf, &template)
+	return &template, err
+}
@@ -0,0 +1,137 @@
+package breez
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+
+	"github.com/breez/breez/data"
+)
+
+// deviceProofMessage is what the shared identity key authenticates: the node
+// receiving the transfer, the amount and the device that requested it.
+func deviceProofMessage(destination string, amount int64, deviceName string) []byte {
+	return []byte(destination + "|" + strconv.FormatInt(amount, 10) + "|" + deviceName)
+}
+
+func deviceProof(identityKey []byte, destination string, amount int64, deviceName string) string {
+	mac := hmac.New(sha256.New, identityKey)
+	mac.Write(deviceProofMessage(destination, amount, deviceName))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// signTransferRequest proves the transfer request comes from a device sharing
+// our identity key. Wrapped invoices are paid to the routing node so the proof
+// can't be bound to our node and they are left unsigned.
+func signTransferRequest(invoice *data.InvoiceMemo) error {
+	invoice.DeviceProof = ""
+	invoice.OwnDevice = false
+	if !invoice.TransferRequest || invoice.DeviceName == "" || !canReceiveLocally() {
+		return nil
+	}
+	identityKey, err := fetchDeviceIdentityKey()
+	if err != nil || identityKey == nil {
+		return err
+	}
+	acc, err := GetAccountInfo()
+	if err != nil {
+		return err
+	}
+	invoice.DeviceProof = deviceProof(identityKey, acc.Id, invoice.Amount, invoice.DeviceName)
+	return nil
+}
+
+// verifyDeviceProof returns true if the transfer request was created by a device
+// sharing our identity key for the invoice destination and amount.
+func verifyDeviceProof(memo *data.InvoiceMemo, destination string, amount int64) bool {
+	if !memo.TransferRequest || memo.DeviceProof == "" {
+		return false
+	}
+	identityKey, err := fetchDeviceIdentityKey()
+	if err != nil || identityKey == nil {
+		return false
+	}
+	proof, err := hex.DecodeString(memo.DeviceProof)
+	if err != nil {
+		return false
+	}
+	expected, _ := hex.DecodeString(deviceProof(identityKey, destination, amount, memo.DeviceName))
+	return hmac.Equal(proof, expected)
+}
+
+/*
+GetDeviceIdentityKey returns the identity key shared by the user devices, generating it on first use.
+It is meant to be passed to the other devices during pairing and set there with SetDeviceIdentityKey.
+*/
+func GetDeviceIdentityKey() (string, error) {
+	identityKey, err := fetchDeviceIdentityKey()
+	if err != nil {
+		return "", err
+	}
+	if identityKey == nil {
+		identityKey = make([]byte, 32)
+		if _, err := rand.Read(identityKey); err != nil {
+			return "", err
+		}
+		if err := saveDeviceIdentityKey(identityKey); err != nil {
+			return "", err
+		}
+	}
+	return hex.EncodeToString(identityKey), nil
+}
+
+/*
+SetDeviceIdentityKey sets the identity key received from another device of the user so transfer
+requests between them prove they share it.
+*/
+func SetDeviceIdentityKey(identityKey string) error {
+	if err := validateHash(identityKey, "identity key"); err != nil {
+		return err
+	}
+	key, _ := hex.DecodeString(identityKey)
+	return saveDeviceIdentityKey(key)
+}
+
+/*
+SetTransferAutoAccept enables paying transfer requests proven to come from the user devices
+without asking for confirmation, see AutoAcceptTransfer.
+*/
+func SetTransferAutoAccept(enabled bool) error {
+	return saveTransferAutoAccept(enabled)
+}
+
+/*
+AutoAcceptTransfer pays the payment request right away if auto-accept is enabled and it is a transfer
+request created by a device sharing our identity key. It returns false when the payment needs the user
+confirmation. Both devices keep the requesting device name with the payment, the receiving one records
+it as a deposit.
+*/
+func AutoAcceptTransfer(paymentRequest string) (bool, error) {
+	enabled, err := fetchTransferAutoAccept()
+	if err != nil || !enabled {
+		return false, err
+	}
+	invoiceMemo, err := DecodePaymentRequest(paymentRequest)
+	if err != nil {
+		return false, err
+	}
+	if !invoiceMemo.OwnDevice {
+		return false, nil
+	}
+	decodedReq, err := decodePayReqLocally(paymentRequest)
+	if err != nil {
+		return false, err
+	}
+	if decodedReq.NumSatoshis == 0 {
+		return false, errors.New("transfer request has no amount")
+	}
+	log.Infof("AutoAcceptTransfer - paying transfer request of device %v", invoiceMemo.DeviceName)
+	if err := sendPaymentForRequest(context.Background(), paymentRequest, 0, 0); err != nil {
+		return false, err
+	}
+	return true, nil
+}
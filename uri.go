@@ -0,0 +1,108 @@
+package breez
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/breez/breez/data"
+	"github.com/btcsuite/btcutil"
+)
+
+// trimScheme returns the URI without the scheme if it has it, in any case.
+func trimScheme(uri, scheme string) (string, bool) {
+	if len(uri) > len(scheme) && strings.EqualFold(uri[:len(scheme)], scheme) {
+		return strings.TrimPrefix(uri[len(scheme):], "//"), true
+	}
+	return uri, false
+}
+
+// parseBIP21 parses the address and the parameters of a bitcoin URI. Unknown
+// required parameters are rejected as the BIP requires.
+func parseBIP21(uri string, result *data.PaymentURI) error {
+	address, query := uri, ""
+	if i := strings.Index(uri, "?"); i >= 0 {
+		address, query = uri[:i], uri[i+1:]
+	}
+	params, err := url.ParseQuery(query)
+	if err != nil {
+		return fmt.Errorf("invalid bitcoin URI parameters: %v", err)
+	}
+	if address != "" {
+		if err := ValidateAddress(address); err != nil {
+			return err
+		}
+		result.Address = address
+	}
+	for key, values := range params {
+		value := values[0]
+		switch strings.ToLower(key) {
+		case "amount":
+			btc, err := strconv.ParseFloat(value, 64)
+			if err != nil || btc < 0 {
+				return fmt.Errorf("invalid amount %v", value)
+			}
+			amount, err := btcutil.NewAmount(btc)
+			if err != nil {
+				return err
+			}
+			result.Amount = int64(amount)
+		case "label":
+			result.Label = value
+		case "message":
+			result.Message = value
+		case "lightning":
+			result.PaymentRequest = value
+		default:
+			if strings.HasPrefix(strings.ToLower(key), "req-") {
+				return fmt.Errorf("unsupported required parameter %v", key)
+			}
+		}
+	}
+	if result.Address == "" && result.PaymentRequest == "" {
+		return errors.New("bitcoin URI has no address")
+	}
+	return nil
+}
+
+/*
+ParsePaymentURI parses what a payment QR code may hold: a "lightning:" URI, a BIP21 "bitcoin:" URI,
+including unified QR codes with a lightning parameter, a bare payment request or a bare address.
+The result has the on-chain address, the BIP21 amount in satoshi, label and message, and the decoded
+payment request if there is one. The payment request fallback address is used when the URI has no address.
+*/
+func ParsePaymentURI(uri string) (*data.PaymentURI, error) {
+	uri = strings.TrimSpace(uri)
+	result := &data.PaymentURI{}
+	if rest, ok := trimScheme(uri, "lightning:"); ok {
+		result.PaymentRequest = rest
+	} else if rest, ok := trimScheme(uri, "bitcoin:"); ok {
+		if err := parseBIP21(rest, result); err != nil {
+			return nil, err
+		}
+	} else if ValidateAddress(uri) == nil {
+		result.Address = uri
+		return result, nil
+	} else {
+		result.PaymentRequest = uri
+	}
+
+	if result.PaymentRequest == "" {
+		return result, nil
+	}
+	invoiceMemo, err := DecodePaymentRequest(result.PaymentRequest)
+	if err != nil {
+		return nil, err
+	}
+	result.InvoiceMemo = invoiceMemo
+	if result.Address == "" {
+		decodedReq, err := decodePayReqLocally(result.PaymentRequest)
+		if err != nil {
+			return nil, err
+		}
+		result.Address = decodedReq.FallbackAddr
+	}
+	return result, nil
+}
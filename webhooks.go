@@ -0,0 +1,296 @@
+package breez
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/breez/breez/data"
+)
+
+const (
+	webhookSecretSize      = 32
+	webhookSignatureHeader = "X-Breez-Signature"
+	webhooksInterval       = 30 * time.Second
+)
+
+var (
+	webhooksSignal = make(chan struct{}, 1)
+
+	webhookRetryPolicy = retryPolicy{
+		maxAttempts:    8,
+		initialBackoff: 30 * time.Second,
+		maxBackoff:     time.Hour,
+	}
+)
+
+// webhookDelivery is a signed payload waiting to be posted, or already posted,
+// to a webhook for a received payment.
+type webhookDelivery struct {
+	ID                   uint64
+	WebhookID            uint64
+	URL                  string
+	PaymentHash          string
+	Body                 []byte
+	Signature            string
+	Status               data.WebhookDelivery_Status
+	Attempts             int32
+	LastAttemptTimestamp int64
+	NextAttemptTimestamp int64
+	LastError            string
+}
+
+func serializeWebhook(w *data.Webhook) ([]byte, error) {
+	webhookBytes, err := json.Marshal(w)
+	if err != nil {
+		return nil, err
+	}
+	return sealDBValue(webhookBytes)
+}
+
+func deserializeWebhook(webhookBytes []byte) (*data.Webhook, error) {
+	var w data.Webhook
+	webhookBytes, err := openDBValue(webhookBytes)
+	if err != nil {
+		return &w, err
+	}
+	err = json.Unmarshal(webhookBytes, &w)
+	return &w, err
+}
+
+func serializeWebhookDelivery(d *webhookDelivery) ([]byte, error) {
+	deliveryBytes, err := json.Marshal(d)
+	if err != nil {
+		return nil, err
+	}
+	return sealDBValue(deliveryBytes)
+}
+
+func deserializeWebhookDelivery(deliveryBytes []byte) (*webhookDelivery, error) {
+	var d webhookDelivery
+	deliveryBytes, err := openDBValue(deliveryBytes)
+	if err != nil {
+		return &d, err
+	}
+	err = json.Unmarshal(deliveryBytes, &d)
+	return &d, err
+}
+
+func (d *webhookDelivery) toProto() *data.WebhookDelivery {
+	return &data.WebhookDelivery{
+		Id:                   d.ID,
+		WebhookID:            d.WebhookID,
+		Url:                  d.URL,
+		PaymentHash:          d.PaymentHash,
+		Status:               d.Status,
+		Attempts:             d.Attempts,
+		LastAttemptTimestamp: d.LastAttemptTimestamp,
+		NextAttemptTimestamp: d.NextAttemptTimestamp,
+		LastError:            d.LastError,
+	}
+}
+
+func webhookSignature(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+/*
+AddWebhook registers an HTTPS URL called when a payment is received, for the invoice with the given payment
+hash only or, when it is empty, for every invoice. The payment is posted as JSON with the HMAC-SHA256 of the
+body keyed with the returned webhook secret, hex encoded in the X-Breez-Signature header, so the merchant
+can verify it. Failed deliveries are retried with a backoff and their status is returned by
+GetWebhookDeliveries. A webhook of a single invoice is removed once the invoice is paid. The URL host must be
+one of the allowed hosts when the configuration restricts them.
+*/
+func AddWebhook(webhookURL, paymentHash string) (*data.Webhook, error) {
+	u, err := url.Parse(webhookURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return nil, errors.New("webhook url must be an https url")
+	}
+	if cfg != nil && !isHostAllowed(u.Hostname(), cfg.HTTPAllowedHosts) {
+		return nil, fmt.Errorf("webhook host %v is not allowed", u.Hostname())
+	}
+	secret := make([]byte, webhookSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	webhook := &data.Webhook{
+		Url:               webhookURL,
+		PaymentHash:       paymentHash,
+		Secret:            hex.EncodeToString(secret),
+		CreationTimestamp: trustedNow().Unix(),
+	}
+	if err := addWebhook(webhook); err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+/*
+RemoveWebhook removes the webhook with the given id. Its pending deliveries are still attempted.
+*/
+func RemoveWebhook(id uint64) error {
+	return deleteWebhook(id)
+}
+
+/*
+GetWebhooks returns the registered webhooks.
+*/
+func GetWebhooks() (*data.Webhooks, error) {
+	webhooks, err := fetchWebhooks()
+	if err != nil {
+		return nil, err
+	}
+	return &data.Webhooks{Webhooks: webhooks}, nil
+}
+
+/*
+GetWebhookDeliveries returns the deliveries of the payment with the given hash, of all the payments
+when it is empty, oldest first.
+*/
+func GetWebhookDeliveries(paymentHash string) (*data.WebhookDeliveries, error) {
+	deliveries, err := fetchWebhookDeliveries()
+	if err != nil {
+		return nil, err
+	}
+	result := &data.WebhookDeliveries{}
+	for _, d := range deliveries {
+		if paymentHash == "" || d.PaymentHash == paymentHash {
+			result.Deliveries = append(result.Deliveries, d.toProto())
+		}
+	}
+	return result, nil
+}
+
+// queueWebhookDeliveries signs the received payment for the matching webhooks
+// and queues the deliveries, the webhooks of the invoice are then removed.
+func queueWebhookDeliveries(payment *paymentInfo) {
+	webhooks, err := fetchWebhooks()
+	if err != nil {
+		log.Errorf("queueWebhookDeliveries - failed to fetch webhooks: %v", err)
+		return
+	}
+	body, err := json.Marshal(struct {
+		Event       string `json:"event"`
+		PaymentHash string `json:"paymentHash"`
+		Amount      int64  `json:"amount"`
+		Description string `json:"description"`
+		Timestamp   int64  `json:"timestamp"`
+	}{"invoice_settled", payment.PaymentHash, payment.Amount, payment.Description, payment.CreationTimestamp})
+	if err != nil {
+		log.Errorf("queueWebhookDeliveries - failed to marshal payment %v: %v", payment.PaymentHash, err)
+		return
+	}
+	queued := false
+	for _, w := range webhooks {
+		if w.PaymentHash != "" && w.PaymentHash != payment.PaymentHash {
+			continue
+		}
+		d := &webhookDelivery{
+			WebhookID:            w.Id,
+			URL:                  w.Url,
+			PaymentHash:          payment.PaymentHash,
+			Body:                 body,
+			Signature:            webhookSignature(w.Secret, body),
+			NextAttemptTimestamp: trustedNow().Unix(),
+		}
+		if err := addWebhookDelivery(d); err != nil {
+			log.Errorf("queueWebhookDeliveries - failed to queue delivery to %v: %v", w.Url, err)
+			continue
+		}
+		queued = true
+		if w.PaymentHash != "" {
+			if err := deleteWebhook(w.Id); err != nil {
+				log.Errorf("queueWebhookDeliveries - failed to remove webhook %v: %v", w.Id, err)
+			}
+		}
+	}
+	if queued {
+		select {
+		case webhooksSignal <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func watchWebhooks() {
+	ticker := time.NewTicker(webhooksInterval)
+	defer ticker.Stop()
+	for {
+		deliverWebhooks()
+		select {
+		case <-ticker.C:
+		case <-webhooksSignal:
+		case <-quitChan:
+			return
+		}
+	}
+}
+
+// deliverWebhooks posts the pending deliveries which are due.
+func deliverWebhooks() {
+	deliveries, err := fetchWebhookDeliveries()
+	if err != nil {
+		log.Errorf("deliverWebhooks - failed to fetch deliveries: %v", err)
+		return
+	}
+	for _, d := range deliveries {
+		if d.Status != data.WebhookDelivery_PENDING || d.NextAttemptTimestamp > trustedNow().Unix() {
+			continue
+		}
+		err := postWebhook(d)
+		d.Attempts++
+		d.LastAttemptTimestamp = trustedNow().Unix()
+		switch {
+		case err == nil:
+			d.Status = data.WebhookDelivery_DELIVERED
+			d.LastError = ""
+		case int(d.Attempts) >= webhookRetryPolicy.maxAttempts:
+			log.Errorf("deliverWebhooks - giving up on %v for %v: %v", d.URL, d.PaymentHash, err)
+			d.Status = data.WebhookDelivery_FAILED
+			d.LastError = err.Error()
+		default:
+			log.Infof("deliverWebhooks - delivery to %v failed, retrying: %v", d.URL, err)
+			d.NextAttemptTimestamp = trustedNow().Add(webhookRetryPolicy.backoff(int(d.Attempts))).Unix()
+			d.LastError = err.Error()
+		}
+		if err := saveWebhookDelivery(d); err != nil {
+			log.Errorf("deliverWebhooks - failed to save delivery %v: %v", d.ID, err)
+		}
+	}
+}
+
+func postWebhook(d *webhookDelivery) error {
+	client, err := getWebhookHTTPClient()
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", d.URL, bytes.NewReader(d.Body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhookSignatureHeader, d.Signature)
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook replied with status %v", resp.StatusCode)
+	}
+	return nil
+}
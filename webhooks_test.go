@@ -0,0 +1,24 @@
+package breez
+
+import "testing"
+
+func TestAddWebhookAllowedHosts(t *testing.T) {
+	defer openTestDB(t)()
+	previousCfg := cfg
+	cfg = &Config{HTTPAllowedHosts: []string{"merchant.com"}}
+	defer func() { cfg = previousCfg }()
+
+	if _, err := AddWebhook("https://shop.merchant.com/paid", ""); err != nil {
+		t.Error("expected a webhook of an allowed host to be added ", err)
+	}
+	if _, err := AddWebhook("https://other.com/paid", ""); err == nil {
+		t.Error("expected a webhook of a host outside the allowlist to be rejected")
+	}
+	webhooks, err := GetWebhooks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(webhooks.Webhooks) != 1 {
+		t.Errorf("expected 1 webhook, got %v", len(webhooks.Webhooks))
+	}
+}